@@ -32,11 +32,55 @@ type WebhookModuleConfig struct {
 	// secret is the HMAC-SHA256 shared secret used to validate webhook payloads.
 	Secret string `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
 	// events is the list of GitHub event types to forward (empty = all events).
+	// An entry may name accepted actions, e.g. "pull_request:opened,synchronize".
 	Events []string `protobuf:"bytes,3,rep,name=events,proto3" json:"events,omitempty"`
 	// topic is the EventBus topic to publish normalized events to. Default: "git.events".
-	Topic         string `protobuf:"bytes,4,opt,name=topic,proto3" json:"topic,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	// It may use the {event_type}, {action}, {repository}, {owner}, and {repo}
+	// placeholders, e.g. "git.events.{event_type}".
+	Topic string `protobuf:"bytes,4,opt,name=topic,proto3" json:"topic,omitempty"`
+	// path is the HTTP route the module serves. Default: "/webhooks/github".
+	Path string `protobuf:"bytes,5,opt,name=path,proto3" json:"path,omitempty"`
+	// dedup_window is how long X-GitHub-Delivery IDs are remembered so
+	// redeliveries are dropped. "0s" disables de-duplication. Default: "24h".
+	DedupWindow string `protobuf:"bytes,6,opt,name=dedup_window,json=dedupWindow,proto3" json:"dedup_window,omitempty"`
+	// dedup_max_entries bounds the number of remembered delivery IDs. Default: 10000.
+	DedupMaxEntries int32 `protobuf:"varint,7,opt,name=dedup_max_entries,json=dedupMaxEntries,proto3" json:"dedup_max_entries,omitempty"`
+	// state_dir persists remembered delivery IDs, and queued events when
+	// async_publish is set, across restarts.
+	StateDir string `protobuf:"bytes,8,opt,name=state_dir,json=stateDir,proto3" json:"state_dir,omitempty"`
+	// repositories is an allowlist of owner/repo globs (e.g. "my-org/*").
+	Repositories []string `protobuf:"bytes,9,rep,name=repositories,proto3" json:"repositories,omitempty"`
+	// exclude_repositories drops events from matching owner/repo globs.
+	ExcludeRepositories []string `protobuf:"bytes,10,rep,name=exclude_repositories,json=excludeRepositories,proto3" json:"exclude_repositories,omitempty"`
+	// branches is an allowlist of branch or tag globs, by short name or full
+	// ref (e.g. "refs/heads/release/*"). Events without a branch or tag pass.
+	Branches []string `protobuf:"bytes,11,rep,name=branches,proto3" json:"branches,omitempty"`
+	// exclude_branches drops events on matching branches or tags.
+	ExcludeBranches []string `protobuf:"bytes,12,rep,name=exclude_branches,json=excludeBranches,proto3" json:"exclude_branches,omitempty"`
+	// exclude_senders drops events triggered by matching logins (e.g. "*[bot]").
+	ExcludeSenders []string `protobuf:"bytes,13,rep,name=exclude_senders,json=excludeSenders,proto3" json:"exclude_senders,omitempty"`
+	// labels requires the event to carry at least one matching label.
+	Labels []string `protobuf:"bytes,14,rep,name=labels,proto3" json:"labels,omitempty"`
+	// exclude_labels drops events carrying any matching label.
+	ExcludeLabels []string `protobuf:"bytes,15,rep,name=exclude_labels,json=excludeLabels,proto3" json:"exclude_labels,omitempty"`
+	// routes send matching events to their own topics. The first matching route
+	// wins; unmatched events go to topic.
+	Routes []*WebhookRoute `protobuf:"bytes,16,rep,name=routes,proto3" json:"routes,omitempty"`
+	// secrets are additional accepted secrets, tried after secret, so a secret
+	// can be rotated without downtime.
+	Secrets []*WebhookSecret `protobuf:"bytes,17,rep,name=secrets,proto3" json:"secrets,omitempty"`
+	// allow_sha1_signature accepts the legacy X-Hub-Signature (HMAC-SHA1) header
+	// when no X-Hub-Signature-256 header is sent.
+	AllowSha1Signature bool `protobuf:"varint,18,opt,name=allow_sha1_signature,json=allowSha1Signature,proto3" json:"allow_sha1_signature,omitempty"`
+	// async_publish acknowledges deliveries once they are queued in state_dir
+	// and publishes them in the background. Requires state_dir.
+	AsyncPublish bool `protobuf:"varint,19,opt,name=async_publish,json=asyncPublish,proto3" json:"async_publish,omitempty"`
+	// retry_initial_backoff is the first delay after a failed publish. Default: "1s".
+	RetryInitialBackoff string `protobuf:"bytes,20,opt,name=retry_initial_backoff,json=retryInitialBackoff,proto3" json:"retry_initial_backoff,omitempty"`
+	// retry_max_backoff caps the delay between publish retries. Default: "5m".
+	RetryMaxBackoff string `protobuf:"bytes,21,opt,name=retry_max_backoff,json=retryMaxBackoff,proto3" json:"retry_max_backoff,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *WebhookModuleConfig) Reset() {
@@ -97,6 +141,254 @@ func (x *WebhookModuleConfig) GetTopic() string {
 	return ""
 }
 
+func (x *WebhookModuleConfig) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *WebhookModuleConfig) GetDedupWindow() string {
+	if x != nil {
+		return x.DedupWindow
+	}
+	return ""
+}
+
+func (x *WebhookModuleConfig) GetDedupMaxEntries() int32 {
+	if x != nil {
+		return x.DedupMaxEntries
+	}
+	return 0
+}
+
+func (x *WebhookModuleConfig) GetStateDir() string {
+	if x != nil {
+		return x.StateDir
+	}
+	return ""
+}
+
+func (x *WebhookModuleConfig) GetRepositories() []string {
+	if x != nil {
+		return x.Repositories
+	}
+	return nil
+}
+
+func (x *WebhookModuleConfig) GetExcludeRepositories() []string {
+	if x != nil {
+		return x.ExcludeRepositories
+	}
+	return nil
+}
+
+func (x *WebhookModuleConfig) GetBranches() []string {
+	if x != nil {
+		return x.Branches
+	}
+	return nil
+}
+
+func (x *WebhookModuleConfig) GetExcludeBranches() []string {
+	if x != nil {
+		return x.ExcludeBranches
+	}
+	return nil
+}
+
+func (x *WebhookModuleConfig) GetExcludeSenders() []string {
+	if x != nil {
+		return x.ExcludeSenders
+	}
+	return nil
+}
+
+func (x *WebhookModuleConfig) GetLabels() []string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *WebhookModuleConfig) GetExcludeLabels() []string {
+	if x != nil {
+		return x.ExcludeLabels
+	}
+	return nil
+}
+
+func (x *WebhookModuleConfig) GetRoutes() []*WebhookRoute {
+	if x != nil {
+		return x.Routes
+	}
+	return nil
+}
+
+func (x *WebhookModuleConfig) GetSecrets() []*WebhookSecret {
+	if x != nil {
+		return x.Secrets
+	}
+	return nil
+}
+
+func (x *WebhookModuleConfig) GetAllowSha1Signature() bool {
+	if x != nil {
+		return x.AllowSha1Signature
+	}
+	return false
+}
+
+func (x *WebhookModuleConfig) GetAsyncPublish() bool {
+	if x != nil {
+		return x.AsyncPublish
+	}
+	return false
+}
+
+func (x *WebhookModuleConfig) GetRetryInitialBackoff() string {
+	if x != nil {
+		return x.RetryInitialBackoff
+	}
+	return ""
+}
+
+func (x *WebhookModuleConfig) GetRetryMaxBackoff() string {
+	if x != nil {
+		return x.RetryMaxBackoff
+	}
+	return ""
+}
+
+// WebhookSecret is one accepted git.webhook secret.
+type WebhookSecret struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// name identifies the secret in step outputs and logs.
+	Name   string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Secret string `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
+	// expires_at is an RFC 3339 time after which the secret is no longer accepted.
+	ExpiresAt     string `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebhookSecret) Reset() {
+	*x = WebhookSecret{}
+	mi := &file_github_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookSecret) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookSecret) ProtoMessage() {}
+
+func (x *WebhookSecret) ProtoReflect() protoreflect.Message {
+	mi := &file_github_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookSecret.ProtoReflect.Descriptor instead.
+func (*WebhookSecret) Descriptor() ([]byte, []int) {
+	return file_github_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *WebhookSecret) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *WebhookSecret) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *WebhookSecret) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+// WebhookRoute maps an event type, optional actions, and optional
+// repositories to a topic.
+type WebhookRoute struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// event is an event type, optionally with actions
+	// ("pull_request:opened,synchronize"), or "*" for every event type.
+	Event string `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	// repositories limits the route to matching owner/repo globs.
+	Repositories []string `protobuf:"bytes,2,rep,name=repositories,proto3" json:"repositories,omitempty"`
+	// topic is the topic template for matching events.
+	Topic         string `protobuf:"bytes,3,opt,name=topic,proto3" json:"topic,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebhookRoute) Reset() {
+	*x = WebhookRoute{}
+	mi := &file_github_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookRoute) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookRoute) ProtoMessage() {}
+
+func (x *WebhookRoute) ProtoReflect() protoreflect.Message {
+	mi := &file_github_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookRoute.ProtoReflect.Descriptor instead.
+func (*WebhookRoute) Descriptor() ([]byte, []int) {
+	return file_github_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *WebhookRoute) GetEvent() string {
+	if x != nil {
+		return x.Event
+	}
+	return ""
+}
+
+func (x *WebhookRoute) GetRepositories() []string {
+	if x != nil {
+		return x.Repositories
+	}
+	return nil
+}
+
+func (x *WebhookRoute) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
+}
+
 // GitHubAppModuleConfig is the typed config for the github.app module type.
 // Manages GitHub App authentication; generates installation access tokens from
 // an App private key and installation ID.
@@ -104,17 +396,30 @@ type GitHubAppModuleConfig struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// app_id is the numeric GitHub App ID.
 	AppId int64 `protobuf:"varint,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
-	// installation_id is the installation ID for the target org or user account.
+	// installation_id pins the module to one installation. When unset, the
+	// installation is looked up from the owner each step targets.
 	InstallationId int64 `protobuf:"varint,2,opt,name=installation_id,json=installationId,proto3" json:"installation_id,omitempty"`
 	// private_key is the PEM-encoded RSA private key for the GitHub App.
-	PrivateKey    string `protobuf:"bytes,3,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`
+	PrivateKey string `protobuf:"bytes,3,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`
+	// repositories limits minted tokens to these repository names.
+	Repositories []string `protobuf:"bytes,4,rep,name=repositories,proto3" json:"repositories,omitempty"`
+	// permissions limits minted tokens to these permission levels
+	// (e.g. contents: read).
+	Permissions map[string]string `protobuf:"bytes,5,rep,name=permissions,proto3" json:"permissions,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// api_base_url is the API root of the GitHub Enterprise Server instance
+	// the App is registered on. Default: "https://api.github.com".
+	ApiBaseUrl string `protobuf:"bytes,6,opt,name=api_base_url,json=apiBaseUrl,proto3" json:"api_base_url,omitempty"`
+	// upload_url overrides the release asset upload root.
+	UploadUrl string `protobuf:"bytes,7,opt,name=upload_url,json=uploadUrl,proto3" json:"upload_url,omitempty"`
+	// graphql_url overrides the GraphQL endpoint.
+	GraphqlUrl    string `protobuf:"bytes,8,opt,name=graphql_url,json=graphqlUrl,proto3" json:"graphql_url,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GitHubAppModuleConfig) Reset() {
 	*x = GitHubAppModuleConfig{}
-	mi := &file_github_proto_msgTypes[1]
+	mi := &file_github_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -126,7 +431,7 @@ func (x *GitHubAppModuleConfig) String() string {
 func (*GitHubAppModuleConfig) ProtoMessage() {}
 
 func (x *GitHubAppModuleConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_github_proto_msgTypes[1]
+	mi := &file_github_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -139,7 +444,7 @@ func (x *GitHubAppModuleConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GitHubAppModuleConfig.ProtoReflect.Descriptor instead.
 func (*GitHubAppModuleConfig) Descriptor() ([]byte, []int) {
-	return file_github_proto_rawDescGZIP(), []int{1}
+	return file_github_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *GitHubAppModuleConfig) GetAppId() int64 {
@@ -163,6 +468,41 @@ func (x *GitHubAppModuleConfig) GetPrivateKey() string {
 	return ""
 }
 
+func (x *GitHubAppModuleConfig) GetRepositories() []string {
+	if x != nil {
+		return x.Repositories
+	}
+	return nil
+}
+
+func (x *GitHubAppModuleConfig) GetPermissions() map[string]string {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+func (x *GitHubAppModuleConfig) GetApiBaseUrl() string {
+	if x != nil {
+		return x.ApiBaseUrl
+	}
+	return ""
+}
+
+func (x *GitHubAppModuleConfig) GetUploadUrl() string {
+	if x != nil {
+		return x.UploadUrl
+	}
+	return ""
+}
+
+func (x *GitHubAppModuleConfig) GetGraphqlUrl() string {
+	if x != nil {
+		return x.GraphqlUrl
+	}
+	return ""
+}
+
 // RunnerProviderModuleConfig is the typed config for the github.runner_provider module type.
 // Exposes an HTTP API for provisioning ephemeral GitHub Actions self-hosted runner tokens.
 type RunnerProviderModuleConfig struct {
@@ -187,7 +527,7 @@ type RunnerProviderModuleConfig struct {
 
 func (x *RunnerProviderModuleConfig) Reset() {
 	*x = RunnerProviderModuleConfig{}
-	mi := &file_github_proto_msgTypes[2]
+	mi := &file_github_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -199,7 +539,7 @@ func (x *RunnerProviderModuleConfig) String() string {
 func (*RunnerProviderModuleConfig) ProtoMessage() {}
 
 func (x *RunnerProviderModuleConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_github_proto_msgTypes[2]
+	mi := &file_github_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -212,7 +552,7 @@ func (x *RunnerProviderModuleConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RunnerProviderModuleConfig.ProtoReflect.Descriptor instead.
 func (*RunnerProviderModuleConfig) Descriptor() ([]byte, []int) {
-	return file_github_proto_rawDescGZIP(), []int{2}
+	return file_github_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *RunnerProviderModuleConfig) GetToken() string {
@@ -266,20 +606,26 @@ func (x *RunnerProviderModuleConfig) GetStateDir() string {
 
 // ActionTriggerConfig is the typed config for step.gh_action_trigger.
 type ActionTriggerConfig struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Owner         string                 `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
-	Repo          string                 `protobuf:"bytes,2,opt,name=repo,proto3" json:"repo,omitempty"`
-	Workflow      string                 `protobuf:"bytes,3,opt,name=workflow,proto3" json:"workflow,omitempty"`
-	Ref           string                 `protobuf:"bytes,4,opt,name=ref,proto3" json:"ref,omitempty"`
-	Inputs        *structpb.Struct       `protobuf:"bytes,5,opt,name=inputs,proto3" json:"inputs,omitempty"`
-	Token         string                 `protobuf:"bytes,6,opt,name=token,proto3" json:"token,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Owner             string                 `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Repo              string                 `protobuf:"bytes,2,opt,name=repo,proto3" json:"repo,omitempty"`
+	Workflow          string                 `protobuf:"bytes,3,opt,name=workflow,proto3" json:"workflow,omitempty"`
+	Ref               string                 `protobuf:"bytes,4,opt,name=ref,proto3" json:"ref,omitempty"`
+	Inputs            *structpb.Struct       `protobuf:"bytes,5,opt,name=inputs,proto3" json:"inputs,omitempty"`
+	Token             string                 `protobuf:"bytes,6,opt,name=token,proto3" json:"token,omitempty"`
+	AuthModule        string                 `protobuf:"bytes,7,opt,name=auth_module,json=authModule,proto3" json:"auth_module,omitempty"`
+	TokenRepositories []string               `protobuf:"bytes,8,rep,name=token_repositories,json=tokenRepositories,proto3" json:"token_repositories,omitempty"`
+	TokenPermissions  map[string]string      `protobuf:"bytes,9,rep,name=token_permissions,json=tokenPermissions,proto3" json:"token_permissions,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	CaptureRun        bool                   `protobuf:"varint,10,opt,name=capture_run,json=captureRun,proto3" json:"capture_run,omitempty"`
+	ApiBaseUrl        string                 `protobuf:"bytes,13,opt,name=api_base_url,json=apiBaseUrl,proto3" json:"api_base_url,omitempty"`
+	StrictTemplates   bool                   `protobuf:"varint,14,opt,name=strict_templates,json=strictTemplates,proto3" json:"strict_templates,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *ActionTriggerConfig) Reset() {
 	*x = ActionTriggerConfig{}
-	mi := &file_github_proto_msgTypes[3]
+	mi := &file_github_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -291,7 +637,7 @@ func (x *ActionTriggerConfig) String() string {
 func (*ActionTriggerConfig) ProtoMessage() {}
 
 func (x *ActionTriggerConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_github_proto_msgTypes[3]
+	mi := &file_github_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -304,7 +650,7 @@ func (x *ActionTriggerConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ActionTriggerConfig.ProtoReflect.Descriptor instead.
 func (*ActionTriggerConfig) Descriptor() ([]byte, []int) {
-	return file_github_proto_rawDescGZIP(), []int{3}
+	return file_github_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *ActionTriggerConfig) GetOwner() string {
@@ -349,29 +695,71 @@ func (x *ActionTriggerConfig) GetToken() string {
 	return ""
 }
 
-// ActionTriggerInput carries runtime inputs for step.gh_action_trigger.
-type ActionTriggerInput struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Data          *structpb.Struct       `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ActionTriggerConfig) GetAuthModule() string {
+	if x != nil {
+		return x.AuthModule
+	}
+	return ""
 }
 
-func (x *ActionTriggerInput) Reset() {
-	*x = ActionTriggerInput{}
-	mi := &file_github_proto_msgTypes[4]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *ActionTriggerConfig) GetTokenRepositories() []string {
+	if x != nil {
+		return x.TokenRepositories
+	}
+	return nil
 }
 
-func (x *ActionTriggerInput) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ActionTriggerConfig) GetTokenPermissions() map[string]string {
+	if x != nil {
+		return x.TokenPermissions
+	}
+	return nil
+}
+
+func (x *ActionTriggerConfig) GetCaptureRun() bool {
+	if x != nil {
+		return x.CaptureRun
+	}
+	return false
+}
+
+func (x *ActionTriggerConfig) GetApiBaseUrl() string {
+	if x != nil {
+		return x.ApiBaseUrl
+	}
+	return ""
+}
+
+func (x *ActionTriggerConfig) GetStrictTemplates() bool {
+	if x != nil {
+		return x.StrictTemplates
+	}
+	return false
+}
+
+// ActionTriggerInput carries runtime inputs for step.gh_action_trigger.
+type ActionTriggerInput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *structpb.Struct       `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActionTriggerInput) Reset() {
+	*x = ActionTriggerInput{}
+	mi := &file_github_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActionTriggerInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
 func (*ActionTriggerInput) ProtoMessage() {}
 
 func (x *ActionTriggerInput) ProtoReflect() protoreflect.Message {
-	mi := &file_github_proto_msgTypes[4]
+	mi := &file_github_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -384,7 +772,7 @@ func (x *ActionTriggerInput) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ActionTriggerInput.ProtoReflect.Descriptor instead.
 func (*ActionTriggerInput) Descriptor() ([]byte, []int) {
-	return file_github_proto_rawDescGZIP(), []int{4}
+	return file_github_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *ActionTriggerInput) GetData() *structpb.Struct {
@@ -396,19 +784,23 @@ func (x *ActionTriggerInput) GetData() *structpb.Struct {
 
 // ActionTriggerOutput holds the result of step.gh_action_trigger.
 type ActionTriggerOutput struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Triggered     bool                   `protobuf:"varint,1,opt,name=triggered,proto3" json:"triggered,omitempty"`
-	Owner         string                 `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
-	Repo          string                 `protobuf:"bytes,3,opt,name=repo,proto3" json:"repo,omitempty"`
-	Workflow      string                 `protobuf:"bytes,4,opt,name=workflow,proto3" json:"workflow,omitempty"`
-	Ref           string                 `protobuf:"bytes,5,opt,name=ref,proto3" json:"ref,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Triggered      bool                   `protobuf:"varint,1,opt,name=triggered,proto3" json:"triggered,omitempty"`
+	Owner          string                 `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	Repo           string                 `protobuf:"bytes,3,opt,name=repo,proto3" json:"repo,omitempty"`
+	Workflow       string                 `protobuf:"bytes,4,opt,name=workflow,proto3" json:"workflow,omitempty"`
+	Ref            string                 `protobuf:"bytes,5,opt,name=ref,proto3" json:"ref,omitempty"`
+	RunId          int64                  `protobuf:"varint,6,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	HtmlUrl        string                 `protobuf:"bytes,7,opt,name=html_url,json=htmlUrl,proto3" json:"html_url,omitempty"`
+	HeadSha        string                 `protobuf:"bytes,8,opt,name=head_sha,json=headSha,proto3" json:"head_sha,omitempty"`
+	RunLookupError string                 `protobuf:"bytes,10,opt,name=run_lookup_error,json=runLookupError,proto3" json:"run_lookup_error,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *ActionTriggerOutput) Reset() {
 	*x = ActionTriggerOutput{}
-	mi := &file_github_proto_msgTypes[5]
+	mi := &file_github_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -420,7 +812,7 @@ func (x *ActionTriggerOutput) String() string {
 func (*ActionTriggerOutput) ProtoMessage() {}
 
 func (x *ActionTriggerOutput) ProtoReflect() protoreflect.Message {
-	mi := &file_github_proto_msgTypes[5]
+	mi := &file_github_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -433,7 +825,7 @@ func (x *ActionTriggerOutput) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ActionTriggerOutput.ProtoReflect.Descriptor instead.
 func (*ActionTriggerOutput) Descriptor() ([]byte, []int) {
-	return file_github_proto_rawDescGZIP(), []int{5}
+	return file_github_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *ActionTriggerOutput) GetTriggered() bool {
@@ -471,23 +863,61 @@ func (x *ActionTriggerOutput) GetRef() string {
 	return ""
 }
 
+func (x *ActionTriggerOutput) GetRunId() int64 {
+	if x != nil {
+		return x.RunId
+	}
+	return 0
+}
+
+func (x *ActionTriggerOutput) GetHtmlUrl() string {
+	if x != nil {
+		return x.HtmlUrl
+	}
+	return ""
+}
+
+func (x *ActionTriggerOutput) GetHeadSha() string {
+	if x != nil {
+		return x.HeadSha
+	}
+	return ""
+}
+
+func (x *ActionTriggerOutput) GetRunLookupError() string {
+	if x != nil {
+		return x.RunLookupError
+	}
+	return ""
+}
+
 // ActionStatusConfig is the typed config for step.gh_action_status.
 type ActionStatusConfig struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Owner         string                 `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
-	Repo          string                 `protobuf:"bytes,2,opt,name=repo,proto3" json:"repo,omitempty"`
-	RunId         string                 `protobuf:"bytes,3,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
-	Token         string                 `protobuf:"bytes,4,opt,name=token,proto3" json:"token,omitempty"`
-	Wait          bool                   `protobuf:"varint,5,opt,name=wait,proto3" json:"wait,omitempty"`
-	PollInterval  string                 `protobuf:"bytes,6,opt,name=poll_interval,json=pollInterval,proto3" json:"poll_interval,omitempty"`
-	Timeout       string                 `protobuf:"bytes,7,opt,name=timeout,proto3" json:"timeout,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Owner              string                 `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Repo               string                 `protobuf:"bytes,2,opt,name=repo,proto3" json:"repo,omitempty"`
+	RunId              string                 `protobuf:"bytes,3,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	Token              string                 `protobuf:"bytes,4,opt,name=token,proto3" json:"token,omitempty"`
+	Wait               bool                   `protobuf:"varint,5,opt,name=wait,proto3" json:"wait,omitempty"`
+	PollInterval       string                 `protobuf:"bytes,6,opt,name=poll_interval,json=pollInterval,proto3" json:"poll_interval,omitempty"`
+	Timeout            string                 `protobuf:"bytes,7,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	AuthModule         string                 `protobuf:"bytes,8,opt,name=auth_module,json=authModule,proto3" json:"auth_module,omitempty"`
+	TokenRepositories  []string               `protobuf:"bytes,9,rep,name=token_repositories,json=tokenRepositories,proto3" json:"token_repositories,omitempty"`
+	TokenPermissions   map[string]string      `protobuf:"bytes,10,rep,name=token_permissions,json=tokenPermissions,proto3" json:"token_permissions,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	IncludeJobs        bool                   `protobuf:"varint,11,opt,name=include_jobs,json=includeJobs,proto3" json:"include_jobs,omitempty"`
+	IncludeAnnotations bool                   `protobuf:"varint,12,opt,name=include_annotations,json=includeAnnotations,proto3" json:"include_annotations,omitempty"`
+	LogTailLines       int32                  `protobuf:"varint,13,opt,name=log_tail_lines,json=logTailLines,proto3" json:"log_tail_lines,omitempty"`
+	MaxPollInterval    string                 `protobuf:"bytes,14,opt,name=max_poll_interval,json=maxPollInterval,proto3" json:"max_poll_interval,omitempty"`
+	RateLimitReserve   int32                  `protobuf:"varint,15,opt,name=rate_limit_reserve,json=rateLimitReserve,proto3" json:"rate_limit_reserve,omitempty"`
+	ApiBaseUrl         string                 `protobuf:"bytes,16,opt,name=api_base_url,json=apiBaseUrl,proto3" json:"api_base_url,omitempty"`
+	StrictTemplates    bool                   `protobuf:"varint,17,opt,name=strict_templates,json=strictTemplates,proto3" json:"strict_templates,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *ActionStatusConfig) Reset() {
 	*x = ActionStatusConfig{}
-	mi := &file_github_proto_msgTypes[6]
+	mi := &file_github_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -499,7 +929,7 @@ func (x *ActionStatusConfig) String() string {
 func (*ActionStatusConfig) ProtoMessage() {}
 
 func (x *ActionStatusConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_github_proto_msgTypes[6]
+	mi := &file_github_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -512,7 +942,7 @@ func (x *ActionStatusConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ActionStatusConfig.ProtoReflect.Descriptor instead.
 func (*ActionStatusConfig) Descriptor() ([]byte, []int) {
-	return file_github_proto_rawDescGZIP(), []int{6}
+	return file_github_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *ActionStatusConfig) GetOwner() string {
@@ -564,6 +994,76 @@ func (x *ActionStatusConfig) GetTimeout() string {
 	return ""
 }
 
+func (x *ActionStatusConfig) GetAuthModule() string {
+	if x != nil {
+		return x.AuthModule
+	}
+	return ""
+}
+
+func (x *ActionStatusConfig) GetTokenRepositories() []string {
+	if x != nil {
+		return x.TokenRepositories
+	}
+	return nil
+}
+
+func (x *ActionStatusConfig) GetTokenPermissions() map[string]string {
+	if x != nil {
+		return x.TokenPermissions
+	}
+	return nil
+}
+
+func (x *ActionStatusConfig) GetIncludeJobs() bool {
+	if x != nil {
+		return x.IncludeJobs
+	}
+	return false
+}
+
+func (x *ActionStatusConfig) GetIncludeAnnotations() bool {
+	if x != nil {
+		return x.IncludeAnnotations
+	}
+	return false
+}
+
+func (x *ActionStatusConfig) GetLogTailLines() int32 {
+	if x != nil {
+		return x.LogTailLines
+	}
+	return 0
+}
+
+func (x *ActionStatusConfig) GetMaxPollInterval() string {
+	if x != nil {
+		return x.MaxPollInterval
+	}
+	return ""
+}
+
+func (x *ActionStatusConfig) GetRateLimitReserve() int32 {
+	if x != nil {
+		return x.RateLimitReserve
+	}
+	return 0
+}
+
+func (x *ActionStatusConfig) GetApiBaseUrl() string {
+	if x != nil {
+		return x.ApiBaseUrl
+	}
+	return ""
+}
+
+func (x *ActionStatusConfig) GetStrictTemplates() bool {
+	if x != nil {
+		return x.StrictTemplates
+	}
+	return false
+}
+
 // ActionStatusInput carries runtime inputs for step.gh_action_status.
 type ActionStatusInput struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -574,7 +1074,7 @@ type ActionStatusInput struct {
 
 func (x *ActionStatusInput) Reset() {
 	*x = ActionStatusInput{}
-	mi := &file_github_proto_msgTypes[7]
+	mi := &file_github_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -586,7 +1086,7 @@ func (x *ActionStatusInput) String() string {
 func (*ActionStatusInput) ProtoMessage() {}
 
 func (x *ActionStatusInput) ProtoReflect() protoreflect.Message {
-	mi := &file_github_proto_msgTypes[7]
+	mi := &file_github_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -599,7 +1099,7 @@ func (x *ActionStatusInput) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ActionStatusInput.ProtoReflect.Descriptor instead.
 func (*ActionStatusInput) Descriptor() ([]byte, []int) {
-	return file_github_proto_rawDescGZIP(), []int{7}
+	return file_github_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *ActionStatusInput) GetData() *structpb.Struct {
@@ -611,18 +1111,22 @@ func (x *ActionStatusInput) GetData() *structpb.Struct {
 
 // ActionStatusOutput holds the result of step.gh_action_status.
 type ActionStatusOutput struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	RunId         int64                  `protobuf:"varint,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
-	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
-	Conclusion    string                 `protobuf:"bytes,3,opt,name=conclusion,proto3" json:"conclusion,omitempty"`
-	Url           string                 `protobuf:"bytes,4,opt,name=url,proto3" json:"url,omitempty"`
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	RunId         int64                     `protobuf:"varint,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	Status        string                    `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Conclusion    string                    `protobuf:"bytes,3,opt,name=conclusion,proto3" json:"conclusion,omitempty"`
+	Url           string                    `protobuf:"bytes,4,opt,name=url,proto3" json:"url,omitempty"`
+	Jobs          []*WorkflowJobSummary     `protobuf:"bytes,5,rep,name=jobs,proto3" json:"jobs,omitempty"`
+	Annotations   []*CheckAnnotationSummary `protobuf:"bytes,6,rep,name=annotations,proto3" json:"annotations,omitempty"`
+	FailedJobLogs map[string]string         `protobuf:"bytes,7,rep,name=failed_job_logs,json=failedJobLogs,proto3" json:"failed_job_logs,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	DetailsError  string                    `protobuf:"bytes,8,opt,name=details_error,json=detailsError,proto3" json:"details_error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ActionStatusOutput) Reset() {
 	*x = ActionStatusOutput{}
-	mi := &file_github_proto_msgTypes[8]
+	mi := &file_github_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -634,7 +1138,7 @@ func (x *ActionStatusOutput) String() string {
 func (*ActionStatusOutput) ProtoMessage() {}
 
 func (x *ActionStatusOutput) ProtoReflect() protoreflect.Message {
-	mi := &file_github_proto_msgTypes[8]
+	mi := &file_github_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -647,7 +1151,7 @@ func (x *ActionStatusOutput) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ActionStatusOutput.ProtoReflect.Descriptor instead.
 func (*ActionStatusOutput) Descriptor() ([]byte, []int) {
-	return file_github_proto_rawDescGZIP(), []int{8}
+	return file_github_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *ActionStatusOutput) GetRunId() int64 {
@@ -678,36 +1182,66 @@ func (x *ActionStatusOutput) GetUrl() string {
 	return ""
 }
 
-// PRCreateConfig is the typed config for step.gh_pr_create.
-type PRCreateConfig struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Owner         string                 `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
-	Repo          string                 `protobuf:"bytes,2,opt,name=repo,proto3" json:"repo,omitempty"`
-	Head          string                 `protobuf:"bytes,3,opt,name=head,proto3" json:"head,omitempty"`
-	Base          string                 `protobuf:"bytes,4,opt,name=base,proto3" json:"base,omitempty"`
-	Title         string                 `protobuf:"bytes,5,opt,name=title,proto3" json:"title,omitempty"`
-	Body          string                 `protobuf:"bytes,6,opt,name=body,proto3" json:"body,omitempty"`
-	Draft         bool                   `protobuf:"varint,7,opt,name=draft,proto3" json:"draft,omitempty"`
-	Token         string                 `protobuf:"bytes,8,opt,name=token,proto3" json:"token,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ActionStatusOutput) GetJobs() []*WorkflowJobSummary {
+	if x != nil {
+		return x.Jobs
+	}
+	return nil
 }
 
-func (x *PRCreateConfig) Reset() {
-	*x = PRCreateConfig{}
-	mi := &file_github_proto_msgTypes[9]
+func (x *ActionStatusOutput) GetAnnotations() []*CheckAnnotationSummary {
+	if x != nil {
+		return x.Annotations
+	}
+	return nil
+}
+
+func (x *ActionStatusOutput) GetFailedJobLogs() map[string]string {
+	if x != nil {
+		return x.FailedJobLogs
+	}
+	return nil
+}
+
+func (x *ActionStatusOutput) GetDetailsError() string {
+	if x != nil {
+		return x.DetailsError
+	}
+	return ""
+}
+
+// WorkflowJobSummary describes one job of a workflow run.
+type WorkflowJobSummary struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name            string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Status          string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Conclusion      string                 `protobuf:"bytes,4,opt,name=conclusion,proto3" json:"conclusion,omitempty"`
+	RunnerName      string                 `protobuf:"bytes,5,opt,name=runner_name,json=runnerName,proto3" json:"runner_name,omitempty"`
+	Url             string                 `protobuf:"bytes,6,opt,name=url,proto3" json:"url,omitempty"`
+	FailedStep      string                 `protobuf:"bytes,7,opt,name=failed_step,json=failedStep,proto3" json:"failed_step,omitempty"`
+	StartedAt       string                 `protobuf:"bytes,8,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	CompletedAt     string                 `protobuf:"bytes,9,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	DurationSeconds int64                  `protobuf:"varint,10,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *WorkflowJobSummary) Reset() {
+	*x = WorkflowJobSummary{}
+	mi := &file_github_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PRCreateConfig) String() string {
+func (x *WorkflowJobSummary) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PRCreateConfig) ProtoMessage() {}
+func (*WorkflowJobSummary) ProtoMessage() {}
 
-func (x *PRCreateConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_github_proto_msgTypes[9]
+func (x *WorkflowJobSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_github_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -718,90 +1252,110 @@ func (x *PRCreateConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PRCreateConfig.ProtoReflect.Descriptor instead.
-func (*PRCreateConfig) Descriptor() ([]byte, []int) {
-	return file_github_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use WorkflowJobSummary.ProtoReflect.Descriptor instead.
+func (*WorkflowJobSummary) Descriptor() ([]byte, []int) {
+	return file_github_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *PRCreateConfig) GetOwner() string {
+func (x *WorkflowJobSummary) GetId() int64 {
 	if x != nil {
-		return x.Owner
+		return x.Id
+	}
+	return 0
+}
+
+func (x *WorkflowJobSummary) GetName() string {
+	if x != nil {
+		return x.Name
 	}
 	return ""
 }
 
-func (x *PRCreateConfig) GetRepo() string {
+func (x *WorkflowJobSummary) GetStatus() string {
 	if x != nil {
-		return x.Repo
+		return x.Status
 	}
 	return ""
 }
 
-func (x *PRCreateConfig) GetHead() string {
+func (x *WorkflowJobSummary) GetConclusion() string {
 	if x != nil {
-		return x.Head
+		return x.Conclusion
 	}
 	return ""
 }
 
-func (x *PRCreateConfig) GetBase() string {
+func (x *WorkflowJobSummary) GetRunnerName() string {
 	if x != nil {
-		return x.Base
+		return x.RunnerName
 	}
 	return ""
 }
 
-func (x *PRCreateConfig) GetTitle() string {
+func (x *WorkflowJobSummary) GetUrl() string {
 	if x != nil {
-		return x.Title
+		return x.Url
 	}
 	return ""
 }
 
-func (x *PRCreateConfig) GetBody() string {
+func (x *WorkflowJobSummary) GetFailedStep() string {
 	if x != nil {
-		return x.Body
+		return x.FailedStep
 	}
 	return ""
 }
 
-func (x *PRCreateConfig) GetDraft() bool {
+func (x *WorkflowJobSummary) GetStartedAt() string {
 	if x != nil {
-		return x.Draft
+		return x.StartedAt
 	}
-	return false
+	return ""
 }
 
-func (x *PRCreateConfig) GetToken() string {
+func (x *WorkflowJobSummary) GetCompletedAt() string {
 	if x != nil {
-		return x.Token
+		return x.CompletedAt
 	}
 	return ""
 }
 
-// PRCreateInput carries runtime inputs for step.gh_pr_create.
-type PRCreateInput struct {
+func (x *WorkflowJobSummary) GetDurationSeconds() int64 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+// CheckAnnotationSummary is a check-run annotation of a failed job.
+type CheckAnnotationSummary struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Data          *structpb.Struct       `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Job           string                 `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	Path          string                 `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	StartLine     int32                  `protobuf:"varint,3,opt,name=start_line,json=startLine,proto3" json:"start_line,omitempty"`
+	EndLine       int32                  `protobuf:"varint,4,opt,name=end_line,json=endLine,proto3" json:"end_line,omitempty"`
+	Level         string                 `protobuf:"bytes,5,opt,name=level,proto3" json:"level,omitempty"`
+	Title         string                 `protobuf:"bytes,6,opt,name=title,proto3" json:"title,omitempty"`
+	Message       string                 `protobuf:"bytes,7,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PRCreateInput) Reset() {
-	*x = PRCreateInput{}
-	mi := &file_github_proto_msgTypes[10]
+func (x *CheckAnnotationSummary) Reset() {
+	*x = CheckAnnotationSummary{}
+	mi := &file_github_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PRCreateInput) String() string {
+func (x *CheckAnnotationSummary) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PRCreateInput) ProtoMessage() {}
+func (*CheckAnnotationSummary) ProtoMessage() {}
 
-func (x *PRCreateInput) ProtoReflect() protoreflect.Message {
-	mi := &file_github_proto_msgTypes[10]
+func (x *CheckAnnotationSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_github_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -812,115 +1366,92 @@ func (x *PRCreateInput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PRCreateInput.ProtoReflect.Descriptor instead.
-func (*PRCreateInput) Descriptor() ([]byte, []int) {
-	return file_github_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use CheckAnnotationSummary.ProtoReflect.Descriptor instead.
+func (*CheckAnnotationSummary) Descriptor() ([]byte, []int) {
+	return file_github_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *PRCreateInput) GetData() *structpb.Struct {
+func (x *CheckAnnotationSummary) GetJob() string {
 	if x != nil {
-		return x.Data
+		return x.Job
 	}
-	return nil
+	return ""
 }
 
-// PRCreateOutput holds the result of step.gh_pr_create.
-type PRCreateOutput struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Number        int64                  `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"`
-	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
-	Id            int64                  `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"`
-	State         string                 `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *CheckAnnotationSummary) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
 }
 
-func (x *PRCreateOutput) Reset() {
-	*x = PRCreateOutput{}
-	mi := &file_github_proto_msgTypes[11]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *PRCreateOutput) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*PRCreateOutput) ProtoMessage() {}
-
-func (x *PRCreateOutput) ProtoReflect() protoreflect.Message {
-	mi := &file_github_proto_msgTypes[11]
+func (x *CheckAnnotationSummary) GetStartLine() int32 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.StartLine
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use PRCreateOutput.ProtoReflect.Descriptor instead.
-func (*PRCreateOutput) Descriptor() ([]byte, []int) {
-	return file_github_proto_rawDescGZIP(), []int{11}
+	return 0
 }
 
-func (x *PRCreateOutput) GetNumber() int64 {
+func (x *CheckAnnotationSummary) GetEndLine() int32 {
 	if x != nil {
-		return x.Number
+		return x.EndLine
 	}
 	return 0
 }
 
-func (x *PRCreateOutput) GetUrl() string {
+func (x *CheckAnnotationSummary) GetLevel() string {
 	if x != nil {
-		return x.Url
+		return x.Level
 	}
 	return ""
 }
 
-func (x *PRCreateOutput) GetId() int64 {
+func (x *CheckAnnotationSummary) GetTitle() string {
 	if x != nil {
-		return x.Id
+		return x.Title
 	}
-	return 0
+	return ""
 }
 
-func (x *PRCreateOutput) GetState() string {
+func (x *CheckAnnotationSummary) GetMessage() string {
 	if x != nil {
-		return x.State
+		return x.Message
 	}
 	return ""
 }
 
-// PRMergeConfig is the typed config for step.gh_pr_merge.
-type PRMergeConfig struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Owner         string                 `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
-	Repo          string                 `protobuf:"bytes,2,opt,name=repo,proto3" json:"repo,omitempty"`
-	PrNumber      int64                  `protobuf:"varint,3,opt,name=pr_number,json=prNumber,proto3" json:"pr_number,omitempty"`
-	CommitTitle   string                 `protobuf:"bytes,4,opt,name=commit_title,json=commitTitle,proto3" json:"commit_title,omitempty"`
-	Method        string                 `protobuf:"bytes,5,opt,name=method,proto3" json:"method,omitempty"`
-	Token         string                 `protobuf:"bytes,6,opt,name=token,proto3" json:"token,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *PRMergeConfig) Reset() {
-	*x = PRMergeConfig{}
-	mi := &file_github_proto_msgTypes[12]
+// ActionCancelConfig is the typed config for step.gh_action_cancel.
+type ActionCancelConfig struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Owner             string                 `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Repo              string                 `protobuf:"bytes,2,opt,name=repo,proto3" json:"repo,omitempty"`
+	RunId             string                 `protobuf:"bytes,3,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	Token             string                 `protobuf:"bytes,4,opt,name=token,proto3" json:"token,omitempty"`
+	Force             bool                   `protobuf:"varint,5,opt,name=force,proto3" json:"force,omitempty"`
+	AuthModule        string                 `protobuf:"bytes,6,opt,name=auth_module,json=authModule,proto3" json:"auth_module,omitempty"`
+	TokenRepositories []string               `protobuf:"bytes,7,rep,name=token_repositories,json=tokenRepositories,proto3" json:"token_repositories,omitempty"`
+	TokenPermissions  map[string]string      `protobuf:"bytes,8,rep,name=token_permissions,json=tokenPermissions,proto3" json:"token_permissions,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	ApiBaseUrl        string                 `protobuf:"bytes,9,opt,name=api_base_url,json=apiBaseUrl,proto3" json:"api_base_url,omitempty"`
+	StrictTemplates   bool                   `protobuf:"varint,10,opt,name=strict_templates,json=strictTemplates,proto3" json:"strict_templates,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ActionCancelConfig) Reset() {
+	*x = ActionCancelConfig{}
+	mi := &file_github_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PRMergeConfig) String() string {
+func (x *ActionCancelConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PRMergeConfig) ProtoMessage() {}
+func (*ActionCancelConfig) ProtoMessage() {}
 
-func (x *PRMergeConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_github_proto_msgTypes[12]
+func (x *ActionCancelConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_github_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -931,76 +1462,104 @@ func (x *PRMergeConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PRMergeConfig.ProtoReflect.Descriptor instead.
-func (*PRMergeConfig) Descriptor() ([]byte, []int) {
-	return file_github_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use ActionCancelConfig.ProtoReflect.Descriptor instead.
+func (*ActionCancelConfig) Descriptor() ([]byte, []int) {
+	return file_github_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *PRMergeConfig) GetOwner() string {
+func (x *ActionCancelConfig) GetOwner() string {
 	if x != nil {
 		return x.Owner
 	}
 	return ""
 }
 
-func (x *PRMergeConfig) GetRepo() string {
+func (x *ActionCancelConfig) GetRepo() string {
 	if x != nil {
 		return x.Repo
 	}
 	return ""
 }
 
-func (x *PRMergeConfig) GetPrNumber() int64 {
+func (x *ActionCancelConfig) GetRunId() string {
 	if x != nil {
-		return x.PrNumber
+		return x.RunId
 	}
-	return 0
+	return ""
 }
 
-func (x *PRMergeConfig) GetCommitTitle() string {
+func (x *ActionCancelConfig) GetToken() string {
 	if x != nil {
-		return x.CommitTitle
+		return x.Token
 	}
 	return ""
 }
 
-func (x *PRMergeConfig) GetMethod() string {
+func (x *ActionCancelConfig) GetForce() bool {
 	if x != nil {
-		return x.Method
+		return x.Force
+	}
+	return false
+}
+
+func (x *ActionCancelConfig) GetAuthModule() string {
+	if x != nil {
+		return x.AuthModule
 	}
 	return ""
 }
 
-func (x *PRMergeConfig) GetToken() string {
+func (x *ActionCancelConfig) GetTokenRepositories() []string {
 	if x != nil {
-		return x.Token
+		return x.TokenRepositories
+	}
+	return nil
+}
+
+func (x *ActionCancelConfig) GetTokenPermissions() map[string]string {
+	if x != nil {
+		return x.TokenPermissions
+	}
+	return nil
+}
+
+func (x *ActionCancelConfig) GetApiBaseUrl() string {
+	if x != nil {
+		return x.ApiBaseUrl
 	}
 	return ""
 }
 
-// PRMergeInput carries runtime inputs for step.gh_pr_merge.
-type PRMergeInput struct {
+func (x *ActionCancelConfig) GetStrictTemplates() bool {
+	if x != nil {
+		return x.StrictTemplates
+	}
+	return false
+}
+
+// ActionCancelInput carries runtime inputs for step.gh_action_cancel.
+type ActionCancelInput struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Data          *structpb.Struct       `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PRMergeInput) Reset() {
-	*x = PRMergeInput{}
-	mi := &file_github_proto_msgTypes[13]
+func (x *ActionCancelInput) Reset() {
+	*x = ActionCancelInput{}
+	mi := &file_github_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PRMergeInput) String() string {
+func (x *ActionCancelInput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PRMergeInput) ProtoMessage() {}
+func (*ActionCancelInput) ProtoMessage() {}
 
-func (x *PRMergeInput) ProtoReflect() protoreflect.Message {
-	mi := &file_github_proto_msgTypes[13]
+func (x *ActionCancelInput) ProtoReflect() protoreflect.Message {
+	mi := &file_github_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1011,43 +1570,43 @@ func (x *PRMergeInput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PRMergeInput.ProtoReflect.Descriptor instead.
-func (*PRMergeInput) Descriptor() ([]byte, []int) {
-	return file_github_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use ActionCancelInput.ProtoReflect.Descriptor instead.
+func (*ActionCancelInput) Descriptor() ([]byte, []int) {
+	return file_github_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *PRMergeInput) GetData() *structpb.Struct {
+func (x *ActionCancelInput) GetData() *structpb.Struct {
 	if x != nil {
 		return x.Data
 	}
 	return nil
 }
 
-// PRMergeOutput holds the result of step.gh_pr_merge.
-type PRMergeOutput struct {
+// ActionCancelOutput holds the result of step.gh_action_cancel.
+type ActionCancelOutput struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Merged        bool                   `protobuf:"varint,1,opt,name=merged,proto3" json:"merged,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Sha           string                 `protobuf:"bytes,3,opt,name=sha,proto3" json:"sha,omitempty"`
+	RunId         int64                  `protobuf:"varint,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	Cancelled     bool                   `protobuf:"varint,2,opt,name=cancelled,proto3" json:"cancelled,omitempty"`
+	Force         bool                   `protobuf:"varint,3,opt,name=force,proto3" json:"force,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PRMergeOutput) Reset() {
-	*x = PRMergeOutput{}
-	mi := &file_github_proto_msgTypes[14]
+func (x *ActionCancelOutput) Reset() {
+	*x = ActionCancelOutput{}
+	mi := &file_github_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PRMergeOutput) String() string {
+func (x *ActionCancelOutput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PRMergeOutput) ProtoMessage() {}
+func (*ActionCancelOutput) ProtoMessage() {}
 
-func (x *PRMergeOutput) ProtoReflect() protoreflect.Message {
-	mi := &file_github_proto_msgTypes[14]
+func (x *ActionCancelOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_github_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1058,59 +1617,65 @@ func (x *PRMergeOutput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PRMergeOutput.ProtoReflect.Descriptor instead.
-func (*PRMergeOutput) Descriptor() ([]byte, []int) {
-	return file_github_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use ActionCancelOutput.ProtoReflect.Descriptor instead.
+func (*ActionCancelOutput) Descriptor() ([]byte, []int) {
+	return file_github_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *PRMergeOutput) GetMerged() bool {
+func (x *ActionCancelOutput) GetRunId() int64 {
 	if x != nil {
-		return x.Merged
+		return x.RunId
 	}
-	return false
+	return 0
 }
 
-func (x *PRMergeOutput) GetMessage() string {
+func (x *ActionCancelOutput) GetCancelled() bool {
 	if x != nil {
-		return x.Message
+		return x.Cancelled
 	}
-	return ""
+	return false
 }
 
-func (x *PRMergeOutput) GetSha() string {
+func (x *ActionCancelOutput) GetForce() bool {
 	if x != nil {
-		return x.Sha
+		return x.Force
 	}
-	return ""
-}
-
-// PRCommentConfig is the typed config for step.gh_pr_comment.
-type PRCommentConfig struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Owner         string                 `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
-	Repo          string                 `protobuf:"bytes,2,opt,name=repo,proto3" json:"repo,omitempty"`
-	PrNumber      int64                  `protobuf:"varint,3,opt,name=pr_number,json=prNumber,proto3" json:"pr_number,omitempty"`
-	Body          string                 `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
-	Token         string                 `protobuf:"bytes,5,opt,name=token,proto3" json:"token,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	return false
 }
 
-func (x *PRCommentConfig) Reset() {
-	*x = PRCommentConfig{}
-	mi := &file_github_proto_msgTypes[15]
+// ActionRerunConfig is the typed config for step.gh_action_rerun.
+type ActionRerunConfig struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Owner              string                 `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Repo               string                 `protobuf:"bytes,2,opt,name=repo,proto3" json:"repo,omitempty"`
+	RunId              string                 `protobuf:"bytes,3,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	Token              string                 `protobuf:"bytes,4,opt,name=token,proto3" json:"token,omitempty"`
+	FailedOnly         bool                   `protobuf:"varint,5,opt,name=failed_only,json=failedOnly,proto3" json:"failed_only,omitempty"`
+	EnableDebugLogging bool                   `protobuf:"varint,6,opt,name=enable_debug_logging,json=enableDebugLogging,proto3" json:"enable_debug_logging,omitempty"`
+	AuthModule         string                 `protobuf:"bytes,7,opt,name=auth_module,json=authModule,proto3" json:"auth_module,omitempty"`
+	TokenRepositories  []string               `protobuf:"bytes,8,rep,name=token_repositories,json=tokenRepositories,proto3" json:"token_repositories,omitempty"`
+	TokenPermissions   map[string]string      `protobuf:"bytes,9,rep,name=token_permissions,json=tokenPermissions,proto3" json:"token_permissions,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	ApiBaseUrl         string                 `protobuf:"bytes,10,opt,name=api_base_url,json=apiBaseUrl,proto3" json:"api_base_url,omitempty"`
+	StrictTemplates    bool                   `protobuf:"varint,11,opt,name=strict_templates,json=strictTemplates,proto3" json:"strict_templates,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ActionRerunConfig) Reset() {
+	*x = ActionRerunConfig{}
+	mi := &file_github_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PRCommentConfig) String() string {
+func (x *ActionRerunConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PRCommentConfig) ProtoMessage() {}
+func (*ActionRerunConfig) ProtoMessage() {}
 
-func (x *PRCommentConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_github_proto_msgTypes[15]
+func (x *ActionRerunConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_github_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1121,69 +1686,111 @@ func (x *PRCommentConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PRCommentConfig.ProtoReflect.Descriptor instead.
-func (*PRCommentConfig) Descriptor() ([]byte, []int) {
-	return file_github_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use ActionRerunConfig.ProtoReflect.Descriptor instead.
+func (*ActionRerunConfig) Descriptor() ([]byte, []int) {
+	return file_github_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *PRCommentConfig) GetOwner() string {
+func (x *ActionRerunConfig) GetOwner() string {
 	if x != nil {
 		return x.Owner
 	}
 	return ""
 }
 
-func (x *PRCommentConfig) GetRepo() string {
+func (x *ActionRerunConfig) GetRepo() string {
 	if x != nil {
 		return x.Repo
 	}
 	return ""
 }
 
-func (x *PRCommentConfig) GetPrNumber() int64 {
+func (x *ActionRerunConfig) GetRunId() string {
 	if x != nil {
-		return x.PrNumber
+		return x.RunId
 	}
-	return 0
+	return ""
 }
 
-func (x *PRCommentConfig) GetBody() string {
+func (x *ActionRerunConfig) GetToken() string {
 	if x != nil {
-		return x.Body
+		return x.Token
 	}
 	return ""
 }
 
-func (x *PRCommentConfig) GetToken() string {
+func (x *ActionRerunConfig) GetFailedOnly() bool {
 	if x != nil {
-		return x.Token
+		return x.FailedOnly
+	}
+	return false
+}
+
+func (x *ActionRerunConfig) GetEnableDebugLogging() bool {
+	if x != nil {
+		return x.EnableDebugLogging
+	}
+	return false
+}
+
+func (x *ActionRerunConfig) GetAuthModule() string {
+	if x != nil {
+		return x.AuthModule
 	}
 	return ""
 }
 
-// PRCommentInput carries runtime inputs for step.gh_pr_comment.
-type PRCommentInput struct {
+func (x *ActionRerunConfig) GetTokenRepositories() []string {
+	if x != nil {
+		return x.TokenRepositories
+	}
+	return nil
+}
+
+func (x *ActionRerunConfig) GetTokenPermissions() map[string]string {
+	if x != nil {
+		return x.TokenPermissions
+	}
+	return nil
+}
+
+func (x *ActionRerunConfig) GetApiBaseUrl() string {
+	if x != nil {
+		return x.ApiBaseUrl
+	}
+	return ""
+}
+
+func (x *ActionRerunConfig) GetStrictTemplates() bool {
+	if x != nil {
+		return x.StrictTemplates
+	}
+	return false
+}
+
+// ActionRerunInput carries runtime inputs for step.gh_action_rerun.
+type ActionRerunInput struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Data          *structpb.Struct       `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PRCommentInput) Reset() {
-	*x = PRCommentInput{}
-	mi := &file_github_proto_msgTypes[16]
+func (x *ActionRerunInput) Reset() {
+	*x = ActionRerunInput{}
+	mi := &file_github_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PRCommentInput) String() string {
+func (x *ActionRerunInput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PRCommentInput) ProtoMessage() {}
+func (*ActionRerunInput) ProtoMessage() {}
 
-func (x *PRCommentInput) ProtoReflect() protoreflect.Message {
-	mi := &file_github_proto_msgTypes[16]
+func (x *ActionRerunInput) ProtoReflect() protoreflect.Message {
+	mi := &file_github_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1194,42 +1801,43 @@ func (x *PRCommentInput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PRCommentInput.ProtoReflect.Descriptor instead.
-func (*PRCommentInput) Descriptor() ([]byte, []int) {
-	return file_github_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use ActionRerunInput.ProtoReflect.Descriptor instead.
+func (*ActionRerunInput) Descriptor() ([]byte, []int) {
+	return file_github_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *PRCommentInput) GetData() *structpb.Struct {
+func (x *ActionRerunInput) GetData() *structpb.Struct {
 	if x != nil {
 		return x.Data
 	}
 	return nil
 }
 
-// PRCommentOutput holds the result of step.gh_pr_comment.
-type PRCommentOutput struct {
+// ActionRerunOutput holds the result of step.gh_action_rerun.
+type ActionRerunOutput struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	CommentId     int64                  `protobuf:"varint,1,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
-	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	RunId         int64                  `protobuf:"varint,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	Rerun         bool                   `protobuf:"varint,2,opt,name=rerun,proto3" json:"rerun,omitempty"`
+	FailedOnly    bool                   `protobuf:"varint,3,opt,name=failed_only,json=failedOnly,proto3" json:"failed_only,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PRCommentOutput) Reset() {
-	*x = PRCommentOutput{}
-	mi := &file_github_proto_msgTypes[17]
+func (x *ActionRerunOutput) Reset() {
+	*x = ActionRerunOutput{}
+	mi := &file_github_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PRCommentOutput) String() string {
+func (x *ActionRerunOutput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PRCommentOutput) ProtoMessage() {}
+func (*ActionRerunOutput) ProtoMessage() {}
 
-func (x *PRCommentOutput) ProtoReflect() protoreflect.Message {
-	mi := &file_github_proto_msgTypes[17]
+func (x *ActionRerunOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_github_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1240,53 +1848,66 @@ func (x *PRCommentOutput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PRCommentOutput.ProtoReflect.Descriptor instead.
-func (*PRCommentOutput) Descriptor() ([]byte, []int) {
-	return file_github_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use ActionRerunOutput.ProtoReflect.Descriptor instead.
+func (*ActionRerunOutput) Descriptor() ([]byte, []int) {
+	return file_github_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *PRCommentOutput) GetCommentId() int64 {
+func (x *ActionRerunOutput) GetRunId() int64 {
 	if x != nil {
-		return x.CommentId
+		return x.RunId
 	}
 	return 0
 }
 
-func (x *PRCommentOutput) GetUrl() string {
+func (x *ActionRerunOutput) GetRerun() bool {
 	if x != nil {
-		return x.Url
+		return x.Rerun
 	}
-	return ""
+	return false
 }
 
-// PRReviewConfig is the typed config for step.gh_pr_review.
-type PRReviewConfig struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Owner         string                 `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
-	Repo          string                 `protobuf:"bytes,2,opt,name=repo,proto3" json:"repo,omitempty"`
-	PrNumber      int64                  `protobuf:"varint,3,opt,name=pr_number,json=prNumber,proto3" json:"pr_number,omitempty"`
-	Event         string                 `protobuf:"bytes,4,opt,name=event,proto3" json:"event,omitempty"`
-	Body          string                 `protobuf:"bytes,5,opt,name=body,proto3" json:"body,omitempty"`
-	Token         string                 `protobuf:"bytes,6,opt,name=token,proto3" json:"token,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ActionRerunOutput) GetFailedOnly() bool {
+	if x != nil {
+		return x.FailedOnly
+	}
+	return false
 }
 
-func (x *PRReviewConfig) Reset() {
-	*x = PRReviewConfig{}
-	mi := &file_github_proto_msgTypes[18]
+// ActionApproveConfig is the typed config for step.gh_action_approve.
+type ActionApproveConfig struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Owner             string                 `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Repo              string                 `protobuf:"bytes,2,opt,name=repo,proto3" json:"repo,omitempty"`
+	RunId             string                 `protobuf:"bytes,3,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	Token             string                 `protobuf:"bytes,4,opt,name=token,proto3" json:"token,omitempty"`
+	Environments      []string               `protobuf:"bytes,5,rep,name=environments,proto3" json:"environments,omitempty"`
+	State             string                 `protobuf:"bytes,6,opt,name=state,proto3" json:"state,omitempty"`
+	Comment           string                 `protobuf:"bytes,7,opt,name=comment,proto3" json:"comment,omitempty"`
+	AuthModule        string                 `protobuf:"bytes,8,opt,name=auth_module,json=authModule,proto3" json:"auth_module,omitempty"`
+	TokenRepositories []string               `protobuf:"bytes,9,rep,name=token_repositories,json=tokenRepositories,proto3" json:"token_repositories,omitempty"`
+	TokenPermissions  map[string]string      `protobuf:"bytes,10,rep,name=token_permissions,json=tokenPermissions,proto3" json:"token_permissions,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	ApiBaseUrl        string                 `protobuf:"bytes,11,opt,name=api_base_url,json=apiBaseUrl,proto3" json:"api_base_url,omitempty"`
+	StrictTemplates   bool                   `protobuf:"varint,12,opt,name=strict_templates,json=strictTemplates,proto3" json:"strict_templates,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ActionApproveConfig) Reset() {
+	*x = ActionApproveConfig{}
+	mi := &file_github_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PRReviewConfig) String() string {
+func (x *ActionApproveConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PRReviewConfig) ProtoMessage() {}
+func (*ActionApproveConfig) ProtoMessage() {}
 
-func (x *PRReviewConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_github_proto_msgTypes[18]
+func (x *ActionApproveConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_github_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1297,76 +1918,118 @@ func (x *PRReviewConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PRReviewConfig.ProtoReflect.Descriptor instead.
-func (*PRReviewConfig) Descriptor() ([]byte, []int) {
-	return file_github_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use ActionApproveConfig.ProtoReflect.Descriptor instead.
+func (*ActionApproveConfig) Descriptor() ([]byte, []int) {
+	return file_github_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *PRReviewConfig) GetOwner() string {
+func (x *ActionApproveConfig) GetOwner() string {
 	if x != nil {
 		return x.Owner
 	}
 	return ""
 }
 
-func (x *PRReviewConfig) GetRepo() string {
+func (x *ActionApproveConfig) GetRepo() string {
 	if x != nil {
 		return x.Repo
 	}
 	return ""
 }
 
-func (x *PRReviewConfig) GetPrNumber() int64 {
+func (x *ActionApproveConfig) GetRunId() string {
 	if x != nil {
-		return x.PrNumber
+		return x.RunId
 	}
-	return 0
+	return ""
 }
 
-func (x *PRReviewConfig) GetEvent() string {
+func (x *ActionApproveConfig) GetToken() string {
 	if x != nil {
-		return x.Event
+		return x.Token
 	}
 	return ""
 }
 
-func (x *PRReviewConfig) GetBody() string {
+func (x *ActionApproveConfig) GetEnvironments() []string {
 	if x != nil {
-		return x.Body
+		return x.Environments
+	}
+	return nil
+}
+
+func (x *ActionApproveConfig) GetState() string {
+	if x != nil {
+		return x.State
 	}
 	return ""
 }
 
-func (x *PRReviewConfig) GetToken() string {
+func (x *ActionApproveConfig) GetComment() string {
 	if x != nil {
-		return x.Token
+		return x.Comment
 	}
 	return ""
 }
 
-// PRReviewInput carries runtime inputs for step.gh_pr_review.
-type PRReviewInput struct {
+func (x *ActionApproveConfig) GetAuthModule() string {
+	if x != nil {
+		return x.AuthModule
+	}
+	return ""
+}
+
+func (x *ActionApproveConfig) GetTokenRepositories() []string {
+	if x != nil {
+		return x.TokenRepositories
+	}
+	return nil
+}
+
+func (x *ActionApproveConfig) GetTokenPermissions() map[string]string {
+	if x != nil {
+		return x.TokenPermissions
+	}
+	return nil
+}
+
+func (x *ActionApproveConfig) GetApiBaseUrl() string {
+	if x != nil {
+		return x.ApiBaseUrl
+	}
+	return ""
+}
+
+func (x *ActionApproveConfig) GetStrictTemplates() bool {
+	if x != nil {
+		return x.StrictTemplates
+	}
+	return false
+}
+
+// ActionApproveInput carries runtime inputs for step.gh_action_approve.
+type ActionApproveInput struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Data          *structpb.Struct       `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PRReviewInput) Reset() {
-	*x = PRReviewInput{}
-	mi := &file_github_proto_msgTypes[19]
+func (x *ActionApproveInput) Reset() {
+	*x = ActionApproveInput{}
+	mi := &file_github_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PRReviewInput) String() string {
+func (x *ActionApproveInput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PRReviewInput) ProtoMessage() {}
+func (*ActionApproveInput) ProtoMessage() {}
 
-func (x *PRReviewInput) ProtoReflect() protoreflect.Message {
-	mi := &file_github_proto_msgTypes[19]
+func (x *ActionApproveInput) ProtoReflect() protoreflect.Message {
+	mi := &file_github_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1377,43 +2040,43 @@ func (x *PRReviewInput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PRReviewInput.ProtoReflect.Descriptor instead.
-func (*PRReviewInput) Descriptor() ([]byte, []int) {
-	return file_github_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use ActionApproveInput.ProtoReflect.Descriptor instead.
+func (*ActionApproveInput) Descriptor() ([]byte, []int) {
+	return file_github_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *PRReviewInput) GetData() *structpb.Struct {
+func (x *ActionApproveInput) GetData() *structpb.Struct {
 	if x != nil {
 		return x.Data
 	}
 	return nil
 }
 
-// PRReviewOutput holds the result of step.gh_pr_review.
-type PRReviewOutput struct {
+// ActionApproveOutput holds the result of step.gh_action_approve.
+type ActionApproveOutput struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ReviewId      int64                  `protobuf:"varint,1,opt,name=review_id,json=reviewId,proto3" json:"review_id,omitempty"`
+	RunId         int64                  `protobuf:"varint,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
 	State         string                 `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
-	Url           string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	Environments  []string               `protobuf:"bytes,3,rep,name=environments,proto3" json:"environments,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PRReviewOutput) Reset() {
-	*x = PRReviewOutput{}
-	mi := &file_github_proto_msgTypes[20]
+func (x *ActionApproveOutput) Reset() {
+	*x = ActionApproveOutput{}
+	mi := &file_github_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PRReviewOutput) String() string {
+func (x *ActionApproveOutput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PRReviewOutput) ProtoMessage() {}
+func (*ActionApproveOutput) ProtoMessage() {}
 
-func (x *PRReviewOutput) ProtoReflect() protoreflect.Message {
-	mi := &file_github_proto_msgTypes[20]
+func (x *ActionApproveOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_github_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1424,61 +2087,64 @@ func (x *PRReviewOutput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PRReviewOutput.ProtoReflect.Descriptor instead.
-func (*PRReviewOutput) Descriptor() ([]byte, []int) {
-	return file_github_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use ActionApproveOutput.ProtoReflect.Descriptor instead.
+func (*ActionApproveOutput) Descriptor() ([]byte, []int) {
+	return file_github_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *PRReviewOutput) GetReviewId() int64 {
+func (x *ActionApproveOutput) GetRunId() int64 {
 	if x != nil {
-		return x.ReviewId
+		return x.RunId
 	}
 	return 0
 }
 
-func (x *PRReviewOutput) GetState() string {
+func (x *ActionApproveOutput) GetState() string {
 	if x != nil {
 		return x.State
 	}
 	return ""
 }
 
-func (x *PRReviewOutput) GetUrl() string {
+func (x *ActionApproveOutput) GetEnvironments() []string {
 	if x != nil {
-		return x.Url
+		return x.Environments
 	}
-	return ""
-}
-
-// IssueCreateConfig is the typed config for step.gh_issue_create.
-type IssueCreateConfig struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Owner         string                 `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
-	Repo          string                 `protobuf:"bytes,2,opt,name=repo,proto3" json:"repo,omitempty"`
-	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
-	Body          string                 `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
-	Labels        []string               `protobuf:"bytes,5,rep,name=labels,proto3" json:"labels,omitempty"`
-	Assignees     []string               `protobuf:"bytes,6,rep,name=assignees,proto3" json:"assignees,omitempty"`
-	Token         string                 `protobuf:"bytes,7,opt,name=token,proto3" json:"token,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	return nil
 }
 
-func (x *IssueCreateConfig) Reset() {
-	*x = IssueCreateConfig{}
-	mi := &file_github_proto_msgTypes[21]
+// ArtifactListConfig is the typed config for step.gh_artifact_list.
+type ArtifactListConfig struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Owner             string                 `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Repo              string                 `protobuf:"bytes,2,opt,name=repo,proto3" json:"repo,omitempty"`
+	RunId             string                 `protobuf:"bytes,3,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	Token             string                 `protobuf:"bytes,4,opt,name=token,proto3" json:"token,omitempty"`
+	Name              string                 `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
+	AuthModule        string                 `protobuf:"bytes,6,opt,name=auth_module,json=authModule,proto3" json:"auth_module,omitempty"`
+	TokenRepositories []string               `protobuf:"bytes,7,rep,name=token_repositories,json=tokenRepositories,proto3" json:"token_repositories,omitempty"`
+	TokenPermissions  map[string]string      `protobuf:"bytes,8,rep,name=token_permissions,json=tokenPermissions,proto3" json:"token_permissions,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	ApiBaseUrl        string                 `protobuf:"bytes,9,opt,name=api_base_url,json=apiBaseUrl,proto3" json:"api_base_url,omitempty"`
+	StrictTemplates   bool                   `protobuf:"varint,10,opt,name=strict_templates,json=strictTemplates,proto3" json:"strict_templates,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ArtifactListConfig) Reset() {
+	*x = ArtifactListConfig{}
+	mi := &file_github_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *IssueCreateConfig) String() string {
+func (x *ArtifactListConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IssueCreateConfig) ProtoMessage() {}
+func (*ArtifactListConfig) ProtoMessage() {}
 
-func (x *IssueCreateConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_github_proto_msgTypes[21]
+func (x *ArtifactListConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_github_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1489,83 +2155,104 @@ func (x *IssueCreateConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IssueCreateConfig.ProtoReflect.Descriptor instead.
-func (*IssueCreateConfig) Descriptor() ([]byte, []int) {
-	return file_github_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use ArtifactListConfig.ProtoReflect.Descriptor instead.
+func (*ArtifactListConfig) Descriptor() ([]byte, []int) {
+	return file_github_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *IssueCreateConfig) GetOwner() string {
+func (x *ArtifactListConfig) GetOwner() string {
 	if x != nil {
 		return x.Owner
 	}
 	return ""
 }
 
-func (x *IssueCreateConfig) GetRepo() string {
+func (x *ArtifactListConfig) GetRepo() string {
 	if x != nil {
 		return x.Repo
 	}
 	return ""
 }
 
-func (x *IssueCreateConfig) GetTitle() string {
+func (x *ArtifactListConfig) GetRunId() string {
 	if x != nil {
-		return x.Title
+		return x.RunId
 	}
 	return ""
 }
 
-func (x *IssueCreateConfig) GetBody() string {
+func (x *ArtifactListConfig) GetToken() string {
 	if x != nil {
-		return x.Body
+		return x.Token
 	}
 	return ""
 }
 
-func (x *IssueCreateConfig) GetLabels() []string {
+func (x *ArtifactListConfig) GetName() string {
 	if x != nil {
-		return x.Labels
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ArtifactListConfig) GetAuthModule() string {
+	if x != nil {
+		return x.AuthModule
+	}
+	return ""
+}
+
+func (x *ArtifactListConfig) GetTokenRepositories() []string {
+	if x != nil {
+		return x.TokenRepositories
 	}
 	return nil
 }
 
-func (x *IssueCreateConfig) GetAssignees() []string {
+func (x *ArtifactListConfig) GetTokenPermissions() map[string]string {
 	if x != nil {
-		return x.Assignees
+		return x.TokenPermissions
 	}
 	return nil
 }
 
-func (x *IssueCreateConfig) GetToken() string {
+func (x *ArtifactListConfig) GetApiBaseUrl() string {
 	if x != nil {
-		return x.Token
+		return x.ApiBaseUrl
 	}
 	return ""
 }
 
-// IssueCreateInput carries runtime inputs for step.gh_issue_create.
-type IssueCreateInput struct {
+func (x *ArtifactListConfig) GetStrictTemplates() bool {
+	if x != nil {
+		return x.StrictTemplates
+	}
+	return false
+}
+
+// ArtifactListInput carries runtime inputs for step.gh_artifact_list.
+type ArtifactListInput struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Data          *structpb.Struct       `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *IssueCreateInput) Reset() {
-	*x = IssueCreateInput{}
-	mi := &file_github_proto_msgTypes[22]
+func (x *ArtifactListInput) Reset() {
+	*x = ArtifactListInput{}
+	mi := &file_github_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *IssueCreateInput) String() string {
+func (x *ArtifactListInput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IssueCreateInput) ProtoMessage() {}
+func (*ArtifactListInput) ProtoMessage() {}
 
-func (x *IssueCreateInput) ProtoReflect() protoreflect.Message {
-	mi := &file_github_proto_msgTypes[22]
+func (x *ArtifactListInput) ProtoReflect() protoreflect.Message {
+	mi := &file_github_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1576,44 +2263,43 @@ func (x *IssueCreateInput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IssueCreateInput.ProtoReflect.Descriptor instead.
-func (*IssueCreateInput) Descriptor() ([]byte, []int) {
-	return file_github_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use ArtifactListInput.ProtoReflect.Descriptor instead.
+func (*ArtifactListInput) Descriptor() ([]byte, []int) {
+	return file_github_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *IssueCreateInput) GetData() *structpb.Struct {
+func (x *ArtifactListInput) GetData() *structpb.Struct {
 	if x != nil {
 		return x.Data
 	}
 	return nil
 }
 
-// IssueCreateOutput holds the result of step.gh_issue_create.
-type IssueCreateOutput struct {
+// ArtifactListOutput holds the result of step.gh_artifact_list.
+type ArtifactListOutput struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Number        int64                  `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"`
-	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
-	Id            int64                  `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"`
-	State         string                 `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"`
+	RunId         int64                  `protobuf:"varint,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	Artifacts     []*ArtifactSummary     `protobuf:"bytes,2,rep,name=artifacts,proto3" json:"artifacts,omitempty"`
+	Count         int32                  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *IssueCreateOutput) Reset() {
-	*x = IssueCreateOutput{}
-	mi := &file_github_proto_msgTypes[23]
+func (x *ArtifactListOutput) Reset() {
+	*x = ArtifactListOutput{}
+	mi := &file_github_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *IssueCreateOutput) String() string {
+func (x *ArtifactListOutput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IssueCreateOutput) ProtoMessage() {}
+func (*ArtifactListOutput) ProtoMessage() {}
 
-func (x *IssueCreateOutput) ProtoReflect() protoreflect.Message {
-	mi := &file_github_proto_msgTypes[23]
+func (x *ArtifactListOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_github_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1624,66 +2310,60 @@ func (x *IssueCreateOutput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IssueCreateOutput.ProtoReflect.Descriptor instead.
-func (*IssueCreateOutput) Descriptor() ([]byte, []int) {
-	return file_github_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use ArtifactListOutput.ProtoReflect.Descriptor instead.
+func (*ArtifactListOutput) Descriptor() ([]byte, []int) {
+	return file_github_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *IssueCreateOutput) GetNumber() int64 {
+func (x *ArtifactListOutput) GetRunId() int64 {
 	if x != nil {
-		return x.Number
+		return x.RunId
 	}
 	return 0
 }
 
-func (x *IssueCreateOutput) GetUrl() string {
+func (x *ArtifactListOutput) GetArtifacts() []*ArtifactSummary {
 	if x != nil {
-		return x.Url
+		return x.Artifacts
 	}
-	return ""
+	return nil
 }
 
-func (x *IssueCreateOutput) GetId() int64 {
+func (x *ArtifactListOutput) GetCount() int32 {
 	if x != nil {
-		return x.Id
+		return x.Count
 	}
 	return 0
 }
 
-func (x *IssueCreateOutput) GetState() string {
-	if x != nil {
-		return x.State
-	}
-	return ""
-}
-
-// IssueCloseConfig is the typed config for step.gh_issue_close.
-type IssueCloseConfig struct {
+// ArtifactSummary describes one artifact of a workflow run.
+type ArtifactSummary struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Owner         string                 `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
-	Repo          string                 `protobuf:"bytes,2,opt,name=repo,proto3" json:"repo,omitempty"`
-	IssueNumber   int64                  `protobuf:"varint,3,opt,name=issue_number,json=issueNumber,proto3" json:"issue_number,omitempty"`
-	Comment       string                 `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment,omitempty"`
-	Token         string                 `protobuf:"bytes,5,opt,name=token,proto3" json:"token,omitempty"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	SizeInBytes   int64                  `protobuf:"varint,3,opt,name=size_in_bytes,json=sizeInBytes,proto3" json:"size_in_bytes,omitempty"`
+	Expired       bool                   `protobuf:"varint,4,opt,name=expired,proto3" json:"expired,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ExpiresAt     string                 `protobuf:"bytes,6,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *IssueCloseConfig) Reset() {
-	*x = IssueCloseConfig{}
-	mi := &file_github_proto_msgTypes[24]
+func (x *ArtifactSummary) Reset() {
+	*x = ArtifactSummary{}
+	mi := &file_github_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *IssueCloseConfig) String() string {
+func (x *ArtifactSummary) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IssueCloseConfig) ProtoMessage() {}
+func (*ArtifactSummary) ProtoMessage() {}
 
-func (x *IssueCloseConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_github_proto_msgTypes[24]
+func (x *ArtifactSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_github_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1694,69 +2374,89 @@ func (x *IssueCloseConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IssueCloseConfig.ProtoReflect.Descriptor instead.
-func (*IssueCloseConfig) Descriptor() ([]byte, []int) {
-	return file_github_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use ArtifactSummary.ProtoReflect.Descriptor instead.
+func (*ArtifactSummary) Descriptor() ([]byte, []int) {
+	return file_github_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *IssueCloseConfig) GetOwner() string {
+func (x *ArtifactSummary) GetId() int64 {
 	if x != nil {
-		return x.Owner
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-func (x *IssueCloseConfig) GetRepo() string {
+func (x *ArtifactSummary) GetName() string {
 	if x != nil {
-		return x.Repo
+		return x.Name
 	}
 	return ""
 }
 
-func (x *IssueCloseConfig) GetIssueNumber() int64 {
+func (x *ArtifactSummary) GetSizeInBytes() int64 {
 	if x != nil {
-		return x.IssueNumber
+		return x.SizeInBytes
 	}
 	return 0
 }
 
-func (x *IssueCloseConfig) GetComment() string {
+func (x *ArtifactSummary) GetExpired() bool {
 	if x != nil {
-		return x.Comment
+		return x.Expired
 	}
-	return ""
+	return false
 }
 
-func (x *IssueCloseConfig) GetToken() string {
+func (x *ArtifactSummary) GetCreatedAt() string {
 	if x != nil {
-		return x.Token
+		return x.CreatedAt
 	}
 	return ""
 }
 
-// IssueCloseInput carries runtime inputs for step.gh_issue_close.
-type IssueCloseInput struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Data          *structpb.Struct       `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ArtifactSummary) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
 }
 
-func (x *IssueCloseInput) Reset() {
-	*x = IssueCloseInput{}
-	mi := &file_github_proto_msgTypes[25]
+// ArtifactDownloadConfig is the typed config for step.gh_artifact_download.
+type ArtifactDownloadConfig struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Owner             string                 `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Repo              string                 `protobuf:"bytes,2,opt,name=repo,proto3" json:"repo,omitempty"`
+	RunId             string                 `protobuf:"bytes,3,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	Token             string                 `protobuf:"bytes,4,opt,name=token,proto3" json:"token,omitempty"`
+	Name              string                 `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
+	Destination       string                 `protobuf:"bytes,6,opt,name=destination,proto3" json:"destination,omitempty"`
+	MaxFiles          int64                  `protobuf:"varint,7,opt,name=max_files,json=maxFiles,proto3" json:"max_files,omitempty"`
+	MaxFileBytes      int64                  `protobuf:"varint,8,opt,name=max_file_bytes,json=maxFileBytes,proto3" json:"max_file_bytes,omitempty"`
+	MaxTotalBytes     int64                  `protobuf:"varint,9,opt,name=max_total_bytes,json=maxTotalBytes,proto3" json:"max_total_bytes,omitempty"`
+	AuthModule        string                 `protobuf:"bytes,10,opt,name=auth_module,json=authModule,proto3" json:"auth_module,omitempty"`
+	TokenRepositories []string               `protobuf:"bytes,11,rep,name=token_repositories,json=tokenRepositories,proto3" json:"token_repositories,omitempty"`
+	TokenPermissions  map[string]string      `protobuf:"bytes,12,rep,name=token_permissions,json=tokenPermissions,proto3" json:"token_permissions,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	ApiBaseUrl        string                 `protobuf:"bytes,13,opt,name=api_base_url,json=apiBaseUrl,proto3" json:"api_base_url,omitempty"`
+	StrictTemplates   bool                   `protobuf:"varint,14,opt,name=strict_templates,json=strictTemplates,proto3" json:"strict_templates,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ArtifactDownloadConfig) Reset() {
+	*x = ArtifactDownloadConfig{}
+	mi := &file_github_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *IssueCloseInput) String() string {
+func (x *ArtifactDownloadConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IssueCloseInput) ProtoMessage() {}
+func (*ArtifactDownloadConfig) ProtoMessage() {}
 
-func (x *IssueCloseInput) ProtoReflect() protoreflect.Message {
-	mi := &file_github_proto_msgTypes[25]
+func (x *ArtifactDownloadConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_github_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1767,106 +2467,131 @@ func (x *IssueCloseInput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IssueCloseInput.ProtoReflect.Descriptor instead.
-func (*IssueCloseInput) Descriptor() ([]byte, []int) {
-	return file_github_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use ArtifactDownloadConfig.ProtoReflect.Descriptor instead.
+func (*ArtifactDownloadConfig) Descriptor() ([]byte, []int) {
+	return file_github_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *IssueCloseInput) GetData() *structpb.Struct {
+func (x *ArtifactDownloadConfig) GetOwner() string {
 	if x != nil {
-		return x.Data
+		return x.Owner
 	}
-	return nil
+	return ""
 }
 
-// IssueCloseOutput holds the result of step.gh_issue_close.
-type IssueCloseOutput struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Number        int64                  `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"`
-	State         string                 `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
-	Url           string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ArtifactDownloadConfig) GetRepo() string {
+	if x != nil {
+		return x.Repo
+	}
+	return ""
 }
 
-func (x *IssueCloseOutput) Reset() {
-	*x = IssueCloseOutput{}
-	mi := &file_github_proto_msgTypes[26]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *ArtifactDownloadConfig) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
 }
 
-func (x *IssueCloseOutput) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ArtifactDownloadConfig) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
 }
 
-func (*IssueCloseOutput) ProtoMessage() {}
+func (x *ArtifactDownloadConfig) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
 
-func (x *IssueCloseOutput) ProtoReflect() protoreflect.Message {
-	mi := &file_github_proto_msgTypes[26]
+func (x *ArtifactDownloadConfig) GetDestination() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Destination
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use IssueCloseOutput.ProtoReflect.Descriptor instead.
-func (*IssueCloseOutput) Descriptor() ([]byte, []int) {
-	return file_github_proto_rawDescGZIP(), []int{26}
+func (x *ArtifactDownloadConfig) GetMaxFiles() int64 {
+	if x != nil {
+		return x.MaxFiles
+	}
+	return 0
 }
 
-func (x *IssueCloseOutput) GetNumber() int64 {
+func (x *ArtifactDownloadConfig) GetMaxFileBytes() int64 {
 	if x != nil {
-		return x.Number
+		return x.MaxFileBytes
 	}
 	return 0
 }
 
-func (x *IssueCloseOutput) GetState() string {
+func (x *ArtifactDownloadConfig) GetMaxTotalBytes() int64 {
 	if x != nil {
-		return x.State
+		return x.MaxTotalBytes
+	}
+	return 0
+}
+
+func (x *ArtifactDownloadConfig) GetAuthModule() string {
+	if x != nil {
+		return x.AuthModule
 	}
 	return ""
 }
 
-func (x *IssueCloseOutput) GetUrl() string {
+func (x *ArtifactDownloadConfig) GetTokenRepositories() []string {
 	if x != nil {
-		return x.Url
+		return x.TokenRepositories
+	}
+	return nil
+}
+
+func (x *ArtifactDownloadConfig) GetTokenPermissions() map[string]string {
+	if x != nil {
+		return x.TokenPermissions
+	}
+	return nil
+}
+
+func (x *ArtifactDownloadConfig) GetApiBaseUrl() string {
+	if x != nil {
+		return x.ApiBaseUrl
 	}
 	return ""
 }
 
-// IssueLabelConfig is the typed config for step.gh_issue_label.
-type IssueLabelConfig struct {
+func (x *ArtifactDownloadConfig) GetStrictTemplates() bool {
+	if x != nil {
+		return x.StrictTemplates
+	}
+	return false
+}
+
+// ArtifactDownloadInput carries runtime inputs for step.gh_artifact_download.
+type ArtifactDownloadInput struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Owner         string                 `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
-	Repo          string                 `protobuf:"bytes,2,opt,name=repo,proto3" json:"repo,omitempty"`
-	IssueNumber   int64                  `protobuf:"varint,3,opt,name=issue_number,json=issueNumber,proto3" json:"issue_number,omitempty"`
-	Add           []string               `protobuf:"bytes,4,rep,name=add,proto3" json:"add,omitempty"`
-	Remove        []string               `protobuf:"bytes,5,rep,name=remove,proto3" json:"remove,omitempty"`
-	Token         string                 `protobuf:"bytes,6,opt,name=token,proto3" json:"token,omitempty"`
+	Data          *structpb.Struct       `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *IssueLabelConfig) Reset() {
-	*x = IssueLabelConfig{}
+func (x *ArtifactDownloadInput) Reset() {
+	*x = ArtifactDownloadInput{}
 	mi := &file_github_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *IssueLabelConfig) String() string {
+func (x *ArtifactDownloadInput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IssueLabelConfig) ProtoMessage() {}
+func (*ArtifactDownloadInput) ProtoMessage() {}
 
-func (x *IssueLabelConfig) ProtoReflect() protoreflect.Message {
+func (x *ArtifactDownloadInput) ProtoReflect() protoreflect.Message {
 	mi := &file_github_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1878,75 +2603,44 @@ func (x *IssueLabelConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IssueLabelConfig.ProtoReflect.Descriptor instead.
-func (*IssueLabelConfig) Descriptor() ([]byte, []int) {
+// Deprecated: Use ArtifactDownloadInput.ProtoReflect.Descriptor instead.
+func (*ArtifactDownloadInput) Descriptor() ([]byte, []int) {
 	return file_github_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *IssueLabelConfig) GetOwner() string {
-	if x != nil {
-		return x.Owner
-	}
-	return ""
-}
-
-func (x *IssueLabelConfig) GetRepo() string {
-	if x != nil {
-		return x.Repo
-	}
-	return ""
-}
-
-func (x *IssueLabelConfig) GetIssueNumber() int64 {
-	if x != nil {
-		return x.IssueNumber
-	}
-	return 0
-}
-
-func (x *IssueLabelConfig) GetAdd() []string {
-	if x != nil {
-		return x.Add
-	}
-	return nil
-}
-
-func (x *IssueLabelConfig) GetRemove() []string {
+func (x *ArtifactDownloadInput) GetData() *structpb.Struct {
 	if x != nil {
-		return x.Remove
+		return x.Data
 	}
 	return nil
 }
 
-func (x *IssueLabelConfig) GetToken() string {
-	if x != nil {
-		return x.Token
-	}
-	return ""
-}
-
-// IssueLabelInput carries runtime inputs for step.gh_issue_label.
-type IssueLabelInput struct {
+// ArtifactDownloadOutput holds the result of step.gh_artifact_download.
+type ArtifactDownloadOutput struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Data          *structpb.Struct       `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	RunId         int64                  `protobuf:"varint,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	Destination   string                 `protobuf:"bytes,2,opt,name=destination,proto3" json:"destination,omitempty"`
+	Artifacts     []*ExtractedArtifact   `protobuf:"bytes,3,rep,name=artifacts,proto3" json:"artifacts,omitempty"`
+	Files         int64                  `protobuf:"varint,4,opt,name=files,proto3" json:"files,omitempty"`
+	Bytes         int64                  `protobuf:"varint,5,opt,name=bytes,proto3" json:"bytes,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *IssueLabelInput) Reset() {
-	*x = IssueLabelInput{}
+func (x *ArtifactDownloadOutput) Reset() {
+	*x = ArtifactDownloadOutput{}
 	mi := &file_github_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *IssueLabelInput) String() string {
+func (x *ArtifactDownloadOutput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IssueLabelInput) ProtoMessage() {}
+func (*ArtifactDownloadOutput) ProtoMessage() {}
 
-func (x *IssueLabelInput) ProtoReflect() protoreflect.Message {
+func (x *ArtifactDownloadOutput) ProtoReflect() protoreflect.Message {
 	mi := &file_github_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1958,41 +2652,72 @@ func (x *IssueLabelInput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IssueLabelInput.ProtoReflect.Descriptor instead.
-func (*IssueLabelInput) Descriptor() ([]byte, []int) {
+// Deprecated: Use ArtifactDownloadOutput.ProtoReflect.Descriptor instead.
+func (*ArtifactDownloadOutput) Descriptor() ([]byte, []int) {
 	return file_github_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *IssueLabelInput) GetData() *structpb.Struct {
+func (x *ArtifactDownloadOutput) GetRunId() int64 {
 	if x != nil {
-		return x.Data
+		return x.RunId
+	}
+	return 0
+}
+
+func (x *ArtifactDownloadOutput) GetDestination() string {
+	if x != nil {
+		return x.Destination
+	}
+	return ""
+}
+
+func (x *ArtifactDownloadOutput) GetArtifacts() []*ExtractedArtifact {
+	if x != nil {
+		return x.Artifacts
 	}
 	return nil
 }
 
-// IssueLabelOutput holds the result of step.gh_issue_label.
-type IssueLabelOutput struct {
+func (x *ArtifactDownloadOutput) GetFiles() int64 {
+	if x != nil {
+		return x.Files
+	}
+	return 0
+}
+
+func (x *ArtifactDownloadOutput) GetBytes() int64 {
+	if x != nil {
+		return x.Bytes
+	}
+	return 0
+}
+
+// ExtractedArtifact describes one artifact extracted by step.gh_artifact_download.
+type ExtractedArtifact struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Added         []string               `protobuf:"bytes,1,rep,name=added,proto3" json:"added,omitempty"`
-	Removed       []string               `protobuf:"bytes,2,rep,name=removed,proto3" json:"removed,omitempty"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Path          string                 `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	Files         int64                  `protobuf:"varint,4,opt,name=files,proto3" json:"files,omitempty"`
+	Bytes         int64                  `protobuf:"varint,5,opt,name=bytes,proto3" json:"bytes,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *IssueLabelOutput) Reset() {
-	*x = IssueLabelOutput{}
+func (x *ExtractedArtifact) Reset() {
+	*x = ExtractedArtifact{}
 	mi := &file_github_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *IssueLabelOutput) String() string {
+func (x *ExtractedArtifact) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IssueLabelOutput) ProtoMessage() {}
+func (*ExtractedArtifact) ProtoMessage() {}
 
-func (x *IssueLabelOutput) ProtoReflect() protoreflect.Message {
+func (x *ExtractedArtifact) ProtoReflect() protoreflect.Message {
 	mi := &file_github_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2004,54 +2729,88 @@ func (x *IssueLabelOutput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IssueLabelOutput.ProtoReflect.Descriptor instead.
-func (*IssueLabelOutput) Descriptor() ([]byte, []int) {
+// Deprecated: Use ExtractedArtifact.ProtoReflect.Descriptor instead.
+func (*ExtractedArtifact) Descriptor() ([]byte, []int) {
 	return file_github_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *IssueLabelOutput) GetAdded() []string {
+func (x *ExtractedArtifact) GetId() int64 {
 	if x != nil {
-		return x.Added
+		return x.Id
 	}
-	return nil
+	return 0
 }
 
-func (x *IssueLabelOutput) GetRemoved() []string {
+func (x *ExtractedArtifact) GetName() string {
 	if x != nil {
-		return x.Removed
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-// ReleaseCreateConfig is the typed config for step.gh_release_create.
-type ReleaseCreateConfig struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Owner         string                 `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
-	Repo          string                 `protobuf:"bytes,2,opt,name=repo,proto3" json:"repo,omitempty"`
-	Tag           string                 `protobuf:"bytes,3,opt,name=tag,proto3" json:"tag,omitempty"`
-	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
-	Body          string                 `protobuf:"bytes,5,opt,name=body,proto3" json:"body,omitempty"`
-	Draft         bool                   `protobuf:"varint,6,opt,name=draft,proto3" json:"draft,omitempty"`
-	Prerelease    bool                   `protobuf:"varint,7,opt,name=prerelease,proto3" json:"prerelease,omitempty"`
-	Token         string                 `protobuf:"bytes,8,opt,name=token,proto3" json:"token,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ExtractedArtifact) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
 }
 
-func (x *ReleaseCreateConfig) Reset() {
-	*x = ReleaseCreateConfig{}
+func (x *ExtractedArtifact) GetFiles() int64 {
+	if x != nil {
+		return x.Files
+	}
+	return 0
+}
+
+func (x *ExtractedArtifact) GetBytes() int64 {
+	if x != nil {
+		return x.Bytes
+	}
+	return 0
+}
+
+// PRCreateConfig is the typed config for step.gh_pr_create.
+type PRCreateConfig struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Owner             string                 `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Repo              string                 `protobuf:"bytes,2,opt,name=repo,proto3" json:"repo,omitempty"`
+	Head              string                 `protobuf:"bytes,3,opt,name=head,proto3" json:"head,omitempty"`
+	Base              string                 `protobuf:"bytes,4,opt,name=base,proto3" json:"base,omitempty"`
+	Title             string                 `protobuf:"bytes,5,opt,name=title,proto3" json:"title,omitempty"`
+	Body              string                 `protobuf:"bytes,6,opt,name=body,proto3" json:"body,omitempty"`
+	Draft             bool                   `protobuf:"varint,7,opt,name=draft,proto3" json:"draft,omitempty"`
+	Token             string                 `protobuf:"bytes,8,opt,name=token,proto3" json:"token,omitempty"`
+	AuthModule        string                 `protobuf:"bytes,9,opt,name=auth_module,json=authModule,proto3" json:"auth_module,omitempty"`
+	TokenRepositories []string               `protobuf:"bytes,10,rep,name=token_repositories,json=tokenRepositories,proto3" json:"token_repositories,omitempty"`
+	TokenPermissions  map[string]string      `protobuf:"bytes,11,rep,name=token_permissions,json=tokenPermissions,proto3" json:"token_permissions,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	ApiBaseUrl        string                 `protobuf:"bytes,12,opt,name=api_base_url,json=apiBaseUrl,proto3" json:"api_base_url,omitempty"`
+	StrictTemplates   bool                   `protobuf:"varint,13,opt,name=strict_templates,json=strictTemplates,proto3" json:"strict_templates,omitempty"`
+	Upsert            bool                   `protobuf:"varint,14,opt,name=upsert,proto3" json:"upsert,omitempty"`
+	CreateBranch      bool                   `protobuf:"varint,15,opt,name=create_branch,json=createBranch,proto3" json:"create_branch,omitempty"`
+	Reviewers         []string               `protobuf:"bytes,16,rep,name=reviewers,proto3" json:"reviewers,omitempty"`
+	TeamReviewers     []string               `protobuf:"bytes,17,rep,name=team_reviewers,json=teamReviewers,proto3" json:"team_reviewers,omitempty"`
+	Labels            []string               `protobuf:"bytes,18,rep,name=labels,proto3" json:"labels,omitempty"`
+	Assignees         []string               `protobuf:"bytes,19,rep,name=assignees,proto3" json:"assignees,omitempty"`
+	Milestone         string                 `protobuf:"bytes,20,opt,name=milestone,proto3" json:"milestone,omitempty"`
+	GraphqlUrl        string                 `protobuf:"bytes,21,opt,name=graphql_url,json=graphqlUrl,proto3" json:"graphql_url,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *PRCreateConfig) Reset() {
+	*x = PRCreateConfig{}
 	mi := &file_github_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReleaseCreateConfig) String() string {
+func (x *PRCreateConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReleaseCreateConfig) ProtoMessage() {}
+func (*PRCreateConfig) ProtoMessage() {}
 
-func (x *ReleaseCreateConfig) ProtoReflect() protoreflect.Message {
+func (x *PRCreateConfig) ProtoReflect() protoreflect.Message {
 	mi := &file_github_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2063,89 +2822,180 @@ func (x *ReleaseCreateConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReleaseCreateConfig.ProtoReflect.Descriptor instead.
-func (*ReleaseCreateConfig) Descriptor() ([]byte, []int) {
+// Deprecated: Use PRCreateConfig.ProtoReflect.Descriptor instead.
+func (*PRCreateConfig) Descriptor() ([]byte, []int) {
 	return file_github_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *ReleaseCreateConfig) GetOwner() string {
+func (x *PRCreateConfig) GetOwner() string {
 	if x != nil {
 		return x.Owner
 	}
 	return ""
 }
 
-func (x *ReleaseCreateConfig) GetRepo() string {
+func (x *PRCreateConfig) GetRepo() string {
 	if x != nil {
 		return x.Repo
 	}
 	return ""
 }
 
-func (x *ReleaseCreateConfig) GetTag() string {
+func (x *PRCreateConfig) GetHead() string {
 	if x != nil {
-		return x.Tag
+		return x.Head
 	}
 	return ""
 }
 
-func (x *ReleaseCreateConfig) GetName() string {
+func (x *PRCreateConfig) GetBase() string {
 	if x != nil {
-		return x.Name
+		return x.Base
 	}
 	return ""
 }
 
-func (x *ReleaseCreateConfig) GetBody() string {
+func (x *PRCreateConfig) GetTitle() string {
 	if x != nil {
-		return x.Body
+		return x.Title
 	}
 	return ""
 }
 
-func (x *ReleaseCreateConfig) GetDraft() bool {
+func (x *PRCreateConfig) GetBody() string {
 	if x != nil {
-		return x.Draft
+		return x.Body
 	}
-	return false
+	return ""
 }
 
-func (x *ReleaseCreateConfig) GetPrerelease() bool {
+func (x *PRCreateConfig) GetDraft() bool {
 	if x != nil {
-		return x.Prerelease
+		return x.Draft
 	}
 	return false
 }
 
-func (x *ReleaseCreateConfig) GetToken() string {
+func (x *PRCreateConfig) GetToken() string {
 	if x != nil {
 		return x.Token
 	}
 	return ""
 }
 
-// ReleaseCreateInput carries runtime inputs for step.gh_release_create.
-type ReleaseCreateInput struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Data          *structpb.Struct       `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *PRCreateConfig) GetAuthModule() string {
+	if x != nil {
+		return x.AuthModule
+	}
+	return ""
 }
 
-func (x *ReleaseCreateInput) Reset() {
-	*x = ReleaseCreateInput{}
-	mi := &file_github_proto_msgTypes[31]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *PRCreateConfig) GetTokenRepositories() []string {
+	if x != nil {
+		return x.TokenRepositories
+	}
+	return nil
 }
 
-func (x *ReleaseCreateInput) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *PRCreateConfig) GetTokenPermissions() map[string]string {
+	if x != nil {
+		return x.TokenPermissions
+	}
+	return nil
 }
 
-func (*ReleaseCreateInput) ProtoMessage() {}
+func (x *PRCreateConfig) GetApiBaseUrl() string {
+	if x != nil {
+		return x.ApiBaseUrl
+	}
+	return ""
+}
 
-func (x *ReleaseCreateInput) ProtoReflect() protoreflect.Message {
+func (x *PRCreateConfig) GetStrictTemplates() bool {
+	if x != nil {
+		return x.StrictTemplates
+	}
+	return false
+}
+
+func (x *PRCreateConfig) GetUpsert() bool {
+	if x != nil {
+		return x.Upsert
+	}
+	return false
+}
+
+func (x *PRCreateConfig) GetCreateBranch() bool {
+	if x != nil {
+		return x.CreateBranch
+	}
+	return false
+}
+
+func (x *PRCreateConfig) GetReviewers() []string {
+	if x != nil {
+		return x.Reviewers
+	}
+	return nil
+}
+
+func (x *PRCreateConfig) GetTeamReviewers() []string {
+	if x != nil {
+		return x.TeamReviewers
+	}
+	return nil
+}
+
+func (x *PRCreateConfig) GetLabels() []string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *PRCreateConfig) GetAssignees() []string {
+	if x != nil {
+		return x.Assignees
+	}
+	return nil
+}
+
+func (x *PRCreateConfig) GetMilestone() string {
+	if x != nil {
+		return x.Milestone
+	}
+	return ""
+}
+
+func (x *PRCreateConfig) GetGraphqlUrl() string {
+	if x != nil {
+		return x.GraphqlUrl
+	}
+	return ""
+}
+
+// PRCreateInput carries runtime inputs for step.gh_pr_create.
+type PRCreateInput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *structpb.Struct       `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PRCreateInput) Reset() {
+	*x = PRCreateInput{}
+	mi := &file_github_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PRCreateInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PRCreateInput) ProtoMessage() {}
+
+func (x *PRCreateInput) ProtoReflect() protoreflect.Message {
 	mi := &file_github_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2157,45 +3007,48 @@ func (x *ReleaseCreateInput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReleaseCreateInput.ProtoReflect.Descriptor instead.
-func (*ReleaseCreateInput) Descriptor() ([]byte, []int) {
+// Deprecated: Use PRCreateInput.ProtoReflect.Descriptor instead.
+func (*PRCreateInput) Descriptor() ([]byte, []int) {
 	return file_github_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *ReleaseCreateInput) GetData() *structpb.Struct {
+func (x *PRCreateInput) GetData() *structpb.Struct {
 	if x != nil {
 		return x.Data
 	}
 	return nil
 }
 
-// ReleaseCreateOutput holds the result of step.gh_release_create.
-type ReleaseCreateOutput struct {
+// PRCreateOutput holds the result of step.gh_pr_create.
+type PRCreateOutput struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ReleaseId     int64                  `protobuf:"varint,1,opt,name=release_id,json=releaseId,proto3" json:"release_id,omitempty"`
+	Number        int64                  `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"`
 	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
-	UploadUrl     string                 `protobuf:"bytes,3,opt,name=upload_url,json=uploadUrl,proto3" json:"upload_url,omitempty"`
-	Tag           string                 `protobuf:"bytes,4,opt,name=tag,proto3" json:"tag,omitempty"`
+	Id            int64                  `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"`
+	State         string                 `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"`
 	Draft         bool                   `protobuf:"varint,5,opt,name=draft,proto3" json:"draft,omitempty"`
-	Prerelease    bool                   `protobuf:"varint,6,opt,name=prerelease,proto3" json:"prerelease,omitempty"`
+	HeadSha       string                 `protobuf:"bytes,6,opt,name=head_sha,json=headSha,proto3" json:"head_sha,omitempty"`
+	Created       bool                   `protobuf:"varint,7,opt,name=created,proto3" json:"created,omitempty"`
+	Updated       bool                   `protobuf:"varint,8,opt,name=updated,proto3" json:"updated,omitempty"`
+	BranchCreated bool                   `protobuf:"varint,9,opt,name=branch_created,json=branchCreated,proto3" json:"branch_created,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ReleaseCreateOutput) Reset() {
-	*x = ReleaseCreateOutput{}
+func (x *PRCreateOutput) Reset() {
+	*x = PRCreateOutput{}
 	mi := &file_github_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReleaseCreateOutput) String() string {
+func (x *PRCreateOutput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReleaseCreateOutput) ProtoMessage() {}
+func (*PRCreateOutput) ProtoMessage() {}
 
-func (x *ReleaseCreateOutput) ProtoReflect() protoreflect.Message {
+func (x *PRCreateOutput) ProtoReflect() protoreflect.Message {
 	mi := &file_github_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2207,80 +3060,115 @@ func (x *ReleaseCreateOutput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReleaseCreateOutput.ProtoReflect.Descriptor instead.
-func (*ReleaseCreateOutput) Descriptor() ([]byte, []int) {
+// Deprecated: Use PRCreateOutput.ProtoReflect.Descriptor instead.
+func (*PRCreateOutput) Descriptor() ([]byte, []int) {
 	return file_github_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *ReleaseCreateOutput) GetReleaseId() int64 {
+func (x *PRCreateOutput) GetNumber() int64 {
 	if x != nil {
-		return x.ReleaseId
+		return x.Number
 	}
 	return 0
 }
 
-func (x *ReleaseCreateOutput) GetUrl() string {
+func (x *PRCreateOutput) GetUrl() string {
 	if x != nil {
 		return x.Url
 	}
 	return ""
 }
 
-func (x *ReleaseCreateOutput) GetUploadUrl() string {
+func (x *PRCreateOutput) GetId() int64 {
 	if x != nil {
-		return x.UploadUrl
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-func (x *ReleaseCreateOutput) GetTag() string {
+func (x *PRCreateOutput) GetState() string {
 	if x != nil {
-		return x.Tag
+		return x.State
 	}
 	return ""
 }
 
-func (x *ReleaseCreateOutput) GetDraft() bool {
+func (x *PRCreateOutput) GetDraft() bool {
 	if x != nil {
 		return x.Draft
 	}
 	return false
 }
 
-func (x *ReleaseCreateOutput) GetPrerelease() bool {
+func (x *PRCreateOutput) GetHeadSha() string {
 	if x != nil {
-		return x.Prerelease
+		return x.HeadSha
+	}
+	return ""
+}
+
+func (x *PRCreateOutput) GetCreated() bool {
+	if x != nil {
+		return x.Created
 	}
 	return false
 }
 
-// ReleaseUploadConfig is the typed config for step.gh_release_upload.
-type ReleaseUploadConfig struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Owner         string                 `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
-	Repo          string                 `protobuf:"bytes,2,opt,name=repo,proto3" json:"repo,omitempty"`
-	ReleaseId     string                 `protobuf:"bytes,3,opt,name=release_id,json=releaseId,proto3" json:"release_id,omitempty"`
-	File          string                 `protobuf:"bytes,4,opt,name=file,proto3" json:"file,omitempty"`
-	Name          string                 `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
-	Token         string                 `protobuf:"bytes,6,opt,name=token,proto3" json:"token,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *PRCreateOutput) GetUpdated() bool {
+	if x != nil {
+		return x.Updated
+	}
+	return false
 }
 
-func (x *ReleaseUploadConfig) Reset() {
-	*x = ReleaseUploadConfig{}
+func (x *PRCreateOutput) GetBranchCreated() bool {
+	if x != nil {
+		return x.BranchCreated
+	}
+	return false
+}
+
+// PRMergeConfig is the typed config for step.gh_pr_merge.
+type PRMergeConfig struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Owner             string                 `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Repo              string                 `protobuf:"bytes,2,opt,name=repo,proto3" json:"repo,omitempty"`
+	PrNumber          string                 `protobuf:"bytes,3,opt,name=pr_number,json=prNumber,proto3" json:"pr_number,omitempty"`
+	CommitTitle       string                 `protobuf:"bytes,4,opt,name=commit_title,json=commitTitle,proto3" json:"commit_title,omitempty"`
+	Method            string                 `protobuf:"bytes,5,opt,name=method,proto3" json:"method,omitempty"`
+	Token             string                 `protobuf:"bytes,6,opt,name=token,proto3" json:"token,omitempty"`
+	AuthModule        string                 `protobuf:"bytes,7,opt,name=auth_module,json=authModule,proto3" json:"auth_module,omitempty"`
+	TokenRepositories []string               `protobuf:"bytes,8,rep,name=token_repositories,json=tokenRepositories,proto3" json:"token_repositories,omitempty"`
+	TokenPermissions  map[string]string      `protobuf:"bytes,9,rep,name=token_permissions,json=tokenPermissions,proto3" json:"token_permissions,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	ApiBaseUrl        string                 `protobuf:"bytes,10,opt,name=api_base_url,json=apiBaseUrl,proto3" json:"api_base_url,omitempty"`
+	StrictTemplates   bool                   `protobuf:"varint,11,opt,name=strict_templates,json=strictTemplates,proto3" json:"strict_templates,omitempty"`
+	Mode              string                 `protobuf:"bytes,12,opt,name=mode,proto3" json:"mode,omitempty"`
+	ExpectedHeadSha   string                 `protobuf:"bytes,13,opt,name=expected_head_sha,json=expectedHeadSha,proto3" json:"expected_head_sha,omitempty"`
+	Wait              bool                   `protobuf:"varint,14,opt,name=wait,proto3" json:"wait,omitempty"`
+	RequiredChecks    []string               `protobuf:"bytes,15,rep,name=required_checks,json=requiredChecks,proto3" json:"required_checks,omitempty"`
+	RequiredApprovals string                 `protobuf:"bytes,16,opt,name=required_approvals,json=requiredApprovals,proto3" json:"required_approvals,omitempty"`
+	PollInterval      string                 `protobuf:"bytes,17,opt,name=poll_interval,json=pollInterval,proto3" json:"poll_interval,omitempty"`
+	Timeout           string                 `protobuf:"bytes,18,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	DeleteBranch      bool                   `protobuf:"varint,19,opt,name=delete_branch,json=deleteBranch,proto3" json:"delete_branch,omitempty"`
+	GraphqlUrl        string                 `protobuf:"bytes,20,opt,name=graphql_url,json=graphqlUrl,proto3" json:"graphql_url,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *PRMergeConfig) Reset() {
+	*x = PRMergeConfig{}
 	mi := &file_github_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReleaseUploadConfig) String() string {
+func (x *PRMergeConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReleaseUploadConfig) ProtoMessage() {}
+func (*PRMergeConfig) ProtoMessage() {}
 
-func (x *ReleaseUploadConfig) ProtoReflect() protoreflect.Message {
+func (x *PRMergeConfig) ProtoReflect() protoreflect.Message {
 	mi := &file_github_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2292,193 +3180,5357 @@ func (x *ReleaseUploadConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReleaseUploadConfig.ProtoReflect.Descriptor instead.
-func (*ReleaseUploadConfig) Descriptor() ([]byte, []int) {
+// Deprecated: Use PRMergeConfig.ProtoReflect.Descriptor instead.
+func (*PRMergeConfig) Descriptor() ([]byte, []int) {
 	return file_github_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *ReleaseUploadConfig) GetOwner() string {
+func (x *PRMergeConfig) GetOwner() string {
 	if x != nil {
 		return x.Owner
 	}
 	return ""
 }
 
-func (x *ReleaseUploadConfig) GetRepo() string {
+func (x *PRMergeConfig) GetRepo() string {
 	if x != nil {
 		return x.Repo
 	}
 	return ""
 }
 
-func (x *ReleaseUploadConfig) GetReleaseId() string {
+func (x *PRMergeConfig) GetPrNumber() string {
 	if x != nil {
-		return x.ReleaseId
+		return x.PrNumber
 	}
 	return ""
 }
 
-func (x *ReleaseUploadConfig) GetFile() string {
+func (x *PRMergeConfig) GetCommitTitle() string {
 	if x != nil {
-		return x.File
+		return x.CommitTitle
 	}
 	return ""
 }
 
-func (x *ReleaseUploadConfig) GetName() string {
+func (x *PRMergeConfig) GetMethod() string {
 	if x != nil {
-		return x.Name
+		return x.Method
 	}
 	return ""
 }
 
-func (x *ReleaseUploadConfig) GetToken() string {
+func (x *PRMergeConfig) GetToken() string {
 	if x != nil {
 		return x.Token
 	}
 	return ""
 }
 
-// ReleaseUploadInput carries runtime inputs for step.gh_release_upload.
-type ReleaseUploadInput struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Data          *structpb.Struct       `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *PRMergeConfig) GetAuthModule() string {
+	if x != nil {
+		return x.AuthModule
+	}
+	return ""
 }
 
-func (x *ReleaseUploadInput) Reset() {
-	*x = ReleaseUploadInput{}
-	mi := &file_github_proto_msgTypes[34]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *PRMergeConfig) GetTokenRepositories() []string {
+	if x != nil {
+		return x.TokenRepositories
+	}
+	return nil
 }
 
-func (x *ReleaseUploadInput) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *PRMergeConfig) GetTokenPermissions() map[string]string {
+	if x != nil {
+		return x.TokenPermissions
+	}
+	return nil
 }
 
-func (*ReleaseUploadInput) ProtoMessage() {}
+func (x *PRMergeConfig) GetApiBaseUrl() string {
+	if x != nil {
+		return x.ApiBaseUrl
+	}
+	return ""
+}
 
-func (x *ReleaseUploadInput) ProtoReflect() protoreflect.Message {
-	mi := &file_github_proto_msgTypes[34]
+func (x *PRMergeConfig) GetStrictTemplates() bool {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.StrictTemplates
 	}
-	return mi.MessageOf(x)
+	return false
 }
 
-// Deprecated: Use ReleaseUploadInput.ProtoReflect.Descriptor instead.
-func (*ReleaseUploadInput) Descriptor() ([]byte, []int) {
-	return file_github_proto_rawDescGZIP(), []int{34}
+func (x *PRMergeConfig) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
 }
 
-func (x *ReleaseUploadInput) GetData() *structpb.Struct {
+func (x *PRMergeConfig) GetExpectedHeadSha() string {
 	if x != nil {
-		return x.Data
+		return x.ExpectedHeadSha
+	}
+	return ""
+}
+
+func (x *PRMergeConfig) GetWait() bool {
+	if x != nil {
+		return x.Wait
+	}
+	return false
+}
+
+func (x *PRMergeConfig) GetRequiredChecks() []string {
+	if x != nil {
+		return x.RequiredChecks
 	}
 	return nil
 }
 
-// ReleaseUploadOutput holds the result of step.gh_release_upload.
-type ReleaseUploadOutput struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	AssetId       int64                  `protobuf:"varint,1,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
-	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
-	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Size          int64                  `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *PRMergeConfig) GetRequiredApprovals() string {
+	if x != nil {
+		return x.RequiredApprovals
+	}
+	return ""
+}
+
+func (x *PRMergeConfig) GetPollInterval() string {
+	if x != nil {
+		return x.PollInterval
+	}
+	return ""
+}
+
+func (x *PRMergeConfig) GetTimeout() string {
+	if x != nil {
+		return x.Timeout
+	}
+	return ""
+}
+
+func (x *PRMergeConfig) GetDeleteBranch() bool {
+	if x != nil {
+		return x.DeleteBranch
+	}
+	return false
+}
+
+func (x *PRMergeConfig) GetGraphqlUrl() string {
+	if x != nil {
+		return x.GraphqlUrl
+	}
+	return ""
+}
+
+// PRMergeInput carries runtime inputs for step.gh_pr_merge.
+type PRMergeInput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *structpb.Struct       `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PRMergeInput) Reset() {
+	*x = PRMergeInput{}
+	mi := &file_github_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PRMergeInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PRMergeInput) ProtoMessage() {}
+
+func (x *PRMergeInput) ProtoReflect() protoreflect.Message {
+	mi := &file_github_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PRMergeInput.ProtoReflect.Descriptor instead.
+func (*PRMergeInput) Descriptor() ([]byte, []int) {
+	return file_github_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *PRMergeInput) GetData() *structpb.Struct {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// PRMergeOutput holds the result of step.gh_pr_merge.
+type PRMergeOutput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Merged        bool                   `protobuf:"varint,1,opt,name=merged,proto3" json:"merged,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Sha           string                 `protobuf:"bytes,3,opt,name=sha,proto3" json:"sha,omitempty"`
+	HeadSha       string                 `protobuf:"bytes,4,opt,name=head_sha,json=headSha,proto3" json:"head_sha,omitempty"`
+	Mode          string                 `protobuf:"bytes,5,opt,name=mode,proto3" json:"mode,omitempty"`
+	AutoMerge     bool                   `protobuf:"varint,6,opt,name=auto_merge,json=autoMerge,proto3" json:"auto_merge,omitempty"`
+	Queued        bool                   `protobuf:"varint,7,opt,name=queued,proto3" json:"queued,omitempty"`
+	QueuePosition int64                  `protobuf:"varint,8,opt,name=queue_position,json=queuePosition,proto3" json:"queue_position,omitempty"`
+	BranchDeleted bool                   `protobuf:"varint,9,opt,name=branch_deleted,json=branchDeleted,proto3" json:"branch_deleted,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PRMergeOutput) Reset() {
+	*x = PRMergeOutput{}
+	mi := &file_github_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PRMergeOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PRMergeOutput) ProtoMessage() {}
+
+func (x *PRMergeOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_github_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PRMergeOutput.ProtoReflect.Descriptor instead.
+func (*PRMergeOutput) Descriptor() ([]byte, []int) {
+	return file_github_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *PRMergeOutput) GetMerged() bool {
+	if x != nil {
+		return x.Merged
+	}
+	return false
+}
+
+func (x *PRMergeOutput) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *PRMergeOutput) GetSha() string {
+	if x != nil {
+		return x.Sha
+	}
+	return ""
+}
+
+func (x *PRMergeOutput) GetHeadSha() string {
+	if x != nil {
+		return x.HeadSha
+	}
+	return ""
+}
+
+func (x *PRMergeOutput) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *PRMergeOutput) GetAutoMerge() bool {
+	if x != nil {
+		return x.AutoMerge
+	}
+	return false
+}
+
+func (x *PRMergeOutput) GetQueued() bool {
+	if x != nil {
+		return x.Queued
+	}
+	return false
+}
+
+func (x *PRMergeOutput) GetQueuePosition() int64 {
+	if x != nil {
+		return x.QueuePosition
+	}
+	return 0
+}
+
+func (x *PRMergeOutput) GetBranchDeleted() bool {
+	if x != nil {
+		return x.BranchDeleted
+	}
+	return false
+}
+
+// PRCommentConfig is the typed config for step.gh_pr_comment.
+type PRCommentConfig struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Owner             string                 `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Repo              string                 `protobuf:"bytes,2,opt,name=repo,proto3" json:"repo,omitempty"`
+	PrNumber          string                 `protobuf:"bytes,3,opt,name=pr_number,json=prNumber,proto3" json:"pr_number,omitempty"`
+	Body              string                 `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
+	Token             string                 `protobuf:"bytes,5,opt,name=token,proto3" json:"token,omitempty"`
+	AuthModule        string                 `protobuf:"bytes,6,opt,name=auth_module,json=authModule,proto3" json:"auth_module,omitempty"`
+	TokenRepositories []string               `protobuf:"bytes,7,rep,name=token_repositories,json=tokenRepositories,proto3" json:"token_repositories,omitempty"`
+	TokenPermissions  map[string]string      `protobuf:"bytes,8,rep,name=token_permissions,json=tokenPermissions,proto3" json:"token_permissions,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	ApiBaseUrl        string                 `protobuf:"bytes,9,opt,name=api_base_url,json=apiBaseUrl,proto3" json:"api_base_url,omitempty"`
+	StrictTemplates   bool                   `protobuf:"varint,10,opt,name=strict_templates,json=strictTemplates,proto3" json:"strict_templates,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *PRCommentConfig) Reset() {
+	*x = PRCommentConfig{}
+	mi := &file_github_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PRCommentConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PRCommentConfig) ProtoMessage() {}
+
+func (x *PRCommentConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_github_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PRCommentConfig.ProtoReflect.Descriptor instead.
+func (*PRCommentConfig) Descriptor() ([]byte, []int) {
+	return file_github_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *PRCommentConfig) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *PRCommentConfig) GetRepo() string {
+	if x != nil {
+		return x.Repo
+	}
+	return ""
+}
+
+func (x *PRCommentConfig) GetPrNumber() string {
+	if x != nil {
+		return x.PrNumber
+	}
+	return ""
+}
+
+func (x *PRCommentConfig) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *PRCommentConfig) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *PRCommentConfig) GetAuthModule() string {
+	if x != nil {
+		return x.AuthModule
+	}
+	return ""
+}
+
+func (x *PRCommentConfig) GetTokenRepositories() []string {
+	if x != nil {
+		return x.TokenRepositories
+	}
+	return nil
+}
+
+func (x *PRCommentConfig) GetTokenPermissions() map[string]string {
+	if x != nil {
+		return x.TokenPermissions
+	}
+	return nil
+}
+
+func (x *PRCommentConfig) GetApiBaseUrl() string {
+	if x != nil {
+		return x.ApiBaseUrl
+	}
+	return ""
+}
+
+func (x *PRCommentConfig) GetStrictTemplates() bool {
+	if x != nil {
+		return x.StrictTemplates
+	}
+	return false
+}
+
+// PRCommentInput carries runtime inputs for step.gh_pr_comment.
+type PRCommentInput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *structpb.Struct       `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PRCommentInput) Reset() {
+	*x = PRCommentInput{}
+	mi := &file_github_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PRCommentInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PRCommentInput) ProtoMessage() {}
+
+func (x *PRCommentInput) ProtoReflect() protoreflect.Message {
+	mi := &file_github_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PRCommentInput.ProtoReflect.Descriptor instead.
+func (*PRCommentInput) Descriptor() ([]byte, []int) {
+	return file_github_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *PRCommentInput) GetData() *structpb.Struct {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// PRCommentOutput holds the result of step.gh_pr_comment.
+type PRCommentOutput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CommentId     int64                  `protobuf:"varint,1,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PRCommentOutput) Reset() {
+	*x = PRCommentOutput{}
+	mi := &file_github_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PRCommentOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PRCommentOutput) ProtoMessage() {}
+
+func (x *PRCommentOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_github_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PRCommentOutput.ProtoReflect.Descriptor instead.
+func (*PRCommentOutput) Descriptor() ([]byte, []int) {
+	return file_github_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *PRCommentOutput) GetCommentId() int64 {
+	if x != nil {
+		return x.CommentId
+	}
+	return 0
+}
+
+func (x *PRCommentOutput) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+// PRReviewConfig is the typed config for step.gh_pr_review.
+type PRReviewConfig struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Owner             string                 `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Repo              string                 `protobuf:"bytes,2,opt,name=repo,proto3" json:"repo,omitempty"`
+	PrNumber          string                 `protobuf:"bytes,3,opt,name=pr_number,json=prNumber,proto3" json:"pr_number,omitempty"`
+	Event             string                 `protobuf:"bytes,4,opt,name=event,proto3" json:"event,omitempty"`
+	Body              string                 `protobuf:"bytes,5,opt,name=body,proto3" json:"body,omitempty"`
+	Token             string                 `protobuf:"bytes,6,opt,name=token,proto3" json:"token,omitempty"`
+	AuthModule        string                 `protobuf:"bytes,7,opt,name=auth_module,json=authModule,proto3" json:"auth_module,omitempty"`
+	TokenRepositories []string               `protobuf:"bytes,8,rep,name=token_repositories,json=tokenRepositories,proto3" json:"token_repositories,omitempty"`
+	TokenPermissions  map[string]string      `protobuf:"bytes,9,rep,name=token_permissions,json=tokenPermissions,proto3" json:"token_permissions,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	ApiBaseUrl        string                 `protobuf:"bytes,10,opt,name=api_base_url,json=apiBaseUrl,proto3" json:"api_base_url,omitempty"`
+	StrictTemplates   bool                   `protobuf:"varint,11,opt,name=strict_templates,json=strictTemplates,proto3" json:"strict_templates,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *PRReviewConfig) Reset() {
+	*x = PRReviewConfig{}
+	mi := &file_github_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PRReviewConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PRReviewConfig) ProtoMessage() {}
+
+func (x *PRReviewConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_github_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PRReviewConfig.ProtoReflect.Descriptor instead.
+func (*PRReviewConfig) Descriptor() ([]byte, []int) {
+	return file_github_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *PRReviewConfig) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *PRReviewConfig) GetRepo() string {
+	if x != nil {
+		return x.Repo
+	}
+	return ""
+}
+
+func (x *PRReviewConfig) GetPrNumber() string {
+	if x != nil {
+		return x.PrNumber
+	}
+	return ""
+}
+
+func (x *PRReviewConfig) GetEvent() string {
+	if x != nil {
+		return x.Event
+	}
+	return ""
+}
+
+func (x *PRReviewConfig) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *PRReviewConfig) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *PRReviewConfig) GetAuthModule() string {
+	if x != nil {
+		return x.AuthModule
+	}
+	return ""
+}
+
+func (x *PRReviewConfig) GetTokenRepositories() []string {
+	if x != nil {
+		return x.TokenRepositories
+	}
+	return nil
+}
+
+func (x *PRReviewConfig) GetTokenPermissions() map[string]string {
+	if x != nil {
+		return x.TokenPermissions
+	}
+	return nil
+}
+
+func (x *PRReviewConfig) GetApiBaseUrl() string {
+	if x != nil {
+		return x.ApiBaseUrl
+	}
+	return ""
+}
+
+func (x *PRReviewConfig) GetStrictTemplates() bool {
+	if x != nil {
+		return x.StrictTemplates
+	}
+	return false
+}
+
+// PRReviewInput carries runtime inputs for step.gh_pr_review.
+type PRReviewInput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *structpb.Struct       `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PRReviewInput) Reset() {
+	*x = PRReviewInput{}
+	mi := &file_github_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PRReviewInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PRReviewInput) ProtoMessage() {}
+
+func (x *PRReviewInput) ProtoReflect() protoreflect.Message {
+	mi := &file_github_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PRReviewInput.ProtoReflect.Descriptor instead.
+func (*PRReviewInput) Descriptor() ([]byte, []int) {
+	return file_github_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *PRReviewInput) GetData() *structpb.Struct {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// PRReviewOutput holds the result of step.gh_pr_review.
+type PRReviewOutput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReviewId      int64                  `protobuf:"varint,1,opt,name=review_id,json=reviewId,proto3" json:"review_id,omitempty"`
+	State         string                 `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	Url           string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PRReviewOutput) Reset() {
+	*x = PRReviewOutput{}
+	mi := &file_github_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PRReviewOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PRReviewOutput) ProtoMessage() {}
+
+func (x *PRReviewOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_github_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PRReviewOutput.ProtoReflect.Descriptor instead.
+func (*PRReviewOutput) Descriptor() ([]byte, []int) {
+	return file_github_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *PRReviewOutput) GetReviewId() int64 {
+	if x != nil {
+		return x.ReviewId
+	}
+	return 0
+}
+
+func (x *PRReviewOutput) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *PRReviewOutput) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+// IssueCreateConfig is the typed config for step.gh_issue_create.
+type IssueCreateConfig struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Owner             string                 `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Repo              string                 `protobuf:"bytes,2,opt,name=repo,proto3" json:"repo,omitempty"`
+	Title             string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Body              string                 `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
+	Labels            []string               `protobuf:"bytes,5,rep,name=labels,proto3" json:"labels,omitempty"`
+	Assignees         []string               `protobuf:"bytes,6,rep,name=assignees,proto3" json:"assignees,omitempty"`
+	Token             string                 `protobuf:"bytes,7,opt,name=token,proto3" json:"token,omitempty"`
+	AuthModule        string                 `protobuf:"bytes,8,opt,name=auth_module,json=authModule,proto3" json:"auth_module,omitempty"`
+	TokenRepositories []string               `protobuf:"bytes,9,rep,name=token_repositories,json=tokenRepositories,proto3" json:"token_repositories,omitempty"`
+	TokenPermissions  map[string]string      `protobuf:"bytes,10,rep,name=token_permissions,json=tokenPermissions,proto3" json:"token_permissions,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	ApiBaseUrl        string                 `protobuf:"bytes,11,opt,name=api_base_url,json=apiBaseUrl,proto3" json:"api_base_url,omitempty"`
+	StrictTemplates   bool                   `protobuf:"varint,12,opt,name=strict_templates,json=strictTemplates,proto3" json:"strict_templates,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *IssueCreateConfig) Reset() {
+	*x = IssueCreateConfig{}
+	mi := &file_github_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IssueCreateConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssueCreateConfig) ProtoMessage() {}
+
+func (x *IssueCreateConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_github_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssueCreateConfig.ProtoReflect.Descriptor instead.
+func (*IssueCreateConfig) Descriptor() ([]byte, []int) {
+	return file_github_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *IssueCreateConfig) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *IssueCreateConfig) GetRepo() string {
+	if x != nil {
+		return x.Repo
+	}
+	return ""
+}
+
+func (x *IssueCreateConfig) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *IssueCreateConfig) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *IssueCreateConfig) GetLabels() []string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *IssueCreateConfig) GetAssignees() []string {
+	if x != nil {
+		return x.Assignees
+	}
+	return nil
+}
+
+func (x *IssueCreateConfig) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *IssueCreateConfig) GetAuthModule() string {
+	if x != nil {
+		return x.AuthModule
+	}
+	return ""
+}
+
+func (x *IssueCreateConfig) GetTokenRepositories() []string {
+	if x != nil {
+		return x.TokenRepositories
+	}
+	return nil
+}
+
+func (x *IssueCreateConfig) GetTokenPermissions() map[string]string {
+	if x != nil {
+		return x.TokenPermissions
+	}
+	return nil
+}
+
+func (x *IssueCreateConfig) GetApiBaseUrl() string {
+	if x != nil {
+		return x.ApiBaseUrl
+	}
+	return ""
+}
+
+func (x *IssueCreateConfig) GetStrictTemplates() bool {
+	if x != nil {
+		return x.StrictTemplates
+	}
+	return false
+}
+
+// IssueCreateInput carries runtime inputs for step.gh_issue_create.
+type IssueCreateInput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *structpb.Struct       `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IssueCreateInput) Reset() {
+	*x = IssueCreateInput{}
+	mi := &file_github_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IssueCreateInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssueCreateInput) ProtoMessage() {}
+
+func (x *IssueCreateInput) ProtoReflect() protoreflect.Message {
+	mi := &file_github_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssueCreateInput.ProtoReflect.Descriptor instead.
+func (*IssueCreateInput) Descriptor() ([]byte, []int) {
+	return file_github_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *IssueCreateInput) GetData() *structpb.Struct {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// IssueCreateOutput holds the result of step.gh_issue_create.
+type IssueCreateOutput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Number        int64                  `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"`
+	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Id            int64                  `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"`
+	State         string                 `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IssueCreateOutput) Reset() {
+	*x = IssueCreateOutput{}
+	mi := &file_github_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IssueCreateOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssueCreateOutput) ProtoMessage() {}
+
+func (x *IssueCreateOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_github_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssueCreateOutput.ProtoReflect.Descriptor instead.
+func (*IssueCreateOutput) Descriptor() ([]byte, []int) {
+	return file_github_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *IssueCreateOutput) GetNumber() int64 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
+}
+
+func (x *IssueCreateOutput) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *IssueCreateOutput) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *IssueCreateOutput) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+// IssueCloseConfig is the typed config for step.gh_issue_close.
+type IssueCloseConfig struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Owner             string                 `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Repo              string                 `protobuf:"bytes,2,opt,name=repo,proto3" json:"repo,omitempty"`
+	IssueNumber       string                 `protobuf:"bytes,3,opt,name=issue_number,json=issueNumber,proto3" json:"issue_number,omitempty"`
+	Comment           string                 `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment,omitempty"`
+	Token             string                 `protobuf:"bytes,5,opt,name=token,proto3" json:"token,omitempty"`
+	AuthModule        string                 `protobuf:"bytes,6,opt,name=auth_module,json=authModule,proto3" json:"auth_module,omitempty"`
+	TokenRepositories []string               `protobuf:"bytes,7,rep,name=token_repositories,json=tokenRepositories,proto3" json:"token_repositories,omitempty"`
+	TokenPermissions  map[string]string      `protobuf:"bytes,8,rep,name=token_permissions,json=tokenPermissions,proto3" json:"token_permissions,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	ApiBaseUrl        string                 `protobuf:"bytes,9,opt,name=api_base_url,json=apiBaseUrl,proto3" json:"api_base_url,omitempty"`
+	StrictTemplates   bool                   `protobuf:"varint,10,opt,name=strict_templates,json=strictTemplates,proto3" json:"strict_templates,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *IssueCloseConfig) Reset() {
+	*x = IssueCloseConfig{}
+	mi := &file_github_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IssueCloseConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssueCloseConfig) ProtoMessage() {}
+
+func (x *IssueCloseConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_github_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssueCloseConfig.ProtoReflect.Descriptor instead.
+func (*IssueCloseConfig) Descriptor() ([]byte, []int) {
+	return file_github_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *IssueCloseConfig) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *IssueCloseConfig) GetRepo() string {
+	if x != nil {
+		return x.Repo
+	}
+	return ""
+}
+
+func (x *IssueCloseConfig) GetIssueNumber() string {
+	if x != nil {
+		return x.IssueNumber
+	}
+	return ""
+}
+
+func (x *IssueCloseConfig) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *IssueCloseConfig) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *IssueCloseConfig) GetAuthModule() string {
+	if x != nil {
+		return x.AuthModule
+	}
+	return ""
+}
+
+func (x *IssueCloseConfig) GetTokenRepositories() []string {
+	if x != nil {
+		return x.TokenRepositories
+	}
+	return nil
+}
+
+func (x *IssueCloseConfig) GetTokenPermissions() map[string]string {
+	if x != nil {
+		return x.TokenPermissions
+	}
+	return nil
+}
+
+func (x *IssueCloseConfig) GetApiBaseUrl() string {
+	if x != nil {
+		return x.ApiBaseUrl
+	}
+	return ""
+}
+
+func (x *IssueCloseConfig) GetStrictTemplates() bool {
+	if x != nil {
+		return x.StrictTemplates
+	}
+	return false
+}
+
+// IssueCloseInput carries runtime inputs for step.gh_issue_close.
+type IssueCloseInput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *structpb.Struct       `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IssueCloseInput) Reset() {
+	*x = IssueCloseInput{}
+	mi := &file_github_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IssueCloseInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssueCloseInput) ProtoMessage() {}
+
+func (x *IssueCloseInput) ProtoReflect() protoreflect.Message {
+	mi := &file_github_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssueCloseInput.ProtoReflect.Descriptor instead.
+func (*IssueCloseInput) Descriptor() ([]byte, []int) {
+	return file_github_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *IssueCloseInput) GetData() *structpb.Struct {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// IssueCloseOutput holds the result of step.gh_issue_close.
+type IssueCloseOutput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Number        int64                  `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"`
+	State         string                 `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	Url           string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IssueCloseOutput) Reset() {
+	*x = IssueCloseOutput{}
+	mi := &file_github_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IssueCloseOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssueCloseOutput) ProtoMessage() {}
+
+func (x *IssueCloseOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_github_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssueCloseOutput.ProtoReflect.Descriptor instead.
+func (*IssueCloseOutput) Descriptor() ([]byte, []int) {
+	return file_github_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *IssueCloseOutput) GetNumber() int64 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
+}
+
+func (x *IssueCloseOutput) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *IssueCloseOutput) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+// IssueLabelConfig is the typed config for step.gh_issue_label.
+type IssueLabelConfig struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Owner             string                 `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Repo              string                 `protobuf:"bytes,2,opt,name=repo,proto3" json:"repo,omitempty"`
+	IssueNumber       string                 `protobuf:"bytes,3,opt,name=issue_number,json=issueNumber,proto3" json:"issue_number,omitempty"`
+	Add               []string               `protobuf:"bytes,4,rep,name=add,proto3" json:"add,omitempty"`
+	Remove            []string               `protobuf:"bytes,5,rep,name=remove,proto3" json:"remove,omitempty"`
+	Token             string                 `protobuf:"bytes,6,opt,name=token,proto3" json:"token,omitempty"`
+	AuthModule        string                 `protobuf:"bytes,7,opt,name=auth_module,json=authModule,proto3" json:"auth_module,omitempty"`
+	TokenRepositories []string               `protobuf:"bytes,8,rep,name=token_repositories,json=tokenRepositories,proto3" json:"token_repositories,omitempty"`
+	TokenPermissions  map[string]string      `protobuf:"bytes,9,rep,name=token_permissions,json=tokenPermissions,proto3" json:"token_permissions,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	ApiBaseUrl        string                 `protobuf:"bytes,10,opt,name=api_base_url,json=apiBaseUrl,proto3" json:"api_base_url,omitempty"`
+	StrictTemplates   bool                   `protobuf:"varint,11,opt,name=strict_templates,json=strictTemplates,proto3" json:"strict_templates,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *IssueLabelConfig) Reset() {
+	*x = IssueLabelConfig{}
+	mi := &file_github_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IssueLabelConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssueLabelConfig) ProtoMessage() {}
+
+func (x *IssueLabelConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_github_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssueLabelConfig.ProtoReflect.Descriptor instead.
+func (*IssueLabelConfig) Descriptor() ([]byte, []int) {
+	return file_github_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *IssueLabelConfig) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *IssueLabelConfig) GetRepo() string {
+	if x != nil {
+		return x.Repo
+	}
+	return ""
+}
+
+func (x *IssueLabelConfig) GetIssueNumber() string {
+	if x != nil {
+		return x.IssueNumber
+	}
+	return ""
+}
+
+func (x *IssueLabelConfig) GetAdd() []string {
+	if x != nil {
+		return x.Add
+	}
+	return nil
+}
+
+func (x *IssueLabelConfig) GetRemove() []string {
+	if x != nil {
+		return x.Remove
+	}
+	return nil
+}
+
+func (x *IssueLabelConfig) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *IssueLabelConfig) GetAuthModule() string {
+	if x != nil {
+		return x.AuthModule
+	}
+	return ""
+}
+
+func (x *IssueLabelConfig) GetTokenRepositories() []string {
+	if x != nil {
+		return x.TokenRepositories
+	}
+	return nil
+}
+
+func (x *IssueLabelConfig) GetTokenPermissions() map[string]string {
+	if x != nil {
+		return x.TokenPermissions
+	}
+	return nil
+}
+
+func (x *IssueLabelConfig) GetApiBaseUrl() string {
+	if x != nil {
+		return x.ApiBaseUrl
+	}
+	return ""
+}
+
+func (x *IssueLabelConfig) GetStrictTemplates() bool {
+	if x != nil {
+		return x.StrictTemplates
+	}
+	return false
+}
+
+// IssueLabelInput carries runtime inputs for step.gh_issue_label.
+type IssueLabelInput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *structpb.Struct       `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IssueLabelInput) Reset() {
+	*x = IssueLabelInput{}
+	mi := &file_github_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IssueLabelInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssueLabelInput) ProtoMessage() {}
+
+func (x *IssueLabelInput) ProtoReflect() protoreflect.Message {
+	mi := &file_github_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssueLabelInput.ProtoReflect.Descriptor instead.
+func (*IssueLabelInput) Descriptor() ([]byte, []int) {
+	return file_github_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *IssueLabelInput) GetData() *structpb.Struct {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// IssueLabelOutput holds the result of step.gh_issue_label.
+type IssueLabelOutput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Added         []string               `protobuf:"bytes,1,rep,name=added,proto3" json:"added,omitempty"`
+	Removed       []string               `protobuf:"bytes,2,rep,name=removed,proto3" json:"removed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IssueLabelOutput) Reset() {
+	*x = IssueLabelOutput{}
+	mi := &file_github_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IssueLabelOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssueLabelOutput) ProtoMessage() {}
+
+func (x *IssueLabelOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_github_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssueLabelOutput.ProtoReflect.Descriptor instead.
+func (*IssueLabelOutput) Descriptor() ([]byte, []int) {
+	return file_github_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *IssueLabelOutput) GetAdded() []string {
+	if x != nil {
+		return x.Added
+	}
+	return nil
+}
+
+func (x *IssueLabelOutput) GetRemoved() []string {
+	if x != nil {
+		return x.Removed
+	}
+	return nil
+}
+
+// ReleaseCreateConfig is the typed config for step.gh_release_create.
+type ReleaseCreateConfig struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Owner             string                 `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Repo              string                 `protobuf:"bytes,2,opt,name=repo,proto3" json:"repo,omitempty"`
+	Tag               string                 `protobuf:"bytes,3,opt,name=tag,proto3" json:"tag,omitempty"`
+	Name              string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Body              string                 `protobuf:"bytes,5,opt,name=body,proto3" json:"body,omitempty"`
+	Draft             bool                   `protobuf:"varint,6,opt,name=draft,proto3" json:"draft,omitempty"`
+	Prerelease        bool                   `protobuf:"varint,7,opt,name=prerelease,proto3" json:"prerelease,omitempty"`
+	Token             string                 `protobuf:"bytes,8,opt,name=token,proto3" json:"token,omitempty"`
+	AuthModule        string                 `protobuf:"bytes,9,opt,name=auth_module,json=authModule,proto3" json:"auth_module,omitempty"`
+	TokenRepositories []string               `protobuf:"bytes,10,rep,name=token_repositories,json=tokenRepositories,proto3" json:"token_repositories,omitempty"`
+	TokenPermissions  map[string]string      `protobuf:"bytes,11,rep,name=token_permissions,json=tokenPermissions,proto3" json:"token_permissions,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	ApiBaseUrl        string                 `protobuf:"bytes,12,opt,name=api_base_url,json=apiBaseUrl,proto3" json:"api_base_url,omitempty"`
+	StrictTemplates   bool                   `protobuf:"varint,13,opt,name=strict_templates,json=strictTemplates,proto3" json:"strict_templates,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ReleaseCreateConfig) Reset() {
+	*x = ReleaseCreateConfig{}
+	mi := &file_github_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseCreateConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseCreateConfig) ProtoMessage() {}
+
+func (x *ReleaseCreateConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_github_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseCreateConfig.ProtoReflect.Descriptor instead.
+func (*ReleaseCreateConfig) Descriptor() ([]byte, []int) {
+	return file_github_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ReleaseCreateConfig) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *ReleaseCreateConfig) GetRepo() string {
+	if x != nil {
+		return x.Repo
+	}
+	return ""
+}
+
+func (x *ReleaseCreateConfig) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *ReleaseCreateConfig) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ReleaseCreateConfig) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *ReleaseCreateConfig) GetDraft() bool {
+	if x != nil {
+		return x.Draft
+	}
+	return false
+}
+
+func (x *ReleaseCreateConfig) GetPrerelease() bool {
+	if x != nil {
+		return x.Prerelease
+	}
+	return false
+}
+
+func (x *ReleaseCreateConfig) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ReleaseCreateConfig) GetAuthModule() string {
+	if x != nil {
+		return x.AuthModule
+	}
+	return ""
+}
+
+func (x *ReleaseCreateConfig) GetTokenRepositories() []string {
+	if x != nil {
+		return x.TokenRepositories
+	}
+	return nil
+}
+
+func (x *ReleaseCreateConfig) GetTokenPermissions() map[string]string {
+	if x != nil {
+		return x.TokenPermissions
+	}
+	return nil
+}
+
+func (x *ReleaseCreateConfig) GetApiBaseUrl() string {
+	if x != nil {
+		return x.ApiBaseUrl
+	}
+	return ""
+}
+
+func (x *ReleaseCreateConfig) GetStrictTemplates() bool {
+	if x != nil {
+		return x.StrictTemplates
+	}
+	return false
+}
+
+// ReleaseCreateInput carries runtime inputs for step.gh_release_create.
+type ReleaseCreateInput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *structpb.Struct       `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseCreateInput) Reset() {
+	*x = ReleaseCreateInput{}
+	mi := &file_github_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseCreateInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseCreateInput) ProtoMessage() {}
+
+func (x *ReleaseCreateInput) ProtoReflect() protoreflect.Message {
+	mi := &file_github_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseCreateInput.ProtoReflect.Descriptor instead.
+func (*ReleaseCreateInput) Descriptor() ([]byte, []int) {
+	return file_github_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ReleaseCreateInput) GetData() *structpb.Struct {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// ReleaseCreateOutput holds the result of step.gh_release_create.
+type ReleaseCreateOutput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReleaseId     int64                  `protobuf:"varint,1,opt,name=release_id,json=releaseId,proto3" json:"release_id,omitempty"`
+	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	UploadUrl     string                 `protobuf:"bytes,3,opt,name=upload_url,json=uploadUrl,proto3" json:"upload_url,omitempty"`
+	Tag           string                 `protobuf:"bytes,4,opt,name=tag,proto3" json:"tag,omitempty"`
+	Draft         bool                   `protobuf:"varint,5,opt,name=draft,proto3" json:"draft,omitempty"`
+	Prerelease    bool                   `protobuf:"varint,6,opt,name=prerelease,proto3" json:"prerelease,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseCreateOutput) Reset() {
+	*x = ReleaseCreateOutput{}
+	mi := &file_github_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseCreateOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseCreateOutput) ProtoMessage() {}
+
+func (x *ReleaseCreateOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_github_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseCreateOutput.ProtoReflect.Descriptor instead.
+func (*ReleaseCreateOutput) Descriptor() ([]byte, []int) {
+	return file_github_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ReleaseCreateOutput) GetReleaseId() int64 {
+	if x != nil {
+		return x.ReleaseId
+	}
+	return 0
+}
+
+func (x *ReleaseCreateOutput) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *ReleaseCreateOutput) GetUploadUrl() string {
+	if x != nil {
+		return x.UploadUrl
+	}
+	return ""
+}
+
+func (x *ReleaseCreateOutput) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *ReleaseCreateOutput) GetDraft() bool {
+	if x != nil {
+		return x.Draft
+	}
+	return false
+}
+
+func (x *ReleaseCreateOutput) GetPrerelease() bool {
+	if x != nil {
+		return x.Prerelease
+	}
+	return false
+}
+
+// ReleaseUploadConfig is the typed config for step.gh_release_upload.
+type ReleaseUploadConfig struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Owner             string                 `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Repo              string                 `protobuf:"bytes,2,opt,name=repo,proto3" json:"repo,omitempty"`
+	ReleaseId         string                 `protobuf:"bytes,3,opt,name=release_id,json=releaseId,proto3" json:"release_id,omitempty"`
+	File              string                 `protobuf:"bytes,4,opt,name=file,proto3" json:"file,omitempty"`
+	Name              string                 `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
+	Token             string                 `protobuf:"bytes,6,opt,name=token,proto3" json:"token,omitempty"`
+	AuthModule        string                 `protobuf:"bytes,7,opt,name=auth_module,json=authModule,proto3" json:"auth_module,omitempty"`
+	TokenRepositories []string               `protobuf:"bytes,8,rep,name=token_repositories,json=tokenRepositories,proto3" json:"token_repositories,omitempty"`
+	TokenPermissions  map[string]string      `protobuf:"bytes,9,rep,name=token_permissions,json=tokenPermissions,proto3" json:"token_permissions,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	ApiBaseUrl        string                 `protobuf:"bytes,10,opt,name=api_base_url,json=apiBaseUrl,proto3" json:"api_base_url,omitempty"`
+	UploadUrl         string                 `protobuf:"bytes,11,opt,name=upload_url,json=uploadUrl,proto3" json:"upload_url,omitempty"`
+	StrictTemplates   bool                   `protobuf:"varint,12,opt,name=strict_templates,json=strictTemplates,proto3" json:"strict_templates,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ReleaseUploadConfig) Reset() {
+	*x = ReleaseUploadConfig{}
+	mi := &file_github_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseUploadConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseUploadConfig) ProtoMessage() {}
+
+func (x *ReleaseUploadConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_github_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseUploadConfig.ProtoReflect.Descriptor instead.
+func (*ReleaseUploadConfig) Descriptor() ([]byte, []int) {
+	return file_github_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ReleaseUploadConfig) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *ReleaseUploadConfig) GetRepo() string {
+	if x != nil {
+		return x.Repo
+	}
+	return ""
+}
+
+func (x *ReleaseUploadConfig) GetReleaseId() string {
+	if x != nil {
+		return x.ReleaseId
+	}
+	return ""
+}
+
+func (x *ReleaseUploadConfig) GetFile() string {
+	if x != nil {
+		return x.File
+	}
+	return ""
+}
+
+func (x *ReleaseUploadConfig) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ReleaseUploadConfig) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ReleaseUploadConfig) GetAuthModule() string {
+	if x != nil {
+		return x.AuthModule
+	}
+	return ""
+}
+
+func (x *ReleaseUploadConfig) GetTokenRepositories() []string {
+	if x != nil {
+		return x.TokenRepositories
+	}
+	return nil
+}
+
+func (x *ReleaseUploadConfig) GetTokenPermissions() map[string]string {
+	if x != nil {
+		return x.TokenPermissions
+	}
+	return nil
+}
+
+func (x *ReleaseUploadConfig) GetApiBaseUrl() string {
+	if x != nil {
+		return x.ApiBaseUrl
+	}
+	return ""
+}
+
+func (x *ReleaseUploadConfig) GetUploadUrl() string {
+	if x != nil {
+		return x.UploadUrl
+	}
+	return ""
+}
+
+func (x *ReleaseUploadConfig) GetStrictTemplates() bool {
+	if x != nil {
+		return x.StrictTemplates
+	}
+	return false
+}
+
+// ReleaseUploadInput carries runtime inputs for step.gh_release_upload.
+type ReleaseUploadInput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *structpb.Struct       `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseUploadInput) Reset() {
+	*x = ReleaseUploadInput{}
+	mi := &file_github_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseUploadInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseUploadInput) ProtoMessage() {}
+
+func (x *ReleaseUploadInput) ProtoReflect() protoreflect.Message {
+	mi := &file_github_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseUploadInput.ProtoReflect.Descriptor instead.
+func (*ReleaseUploadInput) Descriptor() ([]byte, []int) {
+	return file_github_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ReleaseUploadInput) GetData() *structpb.Struct {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// ReleaseUploadOutput holds the result of step.gh_release_upload.
+type ReleaseUploadOutput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AssetId       int64                  `protobuf:"varint,1,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Size          int64                  `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseUploadOutput) Reset() {
+	*x = ReleaseUploadOutput{}
+	mi := &file_github_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseUploadOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseUploadOutput) ProtoMessage() {}
+
+func (x *ReleaseUploadOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_github_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseUploadOutput.ProtoReflect.Descriptor instead.
+func (*ReleaseUploadOutput) Descriptor() ([]byte, []int) {
+	return file_github_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ReleaseUploadOutput) GetAssetId() int64 {
+	if x != nil {
+		return x.AssetId
+	}
+	return 0
+}
+
+func (x *ReleaseUploadOutput) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *ReleaseUploadOutput) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ReleaseUploadOutput) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+// UpstreamReleaseMonitorConfig is the typed config for step.gh_upstream_release_monitor.
+type UpstreamReleaseMonitorConfig struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	UpstreamOwner     string                 `protobuf:"bytes,1,opt,name=upstream_owner,json=upstreamOwner,proto3" json:"upstream_owner,omitempty"`
+	UpstreamRepo      string                 `protobuf:"bytes,2,opt,name=upstream_repo,json=upstreamRepo,proto3" json:"upstream_repo,omitempty"`
+	PinnedTag         string                 `protobuf:"bytes,3,opt,name=pinned_tag,json=pinnedTag,proto3" json:"pinned_tag,omitempty"`
+	Token             string                 `protobuf:"bytes,4,opt,name=token,proto3" json:"token,omitempty"`
+	AuthModule        string                 `protobuf:"bytes,5,opt,name=auth_module,json=authModule,proto3" json:"auth_module,omitempty"`
+	TokenRepositories []string               `protobuf:"bytes,6,rep,name=token_repositories,json=tokenRepositories,proto3" json:"token_repositories,omitempty"`
+	TokenPermissions  map[string]string      `protobuf:"bytes,7,rep,name=token_permissions,json=tokenPermissions,proto3" json:"token_permissions,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	ApiBaseUrl        string                 `protobuf:"bytes,8,opt,name=api_base_url,json=apiBaseUrl,proto3" json:"api_base_url,omitempty"`
+	StrictTemplates   bool                   `protobuf:"varint,9,opt,name=strict_templates,json=strictTemplates,proto3" json:"strict_templates,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *UpstreamReleaseMonitorConfig) Reset() {
+	*x = UpstreamReleaseMonitorConfig{}
+	mi := &file_github_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpstreamReleaseMonitorConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpstreamReleaseMonitorConfig) ProtoMessage() {}
+
+func (x *UpstreamReleaseMonitorConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_github_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpstreamReleaseMonitorConfig.ProtoReflect.Descriptor instead.
+func (*UpstreamReleaseMonitorConfig) Descriptor() ([]byte, []int) {
+	return file_github_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *UpstreamReleaseMonitorConfig) GetUpstreamOwner() string {
+	if x != nil {
+		return x.UpstreamOwner
+	}
+	return ""
+}
+
+func (x *UpstreamReleaseMonitorConfig) GetUpstreamRepo() string {
+	if x != nil {
+		return x.UpstreamRepo
+	}
+	return ""
+}
+
+func (x *UpstreamReleaseMonitorConfig) GetPinnedTag() string {
+	if x != nil {
+		return x.PinnedTag
+	}
+	return ""
+}
+
+func (x *UpstreamReleaseMonitorConfig) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *UpstreamReleaseMonitorConfig) GetAuthModule() string {
+	if x != nil {
+		return x.AuthModule
+	}
+	return ""
+}
+
+func (x *UpstreamReleaseMonitorConfig) GetTokenRepositories() []string {
+	if x != nil {
+		return x.TokenRepositories
+	}
+	return nil
+}
+
+func (x *UpstreamReleaseMonitorConfig) GetTokenPermissions() map[string]string {
+	if x != nil {
+		return x.TokenPermissions
+	}
+	return nil
+}
+
+func (x *UpstreamReleaseMonitorConfig) GetApiBaseUrl() string {
+	if x != nil {
+		return x.ApiBaseUrl
+	}
+	return ""
+}
+
+func (x *UpstreamReleaseMonitorConfig) GetStrictTemplates() bool {
+	if x != nil {
+		return x.StrictTemplates
+	}
+	return false
+}
+
+// UpstreamReleaseMonitorInput carries runtime inputs for step.gh_upstream_release_monitor.
+type UpstreamReleaseMonitorInput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *structpb.Struct       `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpstreamReleaseMonitorInput) Reset() {
+	*x = UpstreamReleaseMonitorInput{}
+	mi := &file_github_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpstreamReleaseMonitorInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpstreamReleaseMonitorInput) ProtoMessage() {}
+
+func (x *UpstreamReleaseMonitorInput) ProtoReflect() protoreflect.Message {
+	mi := &file_github_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpstreamReleaseMonitorInput.ProtoReflect.Descriptor instead.
+func (*UpstreamReleaseMonitorInput) Descriptor() ([]byte, []int) {
+	return file_github_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *UpstreamReleaseMonitorInput) GetData() *structpb.Struct {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// UpstreamReleaseMonitorOutput holds the result of step.gh_upstream_release_monitor.
+type UpstreamReleaseMonitorOutput struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	UpstreamOwner   string                 `protobuf:"bytes,1,opt,name=upstream_owner,json=upstreamOwner,proto3" json:"upstream_owner,omitempty"`
+	UpstreamRepo    string                 `protobuf:"bytes,2,opt,name=upstream_repo,json=upstreamRepo,proto3" json:"upstream_repo,omitempty"`
+	PinnedTag       string                 `protobuf:"bytes,3,opt,name=pinned_tag,json=pinnedTag,proto3" json:"pinned_tag,omitempty"`
+	LatestTag       string                 `protobuf:"bytes,4,opt,name=latest_tag,json=latestTag,proto3" json:"latest_tag,omitempty"`
+	UpdateAvailable bool                   `protobuf:"varint,5,opt,name=update_available,json=updateAvailable,proto3" json:"update_available,omitempty"`
+	ReleaseId       int64                  `protobuf:"varint,6,opt,name=release_id,json=releaseId,proto3" json:"release_id,omitempty"`
+	ReleaseUrl      string                 `protobuf:"bytes,7,opt,name=release_url,json=releaseUrl,proto3" json:"release_url,omitempty"`
+	PublishedAt     string                 `protobuf:"bytes,8,opt,name=published_at,json=publishedAt,proto3" json:"published_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UpstreamReleaseMonitorOutput) Reset() {
+	*x = UpstreamReleaseMonitorOutput{}
+	mi := &file_github_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpstreamReleaseMonitorOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpstreamReleaseMonitorOutput) ProtoMessage() {}
+
+func (x *UpstreamReleaseMonitorOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_github_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpstreamReleaseMonitorOutput.ProtoReflect.Descriptor instead.
+func (*UpstreamReleaseMonitorOutput) Descriptor() ([]byte, []int) {
+	return file_github_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *UpstreamReleaseMonitorOutput) GetUpstreamOwner() string {
+	if x != nil {
+		return x.UpstreamOwner
+	}
+	return ""
+}
+
+func (x *UpstreamReleaseMonitorOutput) GetUpstreamRepo() string {
+	if x != nil {
+		return x.UpstreamRepo
+	}
+	return ""
+}
+
+func (x *UpstreamReleaseMonitorOutput) GetPinnedTag() string {
+	if x != nil {
+		return x.PinnedTag
+	}
+	return ""
+}
+
+func (x *UpstreamReleaseMonitorOutput) GetLatestTag() string {
+	if x != nil {
+		return x.LatestTag
+	}
+	return ""
+}
+
+func (x *UpstreamReleaseMonitorOutput) GetUpdateAvailable() bool {
+	if x != nil {
+		return x.UpdateAvailable
+	}
+	return false
+}
+
+func (x *UpstreamReleaseMonitorOutput) GetReleaseId() int64 {
+	if x != nil {
+		return x.ReleaseId
+	}
+	return 0
+}
+
+func (x *UpstreamReleaseMonitorOutput) GetReleaseUrl() string {
+	if x != nil {
+		return x.ReleaseUrl
+	}
+	return ""
+}
+
+func (x *UpstreamReleaseMonitorOutput) GetPublishedAt() string {
+	if x != nil {
+		return x.PublishedAt
+	}
+	return ""
+}
+
+// RepoDispatchConfig is the typed config for step.gh_repo_dispatch.
+type RepoDispatchConfig struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Owner             string                 `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Repo              string                 `protobuf:"bytes,2,opt,name=repo,proto3" json:"repo,omitempty"`
+	EventType         string                 `protobuf:"bytes,3,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	Payload           *structpb.Struct       `protobuf:"bytes,4,opt,name=payload,proto3" json:"payload,omitempty"`
+	Token             string                 `protobuf:"bytes,5,opt,name=token,proto3" json:"token,omitempty"`
+	AuthModule        string                 `protobuf:"bytes,6,opt,name=auth_module,json=authModule,proto3" json:"auth_module,omitempty"`
+	TokenRepositories []string               `protobuf:"bytes,7,rep,name=token_repositories,json=tokenRepositories,proto3" json:"token_repositories,omitempty"`
+	TokenPermissions  map[string]string      `protobuf:"bytes,8,rep,name=token_permissions,json=tokenPermissions,proto3" json:"token_permissions,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	ApiBaseUrl        string                 `protobuf:"bytes,9,opt,name=api_base_url,json=apiBaseUrl,proto3" json:"api_base_url,omitempty"`
+	StrictTemplates   bool                   `protobuf:"varint,10,opt,name=strict_templates,json=strictTemplates,proto3" json:"strict_templates,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *RepoDispatchConfig) Reset() {
+	*x = RepoDispatchConfig{}
+	mi := &file_github_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RepoDispatchConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepoDispatchConfig) ProtoMessage() {}
+
+func (x *RepoDispatchConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_github_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepoDispatchConfig.ProtoReflect.Descriptor instead.
+func (*RepoDispatchConfig) Descriptor() ([]byte, []int) {
+	return file_github_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *RepoDispatchConfig) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *RepoDispatchConfig) GetRepo() string {
+	if x != nil {
+		return x.Repo
+	}
+	return ""
+}
+
+func (x *RepoDispatchConfig) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *RepoDispatchConfig) GetPayload() *structpb.Struct {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *RepoDispatchConfig) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *RepoDispatchConfig) GetAuthModule() string {
+	if x != nil {
+		return x.AuthModule
+	}
+	return ""
+}
+
+func (x *RepoDispatchConfig) GetTokenRepositories() []string {
+	if x != nil {
+		return x.TokenRepositories
+	}
+	return nil
+}
+
+func (x *RepoDispatchConfig) GetTokenPermissions() map[string]string {
+	if x != nil {
+		return x.TokenPermissions
+	}
+	return nil
+}
+
+func (x *RepoDispatchConfig) GetApiBaseUrl() string {
+	if x != nil {
+		return x.ApiBaseUrl
+	}
+	return ""
+}
+
+func (x *RepoDispatchConfig) GetStrictTemplates() bool {
+	if x != nil {
+		return x.StrictTemplates
+	}
+	return false
+}
+
+// RepoDispatchInput carries runtime inputs for step.gh_repo_dispatch.
+type RepoDispatchInput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *structpb.Struct       `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RepoDispatchInput) Reset() {
+	*x = RepoDispatchInput{}
+	mi := &file_github_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RepoDispatchInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepoDispatchInput) ProtoMessage() {}
+
+func (x *RepoDispatchInput) ProtoReflect() protoreflect.Message {
+	mi := &file_github_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepoDispatchInput.ProtoReflect.Descriptor instead.
+func (*RepoDispatchInput) Descriptor() ([]byte, []int) {
+	return file_github_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *RepoDispatchInput) GetData() *structpb.Struct {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// RepoDispatchOutput holds the result of step.gh_repo_dispatch.
+type RepoDispatchOutput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Dispatched    bool                   `protobuf:"varint,1,opt,name=dispatched,proto3" json:"dispatched,omitempty"`
+	EventType     string                 `protobuf:"bytes,2,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	Owner         string                 `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	Repo          string                 `protobuf:"bytes,4,opt,name=repo,proto3" json:"repo,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RepoDispatchOutput) Reset() {
+	*x = RepoDispatchOutput{}
+	mi := &file_github_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RepoDispatchOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepoDispatchOutput) ProtoMessage() {}
+
+func (x *RepoDispatchOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_github_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepoDispatchOutput.ProtoReflect.Descriptor instead.
+func (*RepoDispatchOutput) Descriptor() ([]byte, []int) {
+	return file_github_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *RepoDispatchOutput) GetDispatched() bool {
+	if x != nil {
+		return x.Dispatched
+	}
+	return false
+}
+
+func (x *RepoDispatchOutput) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *RepoDispatchOutput) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *RepoDispatchOutput) GetRepo() string {
+	if x != nil {
+		return x.Repo
+	}
+	return ""
+}
+
+// DeploymentCreateConfig is the typed config for step.gh_deployment_create.
+type DeploymentCreateConfig struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Owner             string                 `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Repo              string                 `protobuf:"bytes,2,opt,name=repo,proto3" json:"repo,omitempty"`
+	Ref               string                 `protobuf:"bytes,3,opt,name=ref,proto3" json:"ref,omitempty"`
+	Environment       string                 `protobuf:"bytes,4,opt,name=environment,proto3" json:"environment,omitempty"`
+	Description       string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	AutoMerge         bool                   `protobuf:"varint,6,opt,name=auto_merge,json=autoMerge,proto3" json:"auto_merge,omitempty"`
+	Token             string                 `protobuf:"bytes,7,opt,name=token,proto3" json:"token,omitempty"`
+	AuthModule        string                 `protobuf:"bytes,8,opt,name=auth_module,json=authModule,proto3" json:"auth_module,omitempty"`
+	TokenRepositories []string               `protobuf:"bytes,9,rep,name=token_repositories,json=tokenRepositories,proto3" json:"token_repositories,omitempty"`
+	TokenPermissions  map[string]string      `protobuf:"bytes,10,rep,name=token_permissions,json=tokenPermissions,proto3" json:"token_permissions,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	ApiBaseUrl        string                 `protobuf:"bytes,11,opt,name=api_base_url,json=apiBaseUrl,proto3" json:"api_base_url,omitempty"`
+	StrictTemplates   bool                   `protobuf:"varint,12,opt,name=strict_templates,json=strictTemplates,proto3" json:"strict_templates,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *DeploymentCreateConfig) Reset() {
+	*x = DeploymentCreateConfig{}
+	mi := &file_github_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeploymentCreateConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeploymentCreateConfig) ProtoMessage() {}
+
+func (x *DeploymentCreateConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_github_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeploymentCreateConfig.ProtoReflect.Descriptor instead.
+func (*DeploymentCreateConfig) Descriptor() ([]byte, []int) {
+	return file_github_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *DeploymentCreateConfig) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *DeploymentCreateConfig) GetRepo() string {
+	if x != nil {
+		return x.Repo
+	}
+	return ""
+}
+
+func (x *DeploymentCreateConfig) GetRef() string {
+	if x != nil {
+		return x.Ref
+	}
+	return ""
+}
+
+func (x *DeploymentCreateConfig) GetEnvironment() string {
+	if x != nil {
+		return x.Environment
+	}
+	return ""
+}
+
+func (x *DeploymentCreateConfig) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *DeploymentCreateConfig) GetAutoMerge() bool {
+	if x != nil {
+		return x.AutoMerge
+	}
+	return false
+}
+
+func (x *DeploymentCreateConfig) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *DeploymentCreateConfig) GetAuthModule() string {
+	if x != nil {
+		return x.AuthModule
+	}
+	return ""
+}
+
+func (x *DeploymentCreateConfig) GetTokenRepositories() []string {
+	if x != nil {
+		return x.TokenRepositories
+	}
+	return nil
+}
+
+func (x *DeploymentCreateConfig) GetTokenPermissions() map[string]string {
+	if x != nil {
+		return x.TokenPermissions
+	}
+	return nil
+}
+
+func (x *DeploymentCreateConfig) GetApiBaseUrl() string {
+	if x != nil {
+		return x.ApiBaseUrl
+	}
+	return ""
+}
+
+func (x *DeploymentCreateConfig) GetStrictTemplates() bool {
+	if x != nil {
+		return x.StrictTemplates
+	}
+	return false
+}
+
+// DeploymentCreateInput carries runtime inputs for step.gh_deployment_create.
+type DeploymentCreateInput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *structpb.Struct       `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeploymentCreateInput) Reset() {
+	*x = DeploymentCreateInput{}
+	mi := &file_github_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeploymentCreateInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeploymentCreateInput) ProtoMessage() {}
+
+func (x *DeploymentCreateInput) ProtoReflect() protoreflect.Message {
+	mi := &file_github_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeploymentCreateInput.ProtoReflect.Descriptor instead.
+func (*DeploymentCreateInput) Descriptor() ([]byte, []int) {
+	return file_github_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *DeploymentCreateInput) GetData() *structpb.Struct {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// DeploymentCreateOutput holds the result of step.gh_deployment_create.
+type DeploymentCreateOutput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeploymentId  int64                  `protobuf:"varint,1,opt,name=deployment_id,json=deploymentId,proto3" json:"deployment_id,omitempty"`
+	Environment   string                 `protobuf:"bytes,2,opt,name=environment,proto3" json:"environment,omitempty"`
+	Ref           string                 `protobuf:"bytes,3,opt,name=ref,proto3" json:"ref,omitempty"`
+	Sha           string                 `protobuf:"bytes,4,opt,name=sha,proto3" json:"sha,omitempty"`
+	Url           string                 `protobuf:"bytes,5,opt,name=url,proto3" json:"url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeploymentCreateOutput) Reset() {
+	*x = DeploymentCreateOutput{}
+	mi := &file_github_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeploymentCreateOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeploymentCreateOutput) ProtoMessage() {}
+
+func (x *DeploymentCreateOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_github_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeploymentCreateOutput.ProtoReflect.Descriptor instead.
+func (*DeploymentCreateOutput) Descriptor() ([]byte, []int) {
+	return file_github_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *DeploymentCreateOutput) GetDeploymentId() int64 {
+	if x != nil {
+		return x.DeploymentId
+	}
+	return 0
+}
+
+func (x *DeploymentCreateOutput) GetEnvironment() string {
+	if x != nil {
+		return x.Environment
+	}
+	return ""
+}
+
+func (x *DeploymentCreateOutput) GetRef() string {
+	if x != nil {
+		return x.Ref
+	}
+	return ""
+}
+
+func (x *DeploymentCreateOutput) GetSha() string {
+	if x != nil {
+		return x.Sha
+	}
+	return ""
+}
+
+func (x *DeploymentCreateOutput) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+// SecretSetConfig is the typed config for step.gh_secret_set.
+type SecretSetConfig struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Owner                string                 `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Repo                 string                 `protobuf:"bytes,2,opt,name=repo,proto3" json:"repo,omitempty"`
+	Name                 string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Value                string                 `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+	Token                string                 `protobuf:"bytes,5,opt,name=token,proto3" json:"token,omitempty"`
+	AuthModule           string                 `protobuf:"bytes,6,opt,name=auth_module,json=authModule,proto3" json:"auth_module,omitempty"`
+	TokenRepositories    []string               `protobuf:"bytes,7,rep,name=token_repositories,json=tokenRepositories,proto3" json:"token_repositories,omitempty"`
+	TokenPermissions     map[string]string      `protobuf:"bytes,8,rep,name=token_permissions,json=tokenPermissions,proto3" json:"token_permissions,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	ApiBaseUrl           string                 `protobuf:"bytes,9,opt,name=api_base_url,json=apiBaseUrl,proto3" json:"api_base_url,omitempty"`
+	StrictTemplates      bool                   `protobuf:"varint,10,opt,name=strict_templates,json=strictTemplates,proto3" json:"strict_templates,omitempty"`
+	Environment          string                 `protobuf:"bytes,11,opt,name=environment,proto3" json:"environment,omitempty"`
+	App                  string                 `protobuf:"bytes,12,opt,name=app,proto3" json:"app,omitempty"`
+	Secrets              map[string]string      `protobuf:"bytes,13,rep,name=secrets,proto3" json:"secrets,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Visibility           string                 `protobuf:"bytes,14,opt,name=visibility,proto3" json:"visibility,omitempty"`
+	SelectedRepositories []string               `protobuf:"bytes,15,rep,name=selected_repositories,json=selectedRepositories,proto3" json:"selected_repositories,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *SecretSetConfig) Reset() {
+	*x = SecretSetConfig{}
+	mi := &file_github_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SecretSetConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecretSetConfig) ProtoMessage() {}
+
+func (x *SecretSetConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_github_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecretSetConfig.ProtoReflect.Descriptor instead.
+func (*SecretSetConfig) Descriptor() ([]byte, []int) {
+	return file_github_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *SecretSetConfig) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *SecretSetConfig) GetRepo() string {
+	if x != nil {
+		return x.Repo
+	}
+	return ""
+}
+
+func (x *SecretSetConfig) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SecretSetConfig) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *SecretSetConfig) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *SecretSetConfig) GetAuthModule() string {
+	if x != nil {
+		return x.AuthModule
+	}
+	return ""
+}
+
+func (x *SecretSetConfig) GetTokenRepositories() []string {
+	if x != nil {
+		return x.TokenRepositories
+	}
+	return nil
+}
+
+func (x *SecretSetConfig) GetTokenPermissions() map[string]string {
+	if x != nil {
+		return x.TokenPermissions
+	}
+	return nil
+}
+
+func (x *SecretSetConfig) GetApiBaseUrl() string {
+	if x != nil {
+		return x.ApiBaseUrl
+	}
+	return ""
+}
+
+func (x *SecretSetConfig) GetStrictTemplates() bool {
+	if x != nil {
+		return x.StrictTemplates
+	}
+	return false
+}
+
+func (x *SecretSetConfig) GetEnvironment() string {
+	if x != nil {
+		return x.Environment
+	}
+	return ""
+}
+
+func (x *SecretSetConfig) GetApp() string {
+	if x != nil {
+		return x.App
+	}
+	return ""
+}
+
+func (x *SecretSetConfig) GetSecrets() map[string]string {
+	if x != nil {
+		return x.Secrets
+	}
+	return nil
+}
+
+func (x *SecretSetConfig) GetVisibility() string {
+	if x != nil {
+		return x.Visibility
+	}
+	return ""
+}
+
+func (x *SecretSetConfig) GetSelectedRepositories() []string {
+	if x != nil {
+		return x.SelectedRepositories
+	}
+	return nil
+}
+
+// SecretSetInput carries runtime inputs for step.gh_secret_set.
+type SecretSetInput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *structpb.Struct       `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SecretSetInput) Reset() {
+	*x = SecretSetInput{}
+	mi := &file_github_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SecretSetInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecretSetInput) ProtoMessage() {}
+
+func (x *SecretSetInput) ProtoReflect() protoreflect.Message {
+	mi := &file_github_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecretSetInput.ProtoReflect.Descriptor instead.
+func (*SecretSetInput) Descriptor() ([]byte, []int) {
+	return file_github_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *SecretSetInput) GetData() *structpb.Struct {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// SecretSetOutput holds the result of step.gh_secret_set.
+type SecretSetOutput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Owner         string                 `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	Repo          string                 `protobuf:"bytes,3,opt,name=repo,proto3" json:"repo,omitempty"`
+	Set           bool                   `protobuf:"varint,4,opt,name=set,proto3" json:"set,omitempty"`
+	Names         []string               `protobuf:"bytes,5,rep,name=names,proto3" json:"names,omitempty"`
+	Count         int32                  `protobuf:"varint,6,opt,name=count,proto3" json:"count,omitempty"`
+	Environment   string                 `protobuf:"bytes,7,opt,name=environment,proto3" json:"environment,omitempty"`
+	App           string                 `protobuf:"bytes,8,opt,name=app,proto3" json:"app,omitempty"`
+	Scope         string                 `protobuf:"bytes,9,opt,name=scope,proto3" json:"scope,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SecretSetOutput) Reset() {
+	*x = SecretSetOutput{}
+	mi := &file_github_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SecretSetOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecretSetOutput) ProtoMessage() {}
+
+func (x *SecretSetOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_github_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecretSetOutput.ProtoReflect.Descriptor instead.
+func (*SecretSetOutput) Descriptor() ([]byte, []int) {
+	return file_github_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *SecretSetOutput) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SecretSetOutput) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *SecretSetOutput) GetRepo() string {
+	if x != nil {
+		return x.Repo
+	}
+	return ""
+}
+
+func (x *SecretSetOutput) GetSet() bool {
+	if x != nil {
+		return x.Set
+	}
+	return false
+}
+
+func (x *SecretSetOutput) GetNames() []string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+func (x *SecretSetOutput) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *SecretSetOutput) GetEnvironment() string {
+	if x != nil {
+		return x.Environment
+	}
+	return ""
+}
+
+func (x *SecretSetOutput) GetApp() string {
+	if x != nil {
+		return x.App
+	}
+	return ""
+}
+
+func (x *SecretSetOutput) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
+// SecretDeleteConfig is the typed config for step.gh_secret_delete.
+type SecretDeleteConfig struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Owner             string                 `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Repo              string                 `protobuf:"bytes,2,opt,name=repo,proto3" json:"repo,omitempty"`
+	Environment       string                 `protobuf:"bytes,3,opt,name=environment,proto3" json:"environment,omitempty"`
+	App               string                 `protobuf:"bytes,4,opt,name=app,proto3" json:"app,omitempty"`
+	Name              string                 `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
+	Names             []string               `protobuf:"bytes,6,rep,name=names,proto3" json:"names,omitempty"`
+	Token             string                 `protobuf:"bytes,7,opt,name=token,proto3" json:"token,omitempty"`
+	AuthModule        string                 `protobuf:"bytes,8,opt,name=auth_module,json=authModule,proto3" json:"auth_module,omitempty"`
+	TokenRepositories []string               `protobuf:"bytes,9,rep,name=token_repositories,json=tokenRepositories,proto3" json:"token_repositories,omitempty"`
+	TokenPermissions  map[string]string      `protobuf:"bytes,10,rep,name=token_permissions,json=tokenPermissions,proto3" json:"token_permissions,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	ApiBaseUrl        string                 `protobuf:"bytes,11,opt,name=api_base_url,json=apiBaseUrl,proto3" json:"api_base_url,omitempty"`
+	StrictTemplates   bool                   `protobuf:"varint,12,opt,name=strict_templates,json=strictTemplates,proto3" json:"strict_templates,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *SecretDeleteConfig) Reset() {
+	*x = SecretDeleteConfig{}
+	mi := &file_github_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SecretDeleteConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecretDeleteConfig) ProtoMessage() {}
+
+func (x *SecretDeleteConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_github_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecretDeleteConfig.ProtoReflect.Descriptor instead.
+func (*SecretDeleteConfig) Descriptor() ([]byte, []int) {
+	return file_github_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *SecretDeleteConfig) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *SecretDeleteConfig) GetRepo() string {
+	if x != nil {
+		return x.Repo
+	}
+	return ""
+}
+
+func (x *SecretDeleteConfig) GetEnvironment() string {
+	if x != nil {
+		return x.Environment
+	}
+	return ""
+}
+
+func (x *SecretDeleteConfig) GetApp() string {
+	if x != nil {
+		return x.App
+	}
+	return ""
+}
+
+func (x *SecretDeleteConfig) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SecretDeleteConfig) GetNames() []string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+func (x *SecretDeleteConfig) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *SecretDeleteConfig) GetAuthModule() string {
+	if x != nil {
+		return x.AuthModule
+	}
+	return ""
+}
+
+func (x *SecretDeleteConfig) GetTokenRepositories() []string {
+	if x != nil {
+		return x.TokenRepositories
+	}
+	return nil
+}
+
+func (x *SecretDeleteConfig) GetTokenPermissions() map[string]string {
+	if x != nil {
+		return x.TokenPermissions
+	}
+	return nil
+}
+
+func (x *SecretDeleteConfig) GetApiBaseUrl() string {
+	if x != nil {
+		return x.ApiBaseUrl
+	}
+	return ""
+}
+
+func (x *SecretDeleteConfig) GetStrictTemplates() bool {
+	if x != nil {
+		return x.StrictTemplates
+	}
+	return false
+}
+
+// SecretDeleteInput carries runtime inputs for step.gh_secret_delete.
+type SecretDeleteInput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *structpb.Struct       `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SecretDeleteInput) Reset() {
+	*x = SecretDeleteInput{}
+	mi := &file_github_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SecretDeleteInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecretDeleteInput) ProtoMessage() {}
+
+func (x *SecretDeleteInput) ProtoReflect() protoreflect.Message {
+	mi := &file_github_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecretDeleteInput.ProtoReflect.Descriptor instead.
+func (*SecretDeleteInput) Descriptor() ([]byte, []int) {
+	return file_github_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *SecretDeleteInput) GetData() *structpb.Struct {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// SecretDeleteOutput holds the result of step.gh_secret_delete.
+type SecretDeleteOutput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Deleted       []string               `protobuf:"bytes,1,rep,name=deleted,proto3" json:"deleted,omitempty"`
+	Missing       []string               `protobuf:"bytes,2,rep,name=missing,proto3" json:"missing,omitempty"`
+	Count         int32                  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	Owner         string                 `protobuf:"bytes,4,opt,name=owner,proto3" json:"owner,omitempty"`
+	Repo          string                 `protobuf:"bytes,5,opt,name=repo,proto3" json:"repo,omitempty"`
+	Environment   string                 `protobuf:"bytes,6,opt,name=environment,proto3" json:"environment,omitempty"`
+	App           string                 `protobuf:"bytes,7,opt,name=app,proto3" json:"app,omitempty"`
+	Scope         string                 `protobuf:"bytes,8,opt,name=scope,proto3" json:"scope,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SecretDeleteOutput) Reset() {
+	*x = SecretDeleteOutput{}
+	mi := &file_github_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SecretDeleteOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecretDeleteOutput) ProtoMessage() {}
+
+func (x *SecretDeleteOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_github_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecretDeleteOutput.ProtoReflect.Descriptor instead.
+func (*SecretDeleteOutput) Descriptor() ([]byte, []int) {
+	return file_github_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *SecretDeleteOutput) GetDeleted() []string {
+	if x != nil {
+		return x.Deleted
+	}
+	return nil
+}
+
+func (x *SecretDeleteOutput) GetMissing() []string {
+	if x != nil {
+		return x.Missing
+	}
+	return nil
+}
+
+func (x *SecretDeleteOutput) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *SecretDeleteOutput) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *SecretDeleteOutput) GetRepo() string {
+	if x != nil {
+		return x.Repo
+	}
+	return ""
+}
+
+func (x *SecretDeleteOutput) GetEnvironment() string {
+	if x != nil {
+		return x.Environment
+	}
+	return ""
+}
+
+func (x *SecretDeleteOutput) GetApp() string {
+	if x != nil {
+		return x.App
+	}
+	return ""
+}
+
+func (x *SecretDeleteOutput) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
+// SecretSyncConfig is the typed config for step.gh_secret_sync.
+type SecretSyncConfig struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Owner                string                 `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Repo                 string                 `protobuf:"bytes,2,opt,name=repo,proto3" json:"repo,omitempty"`
+	Environment          string                 `protobuf:"bytes,3,opt,name=environment,proto3" json:"environment,omitempty"`
+	App                  string                 `protobuf:"bytes,4,opt,name=app,proto3" json:"app,omitempty"`
+	Secrets              map[string]string      `protobuf:"bytes,5,rep,name=secrets,proto3" json:"secrets,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	StateDir             string                 `protobuf:"bytes,6,opt,name=state_dir,json=stateDir,proto3" json:"state_dir,omitempty"`
+	DeleteUnmanaged      bool                   `protobuf:"varint,7,opt,name=delete_unmanaged,json=deleteUnmanaged,proto3" json:"delete_unmanaged,omitempty"`
+	Keep                 []string               `protobuf:"bytes,8,rep,name=keep,proto3" json:"keep,omitempty"`
+	DryRun               bool                   `protobuf:"varint,9,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	Visibility           string                 `protobuf:"bytes,10,opt,name=visibility,proto3" json:"visibility,omitempty"`
+	SelectedRepositories []string               `protobuf:"bytes,11,rep,name=selected_repositories,json=selectedRepositories,proto3" json:"selected_repositories,omitempty"`
+	Token                string                 `protobuf:"bytes,12,opt,name=token,proto3" json:"token,omitempty"`
+	AuthModule           string                 `protobuf:"bytes,13,opt,name=auth_module,json=authModule,proto3" json:"auth_module,omitempty"`
+	TokenRepositories    []string               `protobuf:"bytes,14,rep,name=token_repositories,json=tokenRepositories,proto3" json:"token_repositories,omitempty"`
+	TokenPermissions     map[string]string      `protobuf:"bytes,15,rep,name=token_permissions,json=tokenPermissions,proto3" json:"token_permissions,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	ApiBaseUrl           string                 `protobuf:"bytes,16,opt,name=api_base_url,json=apiBaseUrl,proto3" json:"api_base_url,omitempty"`
+	StrictTemplates      bool                   `protobuf:"varint,17,opt,name=strict_templates,json=strictTemplates,proto3" json:"strict_templates,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *SecretSyncConfig) Reset() {
+	*x = SecretSyncConfig{}
+	mi := &file_github_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SecretSyncConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecretSyncConfig) ProtoMessage() {}
+
+func (x *SecretSyncConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_github_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecretSyncConfig.ProtoReflect.Descriptor instead.
+func (*SecretSyncConfig) Descriptor() ([]byte, []int) {
+	return file_github_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *SecretSyncConfig) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *SecretSyncConfig) GetRepo() string {
+	if x != nil {
+		return x.Repo
+	}
+	return ""
+}
+
+func (x *SecretSyncConfig) GetEnvironment() string {
+	if x != nil {
+		return x.Environment
+	}
+	return ""
+}
+
+func (x *SecretSyncConfig) GetApp() string {
+	if x != nil {
+		return x.App
+	}
+	return ""
+}
+
+func (x *SecretSyncConfig) GetSecrets() map[string]string {
+	if x != nil {
+		return x.Secrets
+	}
+	return nil
+}
+
+func (x *SecretSyncConfig) GetStateDir() string {
+	if x != nil {
+		return x.StateDir
+	}
+	return ""
+}
+
+func (x *SecretSyncConfig) GetDeleteUnmanaged() bool {
+	if x != nil {
+		return x.DeleteUnmanaged
+	}
+	return false
+}
+
+func (x *SecretSyncConfig) GetKeep() []string {
+	if x != nil {
+		return x.Keep
+	}
+	return nil
+}
+
+func (x *SecretSyncConfig) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *SecretSyncConfig) GetVisibility() string {
+	if x != nil {
+		return x.Visibility
+	}
+	return ""
+}
+
+func (x *SecretSyncConfig) GetSelectedRepositories() []string {
+	if x != nil {
+		return x.SelectedRepositories
+	}
+	return nil
+}
+
+func (x *SecretSyncConfig) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *SecretSyncConfig) GetAuthModule() string {
+	if x != nil {
+		return x.AuthModule
+	}
+	return ""
+}
+
+func (x *SecretSyncConfig) GetTokenRepositories() []string {
+	if x != nil {
+		return x.TokenRepositories
+	}
+	return nil
+}
+
+func (x *SecretSyncConfig) GetTokenPermissions() map[string]string {
+	if x != nil {
+		return x.TokenPermissions
+	}
+	return nil
+}
+
+func (x *SecretSyncConfig) GetApiBaseUrl() string {
+	if x != nil {
+		return x.ApiBaseUrl
+	}
+	return ""
+}
+
+func (x *SecretSyncConfig) GetStrictTemplates() bool {
+	if x != nil {
+		return x.StrictTemplates
+	}
+	return false
+}
+
+// SecretSyncInput carries runtime inputs for step.gh_secret_sync.
+type SecretSyncInput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *structpb.Struct       `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SecretSyncInput) Reset() {
+	*x = SecretSyncInput{}
+	mi := &file_github_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SecretSyncInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecretSyncInput) ProtoMessage() {}
+
+func (x *SecretSyncInput) ProtoReflect() protoreflect.Message {
+	mi := &file_github_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecretSyncInput.ProtoReflect.Descriptor instead.
+func (*SecretSyncInput) Descriptor() ([]byte, []int) {
+	return file_github_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *SecretSyncInput) GetData() *structpb.Struct {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// SecretSyncOutput holds the result of step.gh_secret_sync.
+type SecretSyncOutput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Created       []string               `protobuf:"bytes,1,rep,name=created,proto3" json:"created,omitempty"`
+	Updated       []string               `protobuf:"bytes,2,rep,name=updated,proto3" json:"updated,omitempty"`
+	Deleted       []string               `protobuf:"bytes,3,rep,name=deleted,proto3" json:"deleted,omitempty"`
+	Unchanged     []string               `protobuf:"bytes,4,rep,name=unchanged,proto3" json:"unchanged,omitempty"`
+	Drifted       []string               `protobuf:"bytes,5,rep,name=drifted,proto3" json:"drifted,omitempty"`
+	Unmanaged     []string               `protobuf:"bytes,6,rep,name=unmanaged,proto3" json:"unmanaged,omitempty"`
+	Changed       bool                   `protobuf:"varint,7,opt,name=changed,proto3" json:"changed,omitempty"`
+	DryRun        bool                   `protobuf:"varint,8,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	Owner         string                 `protobuf:"bytes,9,opt,name=owner,proto3" json:"owner,omitempty"`
+	Repo          string                 `protobuf:"bytes,10,opt,name=repo,proto3" json:"repo,omitempty"`
+	Environment   string                 `protobuf:"bytes,11,opt,name=environment,proto3" json:"environment,omitempty"`
+	App           string                 `protobuf:"bytes,12,opt,name=app,proto3" json:"app,omitempty"`
+	Scope         string                 `protobuf:"bytes,13,opt,name=scope,proto3" json:"scope,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SecretSyncOutput) Reset() {
+	*x = SecretSyncOutput{}
+	mi := &file_github_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SecretSyncOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecretSyncOutput) ProtoMessage() {}
+
+func (x *SecretSyncOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_github_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecretSyncOutput.ProtoReflect.Descriptor instead.
+func (*SecretSyncOutput) Descriptor() ([]byte, []int) {
+	return file_github_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *SecretSyncOutput) GetCreated() []string {
+	if x != nil {
+		return x.Created
+	}
+	return nil
+}
+
+func (x *SecretSyncOutput) GetUpdated() []string {
+	if x != nil {
+		return x.Updated
+	}
+	return nil
+}
+
+func (x *SecretSyncOutput) GetDeleted() []string {
+	if x != nil {
+		return x.Deleted
+	}
+	return nil
+}
+
+func (x *SecretSyncOutput) GetUnchanged() []string {
+	if x != nil {
+		return x.Unchanged
+	}
+	return nil
+}
+
+func (x *SecretSyncOutput) GetDrifted() []string {
+	if x != nil {
+		return x.Drifted
+	}
+	return nil
+}
+
+func (x *SecretSyncOutput) GetUnmanaged() []string {
+	if x != nil {
+		return x.Unmanaged
+	}
+	return nil
+}
+
+func (x *SecretSyncOutput) GetChanged() bool {
+	if x != nil {
+		return x.Changed
+	}
+	return false
+}
+
+func (x *SecretSyncOutput) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *SecretSyncOutput) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *SecretSyncOutput) GetRepo() string {
+	if x != nil {
+		return x.Repo
+	}
+	return ""
+}
+
+func (x *SecretSyncOutput) GetEnvironment() string {
+	if x != nil {
+		return x.Environment
+	}
+	return ""
+}
+
+func (x *SecretSyncOutput) GetApp() string {
+	if x != nil {
+		return x.App
+	}
+	return ""
+}
+
+func (x *SecretSyncOutput) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
+// SecretListConfig is the typed config for step.gh_secret_list.
+type SecretListConfig struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Owner             string                 `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Repo              string                 `protobuf:"bytes,2,opt,name=repo,proto3" json:"repo,omitempty"`
+	Environment       string                 `protobuf:"bytes,3,opt,name=environment,proto3" json:"environment,omitempty"`
+	App               string                 `protobuf:"bytes,4,opt,name=app,proto3" json:"app,omitempty"`
+	OlderThan         string                 `protobuf:"bytes,5,opt,name=older_than,json=olderThan,proto3" json:"older_than,omitempty"`
+	Token             string                 `protobuf:"bytes,6,opt,name=token,proto3" json:"token,omitempty"`
+	AuthModule        string                 `protobuf:"bytes,7,opt,name=auth_module,json=authModule,proto3" json:"auth_module,omitempty"`
+	TokenRepositories []string               `protobuf:"bytes,8,rep,name=token_repositories,json=tokenRepositories,proto3" json:"token_repositories,omitempty"`
+	TokenPermissions  map[string]string      `protobuf:"bytes,9,rep,name=token_permissions,json=tokenPermissions,proto3" json:"token_permissions,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	ApiBaseUrl        string                 `protobuf:"bytes,10,opt,name=api_base_url,json=apiBaseUrl,proto3" json:"api_base_url,omitempty"`
+	StrictTemplates   bool                   `protobuf:"varint,11,opt,name=strict_templates,json=strictTemplates,proto3" json:"strict_templates,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *SecretListConfig) Reset() {
+	*x = SecretListConfig{}
+	mi := &file_github_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SecretListConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecretListConfig) ProtoMessage() {}
+
+func (x *SecretListConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_github_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecretListConfig.ProtoReflect.Descriptor instead.
+func (*SecretListConfig) Descriptor() ([]byte, []int) {
+	return file_github_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *SecretListConfig) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *SecretListConfig) GetRepo() string {
+	if x != nil {
+		return x.Repo
+	}
+	return ""
+}
+
+func (x *SecretListConfig) GetEnvironment() string {
+	if x != nil {
+		return x.Environment
+	}
+	return ""
+}
+
+func (x *SecretListConfig) GetApp() string {
+	if x != nil {
+		return x.App
+	}
+	return ""
+}
+
+func (x *SecretListConfig) GetOlderThan() string {
+	if x != nil {
+		return x.OlderThan
+	}
+	return ""
+}
+
+func (x *SecretListConfig) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *SecretListConfig) GetAuthModule() string {
+	if x != nil {
+		return x.AuthModule
+	}
+	return ""
+}
+
+func (x *SecretListConfig) GetTokenRepositories() []string {
+	if x != nil {
+		return x.TokenRepositories
+	}
+	return nil
+}
+
+func (x *SecretListConfig) GetTokenPermissions() map[string]string {
+	if x != nil {
+		return x.TokenPermissions
+	}
+	return nil
+}
+
+func (x *SecretListConfig) GetApiBaseUrl() string {
+	if x != nil {
+		return x.ApiBaseUrl
+	}
+	return ""
+}
+
+func (x *SecretListConfig) GetStrictTemplates() bool {
+	if x != nil {
+		return x.StrictTemplates
+	}
+	return false
+}
+
+// SecretListInput carries runtime inputs for step.gh_secret_list.
+type SecretListInput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *structpb.Struct       `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SecretListInput) Reset() {
+	*x = SecretListInput{}
+	mi := &file_github_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SecretListInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecretListInput) ProtoMessage() {}
+
+func (x *SecretListInput) ProtoReflect() protoreflect.Message {
+	mi := &file_github_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecretListInput.ProtoReflect.Descriptor instead.
+func (*SecretListInput) Descriptor() ([]byte, []int) {
+	return file_github_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *SecretListInput) GetData() *structpb.Struct {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// SecretListOutput holds the result of step.gh_secret_list.
+type SecretListOutput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Secrets       []*SecretSummary       `protobuf:"bytes,1,rep,name=secrets,proto3" json:"secrets,omitempty"`
+	Names         []string               `protobuf:"bytes,2,rep,name=names,proto3" json:"names,omitempty"`
+	Count         int32                  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	Stale         []string               `protobuf:"bytes,4,rep,name=stale,proto3" json:"stale,omitempty"`
+	Owner         string                 `protobuf:"bytes,5,opt,name=owner,proto3" json:"owner,omitempty"`
+	Repo          string                 `protobuf:"bytes,6,opt,name=repo,proto3" json:"repo,omitempty"`
+	Environment   string                 `protobuf:"bytes,7,opt,name=environment,proto3" json:"environment,omitempty"`
+	App           string                 `protobuf:"bytes,8,opt,name=app,proto3" json:"app,omitempty"`
+	Scope         string                 `protobuf:"bytes,9,opt,name=scope,proto3" json:"scope,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SecretListOutput) Reset() {
+	*x = SecretListOutput{}
+	mi := &file_github_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SecretListOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecretListOutput) ProtoMessage() {}
+
+func (x *SecretListOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_github_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecretListOutput.ProtoReflect.Descriptor instead.
+func (*SecretListOutput) Descriptor() ([]byte, []int) {
+	return file_github_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *SecretListOutput) GetSecrets() []*SecretSummary {
+	if x != nil {
+		return x.Secrets
+	}
+	return nil
+}
+
+func (x *SecretListOutput) GetNames() []string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+func (x *SecretListOutput) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *SecretListOutput) GetStale() []string {
+	if x != nil {
+		return x.Stale
+	}
+	return nil
+}
+
+func (x *SecretListOutput) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *SecretListOutput) GetRepo() string {
+	if x != nil {
+		return x.Repo
+	}
+	return ""
+}
+
+func (x *SecretListOutput) GetEnvironment() string {
+	if x != nil {
+		return x.Environment
+	}
+	return ""
+}
+
+func (x *SecretListOutput) GetApp() string {
+	if x != nil {
+		return x.App
+	}
+	return ""
+}
+
+func (x *SecretListOutput) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
+// SecretSummary describes one secret; GitHub never returns secret values.
+type SecretSummary struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     string                 `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Visibility    string                 `protobuf:"bytes,4,opt,name=visibility,proto3" json:"visibility,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SecretSummary) Reset() {
+	*x = SecretSummary{}
+	mi := &file_github_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SecretSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecretSummary) ProtoMessage() {}
+
+func (x *SecretSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_github_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecretSummary.ProtoReflect.Descriptor instead.
+func (*SecretSummary) Descriptor() ([]byte, []int) {
+	return file_github_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *SecretSummary) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SecretSummary) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *SecretSummary) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *SecretSummary) GetVisibility() string {
+	if x != nil {
+		return x.Visibility
+	}
+	return ""
+}
+
+// VariableSetConfig is the typed config for step.gh_variable_set.
+type VariableSetConfig struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Owner                string                 `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Repo                 string                 `protobuf:"bytes,2,opt,name=repo,proto3" json:"repo,omitempty"`
+	Environment          string                 `protobuf:"bytes,3,opt,name=environment,proto3" json:"environment,omitempty"`
+	Name                 string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Value                string                 `protobuf:"bytes,5,opt,name=value,proto3" json:"value,omitempty"`
+	Variables            map[string]string      `protobuf:"bytes,6,rep,name=variables,proto3" json:"variables,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Visibility           string                 `protobuf:"bytes,7,opt,name=visibility,proto3" json:"visibility,omitempty"`
+	SelectedRepositories []string               `protobuf:"bytes,8,rep,name=selected_repositories,json=selectedRepositories,proto3" json:"selected_repositories,omitempty"`
+	Token                string                 `protobuf:"bytes,9,opt,name=token,proto3" json:"token,omitempty"`
+	AuthModule           string                 `protobuf:"bytes,10,opt,name=auth_module,json=authModule,proto3" json:"auth_module,omitempty"`
+	TokenRepositories    []string               `protobuf:"bytes,11,rep,name=token_repositories,json=tokenRepositories,proto3" json:"token_repositories,omitempty"`
+	TokenPermissions     map[string]string      `protobuf:"bytes,12,rep,name=token_permissions,json=tokenPermissions,proto3" json:"token_permissions,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	ApiBaseUrl           string                 `protobuf:"bytes,13,opt,name=api_base_url,json=apiBaseUrl,proto3" json:"api_base_url,omitempty"`
+	StrictTemplates      bool                   `protobuf:"varint,14,opt,name=strict_templates,json=strictTemplates,proto3" json:"strict_templates,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *VariableSetConfig) Reset() {
+	*x = VariableSetConfig{}
+	mi := &file_github_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VariableSetConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VariableSetConfig) ProtoMessage() {}
+
+func (x *VariableSetConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_github_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VariableSetConfig.ProtoReflect.Descriptor instead.
+func (*VariableSetConfig) Descriptor() ([]byte, []int) {
+	return file_github_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *VariableSetConfig) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *VariableSetConfig) GetRepo() string {
+	if x != nil {
+		return x.Repo
+	}
+	return ""
+}
+
+func (x *VariableSetConfig) GetEnvironment() string {
+	if x != nil {
+		return x.Environment
+	}
+	return ""
+}
+
+func (x *VariableSetConfig) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *VariableSetConfig) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *VariableSetConfig) GetVariables() map[string]string {
+	if x != nil {
+		return x.Variables
+	}
+	return nil
+}
+
+func (x *VariableSetConfig) GetVisibility() string {
+	if x != nil {
+		return x.Visibility
+	}
+	return ""
+}
+
+func (x *VariableSetConfig) GetSelectedRepositories() []string {
+	if x != nil {
+		return x.SelectedRepositories
+	}
+	return nil
+}
+
+func (x *VariableSetConfig) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *VariableSetConfig) GetAuthModule() string {
+	if x != nil {
+		return x.AuthModule
+	}
+	return ""
+}
+
+func (x *VariableSetConfig) GetTokenRepositories() []string {
+	if x != nil {
+		return x.TokenRepositories
+	}
+	return nil
+}
+
+func (x *VariableSetConfig) GetTokenPermissions() map[string]string {
+	if x != nil {
+		return x.TokenPermissions
+	}
+	return nil
+}
+
+func (x *VariableSetConfig) GetApiBaseUrl() string {
+	if x != nil {
+		return x.ApiBaseUrl
+	}
+	return ""
+}
+
+func (x *VariableSetConfig) GetStrictTemplates() bool {
+	if x != nil {
+		return x.StrictTemplates
+	}
+	return false
+}
+
+// VariableSetInput carries runtime inputs for step.gh_variable_set.
+type VariableSetInput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *structpb.Struct       `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VariableSetInput) Reset() {
+	*x = VariableSetInput{}
+	mi := &file_github_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VariableSetInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VariableSetInput) ProtoMessage() {}
+
+func (x *VariableSetInput) ProtoReflect() protoreflect.Message {
+	mi := &file_github_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VariableSetInput.ProtoReflect.Descriptor instead.
+func (*VariableSetInput) Descriptor() ([]byte, []int) {
+	return file_github_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *VariableSetInput) GetData() *structpb.Struct {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// VariableSetOutput holds the result of step.gh_variable_set.
+type VariableSetOutput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Names         []string               `protobuf:"bytes,2,rep,name=names,proto3" json:"names,omitempty"`
+	Created       []string               `protobuf:"bytes,3,rep,name=created,proto3" json:"created,omitempty"`
+	Updated       []string               `protobuf:"bytes,4,rep,name=updated,proto3" json:"updated,omitempty"`
+	Count         int32                  `protobuf:"varint,5,opt,name=count,proto3" json:"count,omitempty"`
+	Owner         string                 `protobuf:"bytes,6,opt,name=owner,proto3" json:"owner,omitempty"`
+	Repo          string                 `protobuf:"bytes,7,opt,name=repo,proto3" json:"repo,omitempty"`
+	Environment   string                 `protobuf:"bytes,8,opt,name=environment,proto3" json:"environment,omitempty"`
+	App           string                 `protobuf:"bytes,9,opt,name=app,proto3" json:"app,omitempty"`
+	Scope         string                 `protobuf:"bytes,10,opt,name=scope,proto3" json:"scope,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VariableSetOutput) Reset() {
+	*x = VariableSetOutput{}
+	mi := &file_github_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VariableSetOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VariableSetOutput) ProtoMessage() {}
+
+func (x *VariableSetOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_github_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VariableSetOutput.ProtoReflect.Descriptor instead.
+func (*VariableSetOutput) Descriptor() ([]byte, []int) {
+	return file_github_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *VariableSetOutput) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *VariableSetOutput) GetNames() []string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+func (x *VariableSetOutput) GetCreated() []string {
+	if x != nil {
+		return x.Created
+	}
+	return nil
+}
+
+func (x *VariableSetOutput) GetUpdated() []string {
+	if x != nil {
+		return x.Updated
+	}
+	return nil
+}
+
+func (x *VariableSetOutput) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *VariableSetOutput) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *VariableSetOutput) GetRepo() string {
+	if x != nil {
+		return x.Repo
+	}
+	return ""
+}
+
+func (x *VariableSetOutput) GetEnvironment() string {
+	if x != nil {
+		return x.Environment
+	}
+	return ""
+}
+
+func (x *VariableSetOutput) GetApp() string {
+	if x != nil {
+		return x.App
+	}
+	return ""
+}
+
+func (x *VariableSetOutput) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
+// VariableGetConfig is the typed config for step.gh_variable_get.
+type VariableGetConfig struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Owner             string                 `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Repo              string                 `protobuf:"bytes,2,opt,name=repo,proto3" json:"repo,omitempty"`
+	Environment       string                 `protobuf:"bytes,3,opt,name=environment,proto3" json:"environment,omitempty"`
+	Name              string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Required          bool                   `protobuf:"varint,5,opt,name=required,proto3" json:"required,omitempty"`
+	Token             string                 `protobuf:"bytes,6,opt,name=token,proto3" json:"token,omitempty"`
+	AuthModule        string                 `protobuf:"bytes,7,opt,name=auth_module,json=authModule,proto3" json:"auth_module,omitempty"`
+	TokenRepositories []string               `protobuf:"bytes,8,rep,name=token_repositories,json=tokenRepositories,proto3" json:"token_repositories,omitempty"`
+	TokenPermissions  map[string]string      `protobuf:"bytes,9,rep,name=token_permissions,json=tokenPermissions,proto3" json:"token_permissions,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	ApiBaseUrl        string                 `protobuf:"bytes,10,opt,name=api_base_url,json=apiBaseUrl,proto3" json:"api_base_url,omitempty"`
+	StrictTemplates   bool                   `protobuf:"varint,11,opt,name=strict_templates,json=strictTemplates,proto3" json:"strict_templates,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *VariableGetConfig) Reset() {
+	*x = VariableGetConfig{}
+	mi := &file_github_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VariableGetConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VariableGetConfig) ProtoMessage() {}
+
+func (x *VariableGetConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_github_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VariableGetConfig.ProtoReflect.Descriptor instead.
+func (*VariableGetConfig) Descriptor() ([]byte, []int) {
+	return file_github_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *VariableGetConfig) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *VariableGetConfig) GetRepo() string {
+	if x != nil {
+		return x.Repo
+	}
+	return ""
+}
+
+func (x *VariableGetConfig) GetEnvironment() string {
+	if x != nil {
+		return x.Environment
+	}
+	return ""
+}
+
+func (x *VariableGetConfig) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *VariableGetConfig) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+func (x *VariableGetConfig) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *VariableGetConfig) GetAuthModule() string {
+	if x != nil {
+		return x.AuthModule
+	}
+	return ""
+}
+
+func (x *VariableGetConfig) GetTokenRepositories() []string {
+	if x != nil {
+		return x.TokenRepositories
+	}
+	return nil
+}
+
+func (x *VariableGetConfig) GetTokenPermissions() map[string]string {
+	if x != nil {
+		return x.TokenPermissions
+	}
+	return nil
+}
+
+func (x *VariableGetConfig) GetApiBaseUrl() string {
+	if x != nil {
+		return x.ApiBaseUrl
+	}
+	return ""
+}
+
+func (x *VariableGetConfig) GetStrictTemplates() bool {
+	if x != nil {
+		return x.StrictTemplates
+	}
+	return false
+}
+
+// VariableGetInput carries runtime inputs for step.gh_variable_get.
+type VariableGetInput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *structpb.Struct       `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VariableGetInput) Reset() {
+	*x = VariableGetInput{}
+	mi := &file_github_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VariableGetInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VariableGetInput) ProtoMessage() {}
+
+func (x *VariableGetInput) ProtoReflect() protoreflect.Message {
+	mi := &file_github_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VariableGetInput.ProtoReflect.Descriptor instead.
+func (*VariableGetInput) Descriptor() ([]byte, []int) {
+	return file_github_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *VariableGetInput) GetData() *structpb.Struct {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// VariableGetOutput holds the result of step.gh_variable_get.
+type VariableGetOutput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Found         bool                   `protobuf:"varint,2,opt,name=found,proto3" json:"found,omitempty"`
+	Value         string                 `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     string                 `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Visibility    string                 `protobuf:"bytes,6,opt,name=visibility,proto3" json:"visibility,omitempty"`
+	Owner         string                 `protobuf:"bytes,7,opt,name=owner,proto3" json:"owner,omitempty"`
+	Repo          string                 `protobuf:"bytes,8,opt,name=repo,proto3" json:"repo,omitempty"`
+	Environment   string                 `protobuf:"bytes,9,opt,name=environment,proto3" json:"environment,omitempty"`
+	App           string                 `protobuf:"bytes,10,opt,name=app,proto3" json:"app,omitempty"`
+	Scope         string                 `protobuf:"bytes,11,opt,name=scope,proto3" json:"scope,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VariableGetOutput) Reset() {
+	*x = VariableGetOutput{}
+	mi := &file_github_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VariableGetOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VariableGetOutput) ProtoMessage() {}
+
+func (x *VariableGetOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_github_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VariableGetOutput.ProtoReflect.Descriptor instead.
+func (*VariableGetOutput) Descriptor() ([]byte, []int) {
+	return file_github_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *VariableGetOutput) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *VariableGetOutput) GetFound() bool {
+	if x != nil {
+		return x.Found
+	}
+	return false
+}
+
+func (x *VariableGetOutput) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *VariableGetOutput) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *VariableGetOutput) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *VariableGetOutput) GetVisibility() string {
+	if x != nil {
+		return x.Visibility
+	}
+	return ""
+}
+
+func (x *VariableGetOutput) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *VariableGetOutput) GetRepo() string {
+	if x != nil {
+		return x.Repo
+	}
+	return ""
+}
+
+func (x *VariableGetOutput) GetEnvironment() string {
+	if x != nil {
+		return x.Environment
+	}
+	return ""
+}
+
+func (x *VariableGetOutput) GetApp() string {
+	if x != nil {
+		return x.App
+	}
+	return ""
+}
+
+func (x *VariableGetOutput) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
+// VariableListConfig is the typed config for step.gh_variable_list.
+type VariableListConfig struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Owner             string                 `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Repo              string                 `protobuf:"bytes,2,opt,name=repo,proto3" json:"repo,omitempty"`
+	Environment       string                 `protobuf:"bytes,3,opt,name=environment,proto3" json:"environment,omitempty"`
+	Token             string                 `protobuf:"bytes,4,opt,name=token,proto3" json:"token,omitempty"`
+	AuthModule        string                 `protobuf:"bytes,5,opt,name=auth_module,json=authModule,proto3" json:"auth_module,omitempty"`
+	TokenRepositories []string               `protobuf:"bytes,6,rep,name=token_repositories,json=tokenRepositories,proto3" json:"token_repositories,omitempty"`
+	TokenPermissions  map[string]string      `protobuf:"bytes,7,rep,name=token_permissions,json=tokenPermissions,proto3" json:"token_permissions,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	ApiBaseUrl        string                 `protobuf:"bytes,8,opt,name=api_base_url,json=apiBaseUrl,proto3" json:"api_base_url,omitempty"`
+	StrictTemplates   bool                   `protobuf:"varint,9,opt,name=strict_templates,json=strictTemplates,proto3" json:"strict_templates,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *VariableListConfig) Reset() {
+	*x = VariableListConfig{}
+	mi := &file_github_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VariableListConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VariableListConfig) ProtoMessage() {}
+
+func (x *VariableListConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_github_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VariableListConfig.ProtoReflect.Descriptor instead.
+func (*VariableListConfig) Descriptor() ([]byte, []int) {
+	return file_github_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *VariableListConfig) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *VariableListConfig) GetRepo() string {
+	if x != nil {
+		return x.Repo
+	}
+	return ""
+}
+
+func (x *VariableListConfig) GetEnvironment() string {
+	if x != nil {
+		return x.Environment
+	}
+	return ""
+}
+
+func (x *VariableListConfig) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *VariableListConfig) GetAuthModule() string {
+	if x != nil {
+		return x.AuthModule
+	}
+	return ""
+}
+
+func (x *VariableListConfig) GetTokenRepositories() []string {
+	if x != nil {
+		return x.TokenRepositories
+	}
+	return nil
+}
+
+func (x *VariableListConfig) GetTokenPermissions() map[string]string {
+	if x != nil {
+		return x.TokenPermissions
+	}
+	return nil
+}
+
+func (x *VariableListConfig) GetApiBaseUrl() string {
+	if x != nil {
+		return x.ApiBaseUrl
+	}
+	return ""
+}
+
+func (x *VariableListConfig) GetStrictTemplates() bool {
+	if x != nil {
+		return x.StrictTemplates
+	}
+	return false
+}
+
+// VariableListInput carries runtime inputs for step.gh_variable_list.
+type VariableListInput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *structpb.Struct       `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VariableListInput) Reset() {
+	*x = VariableListInput{}
+	mi := &file_github_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VariableListInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VariableListInput) ProtoMessage() {}
+
+func (x *VariableListInput) ProtoReflect() protoreflect.Message {
+	mi := &file_github_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VariableListInput.ProtoReflect.Descriptor instead.
+func (*VariableListInput) Descriptor() ([]byte, []int) {
+	return file_github_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *VariableListInput) GetData() *structpb.Struct {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// VariableListOutput holds the result of step.gh_variable_list.
+type VariableListOutput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Variables     []*VariableSummary     `protobuf:"bytes,1,rep,name=variables,proto3" json:"variables,omitempty"`
+	Names         []string               `protobuf:"bytes,2,rep,name=names,proto3" json:"names,omitempty"`
+	Values        map[string]string      `protobuf:"bytes,3,rep,name=values,proto3" json:"values,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Count         int32                  `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+	Owner         string                 `protobuf:"bytes,5,opt,name=owner,proto3" json:"owner,omitempty"`
+	Repo          string                 `protobuf:"bytes,6,opt,name=repo,proto3" json:"repo,omitempty"`
+	Environment   string                 `protobuf:"bytes,7,opt,name=environment,proto3" json:"environment,omitempty"`
+	App           string                 `protobuf:"bytes,8,opt,name=app,proto3" json:"app,omitempty"`
+	Scope         string                 `protobuf:"bytes,9,opt,name=scope,proto3" json:"scope,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VariableListOutput) Reset() {
+	*x = VariableListOutput{}
+	mi := &file_github_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VariableListOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VariableListOutput) ProtoMessage() {}
+
+func (x *VariableListOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_github_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VariableListOutput.ProtoReflect.Descriptor instead.
+func (*VariableListOutput) Descriptor() ([]byte, []int) {
+	return file_github_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *VariableListOutput) GetVariables() []*VariableSummary {
+	if x != nil {
+		return x.Variables
+	}
+	return nil
+}
+
+func (x *VariableListOutput) GetNames() []string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+func (x *VariableListOutput) GetValues() map[string]string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *VariableListOutput) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *VariableListOutput) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *VariableListOutput) GetRepo() string {
+	if x != nil {
+		return x.Repo
+	}
+	return ""
+}
+
+func (x *VariableListOutput) GetEnvironment() string {
+	if x != nil {
+		return x.Environment
+	}
+	return ""
+}
+
+func (x *VariableListOutput) GetApp() string {
+	if x != nil {
+		return x.App
+	}
+	return ""
+}
+
+func (x *VariableListOutput) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
+// VariableSummary describes one Actions configuration variable.
+type VariableSummary struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     string                 `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Visibility    string                 `protobuf:"bytes,5,opt,name=visibility,proto3" json:"visibility,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VariableSummary) Reset() {
+	*x = VariableSummary{}
+	mi := &file_github_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VariableSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VariableSummary) ProtoMessage() {}
+
+func (x *VariableSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_github_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VariableSummary.ProtoReflect.Descriptor instead.
+func (*VariableSummary) Descriptor() ([]byte, []int) {
+	return file_github_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *VariableSummary) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *VariableSummary) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *VariableSummary) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *VariableSummary) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *VariableSummary) GetVisibility() string {
+	if x != nil {
+		return x.Visibility
+	}
+	return ""
+}
+
+// VariableDeleteConfig is the typed config for step.gh_variable_delete.
+type VariableDeleteConfig struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Owner             string                 `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Repo              string                 `protobuf:"bytes,2,opt,name=repo,proto3" json:"repo,omitempty"`
+	Environment       string                 `protobuf:"bytes,3,opt,name=environment,proto3" json:"environment,omitempty"`
+	Name              string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Names             []string               `protobuf:"bytes,5,rep,name=names,proto3" json:"names,omitempty"`
+	Token             string                 `protobuf:"bytes,6,opt,name=token,proto3" json:"token,omitempty"`
+	AuthModule        string                 `protobuf:"bytes,7,opt,name=auth_module,json=authModule,proto3" json:"auth_module,omitempty"`
+	TokenRepositories []string               `protobuf:"bytes,8,rep,name=token_repositories,json=tokenRepositories,proto3" json:"token_repositories,omitempty"`
+	TokenPermissions  map[string]string      `protobuf:"bytes,9,rep,name=token_permissions,json=tokenPermissions,proto3" json:"token_permissions,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	ApiBaseUrl        string                 `protobuf:"bytes,10,opt,name=api_base_url,json=apiBaseUrl,proto3" json:"api_base_url,omitempty"`
+	StrictTemplates   bool                   `protobuf:"varint,11,opt,name=strict_templates,json=strictTemplates,proto3" json:"strict_templates,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *VariableDeleteConfig) Reset() {
+	*x = VariableDeleteConfig{}
+	mi := &file_github_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VariableDeleteConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VariableDeleteConfig) ProtoMessage() {}
+
+func (x *VariableDeleteConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_github_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VariableDeleteConfig.ProtoReflect.Descriptor instead.
+func (*VariableDeleteConfig) Descriptor() ([]byte, []int) {
+	return file_github_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *VariableDeleteConfig) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *VariableDeleteConfig) GetRepo() string {
+	if x != nil {
+		return x.Repo
+	}
+	return ""
+}
+
+func (x *VariableDeleteConfig) GetEnvironment() string {
+	if x != nil {
+		return x.Environment
+	}
+	return ""
+}
+
+func (x *VariableDeleteConfig) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *VariableDeleteConfig) GetNames() []string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+func (x *VariableDeleteConfig) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *VariableDeleteConfig) GetAuthModule() string {
+	if x != nil {
+		return x.AuthModule
+	}
+	return ""
+}
+
+func (x *VariableDeleteConfig) GetTokenRepositories() []string {
+	if x != nil {
+		return x.TokenRepositories
+	}
+	return nil
+}
+
+func (x *VariableDeleteConfig) GetTokenPermissions() map[string]string {
+	if x != nil {
+		return x.TokenPermissions
+	}
+	return nil
+}
+
+func (x *VariableDeleteConfig) GetApiBaseUrl() string {
+	if x != nil {
+		return x.ApiBaseUrl
+	}
+	return ""
+}
+
+func (x *VariableDeleteConfig) GetStrictTemplates() bool {
+	if x != nil {
+		return x.StrictTemplates
+	}
+	return false
+}
+
+// VariableDeleteInput carries runtime inputs for step.gh_variable_delete.
+type VariableDeleteInput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *structpb.Struct       `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VariableDeleteInput) Reset() {
+	*x = VariableDeleteInput{}
+	mi := &file_github_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VariableDeleteInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VariableDeleteInput) ProtoMessage() {}
+
+func (x *VariableDeleteInput) ProtoReflect() protoreflect.Message {
+	mi := &file_github_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VariableDeleteInput.ProtoReflect.Descriptor instead.
+func (*VariableDeleteInput) Descriptor() ([]byte, []int) {
+	return file_github_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *VariableDeleteInput) GetData() *structpb.Struct {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// VariableDeleteOutput holds the result of step.gh_variable_delete.
+type VariableDeleteOutput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Deleted       []string               `protobuf:"bytes,1,rep,name=deleted,proto3" json:"deleted,omitempty"`
+	Missing       []string               `protobuf:"bytes,2,rep,name=missing,proto3" json:"missing,omitempty"`
+	Count         int32                  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	Owner         string                 `protobuf:"bytes,4,opt,name=owner,proto3" json:"owner,omitempty"`
+	Repo          string                 `protobuf:"bytes,5,opt,name=repo,proto3" json:"repo,omitempty"`
+	Environment   string                 `protobuf:"bytes,6,opt,name=environment,proto3" json:"environment,omitempty"`
+	App           string                 `protobuf:"bytes,7,opt,name=app,proto3" json:"app,omitempty"`
+	Scope         string                 `protobuf:"bytes,8,opt,name=scope,proto3" json:"scope,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VariableDeleteOutput) Reset() {
+	*x = VariableDeleteOutput{}
+	mi := &file_github_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VariableDeleteOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VariableDeleteOutput) ProtoMessage() {}
+
+func (x *VariableDeleteOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_github_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VariableDeleteOutput.ProtoReflect.Descriptor instead.
+func (*VariableDeleteOutput) Descriptor() ([]byte, []int) {
+	return file_github_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *VariableDeleteOutput) GetDeleted() []string {
+	if x != nil {
+		return x.Deleted
+	}
+	return nil
+}
+
+func (x *VariableDeleteOutput) GetMissing() []string {
+	if x != nil {
+		return x.Missing
+	}
+	return nil
+}
+
+func (x *VariableDeleteOutput) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *VariableDeleteOutput) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *VariableDeleteOutput) GetRepo() string {
+	if x != nil {
+		return x.Repo
+	}
+	return ""
+}
+
+func (x *VariableDeleteOutput) GetEnvironment() string {
+	if x != nil {
+		return x.Environment
+	}
+	return ""
+}
+
+func (x *VariableDeleteOutput) GetApp() string {
+	if x != nil {
+		return x.App
+	}
+	return ""
+}
+
+func (x *VariableDeleteOutput) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
+// GraphQLConfig is the typed config for step.gh_graphql.
+type GraphQLConfig struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Query             string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Variables         *structpb.Struct       `protobuf:"bytes,2,opt,name=variables,proto3" json:"variables,omitempty"`
+	Token             string                 `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+	AuthModule        string                 `protobuf:"bytes,4,opt,name=auth_module,json=authModule,proto3" json:"auth_module,omitempty"`
+	Owner             string                 `protobuf:"bytes,5,opt,name=owner,proto3" json:"owner,omitempty"`
+	TokenRepositories []string               `protobuf:"bytes,6,rep,name=token_repositories,json=tokenRepositories,proto3" json:"token_repositories,omitempty"`
+	TokenPermissions  map[string]string      `protobuf:"bytes,7,rep,name=token_permissions,json=tokenPermissions,proto3" json:"token_permissions,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	ApiBaseUrl        string                 `protobuf:"bytes,8,opt,name=api_base_url,json=apiBaseUrl,proto3" json:"api_base_url,omitempty"`
+	GraphqlUrl        string                 `protobuf:"bytes,9,opt,name=graphql_url,json=graphqlUrl,proto3" json:"graphql_url,omitempty"`
+	StrictTemplates   bool                   `protobuf:"varint,10,opt,name=strict_templates,json=strictTemplates,proto3" json:"strict_templates,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *GraphQLConfig) Reset() {
+	*x = GraphQLConfig{}
+	mi := &file_github_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GraphQLConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GraphQLConfig) ProtoMessage() {}
+
+func (x *GraphQLConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_github_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GraphQLConfig.ProtoReflect.Descriptor instead.
+func (*GraphQLConfig) Descriptor() ([]byte, []int) {
+	return file_github_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *GraphQLConfig) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
 }
 
-func (x *ReleaseUploadOutput) Reset() {
-	*x = ReleaseUploadOutput{}
-	mi := &file_github_proto_msgTypes[35]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *GraphQLConfig) GetVariables() *structpb.Struct {
+	if x != nil {
+		return x.Variables
+	}
+	return nil
 }
 
-func (x *ReleaseUploadOutput) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *GraphQLConfig) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
 }
 
-func (*ReleaseUploadOutput) ProtoMessage() {}
+func (x *GraphQLConfig) GetAuthModule() string {
+	if x != nil {
+		return x.AuthModule
+	}
+	return ""
+}
 
-func (x *ReleaseUploadOutput) ProtoReflect() protoreflect.Message {
-	mi := &file_github_proto_msgTypes[35]
+func (x *GraphQLConfig) GetOwner() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Owner
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use ReleaseUploadOutput.ProtoReflect.Descriptor instead.
-func (*ReleaseUploadOutput) Descriptor() ([]byte, []int) {
-	return file_github_proto_rawDescGZIP(), []int{35}
+func (x *GraphQLConfig) GetTokenRepositories() []string {
+	if x != nil {
+		return x.TokenRepositories
+	}
+	return nil
 }
 
-func (x *ReleaseUploadOutput) GetAssetId() int64 {
+func (x *GraphQLConfig) GetTokenPermissions() map[string]string {
 	if x != nil {
-		return x.AssetId
+		return x.TokenPermissions
 	}
-	return 0
+	return nil
 }
 
-func (x *ReleaseUploadOutput) GetUrl() string {
+func (x *GraphQLConfig) GetApiBaseUrl() string {
 	if x != nil {
-		return x.Url
+		return x.ApiBaseUrl
 	}
 	return ""
 }
 
-func (x *ReleaseUploadOutput) GetName() string {
+func (x *GraphQLConfig) GetGraphqlUrl() string {
 	if x != nil {
-		return x.Name
+		return x.GraphqlUrl
 	}
 	return ""
 }
 
-func (x *ReleaseUploadOutput) GetSize() int64 {
+func (x *GraphQLConfig) GetStrictTemplates() bool {
 	if x != nil {
-		return x.Size
+		return x.StrictTemplates
 	}
-	return 0
+	return false
 }
 
-// UpstreamReleaseMonitorConfig is the typed config for step.gh_upstream_release_monitor.
-type UpstreamReleaseMonitorConfig struct {
+// GraphQLInput carries runtime inputs for step.gh_graphql.
+type GraphQLInput struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UpstreamOwner string                 `protobuf:"bytes,1,opt,name=upstream_owner,json=upstreamOwner,proto3" json:"upstream_owner,omitempty"`
-	UpstreamRepo  string                 `protobuf:"bytes,2,opt,name=upstream_repo,json=upstreamRepo,proto3" json:"upstream_repo,omitempty"`
-	PinnedTag     string                 `protobuf:"bytes,3,opt,name=pinned_tag,json=pinnedTag,proto3" json:"pinned_tag,omitempty"`
-	Token         string                 `protobuf:"bytes,4,opt,name=token,proto3" json:"token,omitempty"`
+	Data          *structpb.Struct       `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpstreamReleaseMonitorConfig) Reset() {
-	*x = UpstreamReleaseMonitorConfig{}
-	mi := &file_github_proto_msgTypes[36]
+func (x *GraphQLInput) Reset() {
+	*x = GraphQLInput{}
+	mi := &file_github_proto_msgTypes[93]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpstreamReleaseMonitorConfig) String() string {
+func (x *GraphQLInput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpstreamReleaseMonitorConfig) ProtoMessage() {}
+func (*GraphQLInput) ProtoMessage() {}
 
-func (x *UpstreamReleaseMonitorConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_github_proto_msgTypes[36]
+func (x *GraphQLInput) ProtoReflect() protoreflect.Message {
+	mi := &file_github_proto_msgTypes[93]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2489,62 +8541,42 @@ func (x *UpstreamReleaseMonitorConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpstreamReleaseMonitorConfig.ProtoReflect.Descriptor instead.
-func (*UpstreamReleaseMonitorConfig) Descriptor() ([]byte, []int) {
-	return file_github_proto_rawDescGZIP(), []int{36}
-}
-
-func (x *UpstreamReleaseMonitorConfig) GetUpstreamOwner() string {
-	if x != nil {
-		return x.UpstreamOwner
-	}
-	return ""
-}
-
-func (x *UpstreamReleaseMonitorConfig) GetUpstreamRepo() string {
-	if x != nil {
-		return x.UpstreamRepo
-	}
-	return ""
-}
-
-func (x *UpstreamReleaseMonitorConfig) GetPinnedTag() string {
-	if x != nil {
-		return x.PinnedTag
-	}
-	return ""
+// Deprecated: Use GraphQLInput.ProtoReflect.Descriptor instead.
+func (*GraphQLInput) Descriptor() ([]byte, []int) {
+	return file_github_proto_rawDescGZIP(), []int{93}
 }
 
-func (x *UpstreamReleaseMonitorConfig) GetToken() string {
+func (x *GraphQLInput) GetData() *structpb.Struct {
 	if x != nil {
-		return x.Token
+		return x.Data
 	}
-	return ""
+	return nil
 }
 
-// UpstreamReleaseMonitorInput carries runtime inputs for step.gh_upstream_release_monitor.
-type UpstreamReleaseMonitorInput struct {
+// GraphQLOutput holds the result of step.gh_graphql.
+type GraphQLOutput struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Data          *structpb.Struct       `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Status        int32                  `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpstreamReleaseMonitorInput) Reset() {
-	*x = UpstreamReleaseMonitorInput{}
-	mi := &file_github_proto_msgTypes[37]
+func (x *GraphQLOutput) Reset() {
+	*x = GraphQLOutput{}
+	mi := &file_github_proto_msgTypes[94]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpstreamReleaseMonitorInput) String() string {
+func (x *GraphQLOutput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpstreamReleaseMonitorInput) ProtoMessage() {}
+func (*GraphQLOutput) ProtoMessage() {}
 
-func (x *UpstreamReleaseMonitorInput) ProtoReflect() protoreflect.Message {
-	mi := &file_github_proto_msgTypes[37]
+func (x *GraphQLOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_github_proto_msgTypes[94]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2555,48 +8587,54 @@ func (x *UpstreamReleaseMonitorInput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpstreamReleaseMonitorInput.ProtoReflect.Descriptor instead.
-func (*UpstreamReleaseMonitorInput) Descriptor() ([]byte, []int) {
-	return file_github_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use GraphQLOutput.ProtoReflect.Descriptor instead.
+func (*GraphQLOutput) Descriptor() ([]byte, []int) {
+	return file_github_proto_rawDescGZIP(), []int{94}
 }
 
-func (x *UpstreamReleaseMonitorInput) GetData() *structpb.Struct {
+func (x *GraphQLOutput) GetData() *structpb.Struct {
 	if x != nil {
 		return x.Data
 	}
 	return nil
 }
 
-// UpstreamReleaseMonitorOutput holds the result of step.gh_upstream_release_monitor.
-type UpstreamReleaseMonitorOutput struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	UpstreamOwner   string                 `protobuf:"bytes,1,opt,name=upstream_owner,json=upstreamOwner,proto3" json:"upstream_owner,omitempty"`
-	UpstreamRepo    string                 `protobuf:"bytes,2,opt,name=upstream_repo,json=upstreamRepo,proto3" json:"upstream_repo,omitempty"`
-	PinnedTag       string                 `protobuf:"bytes,3,opt,name=pinned_tag,json=pinnedTag,proto3" json:"pinned_tag,omitempty"`
-	LatestTag       string                 `protobuf:"bytes,4,opt,name=latest_tag,json=latestTag,proto3" json:"latest_tag,omitempty"`
-	UpdateAvailable bool                   `protobuf:"varint,5,opt,name=update_available,json=updateAvailable,proto3" json:"update_available,omitempty"`
-	ReleaseId       int64                  `protobuf:"varint,6,opt,name=release_id,json=releaseId,proto3" json:"release_id,omitempty"`
-	ReleaseUrl      string                 `protobuf:"bytes,7,opt,name=release_url,json=releaseUrl,proto3" json:"release_url,omitempty"`
-	PublishedAt     string                 `protobuf:"bytes,8,opt,name=published_at,json=publishedAt,proto3" json:"published_at,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+func (x *GraphQLOutput) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
 }
 
-func (x *UpstreamReleaseMonitorOutput) Reset() {
-	*x = UpstreamReleaseMonitorOutput{}
-	mi := &file_github_proto_msgTypes[38]
+// RateLimitConfig is the typed config for step.gh_rate_limit.
+type RateLimitConfig struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Owner             string                 `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Token             string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	AuthModule        string                 `protobuf:"bytes,3,opt,name=auth_module,json=authModule,proto3" json:"auth_module,omitempty"`
+	TokenRepositories []string               `protobuf:"bytes,4,rep,name=token_repositories,json=tokenRepositories,proto3" json:"token_repositories,omitempty"`
+	TokenPermissions  map[string]string      `protobuf:"bytes,5,rep,name=token_permissions,json=tokenPermissions,proto3" json:"token_permissions,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	ApiBaseUrl        string                 `protobuf:"bytes,6,opt,name=api_base_url,json=apiBaseUrl,proto3" json:"api_base_url,omitempty"`
+	StrictTemplates   bool                   `protobuf:"varint,7,opt,name=strict_templates,json=strictTemplates,proto3" json:"strict_templates,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *RateLimitConfig) Reset() {
+	*x = RateLimitConfig{}
+	mi := &file_github_proto_msgTypes[95]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpstreamReleaseMonitorOutput) String() string {
+func (x *RateLimitConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpstreamReleaseMonitorOutput) ProtoMessage() {}
+func (*RateLimitConfig) ProtoMessage() {}
 
-func (x *UpstreamReleaseMonitorOutput) ProtoReflect() protoreflect.Message {
-	mi := &file_github_proto_msgTypes[38]
+func (x *RateLimitConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_github_proto_msgTypes[95]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2607,94 +8645,83 @@ func (x *UpstreamReleaseMonitorOutput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpstreamReleaseMonitorOutput.ProtoReflect.Descriptor instead.
-func (*UpstreamReleaseMonitorOutput) Descriptor() ([]byte, []int) {
-	return file_github_proto_rawDescGZIP(), []int{38}
-}
-
-func (x *UpstreamReleaseMonitorOutput) GetUpstreamOwner() string {
-	if x != nil {
-		return x.UpstreamOwner
-	}
-	return ""
+// Deprecated: Use RateLimitConfig.ProtoReflect.Descriptor instead.
+func (*RateLimitConfig) Descriptor() ([]byte, []int) {
+	return file_github_proto_rawDescGZIP(), []int{95}
 }
 
-func (x *UpstreamReleaseMonitorOutput) GetUpstreamRepo() string {
+func (x *RateLimitConfig) GetOwner() string {
 	if x != nil {
-		return x.UpstreamRepo
+		return x.Owner
 	}
 	return ""
 }
 
-func (x *UpstreamReleaseMonitorOutput) GetPinnedTag() string {
+func (x *RateLimitConfig) GetToken() string {
 	if x != nil {
-		return x.PinnedTag
+		return x.Token
 	}
 	return ""
 }
 
-func (x *UpstreamReleaseMonitorOutput) GetLatestTag() string {
+func (x *RateLimitConfig) GetAuthModule() string {
 	if x != nil {
-		return x.LatestTag
+		return x.AuthModule
 	}
 	return ""
 }
 
-func (x *UpstreamReleaseMonitorOutput) GetUpdateAvailable() bool {
+func (x *RateLimitConfig) GetTokenRepositories() []string {
 	if x != nil {
-		return x.UpdateAvailable
+		return x.TokenRepositories
 	}
-	return false
+	return nil
 }
 
-func (x *UpstreamReleaseMonitorOutput) GetReleaseId() int64 {
+func (x *RateLimitConfig) GetTokenPermissions() map[string]string {
 	if x != nil {
-		return x.ReleaseId
+		return x.TokenPermissions
 	}
-	return 0
+	return nil
 }
 
-func (x *UpstreamReleaseMonitorOutput) GetReleaseUrl() string {
+func (x *RateLimitConfig) GetApiBaseUrl() string {
 	if x != nil {
-		return x.ReleaseUrl
+		return x.ApiBaseUrl
 	}
 	return ""
 }
 
-func (x *UpstreamReleaseMonitorOutput) GetPublishedAt() string {
+func (x *RateLimitConfig) GetStrictTemplates() bool {
 	if x != nil {
-		return x.PublishedAt
+		return x.StrictTemplates
 	}
-	return ""
+	return false
 }
 
-// RepoDispatchConfig is the typed config for step.gh_repo_dispatch.
-type RepoDispatchConfig struct {
+// RateLimitInput carries runtime inputs for step.gh_rate_limit.
+type RateLimitInput struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Owner         string                 `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
-	Repo          string                 `protobuf:"bytes,2,opt,name=repo,proto3" json:"repo,omitempty"`
-	EventType     string                 `protobuf:"bytes,3,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
-	Payload       *structpb.Struct       `protobuf:"bytes,4,opt,name=payload,proto3" json:"payload,omitempty"`
-	Token         string                 `protobuf:"bytes,5,opt,name=token,proto3" json:"token,omitempty"`
+	Data          *structpb.Struct       `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RepoDispatchConfig) Reset() {
-	*x = RepoDispatchConfig{}
-	mi := &file_github_proto_msgTypes[39]
+func (x *RateLimitInput) Reset() {
+	*x = RateLimitInput{}
+	mi := &file_github_proto_msgTypes[96]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RepoDispatchConfig) String() string {
+func (x *RateLimitInput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RepoDispatchConfig) ProtoMessage() {}
+func (*RateLimitInput) ProtoMessage() {}
 
-func (x *RepoDispatchConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_github_proto_msgTypes[39]
+func (x *RateLimitInput) ProtoReflect() protoreflect.Message {
+	mi := &file_github_proto_msgTypes[96]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2705,117 +8732,122 @@ func (x *RepoDispatchConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RepoDispatchConfig.ProtoReflect.Descriptor instead.
-func (*RepoDispatchConfig) Descriptor() ([]byte, []int) {
-	return file_github_proto_rawDescGZIP(), []int{39}
+// Deprecated: Use RateLimitInput.ProtoReflect.Descriptor instead.
+func (*RateLimitInput) Descriptor() ([]byte, []int) {
+	return file_github_proto_rawDescGZIP(), []int{96}
 }
 
-func (x *RepoDispatchConfig) GetOwner() string {
+func (x *RateLimitInput) GetData() *structpb.Struct {
 	if x != nil {
-		return x.Owner
+		return x.Data
 	}
-	return ""
+	return nil
 }
 
-func (x *RepoDispatchConfig) GetRepo() string {
-	if x != nil {
-		return x.Repo
-	}
-	return ""
+// RateLimitOutput holds the result of step.gh_rate_limit.
+type RateLimitOutput struct {
+	state         protoimpl.MessageState        `protogen:"open.v1"`
+	Resources     map[string]*RateLimitResource `protobuf:"bytes,1,rep,name=resources,proto3" json:"resources,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	CoreRemaining int64                         `protobuf:"varint,2,opt,name=core_remaining,json=coreRemaining,proto3" json:"core_remaining,omitempty"`
+	CoreReset     string                        `protobuf:"bytes,3,opt,name=core_reset,json=coreReset,proto3" json:"core_reset,omitempty"`
+	Throttled     []string                      `protobuf:"bytes,4,rep,name=throttled,proto3" json:"throttled,omitempty"`
+	ThrottleWaits int64                         `protobuf:"varint,5,opt,name=throttle_waits,json=throttleWaits,proto3" json:"throttle_waits,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RepoDispatchConfig) GetEventType() string {
-	if x != nil {
-		return x.EventType
-	}
-	return ""
+func (x *RateLimitOutput) Reset() {
+	*x = RateLimitOutput{}
+	mi := &file_github_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *RepoDispatchConfig) GetPayload() *structpb.Struct {
-	if x != nil {
-		return x.Payload
-	}
-	return nil
+func (x *RateLimitOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *RepoDispatchConfig) GetToken() string {
+func (*RateLimitOutput) ProtoMessage() {}
+
+func (x *RateLimitOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_github_proto_msgTypes[97]
 	if x != nil {
-		return x.Token
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-// RepoDispatchInput carries runtime inputs for step.gh_repo_dispatch.
-type RepoDispatchInput struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Data          *structpb.Struct       `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// Deprecated: Use RateLimitOutput.ProtoReflect.Descriptor instead.
+func (*RateLimitOutput) Descriptor() ([]byte, []int) {
+	return file_github_proto_rawDescGZIP(), []int{97}
 }
 
-func (x *RepoDispatchInput) Reset() {
-	*x = RepoDispatchInput{}
-	mi := &file_github_proto_msgTypes[40]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *RateLimitOutput) GetResources() map[string]*RateLimitResource {
+	if x != nil {
+		return x.Resources
+	}
+	return nil
 }
 
-func (x *RepoDispatchInput) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *RateLimitOutput) GetCoreRemaining() int64 {
+	if x != nil {
+		return x.CoreRemaining
+	}
+	return 0
 }
 
-func (*RepoDispatchInput) ProtoMessage() {}
-
-func (x *RepoDispatchInput) ProtoReflect() protoreflect.Message {
-	mi := &file_github_proto_msgTypes[40]
+func (x *RateLimitOutput) GetCoreReset() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.CoreReset
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use RepoDispatchInput.ProtoReflect.Descriptor instead.
-func (*RepoDispatchInput) Descriptor() ([]byte, []int) {
-	return file_github_proto_rawDescGZIP(), []int{40}
+func (x *RateLimitOutput) GetThrottled() []string {
+	if x != nil {
+		return x.Throttled
+	}
+	return nil
 }
 
-func (x *RepoDispatchInput) GetData() *structpb.Struct {
+func (x *RateLimitOutput) GetThrottleWaits() int64 {
 	if x != nil {
-		return x.Data
+		return x.ThrottleWaits
 	}
-	return nil
+	return 0
 }
 
-// RepoDispatchOutput holds the result of step.gh_repo_dispatch.
-type RepoDispatchOutput struct {
+// RateLimitResource is the rate limit of one GitHub API resource.
+type RateLimitResource struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Dispatched    bool                   `protobuf:"varint,1,opt,name=dispatched,proto3" json:"dispatched,omitempty"`
-	EventType     string                 `protobuf:"bytes,2,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
-	Owner         string                 `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
-	Repo          string                 `protobuf:"bytes,4,opt,name=repo,proto3" json:"repo,omitempty"`
+	Limit         int64                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Remaining     int64                  `protobuf:"varint,2,opt,name=remaining,proto3" json:"remaining,omitempty"`
+	Used          int64                  `protobuf:"varint,3,opt,name=used,proto3" json:"used,omitempty"`
+	Reset_        string                 `protobuf:"bytes,4,opt,name=reset,proto3" json:"reset,omitempty"`
+	BlockedUntil  string                 `protobuf:"bytes,5,opt,name=blocked_until,json=blockedUntil,proto3" json:"blocked_until,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RepoDispatchOutput) Reset() {
-	*x = RepoDispatchOutput{}
-	mi := &file_github_proto_msgTypes[41]
+func (x *RateLimitResource) Reset() {
+	*x = RateLimitResource{}
+	mi := &file_github_proto_msgTypes[98]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RepoDispatchOutput) String() string {
+func (x *RateLimitResource) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RepoDispatchOutput) ProtoMessage() {}
+func (*RateLimitResource) ProtoMessage() {}
 
-func (x *RepoDispatchOutput) ProtoReflect() protoreflect.Message {
-	mi := &file_github_proto_msgTypes[41]
+func (x *RateLimitResource) ProtoReflect() protoreflect.Message {
+	mi := &file_github_proto_msgTypes[98]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2826,68 +8858,87 @@ func (x *RepoDispatchOutput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RepoDispatchOutput.ProtoReflect.Descriptor instead.
-func (*RepoDispatchOutput) Descriptor() ([]byte, []int) {
-	return file_github_proto_rawDescGZIP(), []int{41}
+// Deprecated: Use RateLimitResource.ProtoReflect.Descriptor instead.
+func (*RateLimitResource) Descriptor() ([]byte, []int) {
+	return file_github_proto_rawDescGZIP(), []int{98}
 }
 
-func (x *RepoDispatchOutput) GetDispatched() bool {
+func (x *RateLimitResource) GetLimit() int64 {
 	if x != nil {
-		return x.Dispatched
+		return x.Limit
 	}
-	return false
+	return 0
 }
 
-func (x *RepoDispatchOutput) GetEventType() string {
+func (x *RateLimitResource) GetRemaining() int64 {
 	if x != nil {
-		return x.EventType
+		return x.Remaining
 	}
-	return ""
+	return 0
 }
 
-func (x *RepoDispatchOutput) GetOwner() string {
+func (x *RateLimitResource) GetUsed() int64 {
 	if x != nil {
-		return x.Owner
+		return x.Used
+	}
+	return 0
+}
+
+func (x *RateLimitResource) GetReset_() string {
+	if x != nil {
+		return x.Reset_
 	}
 	return ""
 }
 
-func (x *RepoDispatchOutput) GetRepo() string {
+func (x *RateLimitResource) GetBlockedUntil() string {
 	if x != nil {
-		return x.Repo
+		return x.BlockedUntil
 	}
 	return ""
 }
 
-// DeploymentCreateConfig is the typed config for step.gh_deployment_create.
-type DeploymentCreateConfig struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Owner         string                 `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
-	Repo          string                 `protobuf:"bytes,2,opt,name=repo,proto3" json:"repo,omitempty"`
-	Ref           string                 `protobuf:"bytes,3,opt,name=ref,proto3" json:"ref,omitempty"`
-	Environment   string                 `protobuf:"bytes,4,opt,name=environment,proto3" json:"environment,omitempty"`
-	Description   string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
-	AutoMerge     bool                   `protobuf:"varint,6,opt,name=auto_merge,json=autoMerge,proto3" json:"auto_merge,omitempty"`
-	Token         string                 `protobuf:"bytes,7,opt,name=token,proto3" json:"token,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// WebhookReceiveConfig is the typed config for step.gh_webhook_receive.
+type WebhookReceiveConfig struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// module names the git.webhook module to deliver to. Default: the module
+	// whose path matches the request path.
+	Module string `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
+	// event_type is the GitHub event type. Default: the X-GitHub-Event header.
+	EventType string `protobuf:"bytes,2,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	// delivery_id is the delivery GUID. Default: the X-GitHub-Delivery header.
+	DeliveryId string `protobuf:"bytes,3,opt,name=delivery_id,json=deliveryId,proto3" json:"delivery_id,omitempty"`
+	// signature is the "sha256=<hex>" HMAC. Default: the X-Hub-Signature-256 header.
+	Signature string `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
+	// payload is the raw request body the signature covers. Default: the raw
+	// body cached by step.request_parse or step.webhook_verify.
+	Payload string `protobuf:"bytes,5,opt,name=payload,proto3" json:"payload,omitempty"`
+	// signature_verified skips the module's HMAC validation because an earlier
+	// step already checked the signature.
+	SignatureVerified bool `protobuf:"varint,6,opt,name=signature_verified,json=signatureVerified,proto3" json:"signature_verified,omitempty"`
+	// signature_sha1 is the legacy "sha1=<hex>" HMAC, checked when the module
+	// sets allow_sha1_signature. Default: the X-Hub-Signature header.
+	SignatureSha1   string `protobuf:"bytes,7,opt,name=signature_sha1,json=signatureSha1,proto3" json:"signature_sha1,omitempty"`
+	StrictTemplates bool   `protobuf:"varint,8,opt,name=strict_templates,json=strictTemplates,proto3" json:"strict_templates,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *DeploymentCreateConfig) Reset() {
-	*x = DeploymentCreateConfig{}
-	mi := &file_github_proto_msgTypes[42]
+func (x *WebhookReceiveConfig) Reset() {
+	*x = WebhookReceiveConfig{}
+	mi := &file_github_proto_msgTypes[99]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeploymentCreateConfig) String() string {
+func (x *WebhookReceiveConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeploymentCreateConfig) ProtoMessage() {}
+func (*WebhookReceiveConfig) ProtoMessage() {}
 
-func (x *DeploymentCreateConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_github_proto_msgTypes[42]
+func (x *WebhookReceiveConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_github_proto_msgTypes[99]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2898,83 +8949,91 @@ func (x *DeploymentCreateConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeploymentCreateConfig.ProtoReflect.Descriptor instead.
-func (*DeploymentCreateConfig) Descriptor() ([]byte, []int) {
-	return file_github_proto_rawDescGZIP(), []int{42}
+// Deprecated: Use WebhookReceiveConfig.ProtoReflect.Descriptor instead.
+func (*WebhookReceiveConfig) Descriptor() ([]byte, []int) {
+	return file_github_proto_rawDescGZIP(), []int{99}
 }
 
-func (x *DeploymentCreateConfig) GetOwner() string {
+func (x *WebhookReceiveConfig) GetModule() string {
 	if x != nil {
-		return x.Owner
+		return x.Module
 	}
 	return ""
 }
 
-func (x *DeploymentCreateConfig) GetRepo() string {
+func (x *WebhookReceiveConfig) GetEventType() string {
 	if x != nil {
-		return x.Repo
+		return x.EventType
 	}
 	return ""
 }
 
-func (x *DeploymentCreateConfig) GetRef() string {
+func (x *WebhookReceiveConfig) GetDeliveryId() string {
 	if x != nil {
-		return x.Ref
+		return x.DeliveryId
 	}
 	return ""
 }
 
-func (x *DeploymentCreateConfig) GetEnvironment() string {
+func (x *WebhookReceiveConfig) GetSignature() string {
 	if x != nil {
-		return x.Environment
+		return x.Signature
 	}
 	return ""
 }
 
-func (x *DeploymentCreateConfig) GetDescription() string {
+func (x *WebhookReceiveConfig) GetPayload() string {
 	if x != nil {
-		return x.Description
+		return x.Payload
 	}
 	return ""
 }
 
-func (x *DeploymentCreateConfig) GetAutoMerge() bool {
+func (x *WebhookReceiveConfig) GetSignatureVerified() bool {
 	if x != nil {
-		return x.AutoMerge
+		return x.SignatureVerified
 	}
 	return false
 }
 
-func (x *DeploymentCreateConfig) GetToken() string {
+func (x *WebhookReceiveConfig) GetSignatureSha1() string {
 	if x != nil {
-		return x.Token
+		return x.SignatureSha1
 	}
 	return ""
 }
 
-// DeploymentCreateInput carries runtime inputs for step.gh_deployment_create.
-type DeploymentCreateInput struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Data          *structpb.Struct       `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+func (x *WebhookReceiveConfig) GetStrictTemplates() bool {
+	if x != nil {
+		return x.StrictTemplates
+	}
+	return false
+}
+
+// WebhookReceiveInput carries runtime inputs for step.gh_webhook_receive.
+type WebhookReceiveInput struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// headers is the step.request_parse header map.
+	Headers       *structpb.Struct `protobuf:"bytes,1,opt,name=headers,proto3" json:"headers,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeploymentCreateInput) Reset() {
-	*x = DeploymentCreateInput{}
-	mi := &file_github_proto_msgTypes[43]
+func (x *WebhookReceiveInput) Reset() {
+	*x = WebhookReceiveInput{}
+	mi := &file_github_proto_msgTypes[100]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeploymentCreateInput) String() string {
+func (x *WebhookReceiveInput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeploymentCreateInput) ProtoMessage() {}
+func (*WebhookReceiveInput) ProtoMessage() {}
 
-func (x *DeploymentCreateInput) ProtoReflect() protoreflect.Message {
-	mi := &file_github_proto_msgTypes[43]
+func (x *WebhookReceiveInput) ProtoReflect() protoreflect.Message {
+	mi := &file_github_proto_msgTypes[100]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2985,45 +9044,58 @@ func (x *DeploymentCreateInput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeploymentCreateInput.ProtoReflect.Descriptor instead.
-func (*DeploymentCreateInput) Descriptor() ([]byte, []int) {
-	return file_github_proto_rawDescGZIP(), []int{43}
+// Deprecated: Use WebhookReceiveInput.ProtoReflect.Descriptor instead.
+func (*WebhookReceiveInput) Descriptor() ([]byte, []int) {
+	return file_github_proto_rawDescGZIP(), []int{100}
 }
 
-func (x *DeploymentCreateInput) GetData() *structpb.Struct {
+func (x *WebhookReceiveInput) GetHeaders() *structpb.Struct {
 	if x != nil {
-		return x.Data
+		return x.Headers
 	}
 	return nil
 }
 
-// DeploymentCreateOutput holds the result of step.gh_deployment_create.
-type DeploymentCreateOutput struct {
+// WebhookReceiveOutput holds the result of step.gh_webhook_receive.
+type WebhookReceiveOutput struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	DeploymentId  int64                  `protobuf:"varint,1,opt,name=deployment_id,json=deploymentId,proto3" json:"deployment_id,omitempty"`
-	Environment   string                 `protobuf:"bytes,2,opt,name=environment,proto3" json:"environment,omitempty"`
-	Ref           string                 `protobuf:"bytes,3,opt,name=ref,proto3" json:"ref,omitempty"`
-	Sha           string                 `protobuf:"bytes,4,opt,name=sha,proto3" json:"sha,omitempty"`
-	Url           string                 `protobuf:"bytes,5,opt,name=url,proto3" json:"url,omitempty"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Module        string                 `protobuf:"bytes,2,opt,name=module,proto3" json:"module,omitempty"`
+	EventType     string                 `protobuf:"bytes,3,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	DeliveryId    string                 `protobuf:"bytes,4,opt,name=delivery_id,json=deliveryId,proto3" json:"delivery_id,omitempty"`
+	Repository    string                 `protobuf:"bytes,5,opt,name=repository,proto3" json:"repository,omitempty"`
+	Branch        string                 `protobuf:"bytes,6,opt,name=branch,proto3" json:"branch,omitempty"`
+	Commit        string                 `protobuf:"bytes,7,opt,name=commit,proto3" json:"commit,omitempty"`
+	Author        string                 `protobuf:"bytes,8,opt,name=author,proto3" json:"author,omitempty"`
+	Action        string                 `protobuf:"bytes,9,opt,name=action,proto3" json:"action,omitempty"`
+	BaseBranch    string                 `protobuf:"bytes,10,opt,name=base_branch,json=baseBranch,proto3" json:"base_branch,omitempty"`
+	Tag           string                 `protobuf:"bytes,11,opt,name=tag,proto3" json:"tag,omitempty"`
+	PrNumber      int32                  `protobuf:"varint,12,opt,name=pr_number,json=prNumber,proto3" json:"pr_number,omitempty"`
+	IssueNumber   int32                  `protobuf:"varint,13,opt,name=issue_number,json=issueNumber,proto3" json:"issue_number,omitempty"`
+	Labels        []string               `protobuf:"bytes,14,rep,name=labels,proto3" json:"labels,omitempty"`
+	Conclusion    string                 `protobuf:"bytes,15,opt,name=conclusion,proto3" json:"conclusion,omitempty"`
+	Sender        string                 `protobuf:"bytes,16,opt,name=sender,proto3" json:"sender,omitempty"`
+	Topic         string                 `protobuf:"bytes,17,opt,name=topic,proto3" json:"topic,omitempty"`
+	SecretName    string                 `protobuf:"bytes,18,opt,name=secret_name,json=secretName,proto3" json:"secret_name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeploymentCreateOutput) Reset() {
-	*x = DeploymentCreateOutput{}
-	mi := &file_github_proto_msgTypes[44]
+func (x *WebhookReceiveOutput) Reset() {
+	*x = WebhookReceiveOutput{}
+	mi := &file_github_proto_msgTypes[101]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeploymentCreateOutput) String() string {
+func (x *WebhookReceiveOutput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeploymentCreateOutput) ProtoMessage() {}
+func (*WebhookReceiveOutput) ProtoMessage() {}
 
-func (x *DeploymentCreateOutput) ProtoReflect() protoreflect.Message {
-	mi := &file_github_proto_msgTypes[44]
+func (x *WebhookReceiveOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_github_proto_msgTypes[101]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3034,73 +9106,163 @@ func (x *DeploymentCreateOutput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeploymentCreateOutput.ProtoReflect.Descriptor instead.
-func (*DeploymentCreateOutput) Descriptor() ([]byte, []int) {
-	return file_github_proto_rawDescGZIP(), []int{44}
+// Deprecated: Use WebhookReceiveOutput.ProtoReflect.Descriptor instead.
+func (*WebhookReceiveOutput) Descriptor() ([]byte, []int) {
+	return file_github_proto_rawDescGZIP(), []int{101}
 }
 
-func (x *DeploymentCreateOutput) GetDeploymentId() int64 {
+func (x *WebhookReceiveOutput) GetStatus() string {
 	if x != nil {
-		return x.DeploymentId
+		return x.Status
+	}
+	return ""
+}
+
+func (x *WebhookReceiveOutput) GetModule() string {
+	if x != nil {
+		return x.Module
+	}
+	return ""
+}
+
+func (x *WebhookReceiveOutput) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *WebhookReceiveOutput) GetDeliveryId() string {
+	if x != nil {
+		return x.DeliveryId
+	}
+	return ""
+}
+
+func (x *WebhookReceiveOutput) GetRepository() string {
+	if x != nil {
+		return x.Repository
+	}
+	return ""
+}
+
+func (x *WebhookReceiveOutput) GetBranch() string {
+	if x != nil {
+		return x.Branch
+	}
+	return ""
+}
+
+func (x *WebhookReceiveOutput) GetCommit() string {
+	if x != nil {
+		return x.Commit
+	}
+	return ""
+}
+
+func (x *WebhookReceiveOutput) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *WebhookReceiveOutput) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *WebhookReceiveOutput) GetBaseBranch() string {
+	if x != nil {
+		return x.BaseBranch
+	}
+	return ""
+}
+
+func (x *WebhookReceiveOutput) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *WebhookReceiveOutput) GetPrNumber() int32 {
+	if x != nil {
+		return x.PrNumber
 	}
 	return 0
 }
 
-func (x *DeploymentCreateOutput) GetEnvironment() string {
+func (x *WebhookReceiveOutput) GetIssueNumber() int32 {
 	if x != nil {
-		return x.Environment
+		return x.IssueNumber
+	}
+	return 0
+}
+
+func (x *WebhookReceiveOutput) GetLabels() []string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *WebhookReceiveOutput) GetConclusion() string {
+	if x != nil {
+		return x.Conclusion
 	}
 	return ""
 }
 
-func (x *DeploymentCreateOutput) GetRef() string {
+func (x *WebhookReceiveOutput) GetSender() string {
 	if x != nil {
-		return x.Ref
+		return x.Sender
 	}
 	return ""
 }
 
-func (x *DeploymentCreateOutput) GetSha() string {
+func (x *WebhookReceiveOutput) GetTopic() string {
 	if x != nil {
-		return x.Sha
+		return x.Topic
 	}
 	return ""
 }
 
-func (x *DeploymentCreateOutput) GetUrl() string {
+func (x *WebhookReceiveOutput) GetSecretName() string {
 	if x != nil {
-		return x.Url
+		return x.SecretName
 	}
 	return ""
 }
 
-// SecretSetConfig is the typed config for step.gh_secret_set.
-type SecretSetConfig struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Owner         string                 `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
-	Repo          string                 `protobuf:"bytes,2,opt,name=repo,proto3" json:"repo,omitempty"`
-	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Value         string                 `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
-	Token         string                 `protobuf:"bytes,5,opt,name=token,proto3" json:"token,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// WebhookForwardConfig is the typed config for step.gh_webhook_forward.
+type WebhookForwardConfig struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// module names the git.webhook module to forward to. Default: the module
+	// whose path matches the request path.
+	Module          string `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
+	StrictTemplates bool   `protobuf:"varint,2,opt,name=strict_templates,json=strictTemplates,proto3" json:"strict_templates,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *SecretSetConfig) Reset() {
-	*x = SecretSetConfig{}
-	mi := &file_github_proto_msgTypes[45]
+func (x *WebhookForwardConfig) Reset() {
+	*x = WebhookForwardConfig{}
+	mi := &file_github_proto_msgTypes[102]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SecretSetConfig) String() string {
+func (x *WebhookForwardConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SecretSetConfig) ProtoMessage() {}
+func (*WebhookForwardConfig) ProtoMessage() {}
 
-func (x *SecretSetConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_github_proto_msgTypes[45]
+func (x *WebhookForwardConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_github_proto_msgTypes[102]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3111,69 +9273,95 @@ func (x *SecretSetConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SecretSetConfig.ProtoReflect.Descriptor instead.
-func (*SecretSetConfig) Descriptor() ([]byte, []int) {
-	return file_github_proto_rawDescGZIP(), []int{45}
+// Deprecated: Use WebhookForwardConfig.ProtoReflect.Descriptor instead.
+func (*WebhookForwardConfig) Descriptor() ([]byte, []int) {
+	return file_github_proto_rawDescGZIP(), []int{102}
 }
 
-func (x *SecretSetConfig) GetOwner() string {
+func (x *WebhookForwardConfig) GetModule() string {
 	if x != nil {
-		return x.Owner
+		return x.Module
 	}
 	return ""
 }
 
-func (x *SecretSetConfig) GetRepo() string {
+func (x *WebhookForwardConfig) GetStrictTemplates() bool {
 	if x != nil {
-		return x.Repo
+		return x.StrictTemplates
 	}
-	return ""
+	return false
 }
 
-func (x *SecretSetConfig) GetName() string {
+// WebhookForwardInput carries runtime inputs for step.gh_webhook_forward.
+type WebhookForwardInput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *structpb.Struct       `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebhookForwardInput) Reset() {
+	*x = WebhookForwardInput{}
+	mi := &file_github_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookForwardInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookForwardInput) ProtoMessage() {}
+
+func (x *WebhookForwardInput) ProtoReflect() protoreflect.Message {
+	mi := &file_github_proto_msgTypes[103]
 	if x != nil {
-		return x.Name
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *SecretSetConfig) GetValue() string {
-	if x != nil {
-		return x.Value
-	}
-	return ""
+// Deprecated: Use WebhookForwardInput.ProtoReflect.Descriptor instead.
+func (*WebhookForwardInput) Descriptor() ([]byte, []int) {
+	return file_github_proto_rawDescGZIP(), []int{103}
 }
 
-func (x *SecretSetConfig) GetToken() string {
+func (x *WebhookForwardInput) GetData() *structpb.Struct {
 	if x != nil {
-		return x.Token
+		return x.Data
 	}
-	return ""
+	return nil
 }
 
-// SecretSetInput carries runtime inputs for step.gh_secret_set.
-type SecretSetInput struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Data          *structpb.Struct       `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+// WebhookForwardOutput holds the result of step.gh_webhook_forward.
+type WebhookForwardOutput struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Module string                 `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
+	// backend_url is the loopback URL serving module, for step.http_proxy.
+	BackendUrl    string `protobuf:"bytes,2,opt,name=backend_url,json=backendUrl,proto3" json:"backend_url,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SecretSetInput) Reset() {
-	*x = SecretSetInput{}
-	mi := &file_github_proto_msgTypes[46]
+func (x *WebhookForwardOutput) Reset() {
+	*x = WebhookForwardOutput{}
+	mi := &file_github_proto_msgTypes[104]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SecretSetInput) String() string {
+func (x *WebhookForwardOutput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SecretSetInput) ProtoMessage() {}
+func (*WebhookForwardOutput) ProtoMessage() {}
 
-func (x *SecretSetInput) ProtoReflect() protoreflect.Message {
-	mi := &file_github_proto_msgTypes[46]
+func (x *WebhookForwardOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_github_proto_msgTypes[104]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3184,44 +9372,81 @@ func (x *SecretSetInput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SecretSetInput.ProtoReflect.Descriptor instead.
-func (*SecretSetInput) Descriptor() ([]byte, []int) {
-	return file_github_proto_rawDescGZIP(), []int{46}
+// Deprecated: Use WebhookForwardOutput.ProtoReflect.Descriptor instead.
+func (*WebhookForwardOutput) Descriptor() ([]byte, []int) {
+	return file_github_proto_rawDescGZIP(), []int{104}
 }
 
-func (x *SecretSetInput) GetData() *structpb.Struct {
+func (x *WebhookForwardOutput) GetModule() string {
 	if x != nil {
-		return x.Data
+		return x.Module
 	}
-	return nil
+	return ""
 }
 
-// SecretSetOutput holds the result of step.gh_secret_set.
-type SecretSetOutput struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Owner         string                 `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
-	Repo          string                 `protobuf:"bytes,3,opt,name=repo,proto3" json:"repo,omitempty"`
-	Set           bool                   `protobuf:"varint,4,opt,name=set,proto3" json:"set,omitempty"`
+func (x *WebhookForwardOutput) GetBackendUrl() string {
+	if x != nil {
+		return x.BackendUrl
+	}
+	return ""
+}
+
+// WebhookReconcileConfig is the typed config for step.gh_webhook_reconcile.
+type WebhookReconcileConfig struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// owner is the repository owner or organization of the hook.
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// repo selects a repository hook; omit it for an organization hook.
+	Repo string `protobuf:"bytes,2,opt,name=repo,proto3" json:"repo,omitempty"`
+	// hook_id is the hook to reconcile. Required unless app is set.
+	HookId int64 `protobuf:"varint,3,opt,name=hook_id,json=hookId,proto3" json:"hook_id,omitempty"`
+	// app reconciles the webhook of the github.app named by auth_module.
+	App bool `protobuf:"varint,4,opt,name=app,proto3" json:"app,omitempty"`
+	// mode is "redeliver" (ask GitHub to resend failed deliveries) or "publish"
+	// (publish their payloads through module). Default: "redeliver".
+	Mode string `protobuf:"bytes,5,opt,name=mode,proto3" json:"mode,omitempty"`
+	// module is the git.webhook module that publishes deliveries in publish mode.
+	Module string `protobuf:"bytes,6,opt,name=module,proto3" json:"module,omitempty"`
+	// state_dir stores the watermark of the newest reconciled delivery.
+	StateDir string `protobuf:"bytes,7,opt,name=state_dir,json=stateDir,proto3" json:"state_dir,omitempty"`
+	// lookback ignores deliveries older than this duration. Default: "24h".
+	Lookback string `protobuf:"bytes,8,opt,name=lookback,proto3" json:"lookback,omitempty"`
+	// token is the GitHub token used when auth_module is not set.
+	Token string `protobuf:"bytes,9,opt,name=token,proto3" json:"token,omitempty"`
+	// auth_module names a github.app module to authenticate through.
+	AuthModule string `protobuf:"bytes,10,opt,name=auth_module,json=authModule,proto3" json:"auth_module,omitempty"`
+	// token_repositories narrows the installation token to these repositories.
+	TokenRepositories []string `protobuf:"bytes,11,rep,name=token_repositories,json=tokenRepositories,proto3" json:"token_repositories,omitempty"`
+	// token_permissions narrows the installation token to these permissions.
+	TokenPermissions map[string]string `protobuf:"bytes,12,rep,name=token_permissions,json=tokenPermissions,proto3" json:"token_permissions,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// api_base_url targets a GitHub Enterprise Server instance; defaults to
+	// the auth_module's, else https://api.github.com.
+	ApiBaseUrl string `protobuf:"bytes,13,opt,name=api_base_url,json=apiBaseUrl,proto3" json:"api_base_url,omitempty"`
+	// strict_templates fails the step when a config placeholder cannot be
+	// resolved.
+	StrictTemplates bool `protobuf:"varint,14,opt,name=strict_templates,json=strictTemplates,proto3" json:"strict_templates,omitempty"`
+	// max_attempts is how often one delivery is retried before the step gives
+	// up on it. Numeric literal or template expression. Default: "3".
+	MaxAttempts   string `protobuf:"bytes,15,opt,name=max_attempts,json=maxAttempts,proto3" json:"max_attempts,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SecretSetOutput) Reset() {
-	*x = SecretSetOutput{}
-	mi := &file_github_proto_msgTypes[47]
+func (x *WebhookReconcileConfig) Reset() {
+	*x = WebhookReconcileConfig{}
+	mi := &file_github_proto_msgTypes[105]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SecretSetOutput) String() string {
+func (x *WebhookReconcileConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SecretSetOutput) ProtoMessage() {}
+func (*WebhookReconcileConfig) ProtoMessage() {}
 
-func (x *SecretSetOutput) ProtoReflect() protoreflect.Message {
-	mi := &file_github_proto_msgTypes[47]
+func (x *WebhookReconcileConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_github_proto_msgTypes[105]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3232,123 +9457,139 @@ func (x *SecretSetOutput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SecretSetOutput.ProtoReflect.Descriptor instead.
-func (*SecretSetOutput) Descriptor() ([]byte, []int) {
-	return file_github_proto_rawDescGZIP(), []int{47}
+// Deprecated: Use WebhookReconcileConfig.ProtoReflect.Descriptor instead.
+func (*WebhookReconcileConfig) Descriptor() ([]byte, []int) {
+	return file_github_proto_rawDescGZIP(), []int{105}
 }
 
-func (x *SecretSetOutput) GetName() string {
+func (x *WebhookReconcileConfig) GetOwner() string {
 	if x != nil {
-		return x.Name
+		return x.Owner
 	}
 	return ""
 }
 
-func (x *SecretSetOutput) GetOwner() string {
+func (x *WebhookReconcileConfig) GetRepo() string {
 	if x != nil {
-		return x.Owner
+		return x.Repo
 	}
 	return ""
 }
 
-func (x *SecretSetOutput) GetRepo() string {
+func (x *WebhookReconcileConfig) GetHookId() int64 {
 	if x != nil {
-		return x.Repo
+		return x.HookId
 	}
-	return ""
+	return 0
 }
 
-func (x *SecretSetOutput) GetSet() bool {
+func (x *WebhookReconcileConfig) GetApp() bool {
 	if x != nil {
-		return x.Set
+		return x.App
 	}
 	return false
 }
 
-// GraphQLConfig is the typed config for step.gh_graphql.
-type GraphQLConfig struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
-	Variables     *structpb.Struct       `protobuf:"bytes,2,opt,name=variables,proto3" json:"variables,omitempty"`
-	Token         string                 `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *WebhookReconcileConfig) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
 }
 
-func (x *GraphQLConfig) Reset() {
-	*x = GraphQLConfig{}
-	mi := &file_github_proto_msgTypes[48]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *WebhookReconcileConfig) GetModule() string {
+	if x != nil {
+		return x.Module
+	}
+	return ""
 }
 
-func (x *GraphQLConfig) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *WebhookReconcileConfig) GetStateDir() string {
+	if x != nil {
+		return x.StateDir
+	}
+	return ""
 }
 
-func (*GraphQLConfig) ProtoMessage() {}
-
-func (x *GraphQLConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_github_proto_msgTypes[48]
+func (x *WebhookReconcileConfig) GetLookback() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Lookback
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use GraphQLConfig.ProtoReflect.Descriptor instead.
-func (*GraphQLConfig) Descriptor() ([]byte, []int) {
-	return file_github_proto_rawDescGZIP(), []int{48}
+func (x *WebhookReconcileConfig) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
 }
 
-func (x *GraphQLConfig) GetQuery() string {
+func (x *WebhookReconcileConfig) GetAuthModule() string {
 	if x != nil {
-		return x.Query
+		return x.AuthModule
 	}
 	return ""
 }
 
-func (x *GraphQLConfig) GetVariables() *structpb.Struct {
+func (x *WebhookReconcileConfig) GetTokenRepositories() []string {
 	if x != nil {
-		return x.Variables
+		return x.TokenRepositories
 	}
 	return nil
 }
 
-func (x *GraphQLConfig) GetToken() string {
+func (x *WebhookReconcileConfig) GetTokenPermissions() map[string]string {
 	if x != nil {
-		return x.Token
+		return x.TokenPermissions
+	}
+	return nil
+}
+
+func (x *WebhookReconcileConfig) GetApiBaseUrl() string {
+	if x != nil {
+		return x.ApiBaseUrl
 	}
 	return ""
 }
 
-// GraphQLInput carries runtime inputs for step.gh_graphql.
-type GraphQLInput struct {
+func (x *WebhookReconcileConfig) GetStrictTemplates() bool {
+	if x != nil {
+		return x.StrictTemplates
+	}
+	return false
+}
+
+func (x *WebhookReconcileConfig) GetMaxAttempts() string {
+	if x != nil {
+		return x.MaxAttempts
+	}
+	return ""
+}
+
+// WebhookReconcileInput carries runtime inputs for step.gh_webhook_reconcile.
+type WebhookReconcileInput struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Data          *structpb.Struct       `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GraphQLInput) Reset() {
-	*x = GraphQLInput{}
-	mi := &file_github_proto_msgTypes[49]
+func (x *WebhookReconcileInput) Reset() {
+	*x = WebhookReconcileInput{}
+	mi := &file_github_proto_msgTypes[106]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GraphQLInput) String() string {
+func (x *WebhookReconcileInput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GraphQLInput) ProtoMessage() {}
+func (*WebhookReconcileInput) ProtoMessage() {}
 
-func (x *GraphQLInput) ProtoReflect() protoreflect.Message {
-	mi := &file_github_proto_msgTypes[49]
+func (x *WebhookReconcileInput) ProtoReflect() protoreflect.Message {
+	mi := &file_github_proto_msgTypes[106]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3359,42 +9600,49 @@ func (x *GraphQLInput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GraphQLInput.ProtoReflect.Descriptor instead.
-func (*GraphQLInput) Descriptor() ([]byte, []int) {
-	return file_github_proto_rawDescGZIP(), []int{49}
+// Deprecated: Use WebhookReconcileInput.ProtoReflect.Descriptor instead.
+func (*WebhookReconcileInput) Descriptor() ([]byte, []int) {
+	return file_github_proto_rawDescGZIP(), []int{106}
 }
 
-func (x *GraphQLInput) GetData() *structpb.Struct {
+func (x *WebhookReconcileInput) GetData() *structpb.Struct {
 	if x != nil {
 		return x.Data
 	}
 	return nil
 }
 
-// GraphQLOutput holds the result of step.gh_graphql.
-type GraphQLOutput struct {
+// WebhookReconcileOutput holds the result of step.gh_webhook_reconcile.
+type WebhookReconcileOutput struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Data          *structpb.Struct       `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
-	Status        int32                  `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
+	Checked       int32                  `protobuf:"varint,1,opt,name=checked,proto3" json:"checked,omitempty"`
+	Failed        int32                  `protobuf:"varint,2,opt,name=failed,proto3" json:"failed,omitempty"`
+	Redelivered   int32                  `protobuf:"varint,3,opt,name=redelivered,proto3" json:"redelivered,omitempty"`
+	Published     int32                  `protobuf:"varint,4,opt,name=published,proto3" json:"published,omitempty"`
+	Ignored       int32                  `protobuf:"varint,5,opt,name=ignored,proto3" json:"ignored,omitempty"`
+	DeliveryIds   []string               `protobuf:"bytes,6,rep,name=delivery_ids,json=deliveryIds,proto3" json:"delivery_ids,omitempty"`
+	Watermark     int64                  `protobuf:"varint,7,opt,name=watermark,proto3" json:"watermark,omitempty"`
+	Rejected      []string               `protobuf:"bytes,8,rep,name=rejected,proto3" json:"rejected,omitempty"`
+	Abandoned     []string               `protobuf:"bytes,9,rep,name=abandoned,proto3" json:"abandoned,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GraphQLOutput) Reset() {
-	*x = GraphQLOutput{}
-	mi := &file_github_proto_msgTypes[50]
+func (x *WebhookReconcileOutput) Reset() {
+	*x = WebhookReconcileOutput{}
+	mi := &file_github_proto_msgTypes[107]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GraphQLOutput) String() string {
+func (x *WebhookReconcileOutput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GraphQLOutput) ProtoMessage() {}
+func (*WebhookReconcileOutput) ProtoMessage() {}
 
-func (x *GraphQLOutput) ProtoReflect() protoreflect.Message {
-	mi := &file_github_proto_msgTypes[50]
+func (x *WebhookReconcileOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_github_proto_msgTypes[107]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3405,40 +9653,127 @@ func (x *GraphQLOutput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GraphQLOutput.ProtoReflect.Descriptor instead.
-func (*GraphQLOutput) Descriptor() ([]byte, []int) {
-	return file_github_proto_rawDescGZIP(), []int{50}
+// Deprecated: Use WebhookReconcileOutput.ProtoReflect.Descriptor instead.
+func (*WebhookReconcileOutput) Descriptor() ([]byte, []int) {
+	return file_github_proto_rawDescGZIP(), []int{107}
 }
 
-func (x *GraphQLOutput) GetData() *structpb.Struct {
+func (x *WebhookReconcileOutput) GetChecked() int32 {
 	if x != nil {
-		return x.Data
+		return x.Checked
+	}
+	return 0
+}
+
+func (x *WebhookReconcileOutput) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *WebhookReconcileOutput) GetRedelivered() int32 {
+	if x != nil {
+		return x.Redelivered
+	}
+	return 0
+}
+
+func (x *WebhookReconcileOutput) GetPublished() int32 {
+	if x != nil {
+		return x.Published
+	}
+	return 0
+}
+
+func (x *WebhookReconcileOutput) GetIgnored() int32 {
+	if x != nil {
+		return x.Ignored
+	}
+	return 0
+}
+
+func (x *WebhookReconcileOutput) GetDeliveryIds() []string {
+	if x != nil {
+		return x.DeliveryIds
 	}
 	return nil
 }
 
-func (x *GraphQLOutput) GetStatus() int32 {
+func (x *WebhookReconcileOutput) GetWatermark() int64 {
 	if x != nil {
-		return x.Status
+		return x.Watermark
 	}
 	return 0
 }
 
+func (x *WebhookReconcileOutput) GetRejected() []string {
+	if x != nil {
+		return x.Rejected
+	}
+	return nil
+}
+
+func (x *WebhookReconcileOutput) GetAbandoned() []string {
+	if x != nil {
+		return x.Abandoned
+	}
+	return nil
+}
+
 var File_github_proto protoreflect.FileDescriptor
 
 const file_github_proto_rawDesc = "" +
 	"\n" +
-	"\fgithub.proto\x12\x19workflow.plugin.github.v1\x1a\x1cgoogle/protobuf/struct.proto\"w\n" +
+	"\fgithub.proto\x12\x19workflow.plugin.github.v1\x1a\x1cgoogle/protobuf/struct.proto\"\xb9\x06\n" +
 	"\x13WebhookModuleConfig\x12\x1a\n" +
 	"\bprovider\x18\x01 \x01(\tR\bprovider\x12\x16\n" +
 	"\x06secret\x18\x02 \x01(\tR\x06secret\x12\x16\n" +
 	"\x06events\x18\x03 \x03(\tR\x06events\x12\x14\n" +
-	"\x05topic\x18\x04 \x01(\tR\x05topic\"x\n" +
+	"\x05topic\x18\x04 \x01(\tR\x05topic\x12\x12\n" +
+	"\x04path\x18\x05 \x01(\tR\x04path\x12!\n" +
+	"\fdedup_window\x18\x06 \x01(\tR\vdedupWindow\x12*\n" +
+	"\x11dedup_max_entries\x18\a \x01(\x05R\x0fdedupMaxEntries\x12\x1b\n" +
+	"\tstate_dir\x18\b \x01(\tR\bstateDir\x12\"\n" +
+	"\frepositories\x18\t \x03(\tR\frepositories\x121\n" +
+	"\x14exclude_repositories\x18\n" +
+	" \x03(\tR\x13excludeRepositories\x12\x1a\n" +
+	"\bbranches\x18\v \x03(\tR\bbranches\x12)\n" +
+	"\x10exclude_branches\x18\f \x03(\tR\x0fexcludeBranches\x12'\n" +
+	"\x0fexclude_senders\x18\r \x03(\tR\x0eexcludeSenders\x12\x16\n" +
+	"\x06labels\x18\x0e \x03(\tR\x06labels\x12%\n" +
+	"\x0eexclude_labels\x18\x0f \x03(\tR\rexcludeLabels\x12?\n" +
+	"\x06routes\x18\x10 \x03(\v2'.workflow.plugin.github.v1.WebhookRouteR\x06routes\x12B\n" +
+	"\asecrets\x18\x11 \x03(\v2(.workflow.plugin.github.v1.WebhookSecretR\asecrets\x120\n" +
+	"\x14allow_sha1_signature\x18\x12 \x01(\bR\x12allowSha1Signature\x12#\n" +
+	"\rasync_publish\x18\x13 \x01(\bR\fasyncPublish\x122\n" +
+	"\x15retry_initial_backoff\x18\x14 \x01(\tR\x13retryInitialBackoff\x12*\n" +
+	"\x11retry_max_backoff\x18\x15 \x01(\tR\x0fretryMaxBackoff\"Z\n" +
+	"\rWebhookSecret\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06secret\x18\x02 \x01(\tR\x06secret\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x03 \x01(\tR\texpiresAt\"^\n" +
+	"\fWebhookRoute\x12\x14\n" +
+	"\x05event\x18\x01 \x01(\tR\x05event\x12\"\n" +
+	"\frepositories\x18\x02 \x03(\tR\frepositories\x12\x14\n" +
+	"\x05topic\x18\x03 \x01(\tR\x05topic\"\xa3\x03\n" +
 	"\x15GitHubAppModuleConfig\x12\x15\n" +
 	"\x06app_id\x18\x01 \x01(\x03R\x05appId\x12'\n" +
 	"\x0finstallation_id\x18\x02 \x01(\x03R\x0einstallationId\x12\x1f\n" +
 	"\vprivate_key\x18\x03 \x01(\tR\n" +
-	"privateKey\"\x87\x02\n" +
+	"privateKey\x12\"\n" +
+	"\frepositories\x18\x04 \x03(\tR\frepositories\x12c\n" +
+	"\vpermissions\x18\x05 \x03(\v2A.workflow.plugin.github.v1.GitHubAppModuleConfig.PermissionsEntryR\vpermissions\x12 \n" +
+	"\fapi_base_url\x18\x06 \x01(\tR\n" +
+	"apiBaseUrl\x12\x1d\n" +
+	"\n" +
+	"upload_url\x18\a \x01(\tR\tuploadUrl\x12\x1f\n" +
+	"\vgraphql_url\x18\b \x01(\tR\n" +
+	"graphqlUrl\x1a>\n" +
+	"\x10PermissionsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x87\x02\n" +
 	"\x1aRunnerProviderModuleConfig\x12\x14\n" +
 	"\x05token\x18\x01 \x01(\tR\x05token\x12%\n" +
 	"\x0eprovider_token\x18\x02 \x01(\tR\rproviderToken\x12 \n" +
@@ -3447,22 +9782,41 @@ const file_github_proto_rawDesc = "" +
 	"\frepositories\x18\x04 \x03(\tR\frepositories\x12$\n" +
 	"\rorganizations\x18\x05 \x03(\tR\rorganizations\x12#\n" +
 	"\rrunner_groups\x18\x06 \x03(\tR\frunnerGroups\x12\x1b\n" +
-	"\tstate_dir\x18\a \x01(\tR\bstateDir\"\xb4\x01\n" +
+	"\tstate_dir\x18\a \x01(\tR\bstateDir\"\xb6\x04\n" +
 	"\x13ActionTriggerConfig\x12\x14\n" +
 	"\x05owner\x18\x01 \x01(\tR\x05owner\x12\x12\n" +
 	"\x04repo\x18\x02 \x01(\tR\x04repo\x12\x1a\n" +
 	"\bworkflow\x18\x03 \x01(\tR\bworkflow\x12\x10\n" +
 	"\x03ref\x18\x04 \x01(\tR\x03ref\x12/\n" +
 	"\x06inputs\x18\x05 \x01(\v2\x17.google.protobuf.StructR\x06inputs\x12\x14\n" +
-	"\x05token\x18\x06 \x01(\tR\x05token\"A\n" +
+	"\x05token\x18\x06 \x01(\tR\x05token\x12\x1f\n" +
+	"\vauth_module\x18\a \x01(\tR\n" +
+	"authModule\x12-\n" +
+	"\x12token_repositories\x18\b \x03(\tR\x11tokenRepositories\x12q\n" +
+	"\x11token_permissions\x18\t \x03(\v2D.workflow.plugin.github.v1.ActionTriggerConfig.TokenPermissionsEntryR\x10tokenPermissions\x12\x1f\n" +
+	"\vcapture_run\x18\n" +
+	" \x01(\bR\n" +
+	"captureRun\x12 \n" +
+	"\fapi_base_url\x18\r \x01(\tR\n" +
+	"apiBaseUrl\x12)\n" +
+	"\x10strict_templates\x18\x0e \x01(\bR\x0fstrictTemplates\x1aC\n" +
+	"\x15TokenPermissionsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01J\x04\b\v\x10\fJ\x04\b\f\x10\r\"A\n" +
 	"\x12ActionTriggerInput\x12+\n" +
-	"\x04data\x18\x01 \x01(\v2\x17.google.protobuf.StructR\x04data\"\x8b\x01\n" +
+	"\x04data\x18\x01 \x01(\v2\x17.google.protobuf.StructR\x04data\"\x88\x02\n" +
 	"\x13ActionTriggerOutput\x12\x1c\n" +
 	"\ttriggered\x18\x01 \x01(\bR\ttriggered\x12\x14\n" +
 	"\x05owner\x18\x02 \x01(\tR\x05owner\x12\x12\n" +
 	"\x04repo\x18\x03 \x01(\tR\x04repo\x12\x1a\n" +
 	"\bworkflow\x18\x04 \x01(\tR\bworkflow\x12\x10\n" +
-	"\x03ref\x18\x05 \x01(\tR\x03ref\"\xbe\x01\n" +
+	"\x03ref\x18\x05 \x01(\tR\x03ref\x12\x15\n" +
+	"\x06run_id\x18\x06 \x01(\x03R\x05runId\x12\x19\n" +
+	"\bhtml_url\x18\a \x01(\tR\ahtmlUrl\x12\x19\n" +
+	"\bhead_sha\x18\b \x01(\tR\aheadSha\x12(\n" +
+	"\x10run_lookup_error\x18\n" +
+	" \x01(\tR\x0erunLookupErrorJ\x04\b\t\x10\n" +
+	"\"\xe6\x05\n" +
 	"\x12ActionStatusConfig\x12\x14\n" +
 	"\x05owner\x18\x01 \x01(\tR\x05owner\x12\x12\n" +
 	"\x04repo\x18\x02 \x01(\tR\x04repo\x12\x15\n" +
@@ -3470,16 +9824,206 @@ const file_github_proto_rawDesc = "" +
 	"\x05token\x18\x04 \x01(\tR\x05token\x12\x12\n" +
 	"\x04wait\x18\x05 \x01(\bR\x04wait\x12#\n" +
 	"\rpoll_interval\x18\x06 \x01(\tR\fpollInterval\x12\x18\n" +
-	"\atimeout\x18\a \x01(\tR\atimeout\"@\n" +
+	"\atimeout\x18\a \x01(\tR\atimeout\x12\x1f\n" +
+	"\vauth_module\x18\b \x01(\tR\n" +
+	"authModule\x12-\n" +
+	"\x12token_repositories\x18\t \x03(\tR\x11tokenRepositories\x12p\n" +
+	"\x11token_permissions\x18\n" +
+	" \x03(\v2C.workflow.plugin.github.v1.ActionStatusConfig.TokenPermissionsEntryR\x10tokenPermissions\x12!\n" +
+	"\finclude_jobs\x18\v \x01(\bR\vincludeJobs\x12/\n" +
+	"\x13include_annotations\x18\f \x01(\bR\x12includeAnnotations\x12$\n" +
+	"\x0elog_tail_lines\x18\r \x01(\x05R\flogTailLines\x12*\n" +
+	"\x11max_poll_interval\x18\x0e \x01(\tR\x0fmaxPollInterval\x12,\n" +
+	"\x12rate_limit_reserve\x18\x0f \x01(\x05R\x10rateLimitReserve\x12 \n" +
+	"\fapi_base_url\x18\x10 \x01(\tR\n" +
+	"apiBaseUrl\x12)\n" +
+	"\x10strict_templates\x18\x11 \x01(\bR\x0fstrictTemplates\x1aC\n" +
+	"\x15TokenPermissionsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"@\n" +
 	"\x11ActionStatusInput\x12+\n" +
-	"\x04data\x18\x01 \x01(\v2\x17.google.protobuf.StructR\x04data\"u\n" +
+	"\x04data\x18\x01 \x01(\v2\x17.google.protobuf.StructR\x04data\"\xde\x03\n" +
 	"\x12ActionStatusOutput\x12\x15\n" +
 	"\x06run_id\x18\x01 \x01(\x03R\x05runId\x12\x16\n" +
 	"\x06status\x18\x02 \x01(\tR\x06status\x12\x1e\n" +
 	"\n" +
 	"conclusion\x18\x03 \x01(\tR\n" +
 	"conclusion\x12\x10\n" +
-	"\x03url\x18\x04 \x01(\tR\x03url\"\xb8\x01\n" +
+	"\x03url\x18\x04 \x01(\tR\x03url\x12A\n" +
+	"\x04jobs\x18\x05 \x03(\v2-.workflow.plugin.github.v1.WorkflowJobSummaryR\x04jobs\x12S\n" +
+	"\vannotations\x18\x06 \x03(\v21.workflow.plugin.github.v1.CheckAnnotationSummaryR\vannotations\x12h\n" +
+	"\x0ffailed_job_logs\x18\a \x03(\v2@.workflow.plugin.github.v1.ActionStatusOutput.FailedJobLogsEntryR\rfailedJobLogs\x12#\n" +
+	"\rdetails_error\x18\b \x01(\tR\fdetailsError\x1a@\n" +
+	"\x12FailedJobLogsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xb1\x02\n" +
+	"\x12WorkflowJobSummary\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x1e\n" +
+	"\n" +
+	"conclusion\x18\x04 \x01(\tR\n" +
+	"conclusion\x12\x1f\n" +
+	"\vrunner_name\x18\x05 \x01(\tR\n" +
+	"runnerName\x12\x10\n" +
+	"\x03url\x18\x06 \x01(\tR\x03url\x12\x1f\n" +
+	"\vfailed_step\x18\a \x01(\tR\n" +
+	"failedStep\x12\x1d\n" +
+	"\n" +
+	"started_at\x18\b \x01(\tR\tstartedAt\x12!\n" +
+	"\fcompleted_at\x18\t \x01(\tR\vcompletedAt\x12)\n" +
+	"\x10duration_seconds\x18\n" +
+	" \x01(\x03R\x0fdurationSeconds\"\xbe\x01\n" +
+	"\x16CheckAnnotationSummary\x12\x10\n" +
+	"\x03job\x18\x01 \x01(\tR\x03job\x12\x12\n" +
+	"\x04path\x18\x02 \x01(\tR\x04path\x12\x1d\n" +
+	"\n" +
+	"start_line\x18\x03 \x01(\x05R\tstartLine\x12\x19\n" +
+	"\bend_line\x18\x04 \x01(\x05R\aendLine\x12\x14\n" +
+	"\x05level\x18\x05 \x01(\tR\x05level\x12\x14\n" +
+	"\x05title\x18\x06 \x01(\tR\x05title\x12\x18\n" +
+	"\amessage\x18\a \x01(\tR\amessage\"\xd5\x03\n" +
+	"\x12ActionCancelConfig\x12\x14\n" +
+	"\x05owner\x18\x01 \x01(\tR\x05owner\x12\x12\n" +
+	"\x04repo\x18\x02 \x01(\tR\x04repo\x12\x15\n" +
+	"\x06run_id\x18\x03 \x01(\tR\x05runId\x12\x14\n" +
+	"\x05token\x18\x04 \x01(\tR\x05token\x12\x14\n" +
+	"\x05force\x18\x05 \x01(\bR\x05force\x12\x1f\n" +
+	"\vauth_module\x18\x06 \x01(\tR\n" +
+	"authModule\x12-\n" +
+	"\x12token_repositories\x18\a \x03(\tR\x11tokenRepositories\x12p\n" +
+	"\x11token_permissions\x18\b \x03(\v2C.workflow.plugin.github.v1.ActionCancelConfig.TokenPermissionsEntryR\x10tokenPermissions\x12 \n" +
+	"\fapi_base_url\x18\t \x01(\tR\n" +
+	"apiBaseUrl\x12)\n" +
+	"\x10strict_templates\x18\n" +
+	" \x01(\bR\x0fstrictTemplates\x1aC\n" +
+	"\x15TokenPermissionsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"@\n" +
+	"\x11ActionCancelInput\x12+\n" +
+	"\x04data\x18\x01 \x01(\v2\x17.google.protobuf.StructR\x04data\"_\n" +
+	"\x12ActionCancelOutput\x12\x15\n" +
+	"\x06run_id\x18\x01 \x01(\x03R\x05runId\x12\x1c\n" +
+	"\tcancelled\x18\x02 \x01(\bR\tcancelled\x12\x14\n" +
+	"\x05force\x18\x03 \x01(\bR\x05force\"\x90\x04\n" +
+	"\x11ActionRerunConfig\x12\x14\n" +
+	"\x05owner\x18\x01 \x01(\tR\x05owner\x12\x12\n" +
+	"\x04repo\x18\x02 \x01(\tR\x04repo\x12\x15\n" +
+	"\x06run_id\x18\x03 \x01(\tR\x05runId\x12\x14\n" +
+	"\x05token\x18\x04 \x01(\tR\x05token\x12\x1f\n" +
+	"\vfailed_only\x18\x05 \x01(\bR\n" +
+	"failedOnly\x120\n" +
+	"\x14enable_debug_logging\x18\x06 \x01(\bR\x12enableDebugLogging\x12\x1f\n" +
+	"\vauth_module\x18\a \x01(\tR\n" +
+	"authModule\x12-\n" +
+	"\x12token_repositories\x18\b \x03(\tR\x11tokenRepositories\x12o\n" +
+	"\x11token_permissions\x18\t \x03(\v2B.workflow.plugin.github.v1.ActionRerunConfig.TokenPermissionsEntryR\x10tokenPermissions\x12 \n" +
+	"\fapi_base_url\x18\n" +
+	" \x01(\tR\n" +
+	"apiBaseUrl\x12)\n" +
+	"\x10strict_templates\x18\v \x01(\bR\x0fstrictTemplates\x1aC\n" +
+	"\x15TokenPermissionsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"?\n" +
+	"\x10ActionRerunInput\x12+\n" +
+	"\x04data\x18\x01 \x01(\v2\x17.google.protobuf.StructR\x04data\"a\n" +
+	"\x11ActionRerunOutput\x12\x15\n" +
+	"\x06run_id\x18\x01 \x01(\x03R\x05runId\x12\x14\n" +
+	"\x05rerun\x18\x02 \x01(\bR\x05rerun\x12\x1f\n" +
+	"\vfailed_only\x18\x03 \x01(\bR\n" +
+	"failedOnly\"\x95\x04\n" +
+	"\x13ActionApproveConfig\x12\x14\n" +
+	"\x05owner\x18\x01 \x01(\tR\x05owner\x12\x12\n" +
+	"\x04repo\x18\x02 \x01(\tR\x04repo\x12\x15\n" +
+	"\x06run_id\x18\x03 \x01(\tR\x05runId\x12\x14\n" +
+	"\x05token\x18\x04 \x01(\tR\x05token\x12\"\n" +
+	"\fenvironments\x18\x05 \x03(\tR\fenvironments\x12\x14\n" +
+	"\x05state\x18\x06 \x01(\tR\x05state\x12\x18\n" +
+	"\acomment\x18\a \x01(\tR\acomment\x12\x1f\n" +
+	"\vauth_module\x18\b \x01(\tR\n" +
+	"authModule\x12-\n" +
+	"\x12token_repositories\x18\t \x03(\tR\x11tokenRepositories\x12q\n" +
+	"\x11token_permissions\x18\n" +
+	" \x03(\v2D.workflow.plugin.github.v1.ActionApproveConfig.TokenPermissionsEntryR\x10tokenPermissions\x12 \n" +
+	"\fapi_base_url\x18\v \x01(\tR\n" +
+	"apiBaseUrl\x12)\n" +
+	"\x10strict_templates\x18\f \x01(\bR\x0fstrictTemplates\x1aC\n" +
+	"\x15TokenPermissionsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"A\n" +
+	"\x12ActionApproveInput\x12+\n" +
+	"\x04data\x18\x01 \x01(\v2\x17.google.protobuf.StructR\x04data\"f\n" +
+	"\x13ActionApproveOutput\x12\x15\n" +
+	"\x06run_id\x18\x01 \x01(\x03R\x05runId\x12\x14\n" +
+	"\x05state\x18\x02 \x01(\tR\x05state\x12\"\n" +
+	"\fenvironments\x18\x03 \x03(\tR\fenvironments\"\xd3\x03\n" +
+	"\x12ArtifactListConfig\x12\x14\n" +
+	"\x05owner\x18\x01 \x01(\tR\x05owner\x12\x12\n" +
+	"\x04repo\x18\x02 \x01(\tR\x04repo\x12\x15\n" +
+	"\x06run_id\x18\x03 \x01(\tR\x05runId\x12\x14\n" +
+	"\x05token\x18\x04 \x01(\tR\x05token\x12\x12\n" +
+	"\x04name\x18\x05 \x01(\tR\x04name\x12\x1f\n" +
+	"\vauth_module\x18\x06 \x01(\tR\n" +
+	"authModule\x12-\n" +
+	"\x12token_repositories\x18\a \x03(\tR\x11tokenRepositories\x12p\n" +
+	"\x11token_permissions\x18\b \x03(\v2C.workflow.plugin.github.v1.ArtifactListConfig.TokenPermissionsEntryR\x10tokenPermissions\x12 \n" +
+	"\fapi_base_url\x18\t \x01(\tR\n" +
+	"apiBaseUrl\x12)\n" +
+	"\x10strict_templates\x18\n" +
+	" \x01(\bR\x0fstrictTemplates\x1aC\n" +
+	"\x15TokenPermissionsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"@\n" +
+	"\x11ArtifactListInput\x12+\n" +
+	"\x04data\x18\x01 \x01(\v2\x17.google.protobuf.StructR\x04data\"\x8b\x01\n" +
+	"\x12ArtifactListOutput\x12\x15\n" +
+	"\x06run_id\x18\x01 \x01(\x03R\x05runId\x12H\n" +
+	"\tartifacts\x18\x02 \x03(\v2*.workflow.plugin.github.v1.ArtifactSummaryR\tartifacts\x12\x14\n" +
+	"\x05count\x18\x03 \x01(\x05R\x05count\"\xb1\x01\n" +
+	"\x0fArtifactSummary\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\"\n" +
+	"\rsize_in_bytes\x18\x03 \x01(\x03R\vsizeInBytes\x12\x18\n" +
+	"\aexpired\x18\x04 \x01(\bR\aexpired\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x06 \x01(\tR\texpiresAt\"\xe8\x04\n" +
+	"\x16ArtifactDownloadConfig\x12\x14\n" +
+	"\x05owner\x18\x01 \x01(\tR\x05owner\x12\x12\n" +
+	"\x04repo\x18\x02 \x01(\tR\x04repo\x12\x15\n" +
+	"\x06run_id\x18\x03 \x01(\tR\x05runId\x12\x14\n" +
+	"\x05token\x18\x04 \x01(\tR\x05token\x12\x12\n" +
+	"\x04name\x18\x05 \x01(\tR\x04name\x12 \n" +
+	"\vdestination\x18\x06 \x01(\tR\vdestination\x12\x1b\n" +
+	"\tmax_files\x18\a \x01(\x03R\bmaxFiles\x12$\n" +
+	"\x0emax_file_bytes\x18\b \x01(\x03R\fmaxFileBytes\x12&\n" +
+	"\x0fmax_total_bytes\x18\t \x01(\x03R\rmaxTotalBytes\x12\x1f\n" +
+	"\vauth_module\x18\n" +
+	" \x01(\tR\n" +
+	"authModule\x12-\n" +
+	"\x12token_repositories\x18\v \x03(\tR\x11tokenRepositories\x12t\n" +
+	"\x11token_permissions\x18\f \x03(\v2G.workflow.plugin.github.v1.ArtifactDownloadConfig.TokenPermissionsEntryR\x10tokenPermissions\x12 \n" +
+	"\fapi_base_url\x18\r \x01(\tR\n" +
+	"apiBaseUrl\x12)\n" +
+	"\x10strict_templates\x18\x0e \x01(\bR\x0fstrictTemplates\x1aC\n" +
+	"\x15TokenPermissionsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"D\n" +
+	"\x15ArtifactDownloadInput\x12+\n" +
+	"\x04data\x18\x01 \x01(\v2\x17.google.protobuf.StructR\x04data\"\xc9\x01\n" +
+	"\x16ArtifactDownloadOutput\x12\x15\n" +
+	"\x06run_id\x18\x01 \x01(\x03R\x05runId\x12 \n" +
+	"\vdestination\x18\x02 \x01(\tR\vdestination\x12J\n" +
+	"\tartifacts\x18\x03 \x03(\v2,.workflow.plugin.github.v1.ExtractedArtifactR\tartifacts\x12\x14\n" +
+	"\x05files\x18\x04 \x01(\x03R\x05files\x12\x14\n" +
+	"\x05bytes\x18\x05 \x01(\x03R\x05bytes\"w\n" +
+	"\x11ExtractedArtifact\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04path\x18\x03 \x01(\tR\x04path\x12\x14\n" +
+	"\x05files\x18\x04 \x01(\x03R\x05files\x12\x14\n" +
+	"\x05bytes\x18\x05 \x01(\x03R\x05bytes\"\xff\x05\n" +
 	"\x0ePRCreateConfig\x12\x14\n" +
 	"\x05owner\x18\x01 \x01(\tR\x05owner\x12\x12\n" +
 	"\x04repo\x18\x02 \x01(\tR\x04repo\x12\x12\n" +
@@ -3488,52 +10032,127 @@ const file_github_proto_rawDesc = "" +
 	"\x05title\x18\x05 \x01(\tR\x05title\x12\x12\n" +
 	"\x04body\x18\x06 \x01(\tR\x04body\x12\x14\n" +
 	"\x05draft\x18\a \x01(\bR\x05draft\x12\x14\n" +
-	"\x05token\x18\b \x01(\tR\x05token\"<\n" +
+	"\x05token\x18\b \x01(\tR\x05token\x12\x1f\n" +
+	"\vauth_module\x18\t \x01(\tR\n" +
+	"authModule\x12-\n" +
+	"\x12token_repositories\x18\n" +
+	" \x03(\tR\x11tokenRepositories\x12l\n" +
+	"\x11token_permissions\x18\v \x03(\v2?.workflow.plugin.github.v1.PRCreateConfig.TokenPermissionsEntryR\x10tokenPermissions\x12 \n" +
+	"\fapi_base_url\x18\f \x01(\tR\n" +
+	"apiBaseUrl\x12)\n" +
+	"\x10strict_templates\x18\r \x01(\bR\x0fstrictTemplates\x12\x16\n" +
+	"\x06upsert\x18\x0e \x01(\bR\x06upsert\x12#\n" +
+	"\rcreate_branch\x18\x0f \x01(\bR\fcreateBranch\x12\x1c\n" +
+	"\treviewers\x18\x10 \x03(\tR\treviewers\x12%\n" +
+	"\x0eteam_reviewers\x18\x11 \x03(\tR\rteamReviewers\x12\x16\n" +
+	"\x06labels\x18\x12 \x03(\tR\x06labels\x12\x1c\n" +
+	"\tassignees\x18\x13 \x03(\tR\tassignees\x12\x1c\n" +
+	"\tmilestone\x18\x14 \x01(\tR\tmilestone\x12\x1f\n" +
+	"\vgraphql_url\x18\x15 \x01(\tR\n" +
+	"graphqlUrl\x1aC\n" +
+	"\x15TokenPermissionsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"<\n" +
 	"\rPRCreateInput\x12+\n" +
-	"\x04data\x18\x01 \x01(\v2\x17.google.protobuf.StructR\x04data\"`\n" +
+	"\x04data\x18\x01 \x01(\v2\x17.google.protobuf.StructR\x04data\"\xec\x01\n" +
 	"\x0ePRCreateOutput\x12\x16\n" +
 	"\x06number\x18\x01 \x01(\x03R\x06number\x12\x10\n" +
 	"\x03url\x18\x02 \x01(\tR\x03url\x12\x0e\n" +
 	"\x02id\x18\x03 \x01(\x03R\x02id\x12\x14\n" +
-	"\x05state\x18\x04 \x01(\tR\x05state\"\xa7\x01\n" +
+	"\x05state\x18\x04 \x01(\tR\x05state\x12\x14\n" +
+	"\x05draft\x18\x05 \x01(\bR\x05draft\x12\x19\n" +
+	"\bhead_sha\x18\x06 \x01(\tR\aheadSha\x12\x18\n" +
+	"\acreated\x18\a \x01(\bR\acreated\x12\x18\n" +
+	"\aupdated\x18\b \x01(\bR\aupdated\x12%\n" +
+	"\x0ebranch_created\x18\t \x01(\bR\rbranchCreated\"\xa7\x06\n" +
 	"\rPRMergeConfig\x12\x14\n" +
 	"\x05owner\x18\x01 \x01(\tR\x05owner\x12\x12\n" +
 	"\x04repo\x18\x02 \x01(\tR\x04repo\x12\x1b\n" +
-	"\tpr_number\x18\x03 \x01(\x03R\bprNumber\x12!\n" +
+	"\tpr_number\x18\x03 \x01(\tR\bprNumber\x12!\n" +
 	"\fcommit_title\x18\x04 \x01(\tR\vcommitTitle\x12\x16\n" +
 	"\x06method\x18\x05 \x01(\tR\x06method\x12\x14\n" +
-	"\x05token\x18\x06 \x01(\tR\x05token\";\n" +
+	"\x05token\x18\x06 \x01(\tR\x05token\x12\x1f\n" +
+	"\vauth_module\x18\a \x01(\tR\n" +
+	"authModule\x12-\n" +
+	"\x12token_repositories\x18\b \x03(\tR\x11tokenRepositories\x12k\n" +
+	"\x11token_permissions\x18\t \x03(\v2>.workflow.plugin.github.v1.PRMergeConfig.TokenPermissionsEntryR\x10tokenPermissions\x12 \n" +
+	"\fapi_base_url\x18\n" +
+	" \x01(\tR\n" +
+	"apiBaseUrl\x12)\n" +
+	"\x10strict_templates\x18\v \x01(\bR\x0fstrictTemplates\x12\x12\n" +
+	"\x04mode\x18\f \x01(\tR\x04mode\x12*\n" +
+	"\x11expected_head_sha\x18\r \x01(\tR\x0fexpectedHeadSha\x12\x12\n" +
+	"\x04wait\x18\x0e \x01(\bR\x04wait\x12'\n" +
+	"\x0frequired_checks\x18\x0f \x03(\tR\x0erequiredChecks\x12-\n" +
+	"\x12required_approvals\x18\x10 \x01(\tR\x11requiredApprovals\x12#\n" +
+	"\rpoll_interval\x18\x11 \x01(\tR\fpollInterval\x12\x18\n" +
+	"\atimeout\x18\x12 \x01(\tR\atimeout\x12#\n" +
+	"\rdelete_branch\x18\x13 \x01(\bR\fdeleteBranch\x12\x1f\n" +
+	"\vgraphql_url\x18\x14 \x01(\tR\n" +
+	"graphqlUrl\x1aC\n" +
+	"\x15TokenPermissionsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\";\n" +
 	"\fPRMergeInput\x12+\n" +
-	"\x04data\x18\x01 \x01(\v2\x17.google.protobuf.StructR\x04data\"S\n" +
+	"\x04data\x18\x01 \x01(\v2\x17.google.protobuf.StructR\x04data\"\x87\x02\n" +
 	"\rPRMergeOutput\x12\x16\n" +
 	"\x06merged\x18\x01 \x01(\bR\x06merged\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12\x10\n" +
-	"\x03sha\x18\x03 \x01(\tR\x03sha\"\x82\x01\n" +
+	"\x03sha\x18\x03 \x01(\tR\x03sha\x12\x19\n" +
+	"\bhead_sha\x18\x04 \x01(\tR\aheadSha\x12\x12\n" +
+	"\x04mode\x18\x05 \x01(\tR\x04mode\x12\x1d\n" +
+	"\n" +
+	"auto_merge\x18\x06 \x01(\bR\tautoMerge\x12\x16\n" +
+	"\x06queued\x18\a \x01(\bR\x06queued\x12%\n" +
+	"\x0equeue_position\x18\b \x01(\x03R\rqueuePosition\x12%\n" +
+	"\x0ebranch_deleted\x18\t \x01(\bR\rbranchDeleted\"\xd3\x03\n" +
 	"\x0fPRCommentConfig\x12\x14\n" +
 	"\x05owner\x18\x01 \x01(\tR\x05owner\x12\x12\n" +
 	"\x04repo\x18\x02 \x01(\tR\x04repo\x12\x1b\n" +
-	"\tpr_number\x18\x03 \x01(\x03R\bprNumber\x12\x12\n" +
+	"\tpr_number\x18\x03 \x01(\tR\bprNumber\x12\x12\n" +
 	"\x04body\x18\x04 \x01(\tR\x04body\x12\x14\n" +
-	"\x05token\x18\x05 \x01(\tR\x05token\"=\n" +
+	"\x05token\x18\x05 \x01(\tR\x05token\x12\x1f\n" +
+	"\vauth_module\x18\x06 \x01(\tR\n" +
+	"authModule\x12-\n" +
+	"\x12token_repositories\x18\a \x03(\tR\x11tokenRepositories\x12m\n" +
+	"\x11token_permissions\x18\b \x03(\v2@.workflow.plugin.github.v1.PRCommentConfig.TokenPermissionsEntryR\x10tokenPermissions\x12 \n" +
+	"\fapi_base_url\x18\t \x01(\tR\n" +
+	"apiBaseUrl\x12)\n" +
+	"\x10strict_templates\x18\n" +
+	" \x01(\bR\x0fstrictTemplates\x1aC\n" +
+	"\x15TokenPermissionsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"=\n" +
 	"\x0ePRCommentInput\x12+\n" +
 	"\x04data\x18\x01 \x01(\v2\x17.google.protobuf.StructR\x04data\"B\n" +
 	"\x0fPRCommentOutput\x12\x1d\n" +
 	"\n" +
 	"comment_id\x18\x01 \x01(\x03R\tcommentId\x12\x10\n" +
-	"\x03url\x18\x02 \x01(\tR\x03url\"\x97\x01\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\"\xe7\x03\n" +
 	"\x0ePRReviewConfig\x12\x14\n" +
 	"\x05owner\x18\x01 \x01(\tR\x05owner\x12\x12\n" +
 	"\x04repo\x18\x02 \x01(\tR\x04repo\x12\x1b\n" +
-	"\tpr_number\x18\x03 \x01(\x03R\bprNumber\x12\x14\n" +
+	"\tpr_number\x18\x03 \x01(\tR\bprNumber\x12\x14\n" +
 	"\x05event\x18\x04 \x01(\tR\x05event\x12\x12\n" +
 	"\x04body\x18\x05 \x01(\tR\x04body\x12\x14\n" +
-	"\x05token\x18\x06 \x01(\tR\x05token\"<\n" +
+	"\x05token\x18\x06 \x01(\tR\x05token\x12\x1f\n" +
+	"\vauth_module\x18\a \x01(\tR\n" +
+	"authModule\x12-\n" +
+	"\x12token_repositories\x18\b \x03(\tR\x11tokenRepositories\x12l\n" +
+	"\x11token_permissions\x18\t \x03(\v2?.workflow.plugin.github.v1.PRReviewConfig.TokenPermissionsEntryR\x10tokenPermissions\x12 \n" +
+	"\fapi_base_url\x18\n" +
+	" \x01(\tR\n" +
+	"apiBaseUrl\x12)\n" +
+	"\x10strict_templates\x18\v \x01(\bR\x0fstrictTemplates\x1aC\n" +
+	"\x15TokenPermissionsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"<\n" +
 	"\rPRReviewInput\x12+\n" +
 	"\x04data\x18\x01 \x01(\v2\x17.google.protobuf.StructR\x04data\"U\n" +
 	"\x0ePRReviewOutput\x12\x1b\n" +
 	"\treview_id\x18\x01 \x01(\x03R\breviewId\x12\x14\n" +
 	"\x05state\x18\x02 \x01(\tR\x05state\x12\x10\n" +
-	"\x03url\x18\x03 \x01(\tR\x03url\"\xb3\x01\n" +
+	"\x03url\x18\x03 \x01(\tR\x03url\"\x86\x04\n" +
 	"\x11IssueCreateConfig\x12\x14\n" +
 	"\x05owner\x18\x01 \x01(\tR\x05owner\x12\x12\n" +
 	"\x04repo\x18\x02 \x01(\tR\x04repo\x12\x14\n" +
@@ -3541,38 +10160,71 @@ const file_github_proto_rawDesc = "" +
 	"\x04body\x18\x04 \x01(\tR\x04body\x12\x16\n" +
 	"\x06labels\x18\x05 \x03(\tR\x06labels\x12\x1c\n" +
 	"\tassignees\x18\x06 \x03(\tR\tassignees\x12\x14\n" +
-	"\x05token\x18\a \x01(\tR\x05token\"?\n" +
+	"\x05token\x18\a \x01(\tR\x05token\x12\x1f\n" +
+	"\vauth_module\x18\b \x01(\tR\n" +
+	"authModule\x12-\n" +
+	"\x12token_repositories\x18\t \x03(\tR\x11tokenRepositories\x12o\n" +
+	"\x11token_permissions\x18\n" +
+	" \x03(\v2B.workflow.plugin.github.v1.IssueCreateConfig.TokenPermissionsEntryR\x10tokenPermissions\x12 \n" +
+	"\fapi_base_url\x18\v \x01(\tR\n" +
+	"apiBaseUrl\x12)\n" +
+	"\x10strict_templates\x18\f \x01(\bR\x0fstrictTemplates\x1aC\n" +
+	"\x15TokenPermissionsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"?\n" +
 	"\x10IssueCreateInput\x12+\n" +
 	"\x04data\x18\x01 \x01(\v2\x17.google.protobuf.StructR\x04data\"c\n" +
 	"\x11IssueCreateOutput\x12\x16\n" +
 	"\x06number\x18\x01 \x01(\x03R\x06number\x12\x10\n" +
 	"\x03url\x18\x02 \x01(\tR\x03url\x12\x0e\n" +
 	"\x02id\x18\x03 \x01(\x03R\x02id\x12\x14\n" +
-	"\x05state\x18\x04 \x01(\tR\x05state\"\x8f\x01\n" +
+	"\x05state\x18\x04 \x01(\tR\x05state\"\xe1\x03\n" +
 	"\x10IssueCloseConfig\x12\x14\n" +
 	"\x05owner\x18\x01 \x01(\tR\x05owner\x12\x12\n" +
 	"\x04repo\x18\x02 \x01(\tR\x04repo\x12!\n" +
-	"\fissue_number\x18\x03 \x01(\x03R\vissueNumber\x12\x18\n" +
+	"\fissue_number\x18\x03 \x01(\tR\vissueNumber\x12\x18\n" +
 	"\acomment\x18\x04 \x01(\tR\acomment\x12\x14\n" +
-	"\x05token\x18\x05 \x01(\tR\x05token\">\n" +
+	"\x05token\x18\x05 \x01(\tR\x05token\x12\x1f\n" +
+	"\vauth_module\x18\x06 \x01(\tR\n" +
+	"authModule\x12-\n" +
+	"\x12token_repositories\x18\a \x03(\tR\x11tokenRepositories\x12n\n" +
+	"\x11token_permissions\x18\b \x03(\v2A.workflow.plugin.github.v1.IssueCloseConfig.TokenPermissionsEntryR\x10tokenPermissions\x12 \n" +
+	"\fapi_base_url\x18\t \x01(\tR\n" +
+	"apiBaseUrl\x12)\n" +
+	"\x10strict_templates\x18\n" +
+	" \x01(\bR\x0fstrictTemplates\x1aC\n" +
+	"\x15TokenPermissionsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\">\n" +
 	"\x0fIssueCloseInput\x12+\n" +
 	"\x04data\x18\x01 \x01(\v2\x17.google.protobuf.StructR\x04data\"R\n" +
 	"\x10IssueCloseOutput\x12\x16\n" +
 	"\x06number\x18\x01 \x01(\x03R\x06number\x12\x14\n" +
 	"\x05state\x18\x02 \x01(\tR\x05state\x12\x10\n" +
-	"\x03url\x18\x03 \x01(\tR\x03url\"\x9f\x01\n" +
+	"\x03url\x18\x03 \x01(\tR\x03url\"\xf1\x03\n" +
 	"\x10IssueLabelConfig\x12\x14\n" +
 	"\x05owner\x18\x01 \x01(\tR\x05owner\x12\x12\n" +
 	"\x04repo\x18\x02 \x01(\tR\x04repo\x12!\n" +
-	"\fissue_number\x18\x03 \x01(\x03R\vissueNumber\x12\x10\n" +
+	"\fissue_number\x18\x03 \x01(\tR\vissueNumber\x12\x10\n" +
 	"\x03add\x18\x04 \x03(\tR\x03add\x12\x16\n" +
 	"\x06remove\x18\x05 \x03(\tR\x06remove\x12\x14\n" +
-	"\x05token\x18\x06 \x01(\tR\x05token\">\n" +
+	"\x05token\x18\x06 \x01(\tR\x05token\x12\x1f\n" +
+	"\vauth_module\x18\a \x01(\tR\n" +
+	"authModule\x12-\n" +
+	"\x12token_repositories\x18\b \x03(\tR\x11tokenRepositories\x12n\n" +
+	"\x11token_permissions\x18\t \x03(\v2A.workflow.plugin.github.v1.IssueLabelConfig.TokenPermissionsEntryR\x10tokenPermissions\x12 \n" +
+	"\fapi_base_url\x18\n" +
+	" \x01(\tR\n" +
+	"apiBaseUrl\x12)\n" +
+	"\x10strict_templates\x18\v \x01(\bR\x0fstrictTemplates\x1aC\n" +
+	"\x15TokenPermissionsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\">\n" +
 	"\x0fIssueLabelInput\x12+\n" +
 	"\x04data\x18\x01 \x01(\v2\x17.google.protobuf.StructR\x04data\"B\n" +
 	"\x10IssueLabelOutput\x12\x14\n" +
 	"\x05added\x18\x01 \x03(\tR\x05added\x12\x18\n" +
-	"\aremoved\x18\x02 \x03(\tR\aremoved\"\xc5\x01\n" +
+	"\aremoved\x18\x02 \x03(\tR\aremoved\"\x9a\x04\n" +
 	"\x13ReleaseCreateConfig\x12\x14\n" +
 	"\x05owner\x18\x01 \x01(\tR\x05owner\x12\x12\n" +
 	"\x04repo\x18\x02 \x01(\tR\x04repo\x12\x10\n" +
@@ -3583,7 +10235,18 @@ const file_github_proto_rawDesc = "" +
 	"\n" +
 	"prerelease\x18\a \x01(\bR\n" +
 	"prerelease\x12\x14\n" +
-	"\x05token\x18\b \x01(\tR\x05token\"A\n" +
+	"\x05token\x18\b \x01(\tR\x05token\x12\x1f\n" +
+	"\vauth_module\x18\t \x01(\tR\n" +
+	"authModule\x12-\n" +
+	"\x12token_repositories\x18\n" +
+	" \x03(\tR\x11tokenRepositories\x12q\n" +
+	"\x11token_permissions\x18\v \x03(\v2D.workflow.plugin.github.v1.ReleaseCreateConfig.TokenPermissionsEntryR\x10tokenPermissions\x12 \n" +
+	"\fapi_base_url\x18\f \x01(\tR\n" +
+	"apiBaseUrl\x12)\n" +
+	"\x10strict_templates\x18\r \x01(\bR\x0fstrictTemplates\x1aC\n" +
+	"\x15TokenPermissionsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"A\n" +
 	"\x12ReleaseCreateInput\x12+\n" +
 	"\x04data\x18\x01 \x01(\v2\x17.google.protobuf.StructR\x04data\"\xad\x01\n" +
 	"\x13ReleaseCreateOutput\x12\x1d\n" +
@@ -3596,7 +10259,7 @@ const file_github_proto_rawDesc = "" +
 	"\x05draft\x18\x05 \x01(\bR\x05draft\x12\x1e\n" +
 	"\n" +
 	"prerelease\x18\x06 \x01(\bR\n" +
-	"prerelease\"\x9c\x01\n" +
+	"prerelease\"\x90\x04\n" +
 	"\x13ReleaseUploadConfig\x12\x14\n" +
 	"\x05owner\x18\x01 \x01(\tR\x05owner\x12\x12\n" +
 	"\x04repo\x18\x02 \x01(\tR\x04repo\x12\x1d\n" +
@@ -3604,20 +10267,43 @@ const file_github_proto_rawDesc = "" +
 	"release_id\x18\x03 \x01(\tR\treleaseId\x12\x12\n" +
 	"\x04file\x18\x04 \x01(\tR\x04file\x12\x12\n" +
 	"\x04name\x18\x05 \x01(\tR\x04name\x12\x14\n" +
-	"\x05token\x18\x06 \x01(\tR\x05token\"A\n" +
+	"\x05token\x18\x06 \x01(\tR\x05token\x12\x1f\n" +
+	"\vauth_module\x18\a \x01(\tR\n" +
+	"authModule\x12-\n" +
+	"\x12token_repositories\x18\b \x03(\tR\x11tokenRepositories\x12q\n" +
+	"\x11token_permissions\x18\t \x03(\v2D.workflow.plugin.github.v1.ReleaseUploadConfig.TokenPermissionsEntryR\x10tokenPermissions\x12 \n" +
+	"\fapi_base_url\x18\n" +
+	" \x01(\tR\n" +
+	"apiBaseUrl\x12\x1d\n" +
+	"\n" +
+	"upload_url\x18\v \x01(\tR\tuploadUrl\x12)\n" +
+	"\x10strict_templates\x18\f \x01(\bR\x0fstrictTemplates\x1aC\n" +
+	"\x15TokenPermissionsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"A\n" +
 	"\x12ReleaseUploadInput\x12+\n" +
 	"\x04data\x18\x01 \x01(\v2\x17.google.protobuf.StructR\x04data\"j\n" +
 	"\x13ReleaseUploadOutput\x12\x19\n" +
 	"\basset_id\x18\x01 \x01(\x03R\aassetId\x12\x10\n" +
 	"\x03url\x18\x02 \x01(\tR\x03url\x12\x12\n" +
 	"\x04name\x18\x03 \x01(\tR\x04name\x12\x12\n" +
-	"\x04size\x18\x04 \x01(\x03R\x04size\"\x9f\x01\n" +
+	"\x04size\x18\x04 \x01(\x03R\x04size\"\xfd\x03\n" +
 	"\x1cUpstreamReleaseMonitorConfig\x12%\n" +
 	"\x0eupstream_owner\x18\x01 \x01(\tR\rupstreamOwner\x12#\n" +
 	"\rupstream_repo\x18\x02 \x01(\tR\fupstreamRepo\x12\x1d\n" +
 	"\n" +
 	"pinned_tag\x18\x03 \x01(\tR\tpinnedTag\x12\x14\n" +
-	"\x05token\x18\x04 \x01(\tR\x05token\"J\n" +
+	"\x05token\x18\x04 \x01(\tR\x05token\x12\x1f\n" +
+	"\vauth_module\x18\x05 \x01(\tR\n" +
+	"authModule\x12-\n" +
+	"\x12token_repositories\x18\x06 \x03(\tR\x11tokenRepositories\x12z\n" +
+	"\x11token_permissions\x18\a \x03(\v2M.workflow.plugin.github.v1.UpstreamReleaseMonitorConfig.TokenPermissionsEntryR\x10tokenPermissions\x12 \n" +
+	"\fapi_base_url\x18\b \x01(\tR\n" +
+	"apiBaseUrl\x12)\n" +
+	"\x10strict_templates\x18\t \x01(\bR\x0fstrictTemplates\x1aC\n" +
+	"\x15TokenPermissionsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"J\n" +
 	"\x1bUpstreamReleaseMonitorInput\x12+\n" +
 	"\x04data\x18\x01 \x01(\v2\x17.google.protobuf.StructR\x04data\"\xb6\x02\n" +
 	"\x1cUpstreamReleaseMonitorOutput\x12%\n" +
@@ -3632,14 +10318,25 @@ const file_github_proto_rawDesc = "" +
 	"release_id\x18\x06 \x01(\x03R\treleaseId\x12\x1f\n" +
 	"\vrelease_url\x18\a \x01(\tR\n" +
 	"releaseUrl\x12!\n" +
-	"\fpublished_at\x18\b \x01(\tR\vpublishedAt\"\xa6\x01\n" +
+	"\fpublished_at\x18\b \x01(\tR\vpublishedAt\"\xfa\x03\n" +
 	"\x12RepoDispatchConfig\x12\x14\n" +
 	"\x05owner\x18\x01 \x01(\tR\x05owner\x12\x12\n" +
 	"\x04repo\x18\x02 \x01(\tR\x04repo\x12\x1d\n" +
 	"\n" +
 	"event_type\x18\x03 \x01(\tR\teventType\x121\n" +
 	"\apayload\x18\x04 \x01(\v2\x17.google.protobuf.StructR\apayload\x12\x14\n" +
-	"\x05token\x18\x05 \x01(\tR\x05token\"@\n" +
+	"\x05token\x18\x05 \x01(\tR\x05token\x12\x1f\n" +
+	"\vauth_module\x18\x06 \x01(\tR\n" +
+	"authModule\x12-\n" +
+	"\x12token_repositories\x18\a \x03(\tR\x11tokenRepositories\x12p\n" +
+	"\x11token_permissions\x18\b \x03(\v2C.workflow.plugin.github.v1.RepoDispatchConfig.TokenPermissionsEntryR\x10tokenPermissions\x12 \n" +
+	"\fapi_base_url\x18\t \x01(\tR\n" +
+	"apiBaseUrl\x12)\n" +
+	"\x10strict_templates\x18\n" +
+	" \x01(\bR\x0fstrictTemplates\x1aC\n" +
+	"\x15TokenPermissionsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"@\n" +
 	"\x11RepoDispatchInput\x12+\n" +
 	"\x04data\x18\x01 \x01(\v2\x17.google.protobuf.StructR\x04data\"}\n" +
 	"\x12RepoDispatchOutput\x12\x1e\n" +
@@ -3649,7 +10346,7 @@ const file_github_proto_rawDesc = "" +
 	"\n" +
 	"event_type\x18\x02 \x01(\tR\teventType\x12\x14\n" +
 	"\x05owner\x18\x03 \x01(\tR\x05owner\x12\x12\n" +
-	"\x04repo\x18\x04 \x01(\tR\x04repo\"\xcd\x01\n" +
+	"\x04repo\x18\x04 \x01(\tR\x04repo\"\xa5\x04\n" +
 	"\x16DeploymentCreateConfig\x12\x14\n" +
 	"\x05owner\x18\x01 \x01(\tR\x05owner\x12\x12\n" +
 	"\x04repo\x18\x02 \x01(\tR\x04repo\x12\x10\n" +
@@ -3658,7 +10355,18 @@ const file_github_proto_rawDesc = "" +
 	"\vdescription\x18\x05 \x01(\tR\vdescription\x12\x1d\n" +
 	"\n" +
 	"auto_merge\x18\x06 \x01(\bR\tautoMerge\x12\x14\n" +
-	"\x05token\x18\a \x01(\tR\x05token\"D\n" +
+	"\x05token\x18\a \x01(\tR\x05token\x12\x1f\n" +
+	"\vauth_module\x18\b \x01(\tR\n" +
+	"authModule\x12-\n" +
+	"\x12token_repositories\x18\t \x03(\tR\x11tokenRepositories\x12t\n" +
+	"\x11token_permissions\x18\n" +
+	" \x03(\v2G.workflow.plugin.github.v1.DeploymentCreateConfig.TokenPermissionsEntryR\x10tokenPermissions\x12 \n" +
+	"\fapi_base_url\x18\v \x01(\tR\n" +
+	"apiBaseUrl\x12)\n" +
+	"\x10strict_templates\x18\f \x01(\bR\x0fstrictTemplates\x1aC\n" +
+	"\x15TokenPermissionsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"D\n" +
 	"\x15DeploymentCreateInput\x12+\n" +
 	"\x04data\x18\x01 \x01(\v2\x17.google.protobuf.StructR\x04data\"\x95\x01\n" +
 	"\x16DeploymentCreateOutput\x12#\n" +
@@ -3666,29 +10374,446 @@ const file_github_proto_rawDesc = "" +
 	"\venvironment\x18\x02 \x01(\tR\venvironment\x12\x10\n" +
 	"\x03ref\x18\x03 \x01(\tR\x03ref\x12\x10\n" +
 	"\x03sha\x18\x04 \x01(\tR\x03sha\x12\x10\n" +
-	"\x03url\x18\x05 \x01(\tR\x03url\"{\n" +
+	"\x03url\x18\x05 \x01(\tR\x03url\"\xe4\x05\n" +
 	"\x0fSecretSetConfig\x12\x14\n" +
 	"\x05owner\x18\x01 \x01(\tR\x05owner\x12\x12\n" +
 	"\x04repo\x18\x02 \x01(\tR\x04repo\x12\x12\n" +
 	"\x04name\x18\x03 \x01(\tR\x04name\x12\x14\n" +
 	"\x05value\x18\x04 \x01(\tR\x05value\x12\x14\n" +
-	"\x05token\x18\x05 \x01(\tR\x05token\"=\n" +
+	"\x05token\x18\x05 \x01(\tR\x05token\x12\x1f\n" +
+	"\vauth_module\x18\x06 \x01(\tR\n" +
+	"authModule\x12-\n" +
+	"\x12token_repositories\x18\a \x03(\tR\x11tokenRepositories\x12m\n" +
+	"\x11token_permissions\x18\b \x03(\v2@.workflow.plugin.github.v1.SecretSetConfig.TokenPermissionsEntryR\x10tokenPermissions\x12 \n" +
+	"\fapi_base_url\x18\t \x01(\tR\n" +
+	"apiBaseUrl\x12)\n" +
+	"\x10strict_templates\x18\n" +
+	" \x01(\bR\x0fstrictTemplates\x12 \n" +
+	"\venvironment\x18\v \x01(\tR\venvironment\x12\x10\n" +
+	"\x03app\x18\f \x01(\tR\x03app\x12Q\n" +
+	"\asecrets\x18\r \x03(\v27.workflow.plugin.github.v1.SecretSetConfig.SecretsEntryR\asecrets\x12\x1e\n" +
+	"\n" +
+	"visibility\x18\x0e \x01(\tR\n" +
+	"visibility\x123\n" +
+	"\x15selected_repositories\x18\x0f \x03(\tR\x14selectedRepositories\x1aC\n" +
+	"\x15TokenPermissionsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a:\n" +
+	"\fSecretsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"=\n" +
 	"\x0eSecretSetInput\x12+\n" +
-	"\x04data\x18\x01 \x01(\v2\x17.google.protobuf.StructR\x04data\"a\n" +
+	"\x04data\x18\x01 \x01(\v2\x17.google.protobuf.StructR\x04data\"\xd7\x01\n" +
 	"\x0fSecretSetOutput\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
 	"\x05owner\x18\x02 \x01(\tR\x05owner\x12\x12\n" +
 	"\x04repo\x18\x03 \x01(\tR\x04repo\x12\x10\n" +
-	"\x03set\x18\x04 \x01(\bR\x03set\"r\n" +
+	"\x03set\x18\x04 \x01(\bR\x03set\x12\x14\n" +
+	"\x05names\x18\x05 \x03(\tR\x05names\x12\x14\n" +
+	"\x05count\x18\x06 \x01(\x05R\x05count\x12 \n" +
+	"\venvironment\x18\a \x01(\tR\venvironment\x12\x10\n" +
+	"\x03app\x18\b \x01(\tR\x03app\x12\x14\n" +
+	"\x05scope\x18\t \x01(\tR\x05scope\"\x86\x04\n" +
+	"\x12SecretDeleteConfig\x12\x14\n" +
+	"\x05owner\x18\x01 \x01(\tR\x05owner\x12\x12\n" +
+	"\x04repo\x18\x02 \x01(\tR\x04repo\x12 \n" +
+	"\venvironment\x18\x03 \x01(\tR\venvironment\x12\x10\n" +
+	"\x03app\x18\x04 \x01(\tR\x03app\x12\x12\n" +
+	"\x04name\x18\x05 \x01(\tR\x04name\x12\x14\n" +
+	"\x05names\x18\x06 \x03(\tR\x05names\x12\x14\n" +
+	"\x05token\x18\a \x01(\tR\x05token\x12\x1f\n" +
+	"\vauth_module\x18\b \x01(\tR\n" +
+	"authModule\x12-\n" +
+	"\x12token_repositories\x18\t \x03(\tR\x11tokenRepositories\x12p\n" +
+	"\x11token_permissions\x18\n" +
+	" \x03(\v2C.workflow.plugin.github.v1.SecretDeleteConfig.TokenPermissionsEntryR\x10tokenPermissions\x12 \n" +
+	"\fapi_base_url\x18\v \x01(\tR\n" +
+	"apiBaseUrl\x12)\n" +
+	"\x10strict_templates\x18\f \x01(\bR\x0fstrictTemplates\x1aC\n" +
+	"\x15TokenPermissionsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"@\n" +
+	"\x11SecretDeleteInput\x12+\n" +
+	"\x04data\x18\x01 \x01(\v2\x17.google.protobuf.StructR\x04data\"\xd2\x01\n" +
+	"\x12SecretDeleteOutput\x12\x18\n" +
+	"\adeleted\x18\x01 \x03(\tR\adeleted\x12\x18\n" +
+	"\amissing\x18\x02 \x03(\tR\amissing\x12\x14\n" +
+	"\x05count\x18\x03 \x01(\x05R\x05count\x12\x14\n" +
+	"\x05owner\x18\x04 \x01(\tR\x05owner\x12\x12\n" +
+	"\x04repo\x18\x05 \x01(\tR\x04repo\x12 \n" +
+	"\venvironment\x18\x06 \x01(\tR\venvironment\x12\x10\n" +
+	"\x03app\x18\a \x01(\tR\x03app\x12\x14\n" +
+	"\x05scope\x18\b \x01(\tR\x05scope\"\xb2\x06\n" +
+	"\x10SecretSyncConfig\x12\x14\n" +
+	"\x05owner\x18\x01 \x01(\tR\x05owner\x12\x12\n" +
+	"\x04repo\x18\x02 \x01(\tR\x04repo\x12 \n" +
+	"\venvironment\x18\x03 \x01(\tR\venvironment\x12\x10\n" +
+	"\x03app\x18\x04 \x01(\tR\x03app\x12R\n" +
+	"\asecrets\x18\x05 \x03(\v28.workflow.plugin.github.v1.SecretSyncConfig.SecretsEntryR\asecrets\x12\x1b\n" +
+	"\tstate_dir\x18\x06 \x01(\tR\bstateDir\x12)\n" +
+	"\x10delete_unmanaged\x18\a \x01(\bR\x0fdeleteUnmanaged\x12\x12\n" +
+	"\x04keep\x18\b \x03(\tR\x04keep\x12\x17\n" +
+	"\adry_run\x18\t \x01(\bR\x06dryRun\x12\x1e\n" +
+	"\n" +
+	"visibility\x18\n" +
+	" \x01(\tR\n" +
+	"visibility\x123\n" +
+	"\x15selected_repositories\x18\v \x03(\tR\x14selectedRepositories\x12\x14\n" +
+	"\x05token\x18\f \x01(\tR\x05token\x12\x1f\n" +
+	"\vauth_module\x18\r \x01(\tR\n" +
+	"authModule\x12-\n" +
+	"\x12token_repositories\x18\x0e \x03(\tR\x11tokenRepositories\x12n\n" +
+	"\x11token_permissions\x18\x0f \x03(\v2A.workflow.plugin.github.v1.SecretSyncConfig.TokenPermissionsEntryR\x10tokenPermissions\x12 \n" +
+	"\fapi_base_url\x18\x10 \x01(\tR\n" +
+	"apiBaseUrl\x12)\n" +
+	"\x10strict_templates\x18\x11 \x01(\bR\x0fstrictTemplates\x1a:\n" +
+	"\fSecretsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1aC\n" +
+	"\x15TokenPermissionsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\">\n" +
+	"\x0fSecretSyncInput\x12+\n" +
+	"\x04data\x18\x01 \x01(\v2\x17.google.protobuf.StructR\x04data\"\xdd\x02\n" +
+	"\x10SecretSyncOutput\x12\x18\n" +
+	"\acreated\x18\x01 \x03(\tR\acreated\x12\x18\n" +
+	"\aupdated\x18\x02 \x03(\tR\aupdated\x12\x18\n" +
+	"\adeleted\x18\x03 \x03(\tR\adeleted\x12\x1c\n" +
+	"\tunchanged\x18\x04 \x03(\tR\tunchanged\x12\x18\n" +
+	"\adrifted\x18\x05 \x03(\tR\adrifted\x12\x1c\n" +
+	"\tunmanaged\x18\x06 \x03(\tR\tunmanaged\x12\x18\n" +
+	"\achanged\x18\a \x01(\bR\achanged\x12\x17\n" +
+	"\adry_run\x18\b \x01(\bR\x06dryRun\x12\x14\n" +
+	"\x05owner\x18\t \x01(\tR\x05owner\x12\x12\n" +
+	"\x04repo\x18\n" +
+	" \x01(\tR\x04repo\x12 \n" +
+	"\venvironment\x18\v \x01(\tR\venvironment\x12\x10\n" +
+	"\x03app\x18\f \x01(\tR\x03app\x12\x14\n" +
+	"\x05scope\x18\r \x01(\tR\x05scope\"\xf7\x03\n" +
+	"\x10SecretListConfig\x12\x14\n" +
+	"\x05owner\x18\x01 \x01(\tR\x05owner\x12\x12\n" +
+	"\x04repo\x18\x02 \x01(\tR\x04repo\x12 \n" +
+	"\venvironment\x18\x03 \x01(\tR\venvironment\x12\x10\n" +
+	"\x03app\x18\x04 \x01(\tR\x03app\x12\x1d\n" +
+	"\n" +
+	"older_than\x18\x05 \x01(\tR\tolderThan\x12\x14\n" +
+	"\x05token\x18\x06 \x01(\tR\x05token\x12\x1f\n" +
+	"\vauth_module\x18\a \x01(\tR\n" +
+	"authModule\x12-\n" +
+	"\x12token_repositories\x18\b \x03(\tR\x11tokenRepositories\x12n\n" +
+	"\x11token_permissions\x18\t \x03(\v2A.workflow.plugin.github.v1.SecretListConfig.TokenPermissionsEntryR\x10tokenPermissions\x12 \n" +
+	"\fapi_base_url\x18\n" +
+	" \x01(\tR\n" +
+	"apiBaseUrl\x12)\n" +
+	"\x10strict_templates\x18\v \x01(\bR\x0fstrictTemplates\x1aC\n" +
+	"\x15TokenPermissionsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\">\n" +
+	"\x0fSecretListInput\x12+\n" +
+	"\x04data\x18\x01 \x01(\v2\x17.google.protobuf.StructR\x04data\"\x8c\x02\n" +
+	"\x10SecretListOutput\x12B\n" +
+	"\asecrets\x18\x01 \x03(\v2(.workflow.plugin.github.v1.SecretSummaryR\asecrets\x12\x14\n" +
+	"\x05names\x18\x02 \x03(\tR\x05names\x12\x14\n" +
+	"\x05count\x18\x03 \x01(\x05R\x05count\x12\x14\n" +
+	"\x05stale\x18\x04 \x03(\tR\x05stale\x12\x14\n" +
+	"\x05owner\x18\x05 \x01(\tR\x05owner\x12\x12\n" +
+	"\x04repo\x18\x06 \x01(\tR\x04repo\x12 \n" +
+	"\venvironment\x18\a \x01(\tR\venvironment\x12\x10\n" +
+	"\x03app\x18\b \x01(\tR\x03app\x12\x14\n" +
+	"\x05scope\x18\t \x01(\tR\x05scope\"\x81\x01\n" +
+	"\rSecretSummary\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x03 \x01(\tR\tupdatedAt\x12\x1e\n" +
+	"\n" +
+	"visibility\x18\x04 \x01(\tR\n" +
+	"visibility\"\xe0\x05\n" +
+	"\x11VariableSetConfig\x12\x14\n" +
+	"\x05owner\x18\x01 \x01(\tR\x05owner\x12\x12\n" +
+	"\x04repo\x18\x02 \x01(\tR\x04repo\x12 \n" +
+	"\venvironment\x18\x03 \x01(\tR\venvironment\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12\x14\n" +
+	"\x05value\x18\x05 \x01(\tR\x05value\x12Y\n" +
+	"\tvariables\x18\x06 \x03(\v2;.workflow.plugin.github.v1.VariableSetConfig.VariablesEntryR\tvariables\x12\x1e\n" +
+	"\n" +
+	"visibility\x18\a \x01(\tR\n" +
+	"visibility\x123\n" +
+	"\x15selected_repositories\x18\b \x03(\tR\x14selectedRepositories\x12\x14\n" +
+	"\x05token\x18\t \x01(\tR\x05token\x12\x1f\n" +
+	"\vauth_module\x18\n" +
+	" \x01(\tR\n" +
+	"authModule\x12-\n" +
+	"\x12token_repositories\x18\v \x03(\tR\x11tokenRepositories\x12o\n" +
+	"\x11token_permissions\x18\f \x03(\v2B.workflow.plugin.github.v1.VariableSetConfig.TokenPermissionsEntryR\x10tokenPermissions\x12 \n" +
+	"\fapi_base_url\x18\r \x01(\tR\n" +
+	"apiBaseUrl\x12)\n" +
+	"\x10strict_templates\x18\x0e \x01(\bR\x0fstrictTemplates\x1a<\n" +
+	"\x0eVariablesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1aC\n" +
+	"\x15TokenPermissionsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"?\n" +
+	"\x10VariableSetInput\x12+\n" +
+	"\x04data\x18\x01 \x01(\v2\x17.google.protobuf.StructR\x04data\"\xfb\x01\n" +
+	"\x11VariableSetOutput\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05names\x18\x02 \x03(\tR\x05names\x12\x18\n" +
+	"\acreated\x18\x03 \x03(\tR\acreated\x12\x18\n" +
+	"\aupdated\x18\x04 \x03(\tR\aupdated\x12\x14\n" +
+	"\x05count\x18\x05 \x01(\x05R\x05count\x12\x14\n" +
+	"\x05owner\x18\x06 \x01(\tR\x05owner\x12\x12\n" +
+	"\x04repo\x18\a \x01(\tR\x04repo\x12 \n" +
+	"\venvironment\x18\b \x01(\tR\venvironment\x12\x10\n" +
+	"\x03app\x18\t \x01(\tR\x03app\x12\x14\n" +
+	"\x05scope\x18\n" +
+	" \x01(\tR\x05scope\"\xf8\x03\n" +
+	"\x11VariableGetConfig\x12\x14\n" +
+	"\x05owner\x18\x01 \x01(\tR\x05owner\x12\x12\n" +
+	"\x04repo\x18\x02 \x01(\tR\x04repo\x12 \n" +
+	"\venvironment\x18\x03 \x01(\tR\venvironment\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12\x1a\n" +
+	"\brequired\x18\x05 \x01(\bR\brequired\x12\x14\n" +
+	"\x05token\x18\x06 \x01(\tR\x05token\x12\x1f\n" +
+	"\vauth_module\x18\a \x01(\tR\n" +
+	"authModule\x12-\n" +
+	"\x12token_repositories\x18\b \x03(\tR\x11tokenRepositories\x12o\n" +
+	"\x11token_permissions\x18\t \x03(\v2B.workflow.plugin.github.v1.VariableGetConfig.TokenPermissionsEntryR\x10tokenPermissions\x12 \n" +
+	"\fapi_base_url\x18\n" +
+	" \x01(\tR\n" +
+	"apiBaseUrl\x12)\n" +
+	"\x10strict_templates\x18\v \x01(\bR\x0fstrictTemplates\x1aC\n" +
+	"\x15TokenPermissionsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"?\n" +
+	"\x10VariableGetInput\x12+\n" +
+	"\x04data\x18\x01 \x01(\v2\x17.google.protobuf.StructR\x04data\"\xa5\x02\n" +
+	"\x11VariableGetOutput\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05found\x18\x02 \x01(\bR\x05found\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\tR\x05value\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\tR\tupdatedAt\x12\x1e\n" +
+	"\n" +
+	"visibility\x18\x06 \x01(\tR\n" +
+	"visibility\x12\x14\n" +
+	"\x05owner\x18\a \x01(\tR\x05owner\x12\x12\n" +
+	"\x04repo\x18\b \x01(\tR\x04repo\x12 \n" +
+	"\venvironment\x18\t \x01(\tR\venvironment\x12\x10\n" +
+	"\x03app\x18\n" +
+	" \x01(\tR\x03app\x12\x14\n" +
+	"\x05scope\x18\v \x01(\tR\x05scope\"\xca\x03\n" +
+	"\x12VariableListConfig\x12\x14\n" +
+	"\x05owner\x18\x01 \x01(\tR\x05owner\x12\x12\n" +
+	"\x04repo\x18\x02 \x01(\tR\x04repo\x12 \n" +
+	"\venvironment\x18\x03 \x01(\tR\venvironment\x12\x14\n" +
+	"\x05token\x18\x04 \x01(\tR\x05token\x12\x1f\n" +
+	"\vauth_module\x18\x05 \x01(\tR\n" +
+	"authModule\x12-\n" +
+	"\x12token_repositories\x18\x06 \x03(\tR\x11tokenRepositories\x12p\n" +
+	"\x11token_permissions\x18\a \x03(\v2C.workflow.plugin.github.v1.VariableListConfig.TokenPermissionsEntryR\x10tokenPermissions\x12 \n" +
+	"\fapi_base_url\x18\b \x01(\tR\n" +
+	"apiBaseUrl\x12)\n" +
+	"\x10strict_templates\x18\t \x01(\bR\x0fstrictTemplates\x1aC\n" +
+	"\x15TokenPermissionsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"@\n" +
+	"\x11VariableListInput\x12+\n" +
+	"\x04data\x18\x01 \x01(\v2\x17.google.protobuf.StructR\x04data\"\x8c\x03\n" +
+	"\x12VariableListOutput\x12H\n" +
+	"\tvariables\x18\x01 \x03(\v2*.workflow.plugin.github.v1.VariableSummaryR\tvariables\x12\x14\n" +
+	"\x05names\x18\x02 \x03(\tR\x05names\x12Q\n" +
+	"\x06values\x18\x03 \x03(\v29.workflow.plugin.github.v1.VariableListOutput.ValuesEntryR\x06values\x12\x14\n" +
+	"\x05count\x18\x04 \x01(\x05R\x05count\x12\x14\n" +
+	"\x05owner\x18\x05 \x01(\tR\x05owner\x12\x12\n" +
+	"\x04repo\x18\x06 \x01(\tR\x04repo\x12 \n" +
+	"\venvironment\x18\a \x01(\tR\venvironment\x12\x10\n" +
+	"\x03app\x18\b \x01(\tR\x03app\x12\x14\n" +
+	"\x05scope\x18\t \x01(\tR\x05scope\x1a9\n" +
+	"\vValuesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x99\x01\n" +
+	"\x0fVariableSummary\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x04 \x01(\tR\tupdatedAt\x12\x1e\n" +
+	"\n" +
+	"visibility\x18\x05 \x01(\tR\n" +
+	"visibility\"\xf8\x03\n" +
+	"\x14VariableDeleteConfig\x12\x14\n" +
+	"\x05owner\x18\x01 \x01(\tR\x05owner\x12\x12\n" +
+	"\x04repo\x18\x02 \x01(\tR\x04repo\x12 \n" +
+	"\venvironment\x18\x03 \x01(\tR\venvironment\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12\x14\n" +
+	"\x05names\x18\x05 \x03(\tR\x05names\x12\x14\n" +
+	"\x05token\x18\x06 \x01(\tR\x05token\x12\x1f\n" +
+	"\vauth_module\x18\a \x01(\tR\n" +
+	"authModule\x12-\n" +
+	"\x12token_repositories\x18\b \x03(\tR\x11tokenRepositories\x12r\n" +
+	"\x11token_permissions\x18\t \x03(\v2E.workflow.plugin.github.v1.VariableDeleteConfig.TokenPermissionsEntryR\x10tokenPermissions\x12 \n" +
+	"\fapi_base_url\x18\n" +
+	" \x01(\tR\n" +
+	"apiBaseUrl\x12)\n" +
+	"\x10strict_templates\x18\v \x01(\bR\x0fstrictTemplates\x1aC\n" +
+	"\x15TokenPermissionsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"B\n" +
+	"\x13VariableDeleteInput\x12+\n" +
+	"\x04data\x18\x01 \x01(\v2\x17.google.protobuf.StructR\x04data\"\xd4\x01\n" +
+	"\x14VariableDeleteOutput\x12\x18\n" +
+	"\adeleted\x18\x01 \x03(\tR\adeleted\x12\x18\n" +
+	"\amissing\x18\x02 \x03(\tR\amissing\x12\x14\n" +
+	"\x05count\x18\x03 \x01(\x05R\x05count\x12\x14\n" +
+	"\x05owner\x18\x04 \x01(\tR\x05owner\x12\x12\n" +
+	"\x04repo\x18\x05 \x01(\tR\x04repo\x12 \n" +
+	"\venvironment\x18\x06 \x01(\tR\venvironment\x12\x10\n" +
+	"\x03app\x18\a \x01(\tR\x03app\x12\x14\n" +
+	"\x05scope\x18\b \x01(\tR\x05scope\"\xf8\x03\n" +
 	"\rGraphQLConfig\x12\x14\n" +
 	"\x05query\x18\x01 \x01(\tR\x05query\x125\n" +
 	"\tvariables\x18\x02 \x01(\v2\x17.google.protobuf.StructR\tvariables\x12\x14\n" +
-	"\x05token\x18\x03 \x01(\tR\x05token\";\n" +
+	"\x05token\x18\x03 \x01(\tR\x05token\x12\x1f\n" +
+	"\vauth_module\x18\x04 \x01(\tR\n" +
+	"authModule\x12\x14\n" +
+	"\x05owner\x18\x05 \x01(\tR\x05owner\x12-\n" +
+	"\x12token_repositories\x18\x06 \x03(\tR\x11tokenRepositories\x12k\n" +
+	"\x11token_permissions\x18\a \x03(\v2>.workflow.plugin.github.v1.GraphQLConfig.TokenPermissionsEntryR\x10tokenPermissions\x12 \n" +
+	"\fapi_base_url\x18\b \x01(\tR\n" +
+	"apiBaseUrl\x12\x1f\n" +
+	"\vgraphql_url\x18\t \x01(\tR\n" +
+	"graphqlUrl\x12)\n" +
+	"\x10strict_templates\x18\n" +
+	" \x01(\bR\x0fstrictTemplates\x1aC\n" +
+	"\x15TokenPermissionsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\";\n" +
 	"\fGraphQLInput\x12+\n" +
 	"\x04data\x18\x01 \x01(\v2\x17.google.protobuf.StructR\x04data\"T\n" +
 	"\rGraphQLOutput\x12+\n" +
 	"\x04data\x18\x01 \x01(\v2\x17.google.protobuf.StructR\x04data\x12\x16\n" +
-	"\x06status\x18\x02 \x01(\x05R\x06statusB<Z:github.com/GoCodeAlone/workflow-plugin-github/gen;githubv1b\x06proto3"
+	"\x06status\x18\x02 \x01(\x05R\x06status\"\x8e\x03\n" +
+	"\x0fRateLimitConfig\x12\x14\n" +
+	"\x05owner\x18\x01 \x01(\tR\x05owner\x12\x14\n" +
+	"\x05token\x18\x02 \x01(\tR\x05token\x12\x1f\n" +
+	"\vauth_module\x18\x03 \x01(\tR\n" +
+	"authModule\x12-\n" +
+	"\x12token_repositories\x18\x04 \x03(\tR\x11tokenRepositories\x12m\n" +
+	"\x11token_permissions\x18\x05 \x03(\v2@.workflow.plugin.github.v1.RateLimitConfig.TokenPermissionsEntryR\x10tokenPermissions\x12 \n" +
+	"\fapi_base_url\x18\x06 \x01(\tR\n" +
+	"apiBaseUrl\x12)\n" +
+	"\x10strict_templates\x18\a \x01(\bR\x0fstrictTemplates\x1aC\n" +
+	"\x15TokenPermissionsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"=\n" +
+	"\x0eRateLimitInput\x12+\n" +
+	"\x04data\x18\x01 \x01(\v2\x17.google.protobuf.StructR\x04data\"\xe1\x02\n" +
+	"\x0fRateLimitOutput\x12W\n" +
+	"\tresources\x18\x01 \x03(\v29.workflow.plugin.github.v1.RateLimitOutput.ResourcesEntryR\tresources\x12%\n" +
+	"\x0ecore_remaining\x18\x02 \x01(\x03R\rcoreRemaining\x12\x1d\n" +
+	"\n" +
+	"core_reset\x18\x03 \x01(\tR\tcoreReset\x12\x1c\n" +
+	"\tthrottled\x18\x04 \x03(\tR\tthrottled\x12%\n" +
+	"\x0ethrottle_waits\x18\x05 \x01(\x03R\rthrottleWaits\x1aj\n" +
+	"\x0eResourcesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12B\n" +
+	"\x05value\x18\x02 \x01(\v2,.workflow.plugin.github.v1.RateLimitResourceR\x05value:\x028\x01\"\x96\x01\n" +
+	"\x11RateLimitResource\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x03R\x05limit\x12\x1c\n" +
+	"\tremaining\x18\x02 \x01(\x03R\tremaining\x12\x12\n" +
+	"\x04used\x18\x03 \x01(\x03R\x04used\x12\x14\n" +
+	"\x05reset\x18\x04 \x01(\tR\x05reset\x12#\n" +
+	"\rblocked_until\x18\x05 \x01(\tR\fblockedUntil\"\xa7\x02\n" +
+	"\x14WebhookReceiveConfig\x12\x16\n" +
+	"\x06module\x18\x01 \x01(\tR\x06module\x12\x1d\n" +
+	"\n" +
+	"event_type\x18\x02 \x01(\tR\teventType\x12\x1f\n" +
+	"\vdelivery_id\x18\x03 \x01(\tR\n" +
+	"deliveryId\x12\x1c\n" +
+	"\tsignature\x18\x04 \x01(\tR\tsignature\x12\x18\n" +
+	"\apayload\x18\x05 \x01(\tR\apayload\x12-\n" +
+	"\x12signature_verified\x18\x06 \x01(\bR\x11signatureVerified\x12%\n" +
+	"\x0esignature_sha1\x18\a \x01(\tR\rsignatureSha1\x12)\n" +
+	"\x10strict_templates\x18\b \x01(\bR\x0fstrictTemplates\"H\n" +
+	"\x13WebhookReceiveInput\x121\n" +
+	"\aheaders\x18\x01 \x01(\v2\x17.google.protobuf.StructR\aheaders\"\x80\x04\n" +
+	"\x14WebhookReceiveOutput\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x16\n" +
+	"\x06module\x18\x02 \x01(\tR\x06module\x12\x1d\n" +
+	"\n" +
+	"event_type\x18\x03 \x01(\tR\teventType\x12\x1f\n" +
+	"\vdelivery_id\x18\x04 \x01(\tR\n" +
+	"deliveryId\x12\x1e\n" +
+	"\n" +
+	"repository\x18\x05 \x01(\tR\n" +
+	"repository\x12\x16\n" +
+	"\x06branch\x18\x06 \x01(\tR\x06branch\x12\x16\n" +
+	"\x06commit\x18\a \x01(\tR\x06commit\x12\x16\n" +
+	"\x06author\x18\b \x01(\tR\x06author\x12\x16\n" +
+	"\x06action\x18\t \x01(\tR\x06action\x12\x1f\n" +
+	"\vbase_branch\x18\n" +
+	" \x01(\tR\n" +
+	"baseBranch\x12\x10\n" +
+	"\x03tag\x18\v \x01(\tR\x03tag\x12\x1b\n" +
+	"\tpr_number\x18\f \x01(\x05R\bprNumber\x12!\n" +
+	"\fissue_number\x18\r \x01(\x05R\vissueNumber\x12\x16\n" +
+	"\x06labels\x18\x0e \x03(\tR\x06labels\x12\x1e\n" +
+	"\n" +
+	"conclusion\x18\x0f \x01(\tR\n" +
+	"conclusion\x12\x16\n" +
+	"\x06sender\x18\x10 \x01(\tR\x06sender\x12\x14\n" +
+	"\x05topic\x18\x11 \x01(\tR\x05topic\x12\x1f\n" +
+	"\vsecret_name\x18\x12 \x01(\tR\n" +
+	"secretName\"Y\n" +
+	"\x14WebhookForwardConfig\x12\x16\n" +
+	"\x06module\x18\x01 \x01(\tR\x06module\x12)\n" +
+	"\x10strict_templates\x18\x02 \x01(\bR\x0fstrictTemplates\"B\n" +
+	"\x13WebhookForwardInput\x12+\n" +
+	"\x04data\x18\x01 \x01(\v2\x17.google.protobuf.StructR\x04data\"O\n" +
+	"\x14WebhookForwardOutput\x12\x16\n" +
+	"\x06module\x18\x01 \x01(\tR\x06module\x12\x1f\n" +
+	"\vbackend_url\x18\x02 \x01(\tR\n" +
+	"backendUrl\"\xe3\x04\n" +
+	"\x16WebhookReconcileConfig\x12\x14\n" +
+	"\x05owner\x18\x01 \x01(\tR\x05owner\x12\x12\n" +
+	"\x04repo\x18\x02 \x01(\tR\x04repo\x12\x17\n" +
+	"\ahook_id\x18\x03 \x01(\x03R\x06hookId\x12\x10\n" +
+	"\x03app\x18\x04 \x01(\bR\x03app\x12\x12\n" +
+	"\x04mode\x18\x05 \x01(\tR\x04mode\x12\x16\n" +
+	"\x06module\x18\x06 \x01(\tR\x06module\x12\x1b\n" +
+	"\tstate_dir\x18\a \x01(\tR\bstateDir\x12\x1a\n" +
+	"\blookback\x18\b \x01(\tR\blookback\x12\x14\n" +
+	"\x05token\x18\t \x01(\tR\x05token\x12\x1f\n" +
+	"\vauth_module\x18\n" +
+	" \x01(\tR\n" +
+	"authModule\x12-\n" +
+	"\x12token_repositories\x18\v \x03(\tR\x11tokenRepositories\x12t\n" +
+	"\x11token_permissions\x18\f \x03(\v2G.workflow.plugin.github.v1.WebhookReconcileConfig.TokenPermissionsEntryR\x10tokenPermissions\x12 \n" +
+	"\fapi_base_url\x18\r \x01(\tR\n" +
+	"apiBaseUrl\x12)\n" +
+	"\x10strict_templates\x18\x0e \x01(\bR\x0fstrictTemplates\x12!\n" +
+	"\fmax_attempts\x18\x0f \x01(\tR\vmaxAttempts\x1aC\n" +
+	"\x15TokenPermissionsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"D\n" +
+	"\x15WebhookReconcileInput\x12+\n" +
+	"\x04data\x18\x01 \x01(\v2\x17.google.protobuf.StructR\x04data\"\x9f\x02\n" +
+	"\x16WebhookReconcileOutput\x12\x18\n" +
+	"\achecked\x18\x01 \x01(\x05R\achecked\x12\x16\n" +
+	"\x06failed\x18\x02 \x01(\x05R\x06failed\x12 \n" +
+	"\vredelivered\x18\x03 \x01(\x05R\vredelivered\x12\x1c\n" +
+	"\tpublished\x18\x04 \x01(\x05R\tpublished\x12\x18\n" +
+	"\aignored\x18\x05 \x01(\x05R\aignored\x12!\n" +
+	"\fdelivery_ids\x18\x06 \x03(\tR\vdeliveryIds\x12\x1c\n" +
+	"\twatermark\x18\a \x01(\x03R\twatermark\x12\x1a\n" +
+	"\brejected\x18\b \x03(\tR\brejected\x12\x1c\n" +
+	"\tabandoned\x18\t \x03(\tR\tabandonedB<Z:github.com/GoCodeAlone/workflow-plugin-github/gen;githubv1b\x06proto3"
 
 var (
 	file_github_proto_rawDescOnce sync.Once
@@ -3702,87 +10827,243 @@ func file_github_proto_rawDescGZIP() []byte {
 	return file_github_proto_rawDescData
 }
 
-var file_github_proto_msgTypes = make([]protoimpl.MessageInfo, 51)
+var file_github_proto_msgTypes = make([]protoimpl.MessageInfo, 145)
 var file_github_proto_goTypes = []any{
 	(*WebhookModuleConfig)(nil),          // 0: workflow.plugin.github.v1.WebhookModuleConfig
-	(*GitHubAppModuleConfig)(nil),        // 1: workflow.plugin.github.v1.GitHubAppModuleConfig
-	(*RunnerProviderModuleConfig)(nil),   // 2: workflow.plugin.github.v1.RunnerProviderModuleConfig
-	(*ActionTriggerConfig)(nil),          // 3: workflow.plugin.github.v1.ActionTriggerConfig
-	(*ActionTriggerInput)(nil),           // 4: workflow.plugin.github.v1.ActionTriggerInput
-	(*ActionTriggerOutput)(nil),          // 5: workflow.plugin.github.v1.ActionTriggerOutput
-	(*ActionStatusConfig)(nil),           // 6: workflow.plugin.github.v1.ActionStatusConfig
-	(*ActionStatusInput)(nil),            // 7: workflow.plugin.github.v1.ActionStatusInput
-	(*ActionStatusOutput)(nil),           // 8: workflow.plugin.github.v1.ActionStatusOutput
-	(*PRCreateConfig)(nil),               // 9: workflow.plugin.github.v1.PRCreateConfig
-	(*PRCreateInput)(nil),                // 10: workflow.plugin.github.v1.PRCreateInput
-	(*PRCreateOutput)(nil),               // 11: workflow.plugin.github.v1.PRCreateOutput
-	(*PRMergeConfig)(nil),                // 12: workflow.plugin.github.v1.PRMergeConfig
-	(*PRMergeInput)(nil),                 // 13: workflow.plugin.github.v1.PRMergeInput
-	(*PRMergeOutput)(nil),                // 14: workflow.plugin.github.v1.PRMergeOutput
-	(*PRCommentConfig)(nil),              // 15: workflow.plugin.github.v1.PRCommentConfig
-	(*PRCommentInput)(nil),               // 16: workflow.plugin.github.v1.PRCommentInput
-	(*PRCommentOutput)(nil),              // 17: workflow.plugin.github.v1.PRCommentOutput
-	(*PRReviewConfig)(nil),               // 18: workflow.plugin.github.v1.PRReviewConfig
-	(*PRReviewInput)(nil),                // 19: workflow.plugin.github.v1.PRReviewInput
-	(*PRReviewOutput)(nil),               // 20: workflow.plugin.github.v1.PRReviewOutput
-	(*IssueCreateConfig)(nil),            // 21: workflow.plugin.github.v1.IssueCreateConfig
-	(*IssueCreateInput)(nil),             // 22: workflow.plugin.github.v1.IssueCreateInput
-	(*IssueCreateOutput)(nil),            // 23: workflow.plugin.github.v1.IssueCreateOutput
-	(*IssueCloseConfig)(nil),             // 24: workflow.plugin.github.v1.IssueCloseConfig
-	(*IssueCloseInput)(nil),              // 25: workflow.plugin.github.v1.IssueCloseInput
-	(*IssueCloseOutput)(nil),             // 26: workflow.plugin.github.v1.IssueCloseOutput
-	(*IssueLabelConfig)(nil),             // 27: workflow.plugin.github.v1.IssueLabelConfig
-	(*IssueLabelInput)(nil),              // 28: workflow.plugin.github.v1.IssueLabelInput
-	(*IssueLabelOutput)(nil),             // 29: workflow.plugin.github.v1.IssueLabelOutput
-	(*ReleaseCreateConfig)(nil),          // 30: workflow.plugin.github.v1.ReleaseCreateConfig
-	(*ReleaseCreateInput)(nil),           // 31: workflow.plugin.github.v1.ReleaseCreateInput
-	(*ReleaseCreateOutput)(nil),          // 32: workflow.plugin.github.v1.ReleaseCreateOutput
-	(*ReleaseUploadConfig)(nil),          // 33: workflow.plugin.github.v1.ReleaseUploadConfig
-	(*ReleaseUploadInput)(nil),           // 34: workflow.plugin.github.v1.ReleaseUploadInput
-	(*ReleaseUploadOutput)(nil),          // 35: workflow.plugin.github.v1.ReleaseUploadOutput
-	(*UpstreamReleaseMonitorConfig)(nil), // 36: workflow.plugin.github.v1.UpstreamReleaseMonitorConfig
-	(*UpstreamReleaseMonitorInput)(nil),  // 37: workflow.plugin.github.v1.UpstreamReleaseMonitorInput
-	(*UpstreamReleaseMonitorOutput)(nil), // 38: workflow.plugin.github.v1.UpstreamReleaseMonitorOutput
-	(*RepoDispatchConfig)(nil),           // 39: workflow.plugin.github.v1.RepoDispatchConfig
-	(*RepoDispatchInput)(nil),            // 40: workflow.plugin.github.v1.RepoDispatchInput
-	(*RepoDispatchOutput)(nil),           // 41: workflow.plugin.github.v1.RepoDispatchOutput
-	(*DeploymentCreateConfig)(nil),       // 42: workflow.plugin.github.v1.DeploymentCreateConfig
-	(*DeploymentCreateInput)(nil),        // 43: workflow.plugin.github.v1.DeploymentCreateInput
-	(*DeploymentCreateOutput)(nil),       // 44: workflow.plugin.github.v1.DeploymentCreateOutput
-	(*SecretSetConfig)(nil),              // 45: workflow.plugin.github.v1.SecretSetConfig
-	(*SecretSetInput)(nil),               // 46: workflow.plugin.github.v1.SecretSetInput
-	(*SecretSetOutput)(nil),              // 47: workflow.plugin.github.v1.SecretSetOutput
-	(*GraphQLConfig)(nil),                // 48: workflow.plugin.github.v1.GraphQLConfig
-	(*GraphQLInput)(nil),                 // 49: workflow.plugin.github.v1.GraphQLInput
-	(*GraphQLOutput)(nil),                // 50: workflow.plugin.github.v1.GraphQLOutput
-	(*structpb.Struct)(nil),              // 51: google.protobuf.Struct
+	(*WebhookSecret)(nil),                // 1: workflow.plugin.github.v1.WebhookSecret
+	(*WebhookRoute)(nil),                 // 2: workflow.plugin.github.v1.WebhookRoute
+	(*GitHubAppModuleConfig)(nil),        // 3: workflow.plugin.github.v1.GitHubAppModuleConfig
+	(*RunnerProviderModuleConfig)(nil),   // 4: workflow.plugin.github.v1.RunnerProviderModuleConfig
+	(*ActionTriggerConfig)(nil),          // 5: workflow.plugin.github.v1.ActionTriggerConfig
+	(*ActionTriggerInput)(nil),           // 6: workflow.plugin.github.v1.ActionTriggerInput
+	(*ActionTriggerOutput)(nil),          // 7: workflow.plugin.github.v1.ActionTriggerOutput
+	(*ActionStatusConfig)(nil),           // 8: workflow.plugin.github.v1.ActionStatusConfig
+	(*ActionStatusInput)(nil),            // 9: workflow.plugin.github.v1.ActionStatusInput
+	(*ActionStatusOutput)(nil),           // 10: workflow.plugin.github.v1.ActionStatusOutput
+	(*WorkflowJobSummary)(nil),           // 11: workflow.plugin.github.v1.WorkflowJobSummary
+	(*CheckAnnotationSummary)(nil),       // 12: workflow.plugin.github.v1.CheckAnnotationSummary
+	(*ActionCancelConfig)(nil),           // 13: workflow.plugin.github.v1.ActionCancelConfig
+	(*ActionCancelInput)(nil),            // 14: workflow.plugin.github.v1.ActionCancelInput
+	(*ActionCancelOutput)(nil),           // 15: workflow.plugin.github.v1.ActionCancelOutput
+	(*ActionRerunConfig)(nil),            // 16: workflow.plugin.github.v1.ActionRerunConfig
+	(*ActionRerunInput)(nil),             // 17: workflow.plugin.github.v1.ActionRerunInput
+	(*ActionRerunOutput)(nil),            // 18: workflow.plugin.github.v1.ActionRerunOutput
+	(*ActionApproveConfig)(nil),          // 19: workflow.plugin.github.v1.ActionApproveConfig
+	(*ActionApproveInput)(nil),           // 20: workflow.plugin.github.v1.ActionApproveInput
+	(*ActionApproveOutput)(nil),          // 21: workflow.plugin.github.v1.ActionApproveOutput
+	(*ArtifactListConfig)(nil),           // 22: workflow.plugin.github.v1.ArtifactListConfig
+	(*ArtifactListInput)(nil),            // 23: workflow.plugin.github.v1.ArtifactListInput
+	(*ArtifactListOutput)(nil),           // 24: workflow.plugin.github.v1.ArtifactListOutput
+	(*ArtifactSummary)(nil),              // 25: workflow.plugin.github.v1.ArtifactSummary
+	(*ArtifactDownloadConfig)(nil),       // 26: workflow.plugin.github.v1.ArtifactDownloadConfig
+	(*ArtifactDownloadInput)(nil),        // 27: workflow.plugin.github.v1.ArtifactDownloadInput
+	(*ArtifactDownloadOutput)(nil),       // 28: workflow.plugin.github.v1.ArtifactDownloadOutput
+	(*ExtractedArtifact)(nil),            // 29: workflow.plugin.github.v1.ExtractedArtifact
+	(*PRCreateConfig)(nil),               // 30: workflow.plugin.github.v1.PRCreateConfig
+	(*PRCreateInput)(nil),                // 31: workflow.plugin.github.v1.PRCreateInput
+	(*PRCreateOutput)(nil),               // 32: workflow.plugin.github.v1.PRCreateOutput
+	(*PRMergeConfig)(nil),                // 33: workflow.plugin.github.v1.PRMergeConfig
+	(*PRMergeInput)(nil),                 // 34: workflow.plugin.github.v1.PRMergeInput
+	(*PRMergeOutput)(nil),                // 35: workflow.plugin.github.v1.PRMergeOutput
+	(*PRCommentConfig)(nil),              // 36: workflow.plugin.github.v1.PRCommentConfig
+	(*PRCommentInput)(nil),               // 37: workflow.plugin.github.v1.PRCommentInput
+	(*PRCommentOutput)(nil),              // 38: workflow.plugin.github.v1.PRCommentOutput
+	(*PRReviewConfig)(nil),               // 39: workflow.plugin.github.v1.PRReviewConfig
+	(*PRReviewInput)(nil),                // 40: workflow.plugin.github.v1.PRReviewInput
+	(*PRReviewOutput)(nil),               // 41: workflow.plugin.github.v1.PRReviewOutput
+	(*IssueCreateConfig)(nil),            // 42: workflow.plugin.github.v1.IssueCreateConfig
+	(*IssueCreateInput)(nil),             // 43: workflow.plugin.github.v1.IssueCreateInput
+	(*IssueCreateOutput)(nil),            // 44: workflow.plugin.github.v1.IssueCreateOutput
+	(*IssueCloseConfig)(nil),             // 45: workflow.plugin.github.v1.IssueCloseConfig
+	(*IssueCloseInput)(nil),              // 46: workflow.plugin.github.v1.IssueCloseInput
+	(*IssueCloseOutput)(nil),             // 47: workflow.plugin.github.v1.IssueCloseOutput
+	(*IssueLabelConfig)(nil),             // 48: workflow.plugin.github.v1.IssueLabelConfig
+	(*IssueLabelInput)(nil),              // 49: workflow.plugin.github.v1.IssueLabelInput
+	(*IssueLabelOutput)(nil),             // 50: workflow.plugin.github.v1.IssueLabelOutput
+	(*ReleaseCreateConfig)(nil),          // 51: workflow.plugin.github.v1.ReleaseCreateConfig
+	(*ReleaseCreateInput)(nil),           // 52: workflow.plugin.github.v1.ReleaseCreateInput
+	(*ReleaseCreateOutput)(nil),          // 53: workflow.plugin.github.v1.ReleaseCreateOutput
+	(*ReleaseUploadConfig)(nil),          // 54: workflow.plugin.github.v1.ReleaseUploadConfig
+	(*ReleaseUploadInput)(nil),           // 55: workflow.plugin.github.v1.ReleaseUploadInput
+	(*ReleaseUploadOutput)(nil),          // 56: workflow.plugin.github.v1.ReleaseUploadOutput
+	(*UpstreamReleaseMonitorConfig)(nil), // 57: workflow.plugin.github.v1.UpstreamReleaseMonitorConfig
+	(*UpstreamReleaseMonitorInput)(nil),  // 58: workflow.plugin.github.v1.UpstreamReleaseMonitorInput
+	(*UpstreamReleaseMonitorOutput)(nil), // 59: workflow.plugin.github.v1.UpstreamReleaseMonitorOutput
+	(*RepoDispatchConfig)(nil),           // 60: workflow.plugin.github.v1.RepoDispatchConfig
+	(*RepoDispatchInput)(nil),            // 61: workflow.plugin.github.v1.RepoDispatchInput
+	(*RepoDispatchOutput)(nil),           // 62: workflow.plugin.github.v1.RepoDispatchOutput
+	(*DeploymentCreateConfig)(nil),       // 63: workflow.plugin.github.v1.DeploymentCreateConfig
+	(*DeploymentCreateInput)(nil),        // 64: workflow.plugin.github.v1.DeploymentCreateInput
+	(*DeploymentCreateOutput)(nil),       // 65: workflow.plugin.github.v1.DeploymentCreateOutput
+	(*SecretSetConfig)(nil),              // 66: workflow.plugin.github.v1.SecretSetConfig
+	(*SecretSetInput)(nil),               // 67: workflow.plugin.github.v1.SecretSetInput
+	(*SecretSetOutput)(nil),              // 68: workflow.plugin.github.v1.SecretSetOutput
+	(*SecretDeleteConfig)(nil),           // 69: workflow.plugin.github.v1.SecretDeleteConfig
+	(*SecretDeleteInput)(nil),            // 70: workflow.plugin.github.v1.SecretDeleteInput
+	(*SecretDeleteOutput)(nil),           // 71: workflow.plugin.github.v1.SecretDeleteOutput
+	(*SecretSyncConfig)(nil),             // 72: workflow.plugin.github.v1.SecretSyncConfig
+	(*SecretSyncInput)(nil),              // 73: workflow.plugin.github.v1.SecretSyncInput
+	(*SecretSyncOutput)(nil),             // 74: workflow.plugin.github.v1.SecretSyncOutput
+	(*SecretListConfig)(nil),             // 75: workflow.plugin.github.v1.SecretListConfig
+	(*SecretListInput)(nil),              // 76: workflow.plugin.github.v1.SecretListInput
+	(*SecretListOutput)(nil),             // 77: workflow.plugin.github.v1.SecretListOutput
+	(*SecretSummary)(nil),                // 78: workflow.plugin.github.v1.SecretSummary
+	(*VariableSetConfig)(nil),            // 79: workflow.plugin.github.v1.VariableSetConfig
+	(*VariableSetInput)(nil),             // 80: workflow.plugin.github.v1.VariableSetInput
+	(*VariableSetOutput)(nil),            // 81: workflow.plugin.github.v1.VariableSetOutput
+	(*VariableGetConfig)(nil),            // 82: workflow.plugin.github.v1.VariableGetConfig
+	(*VariableGetInput)(nil),             // 83: workflow.plugin.github.v1.VariableGetInput
+	(*VariableGetOutput)(nil),            // 84: workflow.plugin.github.v1.VariableGetOutput
+	(*VariableListConfig)(nil),           // 85: workflow.plugin.github.v1.VariableListConfig
+	(*VariableListInput)(nil),            // 86: workflow.plugin.github.v1.VariableListInput
+	(*VariableListOutput)(nil),           // 87: workflow.plugin.github.v1.VariableListOutput
+	(*VariableSummary)(nil),              // 88: workflow.plugin.github.v1.VariableSummary
+	(*VariableDeleteConfig)(nil),         // 89: workflow.plugin.github.v1.VariableDeleteConfig
+	(*VariableDeleteInput)(nil),          // 90: workflow.plugin.github.v1.VariableDeleteInput
+	(*VariableDeleteOutput)(nil),         // 91: workflow.plugin.github.v1.VariableDeleteOutput
+	(*GraphQLConfig)(nil),                // 92: workflow.plugin.github.v1.GraphQLConfig
+	(*GraphQLInput)(nil),                 // 93: workflow.plugin.github.v1.GraphQLInput
+	(*GraphQLOutput)(nil),                // 94: workflow.plugin.github.v1.GraphQLOutput
+	(*RateLimitConfig)(nil),              // 95: workflow.plugin.github.v1.RateLimitConfig
+	(*RateLimitInput)(nil),               // 96: workflow.plugin.github.v1.RateLimitInput
+	(*RateLimitOutput)(nil),              // 97: workflow.plugin.github.v1.RateLimitOutput
+	(*RateLimitResource)(nil),            // 98: workflow.plugin.github.v1.RateLimitResource
+	(*WebhookReceiveConfig)(nil),         // 99: workflow.plugin.github.v1.WebhookReceiveConfig
+	(*WebhookReceiveInput)(nil),          // 100: workflow.plugin.github.v1.WebhookReceiveInput
+	(*WebhookReceiveOutput)(nil),         // 101: workflow.plugin.github.v1.WebhookReceiveOutput
+	(*WebhookForwardConfig)(nil),         // 102: workflow.plugin.github.v1.WebhookForwardConfig
+	(*WebhookForwardInput)(nil),          // 103: workflow.plugin.github.v1.WebhookForwardInput
+	(*WebhookForwardOutput)(nil),         // 104: workflow.plugin.github.v1.WebhookForwardOutput
+	(*WebhookReconcileConfig)(nil),       // 105: workflow.plugin.github.v1.WebhookReconcileConfig
+	(*WebhookReconcileInput)(nil),        // 106: workflow.plugin.github.v1.WebhookReconcileInput
+	(*WebhookReconcileOutput)(nil),       // 107: workflow.plugin.github.v1.WebhookReconcileOutput
+	nil,                                  // 108: workflow.plugin.github.v1.GitHubAppModuleConfig.PermissionsEntry
+	nil,                                  // 109: workflow.plugin.github.v1.ActionTriggerConfig.TokenPermissionsEntry
+	nil,                                  // 110: workflow.plugin.github.v1.ActionStatusConfig.TokenPermissionsEntry
+	nil,                                  // 111: workflow.plugin.github.v1.ActionStatusOutput.FailedJobLogsEntry
+	nil,                                  // 112: workflow.plugin.github.v1.ActionCancelConfig.TokenPermissionsEntry
+	nil,                                  // 113: workflow.plugin.github.v1.ActionRerunConfig.TokenPermissionsEntry
+	nil,                                  // 114: workflow.plugin.github.v1.ActionApproveConfig.TokenPermissionsEntry
+	nil,                                  // 115: workflow.plugin.github.v1.ArtifactListConfig.TokenPermissionsEntry
+	nil,                                  // 116: workflow.plugin.github.v1.ArtifactDownloadConfig.TokenPermissionsEntry
+	nil,                                  // 117: workflow.plugin.github.v1.PRCreateConfig.TokenPermissionsEntry
+	nil,                                  // 118: workflow.plugin.github.v1.PRMergeConfig.TokenPermissionsEntry
+	nil,                                  // 119: workflow.plugin.github.v1.PRCommentConfig.TokenPermissionsEntry
+	nil,                                  // 120: workflow.plugin.github.v1.PRReviewConfig.TokenPermissionsEntry
+	nil,                                  // 121: workflow.plugin.github.v1.IssueCreateConfig.TokenPermissionsEntry
+	nil,                                  // 122: workflow.plugin.github.v1.IssueCloseConfig.TokenPermissionsEntry
+	nil,                                  // 123: workflow.plugin.github.v1.IssueLabelConfig.TokenPermissionsEntry
+	nil,                                  // 124: workflow.plugin.github.v1.ReleaseCreateConfig.TokenPermissionsEntry
+	nil,                                  // 125: workflow.plugin.github.v1.ReleaseUploadConfig.TokenPermissionsEntry
+	nil,                                  // 126: workflow.plugin.github.v1.UpstreamReleaseMonitorConfig.TokenPermissionsEntry
+	nil,                                  // 127: workflow.plugin.github.v1.RepoDispatchConfig.TokenPermissionsEntry
+	nil,                                  // 128: workflow.plugin.github.v1.DeploymentCreateConfig.TokenPermissionsEntry
+	nil,                                  // 129: workflow.plugin.github.v1.SecretSetConfig.TokenPermissionsEntry
+	nil,                                  // 130: workflow.plugin.github.v1.SecretSetConfig.SecretsEntry
+	nil,                                  // 131: workflow.plugin.github.v1.SecretDeleteConfig.TokenPermissionsEntry
+	nil,                                  // 132: workflow.plugin.github.v1.SecretSyncConfig.SecretsEntry
+	nil,                                  // 133: workflow.plugin.github.v1.SecretSyncConfig.TokenPermissionsEntry
+	nil,                                  // 134: workflow.plugin.github.v1.SecretListConfig.TokenPermissionsEntry
+	nil,                                  // 135: workflow.plugin.github.v1.VariableSetConfig.VariablesEntry
+	nil,                                  // 136: workflow.plugin.github.v1.VariableSetConfig.TokenPermissionsEntry
+	nil,                                  // 137: workflow.plugin.github.v1.VariableGetConfig.TokenPermissionsEntry
+	nil,                                  // 138: workflow.plugin.github.v1.VariableListConfig.TokenPermissionsEntry
+	nil,                                  // 139: workflow.plugin.github.v1.VariableListOutput.ValuesEntry
+	nil,                                  // 140: workflow.plugin.github.v1.VariableDeleteConfig.TokenPermissionsEntry
+	nil,                                  // 141: workflow.plugin.github.v1.GraphQLConfig.TokenPermissionsEntry
+	nil,                                  // 142: workflow.plugin.github.v1.RateLimitConfig.TokenPermissionsEntry
+	nil,                                  // 143: workflow.plugin.github.v1.RateLimitOutput.ResourcesEntry
+	nil,                                  // 144: workflow.plugin.github.v1.WebhookReconcileConfig.TokenPermissionsEntry
+	(*structpb.Struct)(nil),              // 145: google.protobuf.Struct
 }
 var file_github_proto_depIdxs = []int32{
-	51, // 0: workflow.plugin.github.v1.ActionTriggerConfig.inputs:type_name -> google.protobuf.Struct
-	51, // 1: workflow.plugin.github.v1.ActionTriggerInput.data:type_name -> google.protobuf.Struct
-	51, // 2: workflow.plugin.github.v1.ActionStatusInput.data:type_name -> google.protobuf.Struct
-	51, // 3: workflow.plugin.github.v1.PRCreateInput.data:type_name -> google.protobuf.Struct
-	51, // 4: workflow.plugin.github.v1.PRMergeInput.data:type_name -> google.protobuf.Struct
-	51, // 5: workflow.plugin.github.v1.PRCommentInput.data:type_name -> google.protobuf.Struct
-	51, // 6: workflow.plugin.github.v1.PRReviewInput.data:type_name -> google.protobuf.Struct
-	51, // 7: workflow.plugin.github.v1.IssueCreateInput.data:type_name -> google.protobuf.Struct
-	51, // 8: workflow.plugin.github.v1.IssueCloseInput.data:type_name -> google.protobuf.Struct
-	51, // 9: workflow.plugin.github.v1.IssueLabelInput.data:type_name -> google.protobuf.Struct
-	51, // 10: workflow.plugin.github.v1.ReleaseCreateInput.data:type_name -> google.protobuf.Struct
-	51, // 11: workflow.plugin.github.v1.ReleaseUploadInput.data:type_name -> google.protobuf.Struct
-	51, // 12: workflow.plugin.github.v1.UpstreamReleaseMonitorInput.data:type_name -> google.protobuf.Struct
-	51, // 13: workflow.plugin.github.v1.RepoDispatchConfig.payload:type_name -> google.protobuf.Struct
-	51, // 14: workflow.plugin.github.v1.RepoDispatchInput.data:type_name -> google.protobuf.Struct
-	51, // 15: workflow.plugin.github.v1.DeploymentCreateInput.data:type_name -> google.protobuf.Struct
-	51, // 16: workflow.plugin.github.v1.SecretSetInput.data:type_name -> google.protobuf.Struct
-	51, // 17: workflow.plugin.github.v1.GraphQLConfig.variables:type_name -> google.protobuf.Struct
-	51, // 18: workflow.plugin.github.v1.GraphQLInput.data:type_name -> google.protobuf.Struct
-	51, // 19: workflow.plugin.github.v1.GraphQLOutput.data:type_name -> google.protobuf.Struct
-	20, // [20:20] is the sub-list for method output_type
-	20, // [20:20] is the sub-list for method input_type
-	20, // [20:20] is the sub-list for extension type_name
-	20, // [20:20] is the sub-list for extension extendee
-	0,  // [0:20] is the sub-list for field type_name
+	2,   // 0: workflow.plugin.github.v1.WebhookModuleConfig.routes:type_name -> workflow.plugin.github.v1.WebhookRoute
+	1,   // 1: workflow.plugin.github.v1.WebhookModuleConfig.secrets:type_name -> workflow.plugin.github.v1.WebhookSecret
+	108, // 2: workflow.plugin.github.v1.GitHubAppModuleConfig.permissions:type_name -> workflow.plugin.github.v1.GitHubAppModuleConfig.PermissionsEntry
+	145, // 3: workflow.plugin.github.v1.ActionTriggerConfig.inputs:type_name -> google.protobuf.Struct
+	109, // 4: workflow.plugin.github.v1.ActionTriggerConfig.token_permissions:type_name -> workflow.plugin.github.v1.ActionTriggerConfig.TokenPermissionsEntry
+	145, // 5: workflow.plugin.github.v1.ActionTriggerInput.data:type_name -> google.protobuf.Struct
+	110, // 6: workflow.plugin.github.v1.ActionStatusConfig.token_permissions:type_name -> workflow.plugin.github.v1.ActionStatusConfig.TokenPermissionsEntry
+	145, // 7: workflow.plugin.github.v1.ActionStatusInput.data:type_name -> google.protobuf.Struct
+	11,  // 8: workflow.plugin.github.v1.ActionStatusOutput.jobs:type_name -> workflow.plugin.github.v1.WorkflowJobSummary
+	12,  // 9: workflow.plugin.github.v1.ActionStatusOutput.annotations:type_name -> workflow.plugin.github.v1.CheckAnnotationSummary
+	111, // 10: workflow.plugin.github.v1.ActionStatusOutput.failed_job_logs:type_name -> workflow.plugin.github.v1.ActionStatusOutput.FailedJobLogsEntry
+	112, // 11: workflow.plugin.github.v1.ActionCancelConfig.token_permissions:type_name -> workflow.plugin.github.v1.ActionCancelConfig.TokenPermissionsEntry
+	145, // 12: workflow.plugin.github.v1.ActionCancelInput.data:type_name -> google.protobuf.Struct
+	113, // 13: workflow.plugin.github.v1.ActionRerunConfig.token_permissions:type_name -> workflow.plugin.github.v1.ActionRerunConfig.TokenPermissionsEntry
+	145, // 14: workflow.plugin.github.v1.ActionRerunInput.data:type_name -> google.protobuf.Struct
+	114, // 15: workflow.plugin.github.v1.ActionApproveConfig.token_permissions:type_name -> workflow.plugin.github.v1.ActionApproveConfig.TokenPermissionsEntry
+	145, // 16: workflow.plugin.github.v1.ActionApproveInput.data:type_name -> google.protobuf.Struct
+	115, // 17: workflow.plugin.github.v1.ArtifactListConfig.token_permissions:type_name -> workflow.plugin.github.v1.ArtifactListConfig.TokenPermissionsEntry
+	145, // 18: workflow.plugin.github.v1.ArtifactListInput.data:type_name -> google.protobuf.Struct
+	25,  // 19: workflow.plugin.github.v1.ArtifactListOutput.artifacts:type_name -> workflow.plugin.github.v1.ArtifactSummary
+	116, // 20: workflow.plugin.github.v1.ArtifactDownloadConfig.token_permissions:type_name -> workflow.plugin.github.v1.ArtifactDownloadConfig.TokenPermissionsEntry
+	145, // 21: workflow.plugin.github.v1.ArtifactDownloadInput.data:type_name -> google.protobuf.Struct
+	29,  // 22: workflow.plugin.github.v1.ArtifactDownloadOutput.artifacts:type_name -> workflow.plugin.github.v1.ExtractedArtifact
+	117, // 23: workflow.plugin.github.v1.PRCreateConfig.token_permissions:type_name -> workflow.plugin.github.v1.PRCreateConfig.TokenPermissionsEntry
+	145, // 24: workflow.plugin.github.v1.PRCreateInput.data:type_name -> google.protobuf.Struct
+	118, // 25: workflow.plugin.github.v1.PRMergeConfig.token_permissions:type_name -> workflow.plugin.github.v1.PRMergeConfig.TokenPermissionsEntry
+	145, // 26: workflow.plugin.github.v1.PRMergeInput.data:type_name -> google.protobuf.Struct
+	119, // 27: workflow.plugin.github.v1.PRCommentConfig.token_permissions:type_name -> workflow.plugin.github.v1.PRCommentConfig.TokenPermissionsEntry
+	145, // 28: workflow.plugin.github.v1.PRCommentInput.data:type_name -> google.protobuf.Struct
+	120, // 29: workflow.plugin.github.v1.PRReviewConfig.token_permissions:type_name -> workflow.plugin.github.v1.PRReviewConfig.TokenPermissionsEntry
+	145, // 30: workflow.plugin.github.v1.PRReviewInput.data:type_name -> google.protobuf.Struct
+	121, // 31: workflow.plugin.github.v1.IssueCreateConfig.token_permissions:type_name -> workflow.plugin.github.v1.IssueCreateConfig.TokenPermissionsEntry
+	145, // 32: workflow.plugin.github.v1.IssueCreateInput.data:type_name -> google.protobuf.Struct
+	122, // 33: workflow.plugin.github.v1.IssueCloseConfig.token_permissions:type_name -> workflow.plugin.github.v1.IssueCloseConfig.TokenPermissionsEntry
+	145, // 34: workflow.plugin.github.v1.IssueCloseInput.data:type_name -> google.protobuf.Struct
+	123, // 35: workflow.plugin.github.v1.IssueLabelConfig.token_permissions:type_name -> workflow.plugin.github.v1.IssueLabelConfig.TokenPermissionsEntry
+	145, // 36: workflow.plugin.github.v1.IssueLabelInput.data:type_name -> google.protobuf.Struct
+	124, // 37: workflow.plugin.github.v1.ReleaseCreateConfig.token_permissions:type_name -> workflow.plugin.github.v1.ReleaseCreateConfig.TokenPermissionsEntry
+	145, // 38: workflow.plugin.github.v1.ReleaseCreateInput.data:type_name -> google.protobuf.Struct
+	125, // 39: workflow.plugin.github.v1.ReleaseUploadConfig.token_permissions:type_name -> workflow.plugin.github.v1.ReleaseUploadConfig.TokenPermissionsEntry
+	145, // 40: workflow.plugin.github.v1.ReleaseUploadInput.data:type_name -> google.protobuf.Struct
+	126, // 41: workflow.plugin.github.v1.UpstreamReleaseMonitorConfig.token_permissions:type_name -> workflow.plugin.github.v1.UpstreamReleaseMonitorConfig.TokenPermissionsEntry
+	145, // 42: workflow.plugin.github.v1.UpstreamReleaseMonitorInput.data:type_name -> google.protobuf.Struct
+	145, // 43: workflow.plugin.github.v1.RepoDispatchConfig.payload:type_name -> google.protobuf.Struct
+	127, // 44: workflow.plugin.github.v1.RepoDispatchConfig.token_permissions:type_name -> workflow.plugin.github.v1.RepoDispatchConfig.TokenPermissionsEntry
+	145, // 45: workflow.plugin.github.v1.RepoDispatchInput.data:type_name -> google.protobuf.Struct
+	128, // 46: workflow.plugin.github.v1.DeploymentCreateConfig.token_permissions:type_name -> workflow.plugin.github.v1.DeploymentCreateConfig.TokenPermissionsEntry
+	145, // 47: workflow.plugin.github.v1.DeploymentCreateInput.data:type_name -> google.protobuf.Struct
+	129, // 48: workflow.plugin.github.v1.SecretSetConfig.token_permissions:type_name -> workflow.plugin.github.v1.SecretSetConfig.TokenPermissionsEntry
+	130, // 49: workflow.plugin.github.v1.SecretSetConfig.secrets:type_name -> workflow.plugin.github.v1.SecretSetConfig.SecretsEntry
+	145, // 50: workflow.plugin.github.v1.SecretSetInput.data:type_name -> google.protobuf.Struct
+	131, // 51: workflow.plugin.github.v1.SecretDeleteConfig.token_permissions:type_name -> workflow.plugin.github.v1.SecretDeleteConfig.TokenPermissionsEntry
+	145, // 52: workflow.plugin.github.v1.SecretDeleteInput.data:type_name -> google.protobuf.Struct
+	132, // 53: workflow.plugin.github.v1.SecretSyncConfig.secrets:type_name -> workflow.plugin.github.v1.SecretSyncConfig.SecretsEntry
+	133, // 54: workflow.plugin.github.v1.SecretSyncConfig.token_permissions:type_name -> workflow.plugin.github.v1.SecretSyncConfig.TokenPermissionsEntry
+	145, // 55: workflow.plugin.github.v1.SecretSyncInput.data:type_name -> google.protobuf.Struct
+	134, // 56: workflow.plugin.github.v1.SecretListConfig.token_permissions:type_name -> workflow.plugin.github.v1.SecretListConfig.TokenPermissionsEntry
+	145, // 57: workflow.plugin.github.v1.SecretListInput.data:type_name -> google.protobuf.Struct
+	78,  // 58: workflow.plugin.github.v1.SecretListOutput.secrets:type_name -> workflow.plugin.github.v1.SecretSummary
+	135, // 59: workflow.plugin.github.v1.VariableSetConfig.variables:type_name -> workflow.plugin.github.v1.VariableSetConfig.VariablesEntry
+	136, // 60: workflow.plugin.github.v1.VariableSetConfig.token_permissions:type_name -> workflow.plugin.github.v1.VariableSetConfig.TokenPermissionsEntry
+	145, // 61: workflow.plugin.github.v1.VariableSetInput.data:type_name -> google.protobuf.Struct
+	137, // 62: workflow.plugin.github.v1.VariableGetConfig.token_permissions:type_name -> workflow.plugin.github.v1.VariableGetConfig.TokenPermissionsEntry
+	145, // 63: workflow.plugin.github.v1.VariableGetInput.data:type_name -> google.protobuf.Struct
+	138, // 64: workflow.plugin.github.v1.VariableListConfig.token_permissions:type_name -> workflow.plugin.github.v1.VariableListConfig.TokenPermissionsEntry
+	145, // 65: workflow.plugin.github.v1.VariableListInput.data:type_name -> google.protobuf.Struct
+	88,  // 66: workflow.plugin.github.v1.VariableListOutput.variables:type_name -> workflow.plugin.github.v1.VariableSummary
+	139, // 67: workflow.plugin.github.v1.VariableListOutput.values:type_name -> workflow.plugin.github.v1.VariableListOutput.ValuesEntry
+	140, // 68: workflow.plugin.github.v1.VariableDeleteConfig.token_permissions:type_name -> workflow.plugin.github.v1.VariableDeleteConfig.TokenPermissionsEntry
+	145, // 69: workflow.plugin.github.v1.VariableDeleteInput.data:type_name -> google.protobuf.Struct
+	145, // 70: workflow.plugin.github.v1.GraphQLConfig.variables:type_name -> google.protobuf.Struct
+	141, // 71: workflow.plugin.github.v1.GraphQLConfig.token_permissions:type_name -> workflow.plugin.github.v1.GraphQLConfig.TokenPermissionsEntry
+	145, // 72: workflow.plugin.github.v1.GraphQLInput.data:type_name -> google.protobuf.Struct
+	145, // 73: workflow.plugin.github.v1.GraphQLOutput.data:type_name -> google.protobuf.Struct
+	142, // 74: workflow.plugin.github.v1.RateLimitConfig.token_permissions:type_name -> workflow.plugin.github.v1.RateLimitConfig.TokenPermissionsEntry
+	145, // 75: workflow.plugin.github.v1.RateLimitInput.data:type_name -> google.protobuf.Struct
+	143, // 76: workflow.plugin.github.v1.RateLimitOutput.resources:type_name -> workflow.plugin.github.v1.RateLimitOutput.ResourcesEntry
+	145, // 77: workflow.plugin.github.v1.WebhookReceiveInput.headers:type_name -> google.protobuf.Struct
+	145, // 78: workflow.plugin.github.v1.WebhookForwardInput.data:type_name -> google.protobuf.Struct
+	144, // 79: workflow.plugin.github.v1.WebhookReconcileConfig.token_permissions:type_name -> workflow.plugin.github.v1.WebhookReconcileConfig.TokenPermissionsEntry
+	145, // 80: workflow.plugin.github.v1.WebhookReconcileInput.data:type_name -> google.protobuf.Struct
+	98,  // 81: workflow.plugin.github.v1.RateLimitOutput.ResourcesEntry.value:type_name -> workflow.plugin.github.v1.RateLimitResource
+	82,  // [82:82] is the sub-list for method output_type
+	82,  // [82:82] is the sub-list for method input_type
+	82,  // [82:82] is the sub-list for extension type_name
+	82,  // [82:82] is the sub-list for extension extendee
+	0,   // [0:82] is the sub-list for field type_name
 }
 
 func init() { file_github_proto_init() }
@@ -3796,7 +11077,7 @@ func file_github_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_github_proto_rawDesc), len(file_github_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   51,
+			NumMessages:   145,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
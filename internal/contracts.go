@@ -64,6 +64,46 @@ var githubContractRegistry = &pb.ContractRegistry{
 			OutputMessage: githubProtoPkg + "ActionStatusOutput",
 			Mode:          pb.ContractMode_CONTRACT_MODE_STRICT_PROTO,
 		},
+		{
+			Kind:          pb.ContractKind_CONTRACT_KIND_STEP,
+			StepType:      "step.gh_action_cancel",
+			ConfigMessage: githubProtoPkg + "ActionCancelConfig",
+			InputMessage:  githubProtoPkg + "ActionCancelInput",
+			OutputMessage: githubProtoPkg + "ActionCancelOutput",
+			Mode:          pb.ContractMode_CONTRACT_MODE_STRICT_PROTO,
+		},
+		{
+			Kind:          pb.ContractKind_CONTRACT_KIND_STEP,
+			StepType:      "step.gh_action_rerun",
+			ConfigMessage: githubProtoPkg + "ActionRerunConfig",
+			InputMessage:  githubProtoPkg + "ActionRerunInput",
+			OutputMessage: githubProtoPkg + "ActionRerunOutput",
+			Mode:          pb.ContractMode_CONTRACT_MODE_STRICT_PROTO,
+		},
+		{
+			Kind:          pb.ContractKind_CONTRACT_KIND_STEP,
+			StepType:      "step.gh_action_approve",
+			ConfigMessage: githubProtoPkg + "ActionApproveConfig",
+			InputMessage:  githubProtoPkg + "ActionApproveInput",
+			OutputMessage: githubProtoPkg + "ActionApproveOutput",
+			Mode:          pb.ContractMode_CONTRACT_MODE_STRICT_PROTO,
+		},
+		{
+			Kind:          pb.ContractKind_CONTRACT_KIND_STEP,
+			StepType:      "step.gh_artifact_list",
+			ConfigMessage: githubProtoPkg + "ArtifactListConfig",
+			InputMessage:  githubProtoPkg + "ArtifactListInput",
+			OutputMessage: githubProtoPkg + "ArtifactListOutput",
+			Mode:          pb.ContractMode_CONTRACT_MODE_STRICT_PROTO,
+		},
+		{
+			Kind:          pb.ContractKind_CONTRACT_KIND_STEP,
+			StepType:      "step.gh_artifact_download",
+			ConfigMessage: githubProtoPkg + "ArtifactDownloadConfig",
+			InputMessage:  githubProtoPkg + "ArtifactDownloadInput",
+			OutputMessage: githubProtoPkg + "ArtifactDownloadOutput",
+			Mode:          pb.ContractMode_CONTRACT_MODE_STRICT_PROTO,
+		},
 		{
 			Kind:          pb.ContractKind_CONTRACT_KIND_STEP,
 			StepType:      "step.gh_pr_create",
@@ -168,6 +208,62 @@ var githubContractRegistry = &pb.ContractRegistry{
 			OutputMessage: githubProtoPkg + "SecretSetOutput",
 			Mode:          pb.ContractMode_CONTRACT_MODE_STRICT_PROTO,
 		},
+		{
+			Kind:          pb.ContractKind_CONTRACT_KIND_STEP,
+			StepType:      "step.gh_secret_delete",
+			ConfigMessage: githubProtoPkg + "SecretDeleteConfig",
+			InputMessage:  githubProtoPkg + "SecretDeleteInput",
+			OutputMessage: githubProtoPkg + "SecretDeleteOutput",
+			Mode:          pb.ContractMode_CONTRACT_MODE_STRICT_PROTO,
+		},
+		{
+			Kind:          pb.ContractKind_CONTRACT_KIND_STEP,
+			StepType:      "step.gh_secret_sync",
+			ConfigMessage: githubProtoPkg + "SecretSyncConfig",
+			InputMessage:  githubProtoPkg + "SecretSyncInput",
+			OutputMessage: githubProtoPkg + "SecretSyncOutput",
+			Mode:          pb.ContractMode_CONTRACT_MODE_STRICT_PROTO,
+		},
+		{
+			Kind:          pb.ContractKind_CONTRACT_KIND_STEP,
+			StepType:      "step.gh_secret_list",
+			ConfigMessage: githubProtoPkg + "SecretListConfig",
+			InputMessage:  githubProtoPkg + "SecretListInput",
+			OutputMessage: githubProtoPkg + "SecretListOutput",
+			Mode:          pb.ContractMode_CONTRACT_MODE_STRICT_PROTO,
+		},
+		{
+			Kind:          pb.ContractKind_CONTRACT_KIND_STEP,
+			StepType:      "step.gh_variable_set",
+			ConfigMessage: githubProtoPkg + "VariableSetConfig",
+			InputMessage:  githubProtoPkg + "VariableSetInput",
+			OutputMessage: githubProtoPkg + "VariableSetOutput",
+			Mode:          pb.ContractMode_CONTRACT_MODE_STRICT_PROTO,
+		},
+		{
+			Kind:          pb.ContractKind_CONTRACT_KIND_STEP,
+			StepType:      "step.gh_variable_get",
+			ConfigMessage: githubProtoPkg + "VariableGetConfig",
+			InputMessage:  githubProtoPkg + "VariableGetInput",
+			OutputMessage: githubProtoPkg + "VariableGetOutput",
+			Mode:          pb.ContractMode_CONTRACT_MODE_STRICT_PROTO,
+		},
+		{
+			Kind:          pb.ContractKind_CONTRACT_KIND_STEP,
+			StepType:      "step.gh_variable_list",
+			ConfigMessage: githubProtoPkg + "VariableListConfig",
+			InputMessage:  githubProtoPkg + "VariableListInput",
+			OutputMessage: githubProtoPkg + "VariableListOutput",
+			Mode:          pb.ContractMode_CONTRACT_MODE_STRICT_PROTO,
+		},
+		{
+			Kind:          pb.ContractKind_CONTRACT_KIND_STEP,
+			StepType:      "step.gh_variable_delete",
+			ConfigMessage: githubProtoPkg + "VariableDeleteConfig",
+			InputMessage:  githubProtoPkg + "VariableDeleteInput",
+			OutputMessage: githubProtoPkg + "VariableDeleteOutput",
+			Mode:          pb.ContractMode_CONTRACT_MODE_STRICT_PROTO,
+		},
 		{
 			Kind:          pb.ContractKind_CONTRACT_KIND_STEP,
 			StepType:      "step.gh_graphql",
@@ -176,6 +272,38 @@ var githubContractRegistry = &pb.ContractRegistry{
 			OutputMessage: githubProtoPkg + "GraphQLOutput",
 			Mode:          pb.ContractMode_CONTRACT_MODE_STRICT_PROTO,
 		},
+		{
+			Kind:          pb.ContractKind_CONTRACT_KIND_STEP,
+			StepType:      "step.gh_rate_limit",
+			ConfigMessage: githubProtoPkg + "RateLimitConfig",
+			InputMessage:  githubProtoPkg + "RateLimitInput",
+			OutputMessage: githubProtoPkg + "RateLimitOutput",
+			Mode:          pb.ContractMode_CONTRACT_MODE_STRICT_PROTO,
+		},
+		{
+			Kind:          pb.ContractKind_CONTRACT_KIND_STEP,
+			StepType:      "step.gh_webhook_receive",
+			ConfigMessage: githubProtoPkg + "WebhookReceiveConfig",
+			InputMessage:  githubProtoPkg + "WebhookReceiveInput",
+			OutputMessage: githubProtoPkg + "WebhookReceiveOutput",
+			Mode:          pb.ContractMode_CONTRACT_MODE_STRICT_PROTO,
+		},
+		{
+			Kind:          pb.ContractKind_CONTRACT_KIND_STEP,
+			StepType:      "step.gh_webhook_forward",
+			ConfigMessage: githubProtoPkg + "WebhookForwardConfig",
+			InputMessage:  githubProtoPkg + "WebhookForwardInput",
+			OutputMessage: githubProtoPkg + "WebhookForwardOutput",
+			Mode:          pb.ContractMode_CONTRACT_MODE_STRICT_PROTO,
+		},
+		{
+			Kind:          pb.ContractKind_CONTRACT_KIND_STEP,
+			StepType:      "step.gh_webhook_reconcile",
+			ConfigMessage: githubProtoPkg + "WebhookReconcileConfig",
+			InputMessage:  githubProtoPkg + "WebhookReconcileInput",
+			OutputMessage: githubProtoPkg + "WebhookReconcileOutput",
+			Mode:          pb.ContractMode_CONTRACT_MODE_STRICT_PROTO,
+		},
 	},
 }
 
@@ -54,6 +54,11 @@ func TestContractRegistry_CoversAllStepTypes(t *testing.T) {
 	wantSteps := []string{
 		"step.gh_action_trigger",
 		"step.gh_action_status",
+		"step.gh_action_cancel",
+		"step.gh_action_rerun",
+		"step.gh_action_approve",
+		"step.gh_artifact_list",
+		"step.gh_artifact_download",
 		"step.gh_pr_create",
 		"step.gh_pr_merge",
 		"step.gh_pr_comment",
@@ -67,7 +72,18 @@ func TestContractRegistry_CoversAllStepTypes(t *testing.T) {
 		"step.gh_repo_dispatch",
 		"step.gh_deployment_create",
 		"step.gh_secret_set",
+		"step.gh_secret_delete",
+		"step.gh_secret_sync",
+		"step.gh_secret_list",
+		"step.gh_variable_set",
+		"step.gh_variable_get",
+		"step.gh_variable_list",
+		"step.gh_variable_delete",
 		"step.gh_graphql",
+		"step.gh_rate_limit",
+		"step.gh_webhook_receive",
+		"step.gh_webhook_forward",
+		"step.gh_webhook_reconcile",
 	}
 
 	found := map[string]bool{}
@@ -116,8 +132,8 @@ func TestT916_GitHubPluginDoesNotExposeComputeGatewayOrSyntheticCheckSteps(t *te
 func TestContractRegistry_ContractCount(t *testing.T) {
 	p := &githubPlugin{}
 	reg := p.ContractRegistry()
-	// 3 modules + 16 steps = 19 total
-	if len(reg.Contracts) != 19 {
-		t.Errorf("expected 19 contracts (3 modules + 16 steps), got %d", len(reg.Contracts))
+	// 3 modules + 32 steps = 35 total
+	if len(reg.Contracts) != 35 {
+		t.Errorf("expected 35 contracts (3 modules + 32 steps), got %d", len(reg.Contracts))
 	}
 }
@@ -3,20 +3,34 @@
 package internal
 
 import (
+	"bufio"
 	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
+	"strconv"
+	"strings"
 	"time"
 )
 
 // GitHubClient is the interface for interacting with the GitHub API.
 // It is defined as an interface so tests can inject a mock.
 type GitHubClient interface {
-	TriggerWorkflow(ctx context.Context, owner, repo, workflow, ref string, inputs map[string]string, token string) error
+	TriggerWorkflow(ctx context.Context, owner, repo, workflow, ref string, inputs map[string]string, token string) (*WorkflowDispatch, error)
 	GetWorkflowRun(ctx context.Context, owner, repo string, runID int64, token string) (*WorkflowRun, error)
+	PollWorkflowRun(ctx context.Context, owner, repo string, runID int64, etag, token string) (*WorkflowRunPoll, error)
+	ListWorkflowJobs(ctx context.Context, owner, repo string, runID int64, token string) ([]WorkflowJob, error)
+	ListCheckRunAnnotations(ctx context.Context, owner, repo string, checkRunID int64, token string) ([]CheckAnnotation, error)
+	GetJobLogTail(ctx context.Context, owner, repo string, jobID int64, lines int, token string) (string, error)
+	CancelWorkflowRun(ctx context.Context, owner, repo string, runID int64, force bool, token string) error
+	RerunWorkflowRun(ctx context.Context, owner, repo string, runID int64, failedOnly, debugLogging bool, token string) error
+	ListPendingDeployments(ctx context.Context, owner, repo string, runID int64, token string) ([]PendingDeployment, error)
+	ReviewPendingDeployments(ctx context.Context, owner, repo string, runID int64, environmentIDs []int64, state, comment, token string) error
+	ListRunArtifacts(ctx context.Context, owner, repo string, runID int64, token string) ([]WorkflowArtifact, error)
+	DownloadArtifact(ctx context.Context, owner, repo string, artifactID int64, dst io.Writer, maxBytes int64, token string) (int64, error)
+	GetRateLimits(ctx context.Context, token string) (map[string]RateLimitResource, error)
 }
 
 // WorkflowRun represents a GitHub Actions workflow run.
@@ -25,38 +39,168 @@ type WorkflowRun struct {
 	Status     string `json:"status"`
 	Conclusion string `json:"conclusion"`
 	HTMLURL    string `json:"html_url"`
+	HeadSHA    string `json:"head_sha"`
+}
+
+// WorkflowJob is one job of a workflow run. Its ID is also the ID of the
+// job's check run.
+type WorkflowJob struct {
+	ID          int64             `json:"id"`
+	Name        string            `json:"name"`
+	Status      string            `json:"status"`
+	Conclusion  string            `json:"conclusion"`
+	HTMLURL     string            `json:"html_url"`
+	RunnerName  string            `json:"runner_name"`
+	StartedAt   time.Time         `json:"started_at"`
+	CompletedAt time.Time         `json:"completed_at"`
+	Steps       []WorkflowJobStep `json:"steps"`
+}
+
+// WorkflowJobStep is one step of a workflow job.
+type WorkflowJobStep struct {
+	Name       string `json:"name"`
+	Number     int    `json:"number"`
+	Status     string `json:"status"`
+	Conclusion string `json:"conclusion"`
+}
+
+// CheckAnnotation is a check run annotation, such as a compiler error or a
+// failed test reported by a workflow job.
+type CheckAnnotation struct {
+	Path            string `json:"path"`
+	StartLine       int    `json:"start_line"`
+	EndLine         int    `json:"end_line"`
+	AnnotationLevel string `json:"annotation_level"`
+	Title           string `json:"title"`
+	Message         string `json:"message"`
+}
+
+// WorkflowArtifact is an artifact uploaded by a workflow run.
+type WorkflowArtifact struct {
+	ID          int64     `json:"id"`
+	Name        string    `json:"name"`
+	SizeInBytes int64     `json:"size_in_bytes"`
+	Expired     bool      `json:"expired"`
+	CreatedAt   time.Time `json:"created_at"`
+	ExpiresAt   time.Time `json:"expires_at"`
+}
+
+// PendingDeployment is a deployment of a workflow run that waits for an
+// environment's required reviewers.
+type PendingDeployment struct {
+	Environment struct {
+		ID   int64  `json:"id"`
+		Name string `json:"name"`
+	} `json:"environment"`
+	CurrentUserCanApprove bool `json:"current_user_can_approve"`
+}
+
+// WorkflowRunPoll is the answer to a conditional workflow run request.
+type WorkflowRunPoll struct {
+	// Run is nil when the run is unchanged or the request was rate limited.
+	Run *WorkflowRun
+	// NotModified reports a 304 answer to If-None-Match; it does not count
+	// against the rate limit.
+	NotModified bool
+	// RateLimited reports a 403 or 429 rate-limit answer that the shared
+	// transport did not wait out, because the wait exceeded its limit or the
+	// request's deadline.
+	RateLimited bool
+	ETag        string
+	RateLimit   RateLimitStatus
+}
+
+// RateLimitStatus is the rate-limit state GitHub reported with a response.
+type RateLimitStatus struct {
+	// Remaining is the number of requests left in the window, or -1 when the
+	// response did not say.
+	Remaining int
+	// Reset is when the window resets; zero when unknown.
+	Reset time.Time
+	// RetryAfter is the delay GitHub asked for; zero when not given.
+	RetryAfter time.Duration
+}
+
+// RateLimitResource is the rate limit of one resource (core, search,
+// graphql, ...) as reported by GET /rate_limit.
+type RateLimitResource struct {
+	Limit     int   `json:"limit"`
+	Remaining int   `json:"remaining"`
+	Used      int   `json:"used"`
+	Reset     int64 `json:"reset"` // Unix seconds
+}
+
+// parseRateLimitStatus reads the X-RateLimit-* and Retry-After headers.
+func parseRateLimitStatus(h http.Header) RateLimitStatus {
+	status := RateLimitStatus{Remaining: -1}
+	if v, err := strconv.Atoi(h.Get("X-RateLimit-Remaining")); err == nil {
+		status.Remaining = v
+	}
+	if v, err := strconv.ParseInt(h.Get("X-RateLimit-Reset"), 10, 64); err == nil {
+		status.Reset = time.Unix(v, 0)
+	}
+	if v, err := strconv.Atoi(h.Get("Retry-After")); err == nil && v > 0 {
+		status.RetryAfter = time.Duration(v) * time.Second
+	}
+	return status
+}
+
+// WorkflowDispatch is the run identity GitHub returns for a workflow_dispatch.
+// RunID is zero when GitHub answered 204 No Content without run details.
+type WorkflowDispatch struct {
+	RunID   int64  `json:"workflow_run_id"`
+	RunURL  string `json:"run_url"`
+	HTMLURL string `json:"html_url"`
 }
 
 // httpGitHubClient implements GitHubClient using net/http.
 type httpGitHubClient struct {
-	baseURL    string
+	baseURL string
+	// apiBaseURL, when set, supplies the API root per request instead of
+	// baseURL, so a step follows the endpoints of its auth_module.
+	apiBaseURL func() string
 	httpClient *http.Client
 }
 
-// newHTTPGitHubClient returns a production GitHub API client.
-func newHTTPGitHubClient() GitHubClient {
+// newHTTPGitHubClient returns a production GitHub API client whose API root
+// is looked up with apiBaseURL on every request.
+func newHTTPGitHubClient(apiBaseURL func() string) GitHubClient {
 	return &httpGitHubClient{
-		baseURL: "https://api.github.com",
-		httpClient: &http.Client{
-			Timeout: 30 * time.Second,
-		},
+		baseURL:    defaultGitHubAPIBaseURL,
+		apiBaseURL: apiBaseURL,
+		httpClient: newGitHubHTTPClient(),
 	}
 }
 
+// root returns the API root for the next request.
+func (c *httpGitHubClient) root() string {
+	if c.apiBaseURL != nil {
+		return c.apiBaseURL()
+	}
+	return c.baseURL
+}
+
 // doRequest performs an authenticated request to the GitHub API.
 func (c *httpGitHubClient) doRequest(ctx context.Context, method, url string, body any, token string) ([]byte, int, error) {
+	respBody, status, _, err := c.doRequestWithHeaders(ctx, method, url, body, token, nil)
+	return respBody, status, err
+}
+
+// doRequestWithHeaders performs an authenticated request with extra request
+// headers and also returns the response headers.
+func (c *httpGitHubClient) doRequestWithHeaders(ctx context.Context, method, url string, body any, token string, headers map[string]string) ([]byte, int, http.Header, error) {
 	var bodyReader io.Reader
 	if body != nil {
 		data, err := json.Marshal(body)
 		if err != nil {
-			return nil, 0, fmt.Errorf("marshal request body: %w", err)
+			return nil, 0, nil, fmt.Errorf("marshal request body: %w", err)
 		}
 		bodyReader = bytes.NewReader(data)
 	}
 
 	req, err := http.NewRequestWithContext(ctx, method, url, bodyReader)
 	if err != nil {
-		return nil, 0, fmt.Errorf("create request: %w", err)
+		return nil, 0, nil, fmt.Errorf("create request: %w", err)
 	}
 
 	req.Header.Set("Authorization", "Bearer "+token)
@@ -65,45 +209,58 @@ func (c *httpGitHubClient) doRequest(ctx context.Context, method, url string, bo
 	if body != nil {
 		req.Header.Set("Content-Type", "application/json")
 	}
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
 
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		return nil, 0, fmt.Errorf("execute request: %w", err)
+		return nil, 0, nil, fmt.Errorf("execute request: %w", err)
 	}
 	defer resp.Body.Close()
 
 	respBody, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, resp.StatusCode, fmt.Errorf("read response body: %w", err)
+		return nil, resp.StatusCode, resp.Header, fmt.Errorf("read response body: %w", err)
 	}
 
-	return respBody, resp.StatusCode, nil
+	return respBody, resp.StatusCode, resp.Header, nil
 }
 
-// TriggerWorkflow triggers a GitHub Actions workflow via workflow_dispatch.
-func (c *httpGitHubClient) TriggerWorkflow(ctx context.Context, owner, repo, workflow, ref string, inputs map[string]string, token string) error {
-	url := fmt.Sprintf("%s/repos/%s/%s/actions/workflows/%s/dispatches", c.baseURL, owner, repo, workflow)
+// TriggerWorkflow triggers a GitHub Actions workflow via workflow_dispatch and
+// returns the dispatched run when GitHub reports it.
+func (c *httpGitHubClient) TriggerWorkflow(ctx context.Context, owner, repo, workflow, ref string, inputs map[string]string, token string) (*WorkflowDispatch, error) {
+	url := fmt.Sprintf("%s/repos/%s/%s/actions/workflows/%s/dispatches", c.root(), owner, repo, workflow)
 
 	payload := map[string]any{
-		"ref": ref,
+		"ref":                ref,
+		"return_run_details": true,
 	}
 	if len(inputs) > 0 {
 		payload["inputs"] = inputs
 	}
 
-	_, status, err := c.doRequest(ctx, http.MethodPost, url, payload, token)
+	body, status, err := c.doRequest(ctx, http.MethodPost, url, payload, token)
 	if err != nil {
-		return fmt.Errorf("trigger workflow: %w", err)
+		return nil, fmt.Errorf("trigger workflow: %w", err)
 	}
-	if status != http.StatusNoContent {
-		return fmt.Errorf("trigger workflow: unexpected status %d", status)
+	switch status {
+	case http.StatusNoContent:
+		return &WorkflowDispatch{}, nil
+	case http.StatusOK:
+		var dispatch WorkflowDispatch
+		if err := json.Unmarshal(body, &dispatch); err != nil {
+			return nil, fmt.Errorf("parse workflow dispatch: %w", err)
+		}
+		return &dispatch, nil
+	default:
+		return nil, fmt.Errorf("trigger workflow: unexpected status %d", status)
 	}
-	return nil
 }
 
 // GetWorkflowRun fetches the status of a GitHub Actions workflow run.
 func (c *httpGitHubClient) GetWorkflowRun(ctx context.Context, owner, repo string, runID int64, token string) (*WorkflowRun, error) {
-	url := fmt.Sprintf("%s/repos/%s/%s/actions/runs/%d", c.baseURL, owner, repo, runID)
+	url := fmt.Sprintf("%s/repos/%s/%s/actions/runs/%d", c.root(), owner, repo, runID)
 
 	body, status, err := c.doRequest(ctx, http.MethodGet, url, nil, token)
 	if err != nil {
@@ -119,3 +276,308 @@ func (c *httpGitHubClient) GetWorkflowRun(ctx context.Context, owner, repo strin
 	}
 	return &run, nil
 }
+
+// PollWorkflowRun fetches a workflow run unless it is unchanged since the
+// response that returned etag. Rate-limit answers that carry a wait are
+// reported in the poll rather than as errors.
+func (c *httpGitHubClient) PollWorkflowRun(ctx context.Context, owner, repo string, runID int64, etag, token string) (*WorkflowRunPoll, error) {
+	url := fmt.Sprintf("%s/repos/%s/%s/actions/runs/%d", c.root(), owner, repo, runID)
+
+	var headers map[string]string
+	if etag != "" {
+		headers = map[string]string{"If-None-Match": etag}
+	}
+	body, status, respHeaders, err := c.doRequestWithHeaders(ctx, http.MethodGet, url, nil, token, headers)
+	if err != nil {
+		return nil, fmt.Errorf("get workflow run: %w", err)
+	}
+
+	poll := &WorkflowRunPoll{ETag: etag, RateLimit: parseRateLimitStatus(respHeaders)}
+	switch status {
+	case http.StatusNotModified:
+		poll.NotModified = true
+		return poll, nil
+	case http.StatusOK:
+		var run WorkflowRun
+		if err := json.Unmarshal(body, &run); err != nil {
+			return nil, fmt.Errorf("parse workflow run: %w", err)
+		}
+		poll.Run = &run
+		poll.ETag = respHeaders.Get("ETag")
+		return poll, nil
+	case http.StatusForbidden, http.StatusTooManyRequests:
+		if poll.RateLimit.RetryAfter > 0 || poll.RateLimit.Remaining == 0 {
+			poll.RateLimited = true
+			return poll, nil
+		}
+	}
+	return nil, fmt.Errorf("get workflow run: unexpected status %d", status)
+}
+
+// ListWorkflowJobs lists the jobs of the latest attempt of a workflow run.
+func (c *httpGitHubClient) ListWorkflowJobs(ctx context.Context, owner, repo string, runID int64, token string) ([]WorkflowJob, error) {
+	const perPage = 100
+	var jobs []WorkflowJob
+	for page := 1; ; page++ {
+		url := fmt.Sprintf("%s/repos/%s/%s/actions/runs/%d/jobs?filter=latest&per_page=%d&page=%d", c.root(), owner, repo, runID, perPage, page)
+		body, status, err := c.doRequest(ctx, http.MethodGet, url, nil, token)
+		if err != nil {
+			return nil, fmt.Errorf("list workflow jobs: %w", err)
+		}
+		if status != http.StatusOK {
+			return nil, fmt.Errorf("list workflow jobs: unexpected status %d", status)
+		}
+		var out struct {
+			TotalCount int           `json:"total_count"`
+			Jobs       []WorkflowJob `json:"jobs"`
+		}
+		if err := json.Unmarshal(body, &out); err != nil {
+			return nil, fmt.Errorf("parse workflow jobs: %w", err)
+		}
+		jobs = append(jobs, out.Jobs...)
+		if len(out.Jobs) < perPage || len(jobs) >= out.TotalCount {
+			return jobs, nil
+		}
+	}
+}
+
+// ListCheckRunAnnotations lists the annotations of a check run (at most 100).
+func (c *httpGitHubClient) ListCheckRunAnnotations(ctx context.Context, owner, repo string, checkRunID int64, token string) ([]CheckAnnotation, error) {
+	url := fmt.Sprintf("%s/repos/%s/%s/check-runs/%d/annotations?per_page=100", c.root(), owner, repo, checkRunID)
+	body, status, err := c.doRequest(ctx, http.MethodGet, url, nil, token)
+	if err != nil {
+		return nil, fmt.Errorf("list check run annotations: %w", err)
+	}
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("list check run annotations: unexpected status %d", status)
+	}
+	var annotations []CheckAnnotation
+	if err := json.Unmarshal(body, &annotations); err != nil {
+		return nil, fmt.Errorf("parse check run annotations: %w", err)
+	}
+	return annotations, nil
+}
+
+// GetJobLogTail downloads a job's log and returns its last lines lines. The
+// log is streamed so only the tail is held in memory.
+func (c *httpGitHubClient) GetJobLogTail(ctx context.Context, owner, repo string, jobID int64, lines int, token string) (string, error) {
+	url := fmt.Sprintf("%s/repos/%s/%s/actions/jobs/%d/logs", c.root(), owner, repo, jobID)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return "", fmt.Errorf("create request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Accept", "application/vnd.github+json")
+	req.Header.Set("X-GitHub-Api-Version", "2022-11-28")
+
+	// GitHub redirects to a short-lived download URL; net/http drops the
+	// Authorization header when following it to another host.
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("download job log: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("download job log: unexpected status %d", resp.StatusCode)
+	}
+	return tailLines(resp.Body, lines)
+}
+
+// tailLines returns the last n lines of r. Over-long lines are truncated.
+func tailLines(r io.Reader, n int) (string, error) {
+	const maxLine = 64 * 1024
+	ring := make([]string, 0, n)
+	next := 0
+	reader := bufio.NewReaderSize(r, maxLine)
+	for {
+		line, err := reader.ReadSlice('\n')
+		if len(line) > 0 || err == nil {
+			text := strings.TrimRight(string(line), "\r\n")
+			if err == bufio.ErrBufferFull {
+				// Drop the rest of an over-long line.
+				for err == bufio.ErrBufferFull {
+					_, err = reader.ReadSlice('\n')
+				}
+			}
+			if len(ring) < n {
+				ring = append(ring, text)
+			} else {
+				ring[next] = text
+				next = (next + 1) % n
+			}
+		}
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return "", fmt.Errorf("read job log: %w", err)
+		}
+	}
+	return strings.Join(append(ring[next:], ring[:next]...), "\n"), nil
+}
+
+// CancelWorkflowRun cancels a workflow run. force skips always() conditions
+// and other cleanup that would otherwise keep the run going.
+func (c *httpGitHubClient) CancelWorkflowRun(ctx context.Context, owner, repo string, runID int64, force bool, token string) error {
+	action := "cancel"
+	if force {
+		action = "force-cancel"
+	}
+	url := fmt.Sprintf("%s/repos/%s/%s/actions/runs/%d/%s", c.root(), owner, repo, runID, action)
+	body, status, err := c.doRequest(ctx, http.MethodPost, url, nil, token)
+	if err != nil {
+		return fmt.Errorf("cancel workflow run: %w", err)
+	}
+	if status != http.StatusAccepted {
+		return fmt.Errorf("cancel workflow run: %s", unexpectedStatus(status, body))
+	}
+	return nil
+}
+
+// RerunWorkflowRun re-runs a workflow run, or only its failed jobs and their
+// dependents when failedOnly is set.
+func (c *httpGitHubClient) RerunWorkflowRun(ctx context.Context, owner, repo string, runID int64, failedOnly, debugLogging bool, token string) error {
+	action := "rerun"
+	if failedOnly {
+		action = "rerun-failed-jobs"
+	}
+	url := fmt.Sprintf("%s/repos/%s/%s/actions/runs/%d/%s", c.root(), owner, repo, runID, action)
+	payload := map[string]any{"enable_debug_logging": debugLogging}
+	body, status, err := c.doRequest(ctx, http.MethodPost, url, payload, token)
+	if err != nil {
+		return fmt.Errorf("re-run workflow run: %w", err)
+	}
+	if status != http.StatusCreated {
+		return fmt.Errorf("re-run workflow run: %s", unexpectedStatus(status, body))
+	}
+	return nil
+}
+
+// ListPendingDeployments lists the deployments of a workflow run that wait
+// for environment approval.
+func (c *httpGitHubClient) ListPendingDeployments(ctx context.Context, owner, repo string, runID int64, token string) ([]PendingDeployment, error) {
+	url := fmt.Sprintf("%s/repos/%s/%s/actions/runs/%d/pending_deployments", c.root(), owner, repo, runID)
+	body, status, err := c.doRequest(ctx, http.MethodGet, url, nil, token)
+	if err != nil {
+		return nil, fmt.Errorf("list pending deployments: %w", err)
+	}
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("list pending deployments: %s", unexpectedStatus(status, body))
+	}
+	var pending []PendingDeployment
+	if err := json.Unmarshal(body, &pending); err != nil {
+		return nil, fmt.Errorf("parse pending deployments: %w", err)
+	}
+	return pending, nil
+}
+
+// ReviewPendingDeployments approves or rejects the pending deployments of a
+// workflow run to the given environments. state is "approved" or "rejected".
+func (c *httpGitHubClient) ReviewPendingDeployments(ctx context.Context, owner, repo string, runID int64, environmentIDs []int64, state, comment, token string) error {
+	url := fmt.Sprintf("%s/repos/%s/%s/actions/runs/%d/pending_deployments", c.root(), owner, repo, runID)
+	payload := map[string]any{
+		"environment_ids": environmentIDs,
+		"state":           state,
+		"comment":         comment,
+	}
+	body, status, err := c.doRequest(ctx, http.MethodPost, url, payload, token)
+	if err != nil {
+		return fmt.Errorf("review pending deployments: %w", err)
+	}
+	if status != http.StatusOK {
+		return fmt.Errorf("review pending deployments: %s", unexpectedStatus(status, body))
+	}
+	return nil
+}
+
+// ListRunArtifacts lists the artifacts of a workflow run.
+func (c *httpGitHubClient) ListRunArtifacts(ctx context.Context, owner, repo string, runID int64, token string) ([]WorkflowArtifact, error) {
+	const perPage = 100
+	var artifacts []WorkflowArtifact
+	for page := 1; ; page++ {
+		url := fmt.Sprintf("%s/repos/%s/%s/actions/runs/%d/artifacts?per_page=%d&page=%d", c.root(), owner, repo, runID, perPage, page)
+		body, status, err := c.doRequest(ctx, http.MethodGet, url, nil, token)
+		if err != nil {
+			return nil, fmt.Errorf("list run artifacts: %w", err)
+		}
+		if status != http.StatusOK {
+			return nil, fmt.Errorf("list run artifacts: %s", unexpectedStatus(status, body))
+		}
+		var out struct {
+			TotalCount int                `json:"total_count"`
+			Artifacts  []WorkflowArtifact `json:"artifacts"`
+		}
+		if err := json.Unmarshal(body, &out); err != nil {
+			return nil, fmt.Errorf("parse run artifacts: %w", err)
+		}
+		artifacts = append(artifacts, out.Artifacts...)
+		if len(out.Artifacts) < perPage || len(artifacts) >= out.TotalCount {
+			return artifacts, nil
+		}
+	}
+}
+
+// DownloadArtifact writes an artifact's zip archive to dst and returns its
+// size. It fails once the archive grows past maxBytes.
+func (c *httpGitHubClient) DownloadArtifact(ctx context.Context, owner, repo string, artifactID int64, dst io.Writer, maxBytes int64, token string) (int64, error) {
+	url := fmt.Sprintf("%s/repos/%s/%s/actions/artifacts/%d/zip", c.root(), owner, repo, artifactID)
+	// Archives can take longer than an API call to stream.
+	ctx = withAttemptTimeout(ctx, githubTransferTimeout)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return 0, fmt.Errorf("create request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Accept", "application/vnd.github+json")
+	req.Header.Set("X-GitHub-Api-Version", "2022-11-28")
+
+	// As with job logs, the Authorization header is dropped when following
+	// the redirect to the storage host.
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("download artifact: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("download artifact: unexpected status %d", resp.StatusCode)
+	}
+	n, err := io.Copy(dst, io.LimitReader(resp.Body, maxBytes+1))
+	if err != nil {
+		return n, fmt.Errorf("download artifact: %w", err)
+	}
+	if n > maxBytes {
+		return n, fmt.Errorf("download artifact: archive exceeds %d bytes", maxBytes)
+	}
+	return n, nil
+}
+
+// GetRateLimits returns the rate limits of the token's resources. The request
+// itself does not count against any limit.
+func (c *httpGitHubClient) GetRateLimits(ctx context.Context, token string) (map[string]RateLimitResource, error) {
+	body, status, err := c.doRequest(ctx, http.MethodGet, c.root()+"/rate_limit", nil, token)
+	if err != nil {
+		return nil, fmt.Errorf("get rate limits: %w", err)
+	}
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("get rate limits: %s", unexpectedStatus(status, body))
+	}
+	var out struct {
+		Resources map[string]RateLimitResource `json:"resources"`
+	}
+	if err := json.Unmarshal(body, &out); err != nil {
+		return nil, fmt.Errorf("parse rate limits: %w", err)
+	}
+	return out.Resources, nil
+}
+
+// unexpectedStatus describes a failed response, including GitHub's error
+// message when the body carries one.
+func unexpectedStatus(status int, body []byte) string {
+	var apiErr struct {
+		Message string `json:"message"`
+	}
+	if json.Unmarshal(body, &apiErr) == nil && apiErr.Message != "" {
+		return fmt.Sprintf("unexpected status %d: %s", status, apiErr.Message)
+	}
+	return fmt.Sprintf("unexpected status %d", status)
+}
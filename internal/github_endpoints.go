@@ -0,0 +1,127 @@
+package internal
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+)
+
+// githubEndpoints locates the GitHub deployment a step or module talks to.
+// The zero value targets github.com. A GitHub Enterprise Server instance sets
+// api_base_url (e.g. https://ghe.example.com/api/v3); upload_url and
+// graphql_url default to the instance's /api/uploads and /api/graphql.
+//
+// Config:
+//
+//	api_base_url: "https://ghe.example.com/api/v3"
+//	upload_url:   "https://ghe.example.com/api/uploads"   # optional
+//	graphql_url:  "https://ghe.example.com/api/graphql"   # optional
+type githubEndpoints struct {
+	APIBaseURL string `yaml:"api_base_url"`
+	UploadURL  string `yaml:"upload_url"`
+	GraphQLURL string `yaml:"graphql_url"`
+}
+
+const (
+	defaultGitHubUploadURL  = "https://uploads.github.com"
+	defaultGitHubGraphQLURL = "https://api.github.com/graphql"
+)
+
+// parseGitHubEndpoints reads and validates api_base_url, upload_url, and
+// graphql_url from a raw config.
+func parseGitHubEndpoints(raw map[string]any) (githubEndpoints, error) {
+	var e githubEndpoints
+	for key, dst := range map[string]*string{
+		"api_base_url": &e.APIBaseURL,
+		"upload_url":   &e.UploadURL,
+		"graphql_url":  &e.GraphQLURL,
+	} {
+		value, _ := raw[key].(string)
+		if err := validateGitHubURL(key, value); err != nil {
+			return e, err
+		}
+		*dst = strings.TrimRight(strings.TrimSpace(value), "/")
+	}
+	return e, nil
+}
+
+// validateGitHubURL checks that value, the config field key, is an absolute
+// https URL (http is allowed for loopback development endpoints). An empty
+// value is valid and selects the default.
+func validateGitHubURL(key, value string) error {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return nil
+	}
+	parsed, err := url.Parse(value)
+	if err != nil || parsed.Host == "" || parsed.User != nil || parsed.RawQuery != "" || parsed.Fragment != "" {
+		return fmt.Errorf("config.%s must be an absolute GitHub API URL without credentials, query, or fragment", key)
+	}
+	if parsed.Scheme == "https" {
+		return nil
+	}
+	host := parsed.Hostname()
+	if parsed.Scheme == "http" && (strings.EqualFold(host, "localhost") || net.ParseIP(host).IsLoopback()) {
+		return nil
+	}
+	return errors.New("config." + key + " must use https except for loopback development endpoints")
+}
+
+// over returns e with the fields it leaves unset taken from base, so a step
+// that only sets graphql_url stays on its auth_module's instance. When e
+// names a different API root, base's upload and GraphQL URLs belong to
+// another instance and are derived from e's API root instead.
+func (e githubEndpoints) over(base githubEndpoints) githubEndpoints {
+	if e.APIBaseURL != "" && e.APIBaseURL != base.APIBaseURL {
+		base = githubEndpoints{}
+	}
+	merged := base
+	if e.APIBaseURL != "" {
+		merged.APIBaseURL = e.APIBaseURL
+	}
+	if e.UploadURL != "" {
+		merged.UploadURL = e.UploadURL
+	}
+	if e.GraphQLURL != "" {
+		merged.GraphQLURL = e.GraphQLURL
+	}
+	return merged
+}
+
+// apiBaseURL returns the REST API root without a trailing slash.
+func (e githubEndpoints) apiBaseURL() string {
+	if e.APIBaseURL == "" {
+		return defaultGitHubAPIBaseURL
+	}
+	return e.APIBaseURL
+}
+
+// uploadURL returns the release asset upload root without a trailing slash.
+func (e githubEndpoints) uploadURL() string {
+	switch {
+	case e.UploadURL != "":
+		return e.UploadURL
+	case e.APIBaseURL == "":
+		return defaultGitHubUploadURL
+	case strings.HasSuffix(e.APIBaseURL, "/api/v3"):
+		return strings.TrimSuffix(e.APIBaseURL, "/v3") + "/uploads"
+	default:
+		return e.APIBaseURL
+	}
+}
+
+// graphqlURL returns the GraphQL endpoint.
+func (e githubEndpoints) graphqlURL() string {
+	switch {
+	case e.GraphQLURL != "":
+		return e.GraphQLURL
+	case e.APIBaseURL == "":
+		return defaultGitHubGraphQLURL
+	case strings.HasSuffix(e.APIBaseURL, "/api/v3"):
+		return strings.TrimSuffix(e.APIBaseURL, "/v3") + "/graphql"
+	default:
+		return e.APIBaseURL + "/graphql"
+	}
+}
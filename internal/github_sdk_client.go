@@ -2,6 +2,7 @@ package internal
 
 import (
 	"net/http"
+	"net/url"
 
 	"github.com/google/go-github/v69/github"
 )
@@ -13,7 +14,7 @@ type SDKClient struct {
 
 // NewSDKClient creates a client authenticated with a personal access token.
 func NewSDKClient(token string) *SDKClient {
-	client := github.NewClient(nil).WithAuthToken(token)
+	client := github.NewClient(newGitHubHTTPClient()).WithAuthToken(token)
 	return &SDKClient{GH: client}
 }
 
@@ -23,3 +24,18 @@ func NewSDKClientFromTransport(rt http.RoundTripper) *SDKClient {
 	client := github.NewClient(httpClient)
 	return &SDKClient{GH: client}
 }
+
+// withEndpoints points c at the REST and upload roots of e. Roots e leaves
+// unset keep c's current ones, so e must already be merged over the
+// endpoints c was built for (see githubEndpoints.over).
+func (c *SDKClient) withEndpoints(e githubEndpoints) *SDKClient {
+	// parseGitHubEndpoints validated both URLs; go-github requires the
+	// trailing slash.
+	if e.APIBaseURL != "" {
+		c.GH.BaseURL, _ = url.Parse(e.apiBaseURL() + "/")
+	}
+	if e.APIBaseURL != "" || e.UploadURL != "" {
+		c.GH.UploadURL, _ = url.Parse(e.uploadURL() + "/")
+	}
+	return c
+}
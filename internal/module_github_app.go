@@ -4,39 +4,203 @@ import (
 	"context"
 	"crypto/rsa"
 	"crypto/x509"
+	"encoding/json"
 	"encoding/pem"
+	"errors"
 	"fmt"
 	"net/http"
 	"os"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/go-github/v69/github"
 
 	sdk "github.com/GoCodeAlone/workflow/plugin/external/sdk"
 )
 
 // githubAppModule implements sdk.ModuleInstance.
 // It manages GitHub App authentication, generating installation access tokens
-// from an App's private key and installation ID.
+// from an App's private key. A module bound to installation_id always mints
+// tokens for that installation; without it the module discovers the App's
+// installations and selects one per repository owner. repositories and
+// permissions down-scope every token the module mints; steps may narrow them
+// further with token_repositories and token_permissions. An App registered on
+// GitHub Enterprise Server sets api_base_url; tokens are minted there and
+// steps using the module call that instance unless they set their own.
 //
 // Module config:
 //
 //	app_id:          12345
-//	installation_id: 67890
+//	installation_id: 67890                        # optional; omit to look up per owner
 //	private_key:     "${GITHUB_APP_PRIVATE_KEY}"  # PEM-encoded RSA key
+//	repositories:    ["workflow"]                 # optional; repository names
+//	permissions:                                  # optional; permission → read|write|admin
+//	  contents: read
+//	  pull_requests: write
+//	api_base_url:    "https://ghe.example.com/api/v3"  # optional; also upload_url, graphql_url
 type githubAppModule struct {
 	name   string
 	config githubAppConfig
 
-	// cached token and expiry for reuse within the valid window
-	cachedToken  string
-	tokenExpiry  time.Time
+	// newClient builds API clients for JWT and installation calls; tests
+	// replace it to target an httptest server.
+	newClient func(token string) *SDKClient
+
+	mu sync.Mutex
+	// tokens caches installation tokens by installation and scope for reuse
+	// within the valid window.
+	tokens map[tokenCacheKey]installationToken
+	// installations maps a lower-cased owner login to its installation ID.
+	installations map[string]int64
 }
 
 type githubAppConfig struct {
 	AppID          int64  `yaml:"app_id"`
 	InstallationID int64  `yaml:"installation_id"`
 	PrivateKey     string `yaml:"private_key"`
+	Scope          tokenScope
+	Endpoints      githubEndpoints
+}
+
+// tokenScope narrows an installation token to a subset of the installation's
+// repositories and permissions. The zero value requests the installation's
+// full access.
+type tokenScope struct {
+	Repositories []string          `yaml:"repositories"`
+	Permissions  map[string]string `yaml:"permissions"`
+}
+
+// parseTokenScope validates the repositories and permissions of a scope.
+// reposKey and permsKey name the config fields in error messages.
+func parseTokenScope(raw map[string]any, reposKey, permsKey string) (tokenScope, error) {
+	var scope tokenScope
+	repos, err := stringListArg(raw[reposKey])
+	if err != nil {
+		return scope, fmt.Errorf("config.%s: %w", reposKey, err)
+	}
+	for _, repo := range repos {
+		if repo == "" || strings.Contains(repo, "/") {
+			return scope, fmt.Errorf("config.%s: %q must be a repository name without owner", reposKey, repo)
+		}
+	}
+	scope.Repositories = repos
+
+	perms, err := stringMapArg(raw[permsKey])
+	if err != nil {
+		return scope, fmt.Errorf("config.%s: %w", permsKey, err)
+	}
+	for name, level := range perms {
+		switch level {
+		case "read", "write", "admin":
+		default:
+			return scope, fmt.Errorf("config.%s: %q must be read, write, or admin", permsKey, name)
+		}
+		// Decode each entry strictly so unknown permission names fail at
+		// config time instead of being dropped from the token request.
+		dec := json.NewDecoder(strings.NewReader(fmt.Sprintf("{%q:%q}", name, level)))
+		dec.DisallowUnknownFields()
+		if err := dec.Decode(new(github.InstallationPermissions)); err != nil {
+			return scope, fmt.Errorf("config.%s: unknown permission %q", permsKey, name)
+		}
+	}
+	if len(perms) > 0 {
+		scope.Permissions = perms
+	}
+	return scope, nil
+}
+
+// isZero reports whether the scope requests the installation's full access.
+func (s tokenScope) isZero() bool {
+	return len(s.Repositories) == 0 && len(s.Permissions) == 0
+}
+
+// permissionRank orders permission levels from weakest to strongest.
+var permissionRank = map[string]int{"read": 1, "write": 2, "admin": 3}
+
+// narrow returns the intersection of s and the step scope step. A step may
+// only drop repositories and permissions or lower permission levels; asking
+// for anything outside s is an error.
+func (s tokenScope) narrow(step tokenScope) (tokenScope, error) {
+	if len(step.Repositories) > 0 {
+		if len(s.Repositories) > 0 {
+			for _, repo := range step.Repositories {
+				if !slices.ContainsFunc(s.Repositories, func(r string) bool { return strings.EqualFold(r, repo) }) {
+					return s, fmt.Errorf("token_repositories: %q is outside the module's repositories", repo)
+				}
+			}
+		}
+		s.Repositories = step.Repositories
+	}
+	if len(step.Permissions) > 0 {
+		if len(s.Permissions) > 0 {
+			for name, level := range step.Permissions {
+				granted, ok := s.Permissions[name]
+				if !ok {
+					return s, fmt.Errorf("token_permissions: %q is outside the module's permissions", name)
+				}
+				if permissionRank[level] > permissionRank[granted] {
+					return s, fmt.Errorf("token_permissions: %s %q exceeds the module's %q", name, level, granted)
+				}
+			}
+		}
+		s.Permissions = step.Permissions
+	}
+	return s, nil
+}
+
+// key returns a canonical cache key for the scope.
+func (s tokenScope) key() string {
+	repos := append([]string(nil), s.Repositories...)
+	for i := range repos {
+		repos[i] = strings.ToLower(repos[i])
+	}
+	sort.Strings(repos)
+	perms := make([]string, 0, len(s.Permissions))
+	for name, level := range s.Permissions {
+		perms = append(perms, name+"="+level)
+	}
+	sort.Strings(perms)
+	return strings.Join(repos, ",") + ";" + strings.Join(perms, ",")
+}
+
+// options returns the token request body for the scope, or nil for full access.
+func (s tokenScope) options() (*github.InstallationTokenOptions, error) {
+	if s.isZero() {
+		return nil, nil
+	}
+	opts := &github.InstallationTokenOptions{Repositories: s.Repositories}
+	if len(s.Permissions) > 0 {
+		data, err := json.Marshal(s.Permissions)
+		if err != nil {
+			return nil, err
+		}
+		opts.Permissions = new(github.InstallationPermissions)
+		if err := json.Unmarshal(data, opts.Permissions); err != nil {
+			return nil, err
+		}
+	}
+	return opts, nil
+}
+
+// tokenCacheKey identifies a cached token by installation and scope.
+type tokenCacheKey struct {
+	installation int64
+	scope        string
+}
+
+// installationToken is a cached installation access token.
+type installationToken struct {
+	token     string
+	expiresAt time.Time
+}
+
+// valid reports whether the token can be reused (expires in >5 minutes).
+func (t installationToken) valid() bool {
+	return t.token != "" && time.Until(t.expiresAt) > 5*time.Minute
 }
 
 // newGitHubAppModule parses the config map and returns a githubAppModule.
@@ -63,8 +227,8 @@ func newGitHubAppModule(name string, config map[string]any) (*githubAppModule, e
 	case float64:
 		cfg.InstallationID = int64(v)
 	}
-	if cfg.InstallationID == 0 {
-		return nil, fmt.Errorf("github.app %q: config.installation_id is required", name)
+	if cfg.InstallationID < 0 {
+		return nil, fmt.Errorf("github.app %q: config.installation_id must be positive", name)
 	}
 
 	cfg.PrivateKey, _ = config["private_key"].(string)
@@ -73,26 +237,165 @@ func newGitHubAppModule(name string, config map[string]any) (*githubAppModule, e
 		return nil, fmt.Errorf("github.app %q: config.private_key is required", name)
 	}
 
-	return &githubAppModule{name: name, config: cfg}, nil
+	scope, err := parseTokenScope(config, "repositories", "permissions")
+	if err != nil {
+		return nil, fmt.Errorf("github.app %q: %w", name, err)
+	}
+	cfg.Scope = scope
+
+	endpoints, err := parseGitHubEndpoints(config)
+	if err != nil {
+		return nil, fmt.Errorf("github.app %q: %w", name, err)
+	}
+	cfg.Endpoints = endpoints
+
+	return &githubAppModule{
+		name:   name,
+		config: cfg,
+		newClient: func(token string) *SDKClient {
+			return NewSDKClient(token).withEndpoints(endpoints)
+		},
+		tokens:        make(map[tokenCacheKey]installationToken),
+		installations: make(map[string]int64),
+	}, nil
 }
 
-// Init is a no-op; the module is ready after construction.
-func (m *githubAppModule) Init() error { return nil }
+// Init registers the module so steps can reference it via auth_module.
+func (m *githubAppModule) Init() error {
+	registerGitHubAppModule(m)
+	return nil
+}
 
 // Start is a no-op.
 func (m *githubAppModule) Start(_ context.Context) error { return nil }
 
-// Stop is a no-op.
-func (m *githubAppModule) Stop(_ context.Context) error { return nil }
+// Stop unregisters the module; steps referencing it fail until it is re-initialised.
+func (m *githubAppModule) Stop(_ context.Context) error {
+	unregisterGitHubAppModule(m)
+	return nil
+}
 
 // Name returns the module name.
 func (m *githubAppModule) Name() string { return m.name }
 
-// GetInstallationToken returns a valid GitHub App installation access token,
-// using a cached value if it is still valid (expires in >5 minutes).
+// GetInstallationToken returns a valid installation access token for the
+// configured installation_id.
 func (m *githubAppModule) GetInstallationToken(ctx context.Context) (string, error) {
-	if m.cachedToken != "" && time.Until(m.tokenExpiry) > 5*time.Minute {
-		return m.cachedToken, nil
+	return m.GetInstallationTokenForOwner(ctx, "")
+}
+
+// GetInstallationTokenForOwner returns a valid installation access token for
+// the installation that covers owner, using a cached value if it is still
+// valid. owner is ignored when the module is bound to installation_id.
+func (m *githubAppModule) GetInstallationTokenForOwner(ctx context.Context, owner string) (string, error) {
+	return m.GetScopedInstallationToken(ctx, owner, tokenScope{})
+}
+
+// GetScopedInstallationToken returns an installation access token for owner
+// limited to the module's scope narrowed by scope. Tokens are cached per
+// installation and scope.
+func (m *githubAppModule) GetScopedInstallationToken(ctx context.Context, owner string, scope tokenScope) (string, error) {
+	scope, err := m.config.Scope.narrow(scope)
+	if err != nil {
+		return "", err
+	}
+	id, err := m.installationID(ctx, owner)
+	if err != nil {
+		return "", err
+	}
+	token, err := m.installationToken(ctx, id, scope)
+	if err == nil || m.config.InstallationID != 0 || !isGitHubNotFound(err) {
+		return token, err
+	}
+
+	// The App was uninstalled from (or reinstalled on) owner since the
+	// installation map was built; rediscover once and retry.
+	if err := m.refreshInstallations(ctx); err != nil {
+		return "", err
+	}
+	id, err = m.installationID(ctx, owner)
+	if err != nil {
+		return "", err
+	}
+	return m.installationToken(ctx, id, scope)
+}
+
+// installationID resolves the installation used for owner.
+func (m *githubAppModule) installationID(ctx context.Context, owner string) (int64, error) {
+	if m.config.InstallationID != 0 {
+		return m.config.InstallationID, nil
+	}
+	if owner == "" {
+		return 0, fmt.Errorf("github.app %q: owner is required to select an installation when installation_id is not configured", m.name)
+	}
+	key := strings.ToLower(owner)
+
+	m.mu.Lock()
+	id, ok := m.installations[key]
+	m.mu.Unlock()
+	if ok {
+		return id, nil
+	}
+
+	if err := m.refreshInstallations(ctx); err != nil {
+		return 0, err
+	}
+	m.mu.Lock()
+	id, ok = m.installations[key]
+	m.mu.Unlock()
+	if !ok {
+		return 0, fmt.Errorf("github.app %q: app is not installed on %q", m.name, owner)
+	}
+	return id, nil
+}
+
+// refreshInstallations replaces the owner → installation map with the App's
+// current installations.
+func (m *githubAppModule) refreshInstallations(ctx context.Context) error {
+	jwtToken, err := m.generateJWT()
+	if err != nil {
+		return fmt.Errorf("generate app JWT: %w", err)
+	}
+
+	client := m.newClient(jwtToken)
+	installations := make(map[string]int64)
+	opts := &github.ListOptions{PerPage: 100}
+	for page := 0; page < maxGitHubPaginationPages; page++ {
+		batch, resp, err := client.GH.Apps.ListInstallations(ctx, opts)
+		if err != nil {
+			return fmt.Errorf("list app installations: %w", err)
+		}
+		for _, inst := range batch {
+			if login := inst.GetAccount().GetLogin(); login != "" {
+				installations[strings.ToLower(login)] = inst.GetID()
+			}
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	m.mu.Lock()
+	m.installations = installations
+	m.mu.Unlock()
+	return nil
+}
+
+// installationToken returns a cached or freshly minted token for installation
+// id limited to scope.
+func (m *githubAppModule) installationToken(ctx context.Context, id int64, scope tokenScope) (string, error) {
+	key := tokenCacheKey{installation: id, scope: scope.key()}
+	m.mu.Lock()
+	cached := m.tokens[key]
+	m.mu.Unlock()
+	if cached.valid() {
+		return cached.token, nil
+	}
+
+	opts, err := scope.options()
+	if err != nil {
+		return "", fmt.Errorf("build token scope: %w", err)
 	}
 
 	jwtToken, err := m.generateJWT()
@@ -100,15 +403,23 @@ func (m *githubAppModule) GetInstallationToken(ctx context.Context) (string, err
 		return "", fmt.Errorf("generate app JWT: %w", err)
 	}
 
-	client := NewSDKClient(jwtToken)
-	token, _, err := client.GH.Apps.CreateInstallationToken(ctx, m.config.InstallationID, nil)
+	client := m.newClient(jwtToken)
+	token, _, err := client.GH.Apps.CreateInstallationToken(ctx, id, opts)
 	if err != nil {
 		return "", fmt.Errorf("create installation token: %w", err)
 	}
 
-	m.cachedToken = token.GetToken()
-	m.tokenExpiry = token.GetExpiresAt().Time
-	return m.cachedToken, nil
+	minted := installationToken{token: token.GetToken(), expiresAt: token.GetExpiresAt().Time}
+	m.mu.Lock()
+	m.tokens[key] = minted
+	m.mu.Unlock()
+	return minted.token, nil
+}
+
+// isGitHubNotFound reports whether err is a 404 response from the GitHub API.
+func isGitHubNotFound(err error) bool {
+	var respErr *github.ErrorResponse
+	return errors.As(err, &respErr) && respErr.Response != nil && respErr.Response.StatusCode == http.StatusNotFound
 }
 
 // generateJWT creates a short-lived JWT for GitHub App authentication.
@@ -154,18 +465,32 @@ func parseRSAPrivateKey(pem_encoded string) (*rsa.PrivateKey, error) {
 // AppTransport implements http.RoundTripper for GitHub App authentication,
 // automatically refreshing the installation token as needed.
 type AppTransport struct {
-	module    *githubAppModule
-	base      http.RoundTripper
+	module *githubAppModule
+	owner  string
+	scope  tokenScope
+	base   http.RoundTripper
 }
 
 // NewAppTransport creates an http.RoundTripper that uses App installation tokens.
 func NewAppTransport(mod *githubAppModule) *AppTransport {
-	return &AppTransport{module: mod, base: http.DefaultTransport}
+	return NewAppTransportForOwner(mod, "")
+}
+
+// NewAppTransportForOwner creates an http.RoundTripper that uses the
+// installation token of the installation covering owner.
+func NewAppTransportForOwner(mod *githubAppModule, owner string) *AppTransport {
+	return NewScopedAppTransport(mod, owner, tokenScope{})
+}
+
+// NewScopedAppTransport creates an http.RoundTripper that uses installation
+// tokens for owner limited to scope.
+func NewScopedAppTransport(mod *githubAppModule, owner string, scope tokenScope) *AppTransport {
+	return &AppTransport{module: mod, owner: owner, scope: scope, base: sharedGitHubTransport}
 }
 
 // RoundTrip injects the installation token into each request.
 func (t *AppTransport) RoundTrip(req *http.Request) (*http.Response, error) {
-	token, err := t.module.GetInstallationToken(req.Context())
+	token, err := t.module.GetScopedInstallationToken(req.Context(), t.owner, t.scope)
 	if err != nil {
 		return nil, fmt.Errorf("get installation token: %w", err)
 	}
@@ -176,7 +501,63 @@ func (t *AppTransport) RoundTrip(req *http.Request) (*http.Response, error) {
 
 // GetSDKClient returns an SDK client authenticated with this App's installation token.
 func (m *githubAppModule) GetSDKClient() *SDKClient {
-	return NewSDKClientFromTransport(NewAppTransport(m))
+	return NewSDKClientFromTransport(NewAppTransport(m)).withEndpoints(m.config.Endpoints)
+}
+
+// GetSDKClientForOwner returns an SDK client authenticated with the
+// installation token for owner.
+func (m *githubAppModule) GetSDKClientForOwner(owner string) *SDKClient {
+	return NewSDKClientFromTransport(NewAppTransportForOwner(m, owner)).withEndpoints(m.config.Endpoints)
+}
+
+// GetScopedSDKClient returns an SDK client authenticated with installation
+// tokens for owner limited to scope.
+func (m *githubAppModule) GetScopedSDKClient(owner string, scope tokenScope) *SDKClient {
+	return NewSDKClientFromTransport(NewScopedAppTransport(m, owner, scope)).withEndpoints(m.config.Endpoints)
+}
+
+// GetAppSDKClient returns an SDK client authenticated as the App itself with a
+// freshly signed JWT, for App-level endpoints such as webhook deliveries. The
+// JWT is valid for ten minutes.
+func (m *githubAppModule) GetAppSDKClient() (*SDKClient, error) {
+	jwtToken, err := m.generateJWT()
+	if err != nil {
+		return nil, fmt.Errorf("generate app JWT: %w", err)
+	}
+	return m.newClient(jwtToken), nil
+}
+
+// githubAppModules indexes initialised github.app modules by name. Modules and
+// steps are created inside the same plugin process, so steps resolve their
+// auth_module reference here at execution time.
+var githubAppModules = struct {
+	sync.RWMutex
+	byName map[string]*githubAppModule
+}{byName: make(map[string]*githubAppModule)}
+
+// registerGitHubAppModule makes m available to steps under its module name.
+func registerGitHubAppModule(m *githubAppModule) {
+	githubAppModules.Lock()
+	defer githubAppModules.Unlock()
+	githubAppModules.byName[m.name] = m
+}
+
+// unregisterGitHubAppModule removes m unless another instance has since
+// registered under the same name.
+func unregisterGitHubAppModule(m *githubAppModule) {
+	githubAppModules.Lock()
+	defer githubAppModules.Unlock()
+	if githubAppModules.byName[m.name] == m {
+		delete(githubAppModules.byName, m.name)
+	}
+}
+
+// lookupGitHubAppModule returns the registered github.app module called name.
+func lookupGitHubAppModule(name string) (*githubAppModule, bool) {
+	githubAppModules.RLock()
+	defer githubAppModules.RUnlock()
+	m, ok := githubAppModules.byName[name]
+	return m, ok
 }
 
 // Ensure githubAppModule satisfies sdk.ModuleInstance at compile time.
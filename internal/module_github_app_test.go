@@ -0,0 +1,450 @@
+package internal
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v69/github"
+)
+
+// testAppPrivateKey returns a PEM-encoded RSA key for signing test App JWTs.
+func testAppPrivateKey(t *testing.T) string {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("generate RSA key: %v", err)
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)}))
+}
+
+// newTestAppServerModule returns a github.app module whose API calls target srv.
+func newTestAppServerModule(t *testing.T, srv *httptest.Server, config map[string]any) *githubAppModule {
+	t.Helper()
+	config["app_id"] = 1
+	config["private_key"] = testAppPrivateKey(t)
+	m, err := newGitHubAppModule("test-app", config)
+	if err != nil {
+		t.Fatalf("newGitHubAppModule: %v", err)
+	}
+	base, err := url.Parse(srv.URL + "/")
+	if err != nil {
+		t.Fatalf("parse server URL: %v", err)
+	}
+	m.newClient = func(token string) *SDKClient {
+		c := NewSDKClient(token)
+		c.GH.BaseURL = base
+		return c
+	}
+	return m
+}
+
+// fakeAppAPI serves the installation list and token endpoints of the GitHub App API.
+type fakeAppAPI struct {
+	mu            sync.Mutex
+	installations map[string]int64 // login → installation ID
+	gone          map[int64]bool   // installation IDs that answer 404
+	scopes        []string         // non-empty token request bodies, in order
+	listCalls     atomic.Int32
+	tokenCalls    atomic.Int32
+}
+
+func (f *fakeAppAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !strings.HasPrefix(r.Header.Get("Authorization"), "Bearer ") {
+		http.Error(w, "missing JWT", http.StatusUnauthorized)
+		return
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	switch {
+	case r.Method == http.MethodGet && r.URL.Path == "/app/installations":
+		f.listCalls.Add(1)
+		var out []map[string]any
+		for login, id := range f.installations {
+			out = append(out, map[string]any{"id": id, "account": map[string]any{"login": login}})
+		}
+		_ = json.NewEncoder(w).Encode(out)
+	case r.Method == http.MethodPost && strings.HasPrefix(r.URL.Path, "/app/installations/"):
+		f.tokenCalls.Add(1)
+		var id int64
+		_, _ = fmt.Sscanf(r.URL.Path, "/app/installations/%d/access_tokens", &id)
+		if f.gone[id] {
+			http.Error(w, `{"message":"Not Found"}`, http.StatusNotFound)
+			return
+		}
+		token := fmt.Sprintf("tok-%d", id)
+		body, _ := io.ReadAll(r.Body)
+		if scope := strings.TrimSpace(string(body)); scope != "" && scope != "null" {
+			f.scopes = append(f.scopes, scope)
+			token = fmt.Sprintf("%s-scoped-%d", token, len(f.scopes))
+		}
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"token":      token,
+			"expires_at": time.Now().Add(time.Hour).UTC().Format(time.RFC3339),
+		})
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func TestGitHubAppModule_InstallationIDOptional(t *testing.T) {
+	m, err := newGitHubAppModule("app", map[string]any{"app_id": 1, "private_key": "pem"})
+	if err != nil {
+		t.Fatalf("newGitHubAppModule: %v", err)
+	}
+	if m.config.InstallationID != 0 {
+		t.Errorf("expected no installation_id, got %d", m.config.InstallationID)
+	}
+}
+
+func TestGitHubAppModule_PerOwnerInstallationLookup(t *testing.T) {
+	api := &fakeAppAPI{installations: map[string]int64{"GoCodeAlone": 11, "octocat": 22}}
+	srv := httptest.NewServer(api)
+	defer srv.Close()
+	m := newTestAppServerModule(t, srv, map[string]any{})
+
+	token, err := m.GetInstallationTokenForOwner(context.Background(), "gocodealone")
+	if err != nil {
+		t.Fatalf("GetInstallationTokenForOwner: %v", err)
+	}
+	if token != "tok-11" {
+		t.Errorf("expected tok-11, got %q", token)
+	}
+	token, err = m.GetInstallationTokenForOwner(context.Background(), "octocat")
+	if err != nil {
+		t.Fatalf("GetInstallationTokenForOwner: %v", err)
+	}
+	if token != "tok-22" {
+		t.Errorf("expected tok-22, got %q", token)
+	}
+
+	// Cached tokens and installation map are reused.
+	if _, err := m.GetInstallationTokenForOwner(context.Background(), "GoCodeAlone"); err != nil {
+		t.Fatalf("GetInstallationTokenForOwner: %v", err)
+	}
+	if got := api.listCalls.Load(); got != 1 {
+		t.Errorf("expected 1 installation list call, got %d", got)
+	}
+	if got := api.tokenCalls.Load(); got != 2 {
+		t.Errorf("expected 2 token calls, got %d", got)
+	}
+}
+
+func TestGitHubAppModule_UnknownOwner(t *testing.T) {
+	api := &fakeAppAPI{installations: map[string]int64{"GoCodeAlone": 11}}
+	srv := httptest.NewServer(api)
+	defer srv.Close()
+	m := newTestAppServerModule(t, srv, map[string]any{})
+
+	if _, err := m.GetInstallationTokenForOwner(context.Background(), "someone-else"); err == nil {
+		t.Error("expected error for an owner without an installation")
+	}
+	if _, err := m.GetInstallationToken(context.Background()); err == nil {
+		t.Error("expected error when neither owner nor installation_id is available")
+	}
+}
+
+func TestGitHubAppModule_RefreshesInstallationsOn404(t *testing.T) {
+	api := &fakeAppAPI{installations: map[string]int64{"GoCodeAlone": 11}}
+	srv := httptest.NewServer(api)
+	defer srv.Close()
+	m := newTestAppServerModule(t, srv, map[string]any{})
+
+	if _, err := m.GetInstallationTokenForOwner(context.Background(), "GoCodeAlone"); err != nil {
+		t.Fatalf("GetInstallationTokenForOwner: %v", err)
+	}
+
+	// The App is reinstalled: the old installation disappears and the cached
+	// token is no longer usable.
+	api.mu.Lock()
+	api.installations["GoCodeAlone"] = 33
+	api.gone = map[int64]bool{11: true}
+	api.mu.Unlock()
+	m.mu.Lock()
+	clear(m.tokens)
+	m.mu.Unlock()
+
+	token, err := m.GetInstallationTokenForOwner(context.Background(), "GoCodeAlone")
+	if err != nil {
+		t.Fatalf("GetInstallationTokenForOwner after reinstall: %v", err)
+	}
+	if token != "tok-33" {
+		t.Errorf("expected tok-33, got %q", token)
+	}
+	if got := api.listCalls.Load(); got != 2 {
+		t.Errorf("expected installation list refresh, got %d list calls", got)
+	}
+}
+
+func TestGitHubAppModule_FixedInstallationIgnoresOwner(t *testing.T) {
+	api := &fakeAppAPI{}
+	srv := httptest.NewServer(api)
+	defer srv.Close()
+	m := newTestAppServerModule(t, srv, map[string]any{"installation_id": 44})
+
+	token, err := m.GetInstallationTokenForOwner(context.Background(), "anyone")
+	if err != nil {
+		t.Fatalf("GetInstallationTokenForOwner: %v", err)
+	}
+	if token != "tok-44" {
+		t.Errorf("expected tok-44, got %q", token)
+	}
+	if got := api.listCalls.Load(); got != 0 {
+		t.Errorf("expected no installation discovery, got %d list calls", got)
+	}
+}
+
+func TestGitHubAppModule_EnterpriseEndpoints(t *testing.T) {
+	api := &fakeAppAPI{}
+	srv := httptest.NewServer(http.StripPrefix("/api/v3", api))
+	defer srv.Close()
+	m, err := newGitHubAppModule("ghe-app", map[string]any{
+		"app_id":          1,
+		"installation_id": 7,
+		"private_key":     testAppPrivateKey(t),
+		"api_base_url":    srv.URL + "/api/v3",
+	})
+	if err != nil {
+		t.Fatalf("newGitHubAppModule: %v", err)
+	}
+	if err := m.Init(); err != nil {
+		t.Fatalf("Init: %v", err)
+	}
+	t.Cleanup(func() { _ = m.Stop(context.Background()) })
+
+	token, err := m.GetInstallationToken(context.Background())
+	if err != nil {
+		t.Fatalf("GetInstallationToken: %v", err)
+	}
+	if token != "tok-7" {
+		t.Errorf("expected the token to be minted on the instance, got %q", token)
+	}
+
+	// A step using the module follows its endpoints unless it sets its own.
+	auth, err := parseStepAuth(map[string]any{"auth_module": "ghe-app"})
+	if err != nil {
+		t.Fatalf("parseStepAuth: %v", err)
+	}
+	if got := auth.endpoints().graphqlURL(); got != srv.URL+"/api/graphql" {
+		t.Errorf("expected the module's GraphQL endpoint, got %s", got)
+	}
+	client, err := auth.sdkClient("")
+	if err != nil {
+		t.Fatalf("sdkClient: %v", err)
+	}
+	if got := client.GH.BaseURL.String(); got != srv.URL+"/api/v3/" {
+		t.Errorf("expected the module's REST root, got %s", got)
+	}
+	auth.Endpoints.APIBaseURL = "https://other.example.com/api/v3"
+	if got := auth.apiBaseURL(); got != auth.Endpoints.APIBaseURL {
+		t.Errorf("expected the step's api_base_url to win, got %s", got)
+	}
+	if got := auth.endpoints().graphqlURL(); got != "https://other.example.com/api/graphql" {
+		t.Errorf("expected the GraphQL endpoint of the step's instance, got %s", got)
+	}
+
+	// A step that only overrides graphql_url or upload_url stays on the
+	// module's instance for everything else.
+	for _, key := range []string{"graphql_url", "upload_url"} {
+		auth, err := parseStepAuth(map[string]any{"auth_module": "ghe-app", key: srv.URL + "/custom"})
+		if err != nil {
+			t.Fatalf("parseStepAuth: %v", err)
+		}
+		if got := auth.apiBaseURL(); got != srv.URL+"/api/v3" {
+			t.Errorf("%s: expected the module's REST root, got %s", key, got)
+		}
+		client, err := auth.sdkClient("")
+		if err != nil {
+			t.Fatalf("sdkClient: %v", err)
+		}
+		if got := client.GH.BaseURL.String(); got != srv.URL+"/api/v3/" {
+			t.Errorf("%s: expected the client on the module's REST root, got %s", key, got)
+		}
+		wantUpload, wantGraphQL := srv.URL+"/api/uploads/", srv.URL+"/custom"
+		if key == "upload_url" {
+			wantUpload, wantGraphQL = srv.URL+"/custom/", srv.URL+"/api/graphql"
+		}
+		if got := client.GH.UploadURL.String(); got != wantUpload {
+			t.Errorf("%s: expected upload root %s, got %s", key, wantUpload, got)
+		}
+		if got := auth.endpoints().graphqlURL(); got != wantGraphQL {
+			t.Errorf("%s: expected GraphQL endpoint %s, got %s", key, wantGraphQL, got)
+		}
+	}
+}
+
+func TestGitHubAppModule_ConcurrentTokenRequests(t *testing.T) {
+	api := &fakeAppAPI{installations: map[string]int64{"a": 1, "b": 2, "c": 3}}
+	srv := httptest.NewServer(api)
+	defer srv.Close()
+	m := newTestAppServerModule(t, srv, map[string]any{})
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 30)
+	for i := 0; i < 30; i++ {
+		owner := []string{"a", "b", "c"}[i%3]
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := m.GetInstallationTokenForOwner(context.Background(), owner); err != nil {
+				errs <- err
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		t.Errorf("concurrent GetInstallationTokenForOwner: %v", err)
+	}
+}
+
+func TestGitHubAppModule_ScopedTokensCachedPerScope(t *testing.T) {
+	api := &fakeAppAPI{}
+	srv := httptest.NewServer(api)
+	defer srv.Close()
+	m := newTestAppServerModule(t, srv, map[string]any{
+		"installation_id": 7,
+		"permissions":     map[string]any{"contents": "read"},
+	})
+	ctx := context.Background()
+
+	moduleScoped, err := m.GetInstallationToken(ctx)
+	if err != nil {
+		t.Fatalf("GetInstallationToken: %v", err)
+	}
+	repoScope := tokenScope{
+		Repositories: []string{"workflow"},
+		Permissions:  map[string]string{"contents": "read"},
+	}
+	stepScoped, err := m.GetScopedInstallationToken(ctx, "", repoScope)
+	if err != nil {
+		t.Fatalf("GetScopedInstallationToken: %v", err)
+	}
+	if moduleScoped == stepScoped {
+		t.Errorf("expected distinct tokens per scope, both were %q", moduleScoped)
+	}
+
+	// Same scope with reordered fields hits the cache.
+	again, err := m.GetScopedInstallationToken(ctx, "", tokenScope{
+		Repositories: []string{"Workflow"},
+		Permissions:  map[string]string{"contents": "read"},
+	})
+	if err != nil {
+		t.Fatalf("GetScopedInstallationToken: %v", err)
+	}
+	if again != stepScoped {
+		t.Errorf("expected cached token %q, got %q", stepScoped, again)
+	}
+	if got := api.tokenCalls.Load(); got != 2 {
+		t.Errorf("expected 2 token calls, got %d", got)
+	}
+
+	api.mu.Lock()
+	defer api.mu.Unlock()
+	if len(api.scopes) != 2 {
+		t.Fatalf("expected 2 scoped token requests, got %d", len(api.scopes))
+	}
+	var first, second github.InstallationTokenOptions
+	if err := json.Unmarshal([]byte(api.scopes[0]), &first); err != nil {
+		t.Fatalf("decode module scope: %v", err)
+	}
+	if first.GetPermissions().GetContents() != "read" || len(first.Repositories) != 0 {
+		t.Errorf("unexpected module scope request: %s", api.scopes[0])
+	}
+	if err := json.Unmarshal([]byte(api.scopes[1]), &second); err != nil {
+		t.Fatalf("decode step scope: %v", err)
+	}
+	if second.GetPermissions().GetContents() != "read" {
+		t.Errorf("expected the step to keep contents: read: %s", api.scopes[1])
+	}
+	if len(second.Repositories) != 1 || second.Repositories[0] != "workflow" {
+		t.Errorf("expected repositories [workflow], got %v", second.Repositories)
+	}
+}
+
+func TestGitHubAppModule_StepScopeCannotExceedModuleScope(t *testing.T) {
+	api := &fakeAppAPI{}
+	srv := httptest.NewServer(api)
+	defer srv.Close()
+	m := newTestAppServerModule(t, srv, map[string]any{
+		"installation_id": 7,
+		"repositories":    []any{"workflow"},
+		"permissions":     map[string]any{"contents": "write", "issues": "read"},
+	})
+	ctx := context.Background()
+
+	for name, scope := range map[string]tokenScope{
+		"new permission":   {Permissions: map[string]string{"pull_requests": "write"}},
+		"higher level":     {Permissions: map[string]string{"issues": "write"}},
+		"other repository": {Repositories: []string{"workflow-plugin-github"}},
+	} {
+		if _, err := m.GetScopedInstallationToken(ctx, "", scope); err == nil {
+			t.Errorf("%s: expected the step scope to be rejected", name)
+		}
+	}
+	if got := api.tokenCalls.Load(); got != 0 {
+		t.Errorf("expected no token requests, got %d", got)
+	}
+
+	// A weaker level of a granted permission is an intersection.
+	if _, err := m.GetScopedInstallationToken(ctx, "", tokenScope{Permissions: map[string]string{"contents": "read"}}); err != nil {
+		t.Fatalf("GetScopedInstallationToken: %v", err)
+	}
+	api.mu.Lock()
+	defer api.mu.Unlock()
+	var opts github.InstallationTokenOptions
+	if err := json.Unmarshal([]byte(api.scopes[0]), &opts); err != nil {
+		t.Fatalf("decode scope: %v", err)
+	}
+	if opts.GetPermissions().GetContents() != "read" || opts.GetPermissions().Issues != nil {
+		t.Errorf("expected only contents: read, got %s", api.scopes[0])
+	}
+	if len(opts.Repositories) != 1 || opts.Repositories[0] != "workflow" {
+		t.Errorf("expected the module repositories to apply, got %v", opts.Repositories)
+	}
+}
+
+func TestParseTokenScope(t *testing.T) {
+	tests := []struct {
+		name    string
+		raw     map[string]any
+		wantErr string
+	}{
+		{name: "empty", raw: map[string]any{}},
+		{name: "valid", raw: map[string]any{
+			"repositories": []any{"workflow", "workflow-plugin-github"},
+			"permissions":  map[string]any{"contents": "read", "pull_requests": "write"},
+		}},
+		{name: "owner in repository", raw: map[string]any{"repositories": []any{"GoCodeAlone/workflow"}}, wantErr: "without owner"},
+		{name: "unknown permission", raw: map[string]any{"permissions": map[string]any{"everything": "write"}}, wantErr: "unknown permission"},
+		{name: "invalid level", raw: map[string]any{"permissions": map[string]any{"contents": "all"}}, wantErr: "read, write, or admin"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parseTokenScope(tt.raw, "repositories", "permissions")
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
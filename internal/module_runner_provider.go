@@ -11,7 +11,6 @@ import (
 	"errors"
 	"fmt"
 	"io"
-	"net"
 	"net/http"
 	"net/url"
 	"os"
@@ -192,10 +191,8 @@ func newHTTPGitHubRunnerClient(baseURL string) GitHubRunnerClient {
 		baseURL = defaultGitHubAPIBaseURL
 	}
 	return &httpGitHubRunnerClient{
-		baseURL: strings.TrimRight(baseURL, "/"),
-		httpClient: &http.Client{
-			Timeout: 30 * time.Second,
-		},
+		baseURL:    strings.TrimRight(baseURL, "/"),
+		httpClient: newGitHubHTTPClient(),
 	}
 }
 
@@ -742,11 +739,31 @@ func (c *httpGitHubRunnerClient) DispatchWorkflow(ctx context.Context, owner, re
 	if out.WorkflowRunID <= 0 || strings.TrimSpace(out.RunURL) == "" || strings.TrimSpace(out.HTMLURL) == "" {
 		return GitHubWorkflowDispatch{}, errors.New("GitHub workflow dispatch response missing run identity")
 	}
-	var run GitHubWorkflowRun
-	var verificationErr error
+	run, verificationErr := fetchDispatchedRun(ctx, func(ctx context.Context) (GitHubWorkflowRun, error) {
+		return c.GetWorkflowRun(ctx, owner, repo, out.WorkflowRunID, token)
+	})
+	if verificationErr != nil {
+		out.Verification = "uncertain"
+		return out, fmt.Errorf("%w: %v", errWorkflowDispatchVerificationUncertain, verificationErr)
+	}
+	runPath, _, _ := strings.Cut(strings.TrimSpace(run.Path), "@")
+	if strings.TrimPrefix(runPath, ".github/workflows/") != wantWorkflow || !strings.EqualFold(strings.TrimSpace(run.HeadSHA), expectedHeadSHA) {
+		return GitHubWorkflowDispatch{}, fmt.Errorf("dispatched workflow run identity does not match authorized workflow and head SHA")
+	}
+	out.ValidatedHeadSHA = strings.ToLower(expectedHeadSHA)
+	out.Verification = "verified"
+	return out, nil
+}
+
+// fetchDispatchedRun fetches the run a workflow dispatch returned, retrying
+// while GitHub does not serve the new run yet. Both the runner provider and
+// step.gh_action_trigger correlate a dispatch to its run this way.
+func fetchDispatchedRun[R any](ctx context.Context, get func(context.Context) (R, error)) (R, error) {
+	var run R
+	var err error
 	for attempt := 0; attempt < workflowDispatchVerificationAttempts; attempt++ {
-		run, verificationErr = c.GetWorkflowRun(ctx, owner, repo, out.WorkflowRunID, token)
-		if verificationErr == nil {
+		run, err = get(ctx)
+		if err == nil {
 			break
 		}
 		if attempt == workflowDispatchVerificationAttempts-1 {
@@ -762,21 +779,11 @@ func (c *httpGitHubRunnerClient) DispatchWorkflow(ctx context.Context, owner, re
 				default:
 				}
 			}
-			verificationErr = errors.Join(verificationErr, ctx.Err())
+			err = errors.Join(err, ctx.Err())
 			attempt = workflowDispatchVerificationAttempts - 1
 		}
 	}
-	if verificationErr != nil {
-		out.Verification = "uncertain"
-		return out, fmt.Errorf("%w: %v", errWorkflowDispatchVerificationUncertain, verificationErr)
-	}
-	runPath, _, _ := strings.Cut(strings.TrimSpace(run.Path), "@")
-	if strings.TrimPrefix(runPath, ".github/workflows/") != wantWorkflow || !strings.EqualFold(strings.TrimSpace(run.HeadSHA), expectedHeadSHA) {
-		return GitHubWorkflowDispatch{}, fmt.Errorf("dispatched workflow run identity does not match authorized workflow and head SHA")
-	}
-	out.ValidatedHeadSHA = strings.ToLower(expectedHeadSHA)
-	out.Verification = "verified"
-	return out, nil
+	return run, err
 }
 
 func (c *httpGitHubRunnerClient) ListWorkflowRuns(ctx context.Context, owner, repo, workflow string, createdAfter time.Time, token string) ([]GitHubWorkflowRun, error) {
@@ -1010,7 +1017,7 @@ func newGitHubRunnerProviderModule(name string, raw map[string]any, client GitHu
 	if cfg.APIBaseURL != strings.TrimSpace(cfg.APIBaseURL) {
 		return nil, fmt.Errorf("github.runner_provider %q: config.api_base_url must not contain surrounding whitespace", name)
 	}
-	if err := validateGitHubAPIBaseURL(cfg.APIBaseURL); err != nil {
+	if err := validateGitHubURL("api_base_url", cfg.APIBaseURL); err != nil {
 		return nil, fmt.Errorf("github.runner_provider %q: %w", name, err)
 	}
 	repositories, err := parseRunnerProviderRepositories(raw["repositories"])
@@ -1065,25 +1072,6 @@ func newGitHubRunnerProviderModule(name string, raw map[string]any, client GitHu
 	return module, nil
 }
 
-func validateGitHubAPIBaseURL(value string) error {
-	value = strings.TrimSpace(value)
-	if value == "" {
-		return nil
-	}
-	parsed, err := url.Parse(value)
-	if err != nil || parsed.Host == "" || parsed.User != nil || parsed.RawQuery != "" || parsed.Fragment != "" {
-		return errors.New("config.api_base_url must be an absolute GitHub API URL without credentials, query, or fragment")
-	}
-	if parsed.Scheme == "https" {
-		return nil
-	}
-	host := parsed.Hostname()
-	if parsed.Scheme == "http" && (strings.EqualFold(host, "localhost") || net.ParseIP(host).IsLoopback()) {
-		return nil
-	}
-	return errors.New("config.api_base_url must use https except for loopback development endpoints")
-}
-
 type GitHubRunnerProviderHTTPService struct {
 	module  *githubRunnerProviderModule
 	handler http.Handler
@@ -1,6 +1,7 @@
 package internal
 
 import (
+	"bytes"
 	"context"
 	"crypto/hmac"
 	"crypto/sha256"
@@ -9,8 +10,11 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"log"
 	"net/http"
+	"net/url"
 	"strings"
+	"sync"
 	"time"
 
 	sdk "github.com/GoCodeAlone/workflow/plugin/external/sdk"
@@ -18,26 +22,46 @@ import (
 
 // GitEvent is the normalized event schema published to the message broker.
 type GitEvent struct {
-	Provider   string          `json:"provider"`    // "github"
-	EventType  string          `json:"event_type"`  // "push", "pull_request", etc.
-	Repository string          `json:"repository"`  // "owner/repo"
-	Branch     string          `json:"branch"`      // "main", "feature/xyz"
-	Commit     string          `json:"commit"`      // SHA
-	Author     string          `json:"author"`      // username
-	Message    string          `json:"message"`     // commit message or PR title
-	URL        string          `json:"url"`         // link to commit/PR
-	RawPayload json.RawMessage `json:"raw_payload"` // original payload
-	Timestamp  time.Time       `json:"timestamp"`
+	Provider    string          `json:"provider"`     // "github"
+	EventType   string          `json:"event_type"`   // "push", "pull_request", etc.
+	Action      string          `json:"action"`       // payload action, e.g. "opened", "completed"
+	DeliveryID  string          `json:"delivery_id"`  // X-GitHub-Delivery GUID
+	Repository  string          `json:"repository"`   // "owner/repo"
+	Branch      string          `json:"branch"`       // "main", "feature/xyz"
+	BaseBranch  string          `json:"base_branch"`  // PR or merge group target branch
+	Tag         string          `json:"tag"`          // "v1.2.0" for tag pushes, tag refs, and releases
+	Commit      string          `json:"commit"`       // SHA
+	Author      string          `json:"author"`       // username
+	Sender      string          `json:"sender"`       // login that triggered the delivery
+	Message     string          `json:"message"`      // commit message, PR/issue title, or comment body
+	URL         string          `json:"url"`          // link to commit/PR
+	PRNumber    int             `json:"pr_number"`    // pull request number
+	IssueNumber int             `json:"issue_number"` // issue number; also set for comments on PRs
+	Labels      []string        `json:"labels"`       // issue/PR labels, or runner labels for workflow_job
+	Environment string          `json:"environment"`  // deployment environment
+	Status      string          `json:"status"`       // run, check, review, deployment, or commit status state
+	Conclusion  string          `json:"conclusion"`   // run or check conclusion, e.g. "success"
+	RawPayload  json.RawMessage `json:"raw_payload"`  // original payload
+	Timestamp   time.Time       `json:"timestamp"`
 }
 
+// defaultWebhookPath is the route declared by the plugin's config fragment.
+const defaultWebhookPath = "/webhooks/github"
+
 // webhookModule implements sdk.ModuleInstance and sdk.MessageAwareModule.
-// It registers an HTTP handler at /webhooks/github that validates GitHub
-// webhook signatures and publishes normalized GitEvent messages to a topic.
+// It validates GitHub webhook signatures and publishes normalized GitEvent
+// messages to a topic. Requests reach the module on the engine's HTTP route
+// for the module's path, forwarded by step.gh_webhook_forward and
+// step.http_proxy or delivered by step.gh_webhook_receive.
 type webhookModule struct {
 	name   string
 	config webhookConfig
 
-	publisher sdk.MessagePublisher
+	publisher  sdk.MessagePublisher
+	deliveries *webhookDeliveryStore
+	// queue holds accepted events until they are published; nil unless
+	// async_publish is set.
+	queue *webhookQueue
 }
 
 // webhookConfig holds the parsed configuration for a git.webhook module.
@@ -46,6 +70,32 @@ type webhookConfig struct {
 	Secret   string   `yaml:"secret"`
 	Events   []string `yaml:"events"`
 	Topic    string   `yaml:"topic"`
+	Path     string   `yaml:"path"`
+	// Secrets are every accepted secret, including Secret, in the order they
+	// are tried.
+	Secrets []webhookSecret `yaml:"secrets"`
+	// AllowSHA1Signature accepts the legacy X-Hub-Signature header when no
+	// X-Hub-Signature-256 header is sent.
+	AllowSHA1Signature bool `yaml:"allow_sha1_signature"`
+	// DedupWindow is how long delivery IDs are remembered; 0 disables
+	// de-duplication.
+	DedupWindow     time.Duration `yaml:"dedup_window"`
+	DedupMaxEntries int           `yaml:"dedup_max_entries"`
+	// StateDir persists remembered delivery IDs, and queued events when
+	// AsyncPublish is set, across restarts.
+	StateDir string `yaml:"state_dir"`
+	// AsyncPublish acknowledges deliveries once they are queued in StateDir
+	// and publishes them in the background, retrying with backoff between
+	// RetryInitialBackoff and RetryMaxBackoff.
+	AsyncPublish        bool          `yaml:"async_publish"`
+	RetryInitialBackoff time.Duration `yaml:"retry_initial_backoff"`
+	RetryMaxBackoff     time.Duration `yaml:"retry_max_backoff"`
+	// Filter holds the parsed events list and repository, branch, sender,
+	// and label predicates.
+	Filter webhookFilter `yaml:"-"`
+	// Routes send matching events to their own topics; unmatched events go
+	// to Topic. Both may use {event_type}-style placeholders.
+	Routes []webhookRoute `yaml:"-"`
 }
 
 // newWebhookModule parses the config map and returns a webhookModule.
@@ -54,10 +104,15 @@ func newWebhookModule(name string, config map[string]any) (*webhookModule, error
 	if err != nil {
 		return nil, fmt.Errorf("git.webhook %q: %w", name, err)
 	}
-	return &webhookModule{
-		name:   name,
-		config: cfg,
-	}, nil
+	m := &webhookModule{
+		name:       name,
+		config:     cfg,
+		deliveries: newWebhookDeliveryStore(cfg.DedupWindow, cfg.DedupMaxEntries, cfg.StateDir),
+	}
+	if cfg.AsyncPublish {
+		m.queue = newWebhookQueue(cfg.StateDir, cfg.RetryInitialBackoff, cfg.RetryMaxBackoff)
+	}
+	return m, nil
 }
 
 // parseWebhookConfig converts a raw config map to webhookConfig.
@@ -71,6 +126,9 @@ func parseWebhookConfig(raw map[string]any) (webhookConfig, error) {
 	cfg.Provider = provider
 
 	cfg.Secret, _ = raw["secret"].(string)
+	if err := parseWebhookSecrets(raw, &cfg); err != nil {
+		return cfg, err
+	}
 
 	if events, ok := raw["events"].([]any); ok {
 		for _, e := range events {
@@ -86,6 +144,31 @@ func parseWebhookConfig(raw map[string]any) (webhookConfig, error) {
 	}
 	cfg.Topic = topic
 
+	path, _ := raw["path"].(string)
+	if path == "" {
+		path = defaultWebhookPath
+	}
+	if !strings.HasPrefix(path, "/") {
+		return cfg, fmt.Errorf("config.path must start with /")
+	}
+	cfg.Path = path
+
+	if err := parseWebhookRoutes(raw, &cfg); err != nil {
+		return cfg, err
+	}
+
+	if err := parseWebhookFilterConfig(raw, &cfg); err != nil {
+		return cfg, err
+	}
+
+	if err := parseWebhookDedupConfig(raw, &cfg); err != nil {
+		return cfg, err
+	}
+
+	if err := parseWebhookAsyncConfig(raw, &cfg); err != nil {
+		return cfg, err
+	}
+
 	return cfg, nil
 }
 
@@ -97,19 +180,68 @@ func (m *webhookModule) SetMessagePublisher(pub sdk.MessagePublisher) {
 // SetMessageSubscriber is a no-op; this module only publishes.
 func (m *webhookModule) SetMessageSubscriber(_ sdk.MessageSubscriber) {}
 
-// Init is a no-op; the module is ready after construction.
-func (m *webhookModule) Init() error { return nil }
+// Init loads persisted delivery IDs and queued events and registers the
+// module so the webhook steps can deliver to it.
+func (m *webhookModule) Init() error {
+	if err := m.deliveries.open(); err != nil {
+		return fmt.Errorf("git.webhook %q: %w", m.name, err)
+	}
+	if m.queue != nil {
+		if err := m.queue.open(); err != nil {
+			_ = m.deliveries.close()
+			return fmt.Errorf("git.webhook %q: %w", m.name, err)
+		}
+	}
+	registerWebhookModule(m)
+	return nil
+}
 
-// Start is a no-op; the webhook route is declared via ConfigFragment so the
-// engine's HTTP server registers it through the normal config pipeline.
-func (m *webhookModule) Start(_ context.Context) error { return nil }
+// Start begins draining the publish queue when async_publish is set. The
+// webhook route is declared via ConfigFragment so the engine's HTTP server
+// registers it through the normal config pipeline.
+func (m *webhookModule) Start(_ context.Context) error {
+	if m.queue != nil {
+		m.queue.start(m.publish)
+	}
+	return nil
+}
 
-// Stop is a no-op.
-func (m *webhookModule) Stop(_ context.Context) error { return nil }
+// Stop unregisters the module, stops draining the queue, and releases its
+// state_dir. Unpublished events stay queued for the next start.
+func (m *webhookModule) Stop(_ context.Context) error {
+	unregisterWebhookModule(m)
+	return errors.Join(m.queue.close(), m.deliveries.close())
+}
 
 // Name returns the module name.
 func (m *webhookModule) Name() string { return m.name }
 
+// webhookDelivery is a single webhook request as seen by the module,
+// independent of whether it arrived over HTTP or through a pipeline step.
+type webhookDelivery struct {
+	EventType  string
+	DeliveryID string
+	Signature  string
+	Body       []byte
+	// SignatureVerified skips HMAC validation because an earlier pipeline
+	// step (step.webhook_verify) already checked the raw request body.
+	SignatureVerified bool
+	// SignatureSHA1 is the legacy X-Hub-Signature header.
+	SignatureSHA1 string
+	// Secret names the secret that verified the signature; set by receive.
+	Secret string
+}
+
+// webhookOutcome is the result of processing a delivery.
+type webhookOutcome struct {
+	Status int       // HTTP status to answer GitHub with
+	Result string    // "accepted", "queued", "ignored", or "duplicate"; empty when rejected
+	Err    string    // rejection reason
+	Event  *GitEvent // normalized event; nil when ignored or rejected
+	Topic  string    // topic the event was routed to; empty unless accepted
+	Secret string    // name of the secret that verified the signature
+}
+
 // handleWebhook is the HTTP handler for incoming GitHub webhook events.
 func (m *webhookModule) handleWebhook(w http.ResponseWriter, r *http.Request) {
 	if r.Method != http.MethodPost {
@@ -123,58 +255,140 @@ func (m *webhookModule) handleWebhook(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Validate HMAC-SHA256 signature when a secret is configured.
-	if m.config.Secret != "" {
-		sig := r.Header.Get("X-Hub-Signature-256")
-		if sig == "" {
-			http.Error(w, "missing X-Hub-Signature-256 header", http.StatusUnauthorized)
-			return
+	outcome := m.receive(webhookDelivery{
+		EventType:     r.Header.Get("X-GitHub-Event"),
+		DeliveryID:    r.Header.Get("X-GitHub-Delivery"),
+		Signature:     r.Header.Get("X-Hub-Signature-256"),
+		SignatureSHA1: r.Header.Get("X-Hub-Signature"),
+		Body:          body,
+	})
+	if outcome.Err != "" {
+		http.Error(w, outcome.Err, outcome.Status)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(outcome.Status)
+	_, _ = fmt.Fprintf(w, `{"status":%q}`, outcome.Result)
+}
+
+// receive validates, de-duplicates, filters, normalizes, and publishes a
+// delivery.
+func (m *webhookModule) receive(d webhookDelivery) webhookOutcome {
+	// Validate the HMAC signature against every accepted secret.
+	if len(m.config.Secrets) > 0 && !d.SignatureVerified {
+		if d.Signature == "" && (!m.config.AllowSHA1Signature || d.SignatureSHA1 == "") {
+			return webhookOutcome{Status: http.StatusUnauthorized, Err: "missing X-Hub-Signature-256 header"}
 		}
-		if !validateSignature(body, m.config.Secret, sig) {
-			http.Error(w, "invalid signature", http.StatusUnauthorized)
-			return
+		name, ok := m.config.verifySignature(d.Body, d.Signature, d.SignatureSHA1, time.Now())
+		if !ok {
+			return webhookOutcome{Status: http.StatusUnauthorized, Err: "invalid signature"}
+		}
+		d.Secret = name
+		if name != m.config.Secrets[0].Name {
+			log.Printf("git.webhook %q: delivery %q verified with secret %q", m.name, d.DeliveryID, name)
 		}
 	}
 
-	eventType := r.Header.Get("X-GitHub-Event")
-	if eventType == "" {
-		http.Error(w, "missing X-GitHub-Event header", http.StatusBadRequest)
-		return
+	if d.EventType == "" {
+		return webhookOutcome{Status: http.StatusBadRequest, Err: "missing X-GitHub-Event header"}
 	}
+	d.Body = webhookJSON(d.Body)
+
+	// Drop GitHub redeliveries of an already accepted delivery. The ID is
+	// only recorded once the delivery is handled, so a failed attempt can be
+	// retried.
+	if d.DeliveryID != "" && m.deliveries.enabled() {
+		if !m.deliveries.begin(d.DeliveryID) {
+			return webhookOutcome{Status: http.StatusOK, Result: "duplicate"}
+		}
+		outcome := m.process(d)
+		if outcome.Err != "" {
+			m.deliveries.release(d.DeliveryID)
+			return outcome
+		}
+		if err := m.deliveries.commit(d.DeliveryID); err != nil {
+			return webhookOutcome{Status: http.StatusInternalServerError, Err: fmt.Sprintf("failed to record delivery: %v", err)}
+		}
+		return outcome
+	}
+	return m.process(d)
+}
 
-	// Filter to configured event types if specified.
-	if len(m.config.Events) > 0 && !containsString(m.config.Events, eventType) {
-		w.WriteHeader(http.StatusOK)
-		_, _ = w.Write([]byte(`{"status":"ignored"}`))
-		return
+// webhookJSON returns the JSON event of a delivery body. GitHub sends hooks
+// with content type application/x-www-form-urlencoded as a single payload
+// form field; the signature covers the encoded form.
+func webhookJSON(body []byte) []byte {
+	trimmed := bytes.TrimSpace(body)
+	if len(trimmed) == 0 || trimmed[0] == '{' {
+		return body
 	}
+	form, err := url.ParseQuery(string(trimmed))
+	if err != nil || !form.Has("payload") {
+		return body
+	}
+	return []byte(form.Get("payload"))
+}
 
-	event, err := normalizeGitHubEvent(eventType, body)
+// process filters, normalizes, and publishes an authenticated delivery.
+func (m *webhookModule) process(d webhookDelivery) webhookOutcome {
+	// Filter to configured event types before parsing the payload.
+	if !m.config.Filter.acceptsEventType(d.EventType) {
+		return webhookOutcome{Status: http.StatusOK, Result: "ignored"}
+	}
+
+	event, err := normalizeGitHubEvent(d.EventType, d.Body)
 	if err != nil {
-		http.Error(w, fmt.Sprintf("failed to normalize event: %v", err), http.StatusBadRequest)
-		return
+		return webhookOutcome{Status: http.StatusBadRequest, Err: fmt.Sprintf("failed to normalize event: %v", err)}
 	}
+	event.DeliveryID = d.DeliveryID
 
-	if m.publisher != nil {
+	// Apply action, repository, branch, sender, and label filters.
+	if !m.config.Filter.accepts(event) {
+		return webhookOutcome{Status: http.StatusOK, Result: "ignored"}
+	}
+
+	topic := m.config.topicFor(event)
+	metadata := map[string]string{
+		"action":      event.Action,
+		"delivery_id": event.DeliveryID,
+		"event_type":  event.EventType,
+		"provider":    event.Provider,
+		"repository":  event.Repository,
+	}
+
+	// With async_publish, acknowledge once the event is durably queued.
+	if m.queue != nil {
 		payload, err := json.Marshal(event)
 		if err != nil {
-			http.Error(w, "failed to marshal event", http.StatusInternalServerError)
-			return
+			return webhookOutcome{Status: http.StatusInternalServerError, Err: "failed to marshal event"}
+		}
+		if err := m.queue.enqueue(topic, payload, metadata); err != nil {
+			return webhookOutcome{Status: http.StatusInternalServerError, Err: fmt.Sprintf("failed to queue event: %v", err)}
 		}
-		_, err = m.publisher.Publish(m.config.Topic, payload, map[string]string{
-			"event_type": event.EventType,
-			"provider":   event.Provider,
-			"repository": event.Repository,
-		})
+		return webhookOutcome{Status: http.StatusAccepted, Result: "queued", Event: event, Topic: topic, Secret: d.Secret}
+	}
+
+	if m.publisher != nil {
+		payload, err := json.Marshal(event)
 		if err != nil {
-			http.Error(w, fmt.Sprintf("failed to publish event: %v", err), http.StatusInternalServerError)
-			return
+			return webhookOutcome{Status: http.StatusInternalServerError, Err: "failed to marshal event"}
+		}
+		if err := m.publish(topic, payload, metadata); err != nil {
+			return webhookOutcome{Status: http.StatusInternalServerError, Err: fmt.Sprintf("failed to publish event: %v", err)}
 		}
 	}
 
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(http.StatusOK)
-	_, _ = w.Write([]byte(`{"status":"accepted"}`))
+	return webhookOutcome{Status: http.StatusOK, Result: "accepted", Event: event, Topic: topic, Secret: d.Secret}
+}
+
+// publish sends one event to the message publisher.
+func (m *webhookModule) publish(topic string, payload []byte, metadata map[string]string) error {
+	if m.publisher == nil {
+		return errors.New("no message publisher")
+	}
+	_, err := m.publisher.Publish(topic, payload, metadata)
+	return err
 }
 
 // validateSignature verifies a GitHub webhook HMAC-SHA256 signature.
@@ -213,18 +427,48 @@ func normalizeGitHubEvent(eventType string, body []byte) (*GitEvent, error) {
 	if repo, ok := payload["repository"].(map[string]any); ok {
 		event.Repository, _ = repo["full_name"].(string)
 	}
+	event.Action, _ = payload["action"].(string)
+	if sender, ok := payload["sender"].(map[string]any); ok {
+		event.Sender, _ = sender["login"].(string)
+	}
 
 	switch eventType {
 	case "push":
 		normalizePushEvent(event, payload)
 	case "pull_request":
 		normalizePREvent(event, payload)
+	case "pull_request_review":
+		normalizePRReviewEvent(event, payload)
+	case "pull_request_review_comment":
+		normalizePRReviewCommentEvent(event, payload)
+	case "issues":
+		normalizeIssueEvent(event, payload)
+	case "issue_comment":
+		normalizeIssueCommentEvent(event, payload)
 	case "release":
 		normalizeReleaseEvent(event, payload)
 	case "create", "delete":
 		normalizeRefEvent(event, payload)
-	default:
-		// Best-effort extraction for unknown event types.
+	case "workflow_run":
+		normalizeWorkflowRunEvent(event, payload)
+	case "workflow_job":
+		normalizeWorkflowJobEvent(event, payload)
+	case "check_run":
+		normalizeCheckRunEvent(event, payload)
+	case "check_suite":
+		normalizeCheckSuiteEvent(event, payload)
+	case "deployment":
+		normalizeDeploymentEvent(event, payload)
+	case "deployment_status":
+		normalizeDeploymentStatusEvent(event, payload)
+	case "status":
+		normalizeStatusEvent(event, payload)
+	case "merge_group":
+		normalizeMergeGroupEvent(event, payload)
+	}
+
+	// Fall back to the sender for events without a more specific author.
+	if event.Author == "" {
 		normalizeGenericEvent(event, payload)
 	}
 
@@ -234,8 +478,13 @@ func normalizeGitHubEvent(eventType string, body []byte) (*GitEvent, error) {
 // normalizePushEvent extracts fields from a push event payload.
 func normalizePushEvent(event *GitEvent, payload map[string]any) {
 	ref, _ := payload["ref"].(string)
-	// Convert "refs/heads/main" → "main"
-	event.Branch = strings.TrimPrefix(ref, "refs/heads/")
+	// Convert "refs/heads/main" → "main" and "refs/tags/v1.0.0" → "v1.0.0".
+	// A tag push carries no branch.
+	if tag, ok := strings.CutPrefix(ref, "refs/tags/"); ok {
+		event.Tag = tag
+	} else {
+		event.Branch = strings.TrimPrefix(ref, "refs/heads/")
+	}
 
 	if headCommit, ok := payload["head_commit"].(map[string]any); ok {
 		event.Commit, _ = headCommit["id"].(string)
@@ -255,21 +504,17 @@ func normalizePushEvent(event *GitEvent, payload map[string]any) {
 	if pusher, ok := payload["pusher"].(map[string]any); ok && event.Author == "" {
 		event.Author, _ = pusher["name"].(string)
 	}
-
-	if sender, ok := payload["sender"].(map[string]any); ok && event.Author == "" {
-		event.Author, _ = sender["login"].(string)
-	}
 }
 
 // normalizePREvent extracts fields from a pull_request event payload.
 func normalizePREvent(event *GitEvent, payload map[string]any) {
 	if pr, ok := payload["pull_request"].(map[string]any); ok {
+		normalizePullRequest(event, pr)
 		event.Message, _ = pr["title"].(string)
 		event.URL, _ = pr["html_url"].(string)
-
-		if head, ok := pr["head"].(map[string]any); ok {
-			event.Branch, _ = head["ref"].(string)
-			event.Commit, _ = head["sha"].(string)
+		event.Status, _ = pr["state"].(string)
+		if merged, _ := pr["merged"].(bool); merged {
+			event.Status = "merged"
 		}
 		if user, ok := pr["user"].(map[string]any); ok {
 			event.Author, _ = user["login"].(string)
@@ -277,12 +522,103 @@ func normalizePREvent(event *GitEvent, payload map[string]any) {
 	}
 }
 
+// normalizePRReviewEvent extracts fields from a pull_request_review event
+// payload. Status is the review state, e.g. "approved".
+func normalizePRReviewEvent(event *GitEvent, payload map[string]any) {
+	if pr, ok := payload["pull_request"].(map[string]any); ok {
+		normalizePullRequest(event, pr)
+	}
+	if review, ok := payload["review"].(map[string]any); ok {
+		event.Message, _ = review["body"].(string)
+		event.URL, _ = review["html_url"].(string)
+		event.Status, _ = review["state"].(string)
+		if sha, _ := review["commit_id"].(string); sha != "" {
+			event.Commit = sha
+		}
+		if user, ok := review["user"].(map[string]any); ok {
+			event.Author, _ = user["login"].(string)
+		}
+	}
+}
+
+// normalizePRReviewCommentEvent extracts fields from a
+// pull_request_review_comment event payload.
+func normalizePRReviewCommentEvent(event *GitEvent, payload map[string]any) {
+	if pr, ok := payload["pull_request"].(map[string]any); ok {
+		normalizePullRequest(event, pr)
+	}
+	if comment, ok := payload["comment"].(map[string]any); ok {
+		normalizeComment(event, comment)
+		if sha, _ := comment["commit_id"].(string); sha != "" {
+			event.Commit = sha
+		}
+	}
+}
+
+// normalizePullRequest fills the PR number, head and base branches, head
+// commit, and labels shared by the pull_request* events.
+func normalizePullRequest(event *GitEvent, pr map[string]any) {
+	event.PRNumber = intField(pr, "number")
+	event.Labels = labelNames(pr["labels"])
+	if head, ok := pr["head"].(map[string]any); ok {
+		event.Branch, _ = head["ref"].(string)
+		event.Commit, _ = head["sha"].(string)
+	}
+	if base, ok := pr["base"].(map[string]any); ok {
+		event.BaseBranch, _ = base["ref"].(string)
+	}
+}
+
+// normalizeIssueEvent extracts fields from an issues event payload.
+func normalizeIssueEvent(event *GitEvent, payload map[string]any) {
+	if issue, ok := payload["issue"].(map[string]any); ok {
+		normalizeIssue(event, issue)
+		event.Message, _ = issue["title"].(string)
+		event.URL, _ = issue["html_url"].(string)
+		event.Status, _ = issue["state"].(string)
+		if user, ok := issue["user"].(map[string]any); ok {
+			event.Author, _ = user["login"].(string)
+		}
+	}
+}
+
+// normalizeIssueCommentEvent extracts fields from an issue_comment event
+// payload. Comments on pull requests also set PRNumber.
+func normalizeIssueCommentEvent(event *GitEvent, payload map[string]any) {
+	if issue, ok := payload["issue"].(map[string]any); ok {
+		normalizeIssue(event, issue)
+	}
+	if comment, ok := payload["comment"].(map[string]any); ok {
+		normalizeComment(event, comment)
+	}
+}
+
+// normalizeIssue fills the issue number and labels. GitHub models pull
+// requests as issues, so an issue with a pull_request link also sets PRNumber.
+func normalizeIssue(event *GitEvent, issue map[string]any) {
+	event.IssueNumber = intField(issue, "number")
+	event.Labels = labelNames(issue["labels"])
+	if _, isPR := issue["pull_request"].(map[string]any); isPR {
+		event.PRNumber = event.IssueNumber
+	}
+}
+
+// normalizeComment fills the message, URL, and author from a comment object.
+func normalizeComment(event *GitEvent, comment map[string]any) {
+	event.Message, _ = comment["body"].(string)
+	event.URL, _ = comment["html_url"].(string)
+	if user, ok := comment["user"].(map[string]any); ok {
+		event.Author, _ = user["login"].(string)
+	}
+}
+
 // normalizeReleaseEvent extracts fields from a release event payload.
 func normalizeReleaseEvent(event *GitEvent, payload map[string]any) {
 	if release, ok := payload["release"].(map[string]any); ok {
-		event.Message, _ = release["tag_name"].(string)
+		// A release names a tag, not a branch.
+		event.Tag, _ = release["tag_name"].(string)
+		event.Message = event.Tag
 		event.URL, _ = release["html_url"].(string)
-		event.Branch, _ = release["tag_name"].(string)
 		if author, ok := release["author"].(map[string]any); ok {
 			event.Author, _ = author["login"].(string)
 		}
@@ -291,12 +627,174 @@ func normalizeReleaseEvent(event *GitEvent, payload map[string]any) {
 
 // normalizeRefEvent extracts fields from a create/delete event payload.
 func normalizeRefEvent(event *GitEvent, payload map[string]any) {
-	event.Branch, _ = payload["ref"].(string)
+	ref, _ := payload["ref"].(string)
+	if refType, _ := payload["ref_type"].(string); refType == "tag" {
+		event.Tag = ref
+	} else {
+		event.Branch = ref
+	}
 	if sender, ok := payload["sender"].(map[string]any); ok {
 		event.Author, _ = sender["login"].(string)
 	}
 }
 
+// normalizeWorkflowRunEvent extracts fields from a workflow_run event payload.
+func normalizeWorkflowRunEvent(event *GitEvent, payload map[string]any) {
+	run, ok := payload["workflow_run"].(map[string]any)
+	if !ok {
+		return
+	}
+	event.Branch, _ = run["head_branch"].(string)
+	event.Commit, _ = run["head_sha"].(string)
+	event.URL, _ = run["html_url"].(string)
+	event.Status, _ = run["status"].(string)
+	event.Conclusion, _ = run["conclusion"].(string)
+	if event.Message, _ = run["display_title"].(string); event.Message == "" {
+		event.Message, _ = run["name"].(string)
+	}
+	if actor, ok := run["actor"].(map[string]any); ok {
+		event.Author, _ = actor["login"].(string)
+	}
+	normalizeAssociatedPR(event, run)
+}
+
+// normalizeWorkflowJobEvent extracts fields from a workflow_job event payload.
+// Message is the job name.
+func normalizeWorkflowJobEvent(event *GitEvent, payload map[string]any) {
+	job, ok := payload["workflow_job"].(map[string]any)
+	if !ok {
+		return
+	}
+	event.Branch, _ = job["head_branch"].(string)
+	event.Commit, _ = job["head_sha"].(string)
+	event.URL, _ = job["html_url"].(string)
+	event.Message, _ = job["name"].(string)
+	event.Status, _ = job["status"].(string)
+	event.Conclusion, _ = job["conclusion"].(string)
+	event.Labels = stringSlice(job["labels"])
+}
+
+// normalizeCheckRunEvent extracts fields from a check_run event payload.
+// Message is the check name.
+func normalizeCheckRunEvent(event *GitEvent, payload map[string]any) {
+	check, ok := payload["check_run"].(map[string]any)
+	if !ok {
+		return
+	}
+	event.Commit, _ = check["head_sha"].(string)
+	event.URL, _ = check["html_url"].(string)
+	event.Message, _ = check["name"].(string)
+	event.Status, _ = check["status"].(string)
+	event.Conclusion, _ = check["conclusion"].(string)
+	if suite, ok := check["check_suite"].(map[string]any); ok {
+		event.Branch, _ = suite["head_branch"].(string)
+	}
+	normalizeAssociatedPR(event, check)
+}
+
+// normalizeCheckSuiteEvent extracts fields from a check_suite event payload.
+func normalizeCheckSuiteEvent(event *GitEvent, payload map[string]any) {
+	suite, ok := payload["check_suite"].(map[string]any)
+	if !ok {
+		return
+	}
+	event.Branch, _ = suite["head_branch"].(string)
+	event.Commit, _ = suite["head_sha"].(string)
+	event.Status, _ = suite["status"].(string)
+	event.Conclusion, _ = suite["conclusion"].(string)
+	if app, ok := suite["app"].(map[string]any); ok {
+		event.Message, _ = app["name"].(string)
+	}
+	normalizeAssociatedPR(event, suite)
+}
+
+// normalizeAssociatedPR fills PRNumber and BaseBranch from the first entry of
+// a run's or check's pull_requests list.
+func normalizeAssociatedPR(event *GitEvent, obj map[string]any) {
+	prs, _ := obj["pull_requests"].([]any)
+	if len(prs) == 0 {
+		return
+	}
+	pr, ok := prs[0].(map[string]any)
+	if !ok {
+		return
+	}
+	event.PRNumber = intField(pr, "number")
+	if base, ok := pr["base"].(map[string]any); ok {
+		event.BaseBranch, _ = base["ref"].(string)
+	}
+}
+
+// normalizeDeploymentEvent extracts fields from a deployment event payload.
+func normalizeDeploymentEvent(event *GitEvent, payload map[string]any) {
+	if deployment, ok := payload["deployment"].(map[string]any); ok {
+		normalizeDeployment(event, deployment)
+		event.Message, _ = deployment["description"].(string)
+		if creator, ok := deployment["creator"].(map[string]any); ok {
+			event.Author, _ = creator["login"].(string)
+		}
+	}
+}
+
+// normalizeDeploymentStatusEvent extracts fields from a deployment_status
+// event payload. Status is the deployment state, e.g. "success".
+func normalizeDeploymentStatusEvent(event *GitEvent, payload map[string]any) {
+	if deployment, ok := payload["deployment"].(map[string]any); ok {
+		normalizeDeployment(event, deployment)
+	}
+	if status, ok := payload["deployment_status"].(map[string]any); ok {
+		event.Status, _ = status["state"].(string)
+		event.Message, _ = status["description"].(string)
+		if event.URL, _ = status["target_url"].(string); event.URL == "" {
+			event.URL, _ = status["log_url"].(string)
+		}
+		if env, _ := status["environment"].(string); env != "" {
+			event.Environment = env
+		}
+		if creator, ok := status["creator"].(map[string]any); ok {
+			event.Author, _ = creator["login"].(string)
+		}
+	}
+}
+
+// normalizeDeployment fills the ref, commit, and environment of a deployment.
+func normalizeDeployment(event *GitEvent, deployment map[string]any) {
+	ref, _ := deployment["ref"].(string)
+	event.Branch = strings.TrimPrefix(ref, "refs/heads/")
+	event.Commit, _ = deployment["sha"].(string)
+	event.Environment, _ = deployment["environment"].(string)
+}
+
+// normalizeStatusEvent extracts fields from a commit status event payload.
+// Status is the commit state and Message the status context.
+func normalizeStatusEvent(event *GitEvent, payload map[string]any) {
+	event.Commit, _ = payload["sha"].(string)
+	event.Status, _ = payload["state"].(string)
+	event.Message, _ = payload["context"].(string)
+	event.URL, _ = payload["target_url"].(string)
+	if branches, _ := payload["branches"].([]any); len(branches) == 1 {
+		if branch, ok := branches[0].(map[string]any); ok {
+			event.Branch, _ = branch["name"].(string)
+		}
+	}
+}
+
+// normalizeMergeGroupEvent extracts fields from a merge_group event payload.
+func normalizeMergeGroupEvent(event *GitEvent, payload map[string]any) {
+	group, ok := payload["merge_group"].(map[string]any)
+	if !ok {
+		return
+	}
+	headRef, _ := group["head_ref"].(string)
+	baseRef, _ := group["base_ref"].(string)
+	event.Branch = strings.TrimPrefix(headRef, "refs/heads/")
+	event.BaseBranch = strings.TrimPrefix(baseRef, "refs/heads/")
+	event.Commit, _ = group["head_sha"].(string)
+	if headCommit, ok := group["head_commit"].(map[string]any); ok {
+		event.Message, _ = headCommit["message"].(string)
+	}
+}
+
 // normalizeGenericEvent does best-effort extraction from an unknown event.
 func normalizeGenericEvent(event *GitEvent, payload map[string]any) {
 	if sender, ok := payload["sender"].(map[string]any); ok {
@@ -304,6 +802,38 @@ func normalizeGenericEvent(event *GitEvent, payload map[string]any) {
 	}
 }
 
+// intField returns obj[key] as an int. JSON numbers decode as float64.
+func intField(obj map[string]any, key string) int {
+	n, _ := obj[key].(float64)
+	return int(n)
+}
+
+// labelNames returns the names of a GitHub label list.
+func labelNames(v any) []string {
+	labels, _ := v.([]any)
+	var names []string
+	for _, l := range labels {
+		if label, ok := l.(map[string]any); ok {
+			if name, _ := label["name"].(string); name != "" {
+				names = append(names, name)
+			}
+		}
+	}
+	return names
+}
+
+// stringSlice returns the string elements of a JSON array.
+func stringSlice(v any) []string {
+	items, _ := v.([]any)
+	var out []string
+	for _, item := range items {
+		if s, ok := item.(string); ok {
+			out = append(out, s)
+		}
+	}
+	return out
+}
+
 // readLimitedBody reads up to maxBytes from the request body.
 // It uses io.LimitReader to cap reads safely without requiring a ResponseWriter.
 // If the body is exactly maxBytes, an extra byte is attempted to detect overflow.
@@ -319,12 +849,55 @@ func readLimitedBody(r *http.Request, maxBytes int64) ([]byte, error) {
 	return buf, nil
 }
 
-// containsString reports whether slice contains s.
-func containsString(slice []string, s string) bool {
-	for _, v := range slice {
-		if v == s {
-			return true
+// webhookModules indexes initialised git.webhook modules by name so
+// the webhook steps can deliver requests to them.
+var webhookModules = struct {
+	sync.RWMutex
+	byName map[string]*webhookModule
+}{byName: make(map[string]*webhookModule)}
+
+// registerWebhookModule makes m available to steps under its module name.
+func registerWebhookModule(m *webhookModule) {
+	webhookModules.Lock()
+	defer webhookModules.Unlock()
+	webhookModules.byName[m.name] = m
+}
+
+// unregisterWebhookModule removes m unless another instance has since
+// registered under the same name.
+func unregisterWebhookModule(m *webhookModule) {
+	webhookModules.Lock()
+	defer webhookModules.Unlock()
+	if webhookModules.byName[m.name] == m {
+		delete(webhookModules.byName, m.name)
+	}
+}
+
+// lookupWebhookModule returns the registered git.webhook module called name.
+func lookupWebhookModule(name string) (*webhookModule, bool) {
+	webhookModules.RLock()
+	defer webhookModules.RUnlock()
+	m, ok := webhookModules.byName[name]
+	return m, ok
+}
+
+// lookupWebhookModuleByPath returns the registered git.webhook module serving
+// path. It fails when no module, or more than one, is configured for path.
+func lookupWebhookModuleByPath(path string) (*webhookModule, error) {
+	webhookModules.RLock()
+	defer webhookModules.RUnlock()
+	var found *webhookModule
+	for _, m := range webhookModules.byName {
+		if m.config.Path != path {
+			continue
 		}
+		if found != nil {
+			return nil, fmt.Errorf("git.webhook modules %q and %q both serve %s; set module explicitly", found.name, m.name, path)
+		}
+		found = m
+	}
+	if found == nil {
+		return nil, fmt.Errorf("no git.webhook module serves %s", path)
 	}
-	return false
+	return found, nil
 }
@@ -2,12 +2,14 @@ package internal
 
 import (
 	"bytes"
+	"context"
 	"crypto/hmac"
 	"crypto/sha256"
 	"encoding/hex"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 )
 
@@ -314,8 +316,8 @@ func TestNormalizeReleaseEvent(t *testing.T) {
 	if err != nil {
 		t.Fatalf("normalizeGitHubEvent: %v", err)
 	}
-	if event.Branch != "v1.0.0" {
-		t.Errorf("expected branch=v1.0.0, got %q", event.Branch)
+	if event.Tag != "v1.0.0" || event.Branch != "" {
+		t.Errorf("expected tag=v1.0.0 and no branch, got tag=%q branch=%q", event.Tag, event.Branch)
 	}
 	if event.Author != "dave" {
 		t.Errorf("expected author=dave, got %q", event.Author)
@@ -341,6 +343,25 @@ func TestNormalizeRefEvent(t *testing.T) {
 	}
 }
 
+func TestNormalizeTagRefEvent(t *testing.T) {
+	body := []byte(`{
+		"ref": "v2.0.0",
+		"ref_type": "tag",
+		"sender": {"login": "eve"},
+		"repository": {"full_name": "owner/repo"}
+	}`)
+
+	for _, eventType := range []string{"create", "delete"} {
+		event, err := normalizeGitHubEvent(eventType, body)
+		if err != nil {
+			t.Fatalf("normalizeGitHubEvent(%s): %v", eventType, err)
+		}
+		if event.Tag != "v2.0.0" || event.Branch != "" {
+			t.Errorf("%s: expected tag=v2.0.0 and no branch, got tag=%q branch=%q", eventType, event.Tag, event.Branch)
+		}
+	}
+}
+
 func TestNormalizeUnknownEvent(t *testing.T) {
 	body := []byte(`{"sender":{"login":"frank"},"repository":{"full_name":"owner/repo"}}`)
 
@@ -356,6 +377,196 @@ func TestNormalizeUnknownEvent(t *testing.T) {
 	}
 }
 
+func TestNormalizeTagPushEvent(t *testing.T) {
+	body := []byte(`{"ref":"refs/tags/v1.2.0","after":"cafe","repository":{"full_name":"owner/repo"}}`)
+
+	event, err := normalizeGitHubEvent("push", body)
+	if err != nil {
+		t.Fatalf("normalizeGitHubEvent: %v", err)
+	}
+	if event.Tag != "v1.2.0" || event.Branch != "" {
+		t.Errorf("expected tag=v1.2.0 and no branch, got tag=%q branch=%q", event.Tag, event.Branch)
+	}
+}
+
+func TestNormalizePREvent_TypedFields(t *testing.T) {
+	body := []byte(`{
+		"action": "synchronize",
+		"pull_request": {
+			"number": 42,
+			"state": "open",
+			"title": "Add feature",
+			"head": {"ref": "feature/pr", "sha": "aabbcc"},
+			"base": {"ref": "main"},
+			"labels": [{"name": "bug"}, {"name": "ci"}],
+			"user": {"login": "carol"}
+		}
+	}`)
+
+	event, err := normalizeGitHubEvent("pull_request", body)
+	if err != nil {
+		t.Fatalf("normalizeGitHubEvent: %v", err)
+	}
+	if event.Action != "synchronize" {
+		t.Errorf("expected action=synchronize, got %q", event.Action)
+	}
+	if event.PRNumber != 42 {
+		t.Errorf("expected pr_number=42, got %d", event.PRNumber)
+	}
+	if event.BaseBranch != "main" {
+		t.Errorf("expected base_branch=main, got %q", event.BaseBranch)
+	}
+	if strings.Join(event.Labels, ",") != "bug,ci" {
+		t.Errorf("expected labels=[bug ci], got %v", event.Labels)
+	}
+}
+
+func TestNormalizeIssueCommentEvent_OnPullRequest(t *testing.T) {
+	body := []byte(`{
+		"action": "created",
+		"issue": {"number": 7, "labels": [{"name": "triage"}], "pull_request": {"url": "https://api.github.com/x"}},
+		"comment": {"body": "/retest", "html_url": "https://github.com/owner/repo/pull/7#c1", "user": {"login": "gina"}}
+	}`)
+
+	event, err := normalizeGitHubEvent("issue_comment", body)
+	if err != nil {
+		t.Fatalf("normalizeGitHubEvent: %v", err)
+	}
+	if event.IssueNumber != 7 || event.PRNumber != 7 {
+		t.Errorf("expected issue_number=pr_number=7, got %d/%d", event.IssueNumber, event.PRNumber)
+	}
+	if event.Message != "/retest" || event.Author != "gina" {
+		t.Errorf("expected comment body and author, got %q by %q", event.Message, event.Author)
+	}
+	if len(event.Labels) != 1 || event.Labels[0] != "triage" {
+		t.Errorf("expected labels=[triage], got %v", event.Labels)
+	}
+}
+
+func TestNormalizeIssueEvent(t *testing.T) {
+	body := []byte(`{"action":"labeled","issue":{"number":3,"title":"Broken","state":"open","user":{"login":"hal"},"labels":[{"name":"bug"}]}}`)
+
+	event, err := normalizeGitHubEvent("issues", body)
+	if err != nil {
+		t.Fatalf("normalizeGitHubEvent: %v", err)
+	}
+	if event.IssueNumber != 3 || event.PRNumber != 0 {
+		t.Errorf("expected issue_number=3 and no pr_number, got %d/%d", event.IssueNumber, event.PRNumber)
+	}
+	if event.Message != "Broken" || event.Status != "open" || event.Author != "hal" {
+		t.Errorf("unexpected issue fields: %+v", event)
+	}
+}
+
+func TestNormalizePRReviewEvent(t *testing.T) {
+	body := []byte(`{
+		"action": "submitted",
+		"review": {"state": "approved", "commit_id": "ddee", "user": {"login": "ivy"}},
+		"pull_request": {"number": 9, "head": {"ref": "feat", "sha": "old"}, "base": {"ref": "main"}}
+	}`)
+
+	event, err := normalizeGitHubEvent("pull_request_review", body)
+	if err != nil {
+		t.Fatalf("normalizeGitHubEvent: %v", err)
+	}
+	if event.PRNumber != 9 || event.Status != "approved" || event.Commit != "ddee" || event.Author != "ivy" {
+		t.Errorf("unexpected review fields: %+v", event)
+	}
+}
+
+func TestNormalizeWorkflowRunEvent(t *testing.T) {
+	body := []byte(`{
+		"action": "completed",
+		"workflow_run": {
+			"name": "CI",
+			"head_branch": "feature",
+			"head_sha": "abc",
+			"status": "completed",
+			"conclusion": "failure",
+			"html_url": "https://github.com/owner/repo/actions/runs/1",
+			"actor": {"login": "jo"},
+			"pull_requests": [{"number": 5, "base": {"ref": "main"}}]
+		}
+	}`)
+
+	event, err := normalizeGitHubEvent("workflow_run", body)
+	if err != nil {
+		t.Fatalf("normalizeGitHubEvent: %v", err)
+	}
+	if event.Conclusion != "failure" || event.Status != "completed" {
+		t.Errorf("expected completed/failure, got %q/%q", event.Status, event.Conclusion)
+	}
+	if event.PRNumber != 5 || event.BaseBranch != "main" {
+		t.Errorf("expected pr_number=5 base_branch=main, got %d %q", event.PRNumber, event.BaseBranch)
+	}
+	if event.Branch != "feature" || event.Commit != "abc" || event.Message != "CI" || event.Author != "jo" {
+		t.Errorf("unexpected run fields: %+v", event)
+	}
+}
+
+func TestNormalizeCheckRunEvent(t *testing.T) {
+	body := []byte(`{
+		"action": "completed",
+		"check_run": {"name": "lint", "head_sha": "abc", "status": "completed", "conclusion": "success",
+			"check_suite": {"head_branch": "feature"}, "pull_requests": []},
+		"sender": {"login": "bot[bot]"}
+	}`)
+
+	event, err := normalizeGitHubEvent("check_run", body)
+	if err != nil {
+		t.Fatalf("normalizeGitHubEvent: %v", err)
+	}
+	if event.Conclusion != "success" || event.Branch != "feature" || event.Message != "lint" {
+		t.Errorf("unexpected check_run fields: %+v", event)
+	}
+	if event.Author != "bot[bot]" {
+		t.Errorf("expected sender fallback author, got %q", event.Author)
+	}
+}
+
+func TestNormalizeDeploymentStatusEvent(t *testing.T) {
+	body := []byte(`{
+		"action": "created",
+		"deployment_status": {"state": "success", "target_url": "https://example.com", "creator": {"login": "deployer"}},
+		"deployment": {"ref": "main", "sha": "abc", "environment": "production"}
+	}`)
+
+	event, err := normalizeGitHubEvent("deployment_status", body)
+	if err != nil {
+		t.Fatalf("normalizeGitHubEvent: %v", err)
+	}
+	if event.Status != "success" || event.Environment != "production" || event.Branch != "main" || event.Commit != "abc" {
+		t.Errorf("unexpected deployment_status fields: %+v", event)
+	}
+}
+
+func TestNormalizeStatusEvent(t *testing.T) {
+	body := []byte(`{"sha":"abc","state":"pending","context":"ci/build","branches":[{"name":"main"}]}`)
+
+	event, err := normalizeGitHubEvent("status", body)
+	if err != nil {
+		t.Fatalf("normalizeGitHubEvent: %v", err)
+	}
+	if event.Commit != "abc" || event.Status != "pending" || event.Message != "ci/build" || event.Branch != "main" {
+		t.Errorf("unexpected status fields: %+v", event)
+	}
+}
+
+func TestNormalizeMergeGroupEvent(t *testing.T) {
+	body := []byte(`{
+		"action": "checks_requested",
+		"merge_group": {"head_sha": "abc", "head_ref": "refs/heads/gh-readonly-queue/main/pr-1-abc", "base_ref": "refs/heads/main"}
+	}`)
+
+	event, err := normalizeGitHubEvent("merge_group", body)
+	if err != nil {
+		t.Fatalf("normalizeGitHubEvent: %v", err)
+	}
+	if event.BaseBranch != "main" || event.Branch != "gh-readonly-queue/main/pr-1-abc" || event.Commit != "abc" {
+		t.Errorf("unexpected merge_group fields: %+v", event)
+	}
+}
+
 func TestNormalizeGitHubEvent_InvalidJSON(t *testing.T) {
 	_, err := normalizeGitHubEvent("push", []byte("not json"))
 	if err == nil {
@@ -370,8 +581,14 @@ func TestWebhookModule_Lifecycle(t *testing.T) {
 	if err := m.Init(); err != nil {
 		t.Fatalf("Init: %v", err)
 	}
-	// Start registers the HTTP handler on the default mux; just verify it does not error.
-	// (We can't easily un-register it, but it won't conflict in tests.)
+	// Init registers the module for step.gh_webhook_receive; Stop must undo it
+	// so later tests on the default path see only their own module.
+	if err := m.Stop(context.Background()); err != nil {
+		t.Fatalf("Stop: %v", err)
+	}
+	if _, ok := lookupWebhookModule(m.Name()); ok {
+		t.Error("expected Stop to unregister the module")
+	}
 }
 
 func TestWebhookModule_Name(t *testing.T) {
@@ -397,3 +614,131 @@ func (p *fakePublisher) Publish(topic string, payload []byte, metadata map[strin
 	p.messages = append(p.messages, publishedMessage{topic: topic, payload: payload, metadata: metadata})
 	return "msg-id", nil
 }
+
+// --- delivery de-duplication ---
+
+func TestParseWebhookConfig_DedupDefaults(t *testing.T) {
+	cfg, err := parseWebhookConfig(map[string]any{})
+	if err != nil {
+		t.Fatalf("parseWebhookConfig: %v", err)
+	}
+	if cfg.DedupWindow != defaultWebhookDedupWindow {
+		t.Errorf("expected default dedup_window=%s, got %s", defaultWebhookDedupWindow, cfg.DedupWindow)
+	}
+	if cfg.DedupMaxEntries != defaultWebhookDedupMaxEntries {
+		t.Errorf("expected default dedup_max_entries=%d, got %d", defaultWebhookDedupMaxEntries, cfg.DedupMaxEntries)
+	}
+}
+
+func TestParseWebhookConfig_InvalidDedupWindow(t *testing.T) {
+	if _, err := parseWebhookConfig(map[string]any{"dedup_window": "soon"}); err == nil {
+		t.Error("expected error for invalid dedup_window")
+	}
+}
+
+func TestHandleWebhook_DuplicateDelivery(t *testing.T) {
+	m := newTestWebhookModule(t, map[string]any{})
+	pub := &fakePublisher{}
+	m.SetMessagePublisher(pub)
+
+	body := []byte(`{"ref":"refs/heads/main","repository":{"full_name":"owner/repo"}}`)
+	headers := map[string]string{"X-GitHub-Delivery": "72d3162e-cc78-11e3-81ab-4c9367dc0958"}
+	first := doRequest(t, m, http.MethodPost, "push", body, headers)
+	second := doRequest(t, m, http.MethodPost, "push", body, headers)
+	if first.Code != http.StatusOK || second.Code != http.StatusOK {
+		t.Fatalf("expected 200/200, got %d/%d", first.Code, second.Code)
+	}
+	var resp map[string]any
+	json.NewDecoder(second.Body).Decode(&resp) //nolint:errcheck
+	if resp["status"] != "duplicate" {
+		t.Errorf("expected status=duplicate, got %v", resp["status"])
+	}
+	if len(pub.messages) != 1 {
+		t.Fatalf("expected 1 published message, got %d", len(pub.messages))
+	}
+	var event GitEvent
+	if err := json.Unmarshal(pub.messages[0].payload, &event); err != nil {
+		t.Fatalf("unmarshal event: %v", err)
+	}
+	if event.DeliveryID != headers["X-GitHub-Delivery"] {
+		t.Errorf("expected delivery_id=%s, got %q", headers["X-GitHub-Delivery"], event.DeliveryID)
+	}
+}
+
+func TestHandleWebhook_FailedDeliveryCanBeRetried(t *testing.T) {
+	m := newTestWebhookModule(t, map[string]any{})
+	headers := map[string]string{"X-GitHub-Delivery": "retry-me"}
+
+	rr := doRequest(t, m, http.MethodPost, "push", []byte("not json"), headers)
+	if rr.Code != http.StatusBadRequest {
+		t.Fatalf("expected 400, got %d", rr.Code)
+	}
+	rr = doRequest(t, m, http.MethodPost, "push", []byte(`{}`), headers)
+	var resp map[string]any
+	json.NewDecoder(rr.Body).Decode(&resp) //nolint:errcheck
+	if resp["status"] != "accepted" {
+		t.Errorf("expected retried delivery to be accepted, got %v", resp["status"])
+	}
+}
+
+func TestHandleWebhook_DedupDisabled(t *testing.T) {
+	m := newTestWebhookModule(t, map[string]any{"dedup_window": "0s"})
+	pub := &fakePublisher{}
+	m.SetMessagePublisher(pub)
+
+	headers := map[string]string{"X-GitHub-Delivery": "same"}
+	doRequest(t, m, http.MethodPost, "push", []byte(`{}`), headers)
+	doRequest(t, m, http.MethodPost, "push", []byte(`{}`), headers)
+	if len(pub.messages) != 2 {
+		t.Errorf("expected 2 published messages with dedup disabled, got %d", len(pub.messages))
+	}
+}
+
+func TestHandleWebhook_FilteredAction(t *testing.T) {
+	m := newTestWebhookModule(t, map[string]any{
+		"events": []any{"pull_request:opened"},
+	})
+	pub := &fakePublisher{}
+	m.SetMessagePublisher(pub)
+
+	rr := doRequest(t, m, http.MethodPost, "pull_request", []byte(`{"action":"closed"}`), nil)
+	var resp map[string]any
+	json.NewDecoder(rr.Body).Decode(&resp) //nolint:errcheck
+	if resp["status"] != "ignored" {
+		t.Errorf("expected status=ignored, got %v", resp["status"])
+	}
+	doRequest(t, m, http.MethodPost, "pull_request", []byte(`{"action":"opened"}`), nil)
+	if len(pub.messages) != 1 {
+		t.Errorf("expected only the opened event to be published, got %d", len(pub.messages))
+	}
+}
+
+func TestHandleWebhook_PublishesToRoutedTopic(t *testing.T) {
+	m := newTestWebhookModule(t, map[string]any{"topic": "git.events.{event_type}"})
+	pub := &fakePublisher{}
+	m.SetMessagePublisher(pub)
+
+	doRequest(t, m, http.MethodPost, "issues", []byte(`{"action":"opened"}`), nil)
+	if len(pub.messages) != 1 {
+		t.Fatalf("expected 1 published message, got %d", len(pub.messages))
+	}
+	if pub.messages[0].topic != "git.events.issues" {
+		t.Errorf("expected topic=git.events.issues, got %q", pub.messages[0].topic)
+	}
+}
+
+func TestHandleWebhook_SecretsWithoutPrimary(t *testing.T) {
+	m := newTestWebhookModule(t, map[string]any{
+		"secrets": []any{map[string]any{"name": "rotated", "secret": "next"}},
+	})
+	body := []byte(`{}`)
+
+	rr := doRequest(t, m, http.MethodPost, "push", body, nil)
+	if rr.Code != http.StatusUnauthorized {
+		t.Errorf("expected 401 without a signature, got %d", rr.Code)
+	}
+	rr = doRequest(t, m, http.MethodPost, "push", body, map[string]string{"X-Hub-Signature-256": signBody("next", body)})
+	if rr.Code != http.StatusOK {
+		t.Errorf("expected 200, got %d: %s", rr.Code, rr.Body.String())
+	}
+}
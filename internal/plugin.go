@@ -12,7 +12,11 @@ var Version = "0.0.0"
 
 // webhookRouteConfig is the config fragment YAML that declares the GitHub
 // webhook HTTP route so the engine's HTTP server registers it via the normal
-// config pipeline instead of the unreachable global DefaultServeMux.
+// config pipeline instead of the unreachable global DefaultServeMux. The route
+// forwards the request, raw body and GitHub headers intact, to the git.webhook
+// module serving /webhooks/github, which needs the raw body to validate the
+// signature of JSON and form-encoded deliveries alike. Modules on other paths
+// need their own route with the same steps.
 const webhookRouteConfig = `
 workflows:
   github-webhook-receiver:
@@ -21,7 +25,14 @@ workflows:
         config:
           path: /webhooks/github
           method: POST
-    steps: []
+    steps:
+      - name: route
+        type: step.gh_webhook_forward
+      - name: receive
+        type: step.http_proxy
+        config:
+          backend_url_key: backend_url
+          forward_headers: [Content-Type, X-GitHub-Event, X-GitHub-Delivery, X-Hub-Signature-256, X-Hub-Signature]
 `
 
 // githubPlugin implements sdk.PluginProvider, sdk.ModuleProvider, and sdk.StepProvider.
@@ -71,6 +82,12 @@ func (p *githubPlugin) StepTypes() []string {
 		// Existing steps
 		"step.gh_action_trigger",
 		"step.gh_action_status",
+		"step.gh_action_cancel",
+		"step.gh_action_rerun",
+		"step.gh_action_approve",
+		// Artifact steps
+		"step.gh_artifact_list",
+		"step.gh_artifact_download",
 		// Pull request steps
 		"step.gh_pr_create",
 		"step.gh_pr_merge",
@@ -88,18 +105,54 @@ func (p *githubPlugin) StepTypes() []string {
 		"step.gh_repo_dispatch",
 		"step.gh_deployment_create",
 		"step.gh_secret_set",
+		"step.gh_secret_delete",
+		"step.gh_secret_sync",
+		"step.gh_secret_list",
+		"step.gh_variable_set",
+		"step.gh_variable_get",
+		"step.gh_variable_list",
+		"step.gh_variable_delete",
 		// GraphQL
 		"step.gh_graphql",
+		// Rate limits
+		"step.gh_rate_limit",
+		// Webhooks
+		"step.gh_webhook_receive",
+		"step.gh_webhook_forward",
+		"step.gh_webhook_reconcile",
 	}
 }
 
 // CreateStep creates a step instance of the given type.
 func (p *githubPlugin) CreateStep(typeName, name string, config map[string]any) (sdk.StepInstance, error) {
+	step, err := p.newStep(typeName, name, config)
+	if err != nil {
+		return nil, err
+	}
+	strict, err := withStrictTemplates(step, config)
+	if err != nil {
+		return nil, fmt.Errorf("%s %q: %w", typeName, name, err)
+	}
+	return strict, nil
+}
+
+// newStep constructs the step instance for typeName.
+func (p *githubPlugin) newStep(typeName, name string, config map[string]any) (sdk.StepInstance, error) {
 	switch typeName {
 	case "step.gh_action_trigger":
 		return newActionTriggerStep(name, config, nil)
 	case "step.gh_action_status":
 		return newActionStatusStep(name, config, nil)
+	case "step.gh_action_cancel":
+		return newActionCancelStep(name, config, nil)
+	case "step.gh_action_rerun":
+		return newActionRerunStep(name, config, nil)
+	case "step.gh_action_approve":
+		return newActionApproveStep(name, config, nil)
+	case "step.gh_artifact_list":
+		return newArtifactListStep(name, config, nil)
+	case "step.gh_artifact_download":
+		return newArtifactDownloadStep(name, config, nil)
 	case "step.gh_pr_create":
 		return newPRCreateStep(name, config)
 	case "step.gh_pr_merge":
@@ -126,8 +179,30 @@ func (p *githubPlugin) CreateStep(typeName, name string, config map[string]any)
 		return newDeploymentCreateStep(name, config)
 	case "step.gh_secret_set":
 		return newSecretSetStep(name, config)
+	case "step.gh_secret_delete":
+		return newSecretDeleteStep(name, config)
+	case "step.gh_secret_sync":
+		return newSecretSyncStep(name, config)
+	case "step.gh_secret_list":
+		return newSecretListStep(name, config)
+	case "step.gh_variable_set":
+		return newVariableSetStep(name, config)
+	case "step.gh_variable_get":
+		return newVariableGetStep(name, config)
+	case "step.gh_variable_list":
+		return newVariableListStep(name, config)
+	case "step.gh_variable_delete":
+		return newVariableDeleteStep(name, config)
 	case "step.gh_graphql":
 		return newGraphQLStep(name, config)
+	case "step.gh_rate_limit":
+		return newRateLimitStep(name, config, nil)
+	case "step.gh_webhook_receive":
+		return newWebhookReceiveStep(name, config)
+	case "step.gh_webhook_forward":
+		return newWebhookForwardStep(name, config)
+	case "step.gh_webhook_reconcile":
+		return newWebhookReconcileStep(name, config)
 	default:
 		return nil, fmt.Errorf("github plugin: unknown step type %q", typeName)
 	}
@@ -0,0 +1,363 @@
+package internal
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Rate-limit handling shared by every GitHub client of the plugin.
+const (
+	// githubResponseHeaderTimeout bounds each attempt until GitHub starts
+	// answering.
+	githubResponseHeaderTimeout = 30 * time.Second
+	// githubAttemptTimeout bounds each attempt including reading the body.
+	// Clients do not set http.Client.Timeout because it would also cut
+	// rate-limit waits short; requests that stream large bodies raise it
+	// with withAttemptTimeout.
+	githubAttemptTimeout = 30 * time.Second
+	// githubTransferTimeout bounds each attempt of an artifact download or
+	// release asset upload.
+	githubTransferTimeout = 30 * time.Minute
+	rateLimitMaxRetries   = 3
+	// rateLimitPruneInterval is how often limits whose window has reset are
+	// forgotten, so rotated tokens do not accumulate.
+	rateLimitPruneInterval = time.Minute
+)
+
+// Rate-limit waits; variables so tests can shorten them.
+var (
+	// rateLimitMaxWait is the longest the transport waits for a rate limit
+	// to clear before it hands GitHub's answer back to the caller.
+	rateLimitMaxWait = 15 * time.Minute
+	// secondaryRateLimitBackoff is the first wait after a secondary rate
+	// limit answer without Retry-After; it doubles on each retry.
+	secondaryRateLimitBackoff = time.Minute
+)
+
+// sharedGitHubTransport is the transport of every GitHub API client, so
+// limits learned by one client also hold back the others.
+var sharedGitHubTransport = newRateLimitTransport(newGitHubBaseTransport())
+
+// newGitHubHTTPClient returns an HTTP client that goes through the shared
+// rate-limit-aware transport.
+func newGitHubHTTPClient() *http.Client {
+	return &http.Client{Transport: sharedGitHubTransport}
+}
+
+func newGitHubBaseTransport() http.RoundTripper {
+	base, ok := http.DefaultTransport.(*http.Transport)
+	if !ok {
+		return http.DefaultTransport
+	}
+	t := base.Clone()
+	t.ResponseHeaderTimeout = githubResponseHeaderTimeout
+	return t
+}
+
+// rateLimitTransport is an http.RoundTripper that honors GitHub's primary and
+// secondary rate limits. It remembers the X-RateLimit-* headers of each
+// credential and resource, holds requests back while a limit is exhausted or
+// a Retry-After is pending, and retries requests GitHub rejected with a
+// rate-limit 403 or 429. A wait longer than rateLimitMaxWait, or past the
+// request's deadline, is not attempted: the caller gets GitHub's answer.
+type rateLimitTransport struct {
+	base http.RoundTripper
+
+	mu        sync.Mutex
+	limits    map[string]*rateLimitState
+	waits     int64
+	lastPrune time.Time
+}
+
+// rateLimitState is what the transport knows about one rate limit bucket.
+type rateLimitState struct {
+	Resource     string
+	Limit        int
+	Remaining    int
+	Used         int
+	Reset        time.Time
+	BlockedUntil time.Time // from Retry-After or a secondary limit
+	UpdatedAt    time.Time
+}
+
+func newRateLimitTransport(base http.RoundTripper) *rateLimitTransport {
+	return &rateLimitTransport{base: base, limits: make(map[string]*rateLimitState)}
+}
+
+// RoundTrip sends req once the rate limit of its credential allows it and
+// retries it while GitHub answers with a rate limit it is willing to wait for.
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	key := rateLimitKey(req)
+	for attempt := 0; ; attempt++ {
+		// A wait that is too long is skipped; GitHub then answers with the
+		// rate-limit error itself.
+		if wait := t.pendingWait(key); wait > 0 && t.canWait(ctx, wait) {
+			if err := t.wait(ctx, key, wait); err != nil {
+				return nil, err
+			}
+		}
+
+		attemptCtx, cancel := context.WithTimeout(ctx, attemptTimeout(ctx))
+		attemptReq := req.WithContext(attemptCtx)
+		if attempt > 0 {
+			attemptReq = req.Clone(attemptCtx)
+			if req.Body != nil {
+				body, err := req.GetBody()
+				if err != nil {
+					cancel()
+					return nil, fmt.Errorf("replay request body: %w", err)
+				}
+				attemptReq.Body = body
+			}
+		}
+		resp, err := t.base.RoundTrip(attemptReq)
+		if err != nil {
+			cancel()
+			return nil, err
+		}
+		resp.Body = &cancelOnCloseBody{ReadCloser: resp.Body, cancel: cancel}
+		t.observe(key, resp.Header)
+
+		wait, limited := t.rateLimitWait(resp, attempt)
+		if !limited {
+			return resp, nil
+		}
+		t.block(key, wait)
+		replayable := req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+		if attempt >= rateLimitMaxRetries || !replayable || !t.canWait(ctx, wait) {
+			return resp, nil
+		}
+		_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64*1024))
+		_ = resp.Body.Close()
+	}
+}
+
+// attemptTimeoutKey is the context key of withAttemptTimeout.
+type attemptTimeoutKey struct{}
+
+// withAttemptTimeout returns a context whose requests may take up to d per
+// attempt instead of githubAttemptTimeout.
+func withAttemptTimeout(ctx context.Context, d time.Duration) context.Context {
+	return context.WithValue(ctx, attemptTimeoutKey{}, d)
+}
+
+func attemptTimeout(ctx context.Context) time.Duration {
+	if d, ok := ctx.Value(attemptTimeoutKey{}).(time.Duration); ok && d > 0 {
+		return d
+	}
+	return githubAttemptTimeout
+}
+
+// cancelOnCloseBody releases an attempt's context once its body is closed.
+type cancelOnCloseBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelOnCloseBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
+
+// rateLimitWait reports whether resp is a rate-limit answer and how long to
+// wait before retrying. A 403 that is not about rate limits is left alone.
+func (t *rateLimitTransport) rateLimitWait(resp *http.Response, attempt int) (time.Duration, bool) {
+	if resp.StatusCode != http.StatusForbidden && resp.StatusCode != http.StatusTooManyRequests {
+		return 0, false
+	}
+	status := parseRateLimitStatus(resp.Header)
+	switch {
+	case status.RetryAfter > 0:
+		return status.RetryAfter, true
+	case status.Remaining == 0 && !status.Reset.IsZero():
+		return max(time.Until(status.Reset), time.Second), true
+	case resp.StatusCode == http.StatusTooManyRequests || isSecondaryRateLimit(resp):
+		return secondaryRateLimitBackoff << attempt, true
+	}
+	return 0, false
+}
+
+// isSecondaryRateLimit reports whether a 403 body describes a secondary
+// (abuse-detection) rate limit. The peeked bytes are put back into the body.
+func isSecondaryRateLimit(resp *http.Response) bool {
+	peek, err := io.ReadAll(io.LimitReader(resp.Body, 4096))
+	resp.Body = &peekedBody{Reader: io.MultiReader(bytes.NewReader(peek), resp.Body), closer: resp.Body}
+	if err != nil {
+		return false
+	}
+	msg := strings.ToLower(string(peek))
+	return strings.Contains(msg, "secondary rate limit") || strings.Contains(msg, "abuse detection")
+}
+
+// peekedBody is a response body with some bytes read ahead.
+type peekedBody struct {
+	io.Reader
+	closer io.Closer
+}
+
+func (b *peekedBody) Close() error { return b.closer.Close() }
+
+// canWait reports whether waiting d is within rateLimitMaxWait and ends
+// before the request's deadline.
+func (t *rateLimitTransport) canWait(ctx context.Context, d time.Duration) bool {
+	if d > rateLimitMaxWait {
+		return false
+	}
+	if deadline, ok := ctx.Deadline(); ok && time.Now().Add(d).After(deadline) {
+		return false
+	}
+	return true
+}
+
+func (t *rateLimitTransport) wait(ctx context.Context, key rateLimitBucket, d time.Duration) error {
+	t.mu.Lock()
+	t.waits++
+	t.mu.Unlock()
+	log.Printf("github: rate limit for %s reached, waiting %s", key.resource, d.Round(time.Second))
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// pendingWait returns how long a request to key must wait before it is sent.
+func (t *rateLimitTransport) pendingWait(key rateLimitBucket) time.Duration {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	state := t.limits[key.String()]
+	if state == nil {
+		return 0
+	}
+	now := time.Now()
+	var until time.Time
+	if state.BlockedUntil.After(now) {
+		until = state.BlockedUntil
+	}
+	if state.Remaining == 0 && state.Reset.After(now) && state.Reset.After(until) {
+		until = state.Reset
+	}
+	if until.IsZero() {
+		return 0
+	}
+	return until.Sub(now)
+}
+
+// observe records the rate-limit headers of a response.
+func (t *rateLimitTransport) observe(key rateLimitBucket, h http.Header) {
+	status := parseRateLimitStatus(h)
+	if status.Remaining < 0 {
+		return
+	}
+	if resource := h.Get("X-RateLimit-Resource"); resource != "" {
+		key.resource = resource
+	}
+	limit, _ := strconv.Atoi(h.Get("X-RateLimit-Limit"))
+	used, _ := strconv.Atoi(h.Get("X-RateLimit-Used"))
+
+	now := time.Now()
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.pruneLocked(now)
+	state := t.limits[key.String()]
+	if state == nil {
+		state = &rateLimitState{}
+		t.limits[key.String()] = state
+	}
+	state.Resource = key.resource
+	state.Limit = limit
+	state.Remaining = status.Remaining
+	state.Used = used
+	state.Reset = status.Reset
+	state.UpdatedAt = now
+}
+
+// pruneLocked forgets limits whose window has reset and whose block has
+// expired; nothing would hold their requests back any more. t.mu is held.
+func (t *rateLimitTransport) pruneLocked(now time.Time) {
+	if now.Sub(t.lastPrune) < rateLimitPruneInterval {
+		return
+	}
+	t.lastPrune = now
+	for key, state := range t.limits {
+		if !state.Reset.After(now) && !state.BlockedUntil.After(now) {
+			delete(t.limits, key)
+		}
+	}
+}
+
+// block holds back requests to key for d.
+func (t *rateLimitTransport) block(key rateLimitBucket, d time.Duration) {
+	now := time.Now()
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.pruneLocked(now)
+	state := t.limits[key.String()]
+	if state == nil {
+		state = &rateLimitState{Resource: key.resource, Remaining: -1}
+		t.limits[key.String()] = state
+	}
+	if until := now.Add(d); until.After(state.BlockedUntil) {
+		state.BlockedUntil = until
+	}
+}
+
+// snapshot returns the known limits of the credential in authorization.
+func (t *rateLimitTransport) snapshot(authorization string) (states []rateLimitState, waits int64) {
+	credential := credentialID(authorization)
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for key, state := range t.limits {
+		if strings.HasPrefix(key, credential+"/") {
+			states = append(states, *state)
+		}
+	}
+	return states, t.waits
+}
+
+// rateLimitBucket identifies a rate limit: GitHub counts requests per
+// credential and resource (core, search, graphql, ...).
+type rateLimitBucket struct {
+	credential string
+	resource   string
+}
+
+func (b rateLimitBucket) String() string { return b.credential + "/" + b.resource }
+
+func rateLimitKey(req *http.Request) rateLimitBucket {
+	resource := "core"
+	switch p := req.URL.Path; {
+	case strings.HasSuffix(p, "/graphql"):
+		resource = "graphql"
+	case strings.Contains(p, "/search/code"):
+		resource = "code_search"
+	case strings.Contains(p, "/search/"):
+		resource = "search"
+	}
+	return rateLimitBucket{credential: credentialID(req.Header.Get("Authorization")), resource: resource}
+}
+
+// credentialID identifies a credential without keeping the token itself.
+func credentialID(authorization string) string {
+	if authorization == "" {
+		return "anonymous"
+	}
+	sum := sha256.Sum256([]byte(authorization))
+	return hex.EncodeToString(sum[:8])
+}
@@ -0,0 +1,228 @@
+package internal
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+// shortenRateLimitWaits makes secondary rate limit backoffs instant for a test.
+func shortenRateLimitWaits(t *testing.T) {
+	t.Helper()
+	backoff := secondaryRateLimitBackoff
+	secondaryRateLimitBackoff = time.Millisecond
+	t.Cleanup(func() { secondaryRateLimitBackoff = backoff })
+}
+
+func newRateLimitTestClient() (*http.Client, *rateLimitTransport) {
+	transport := newRateLimitTransport(http.DefaultTransport)
+	return &http.Client{Transport: transport}, transport
+}
+
+func TestRateLimitTransport_RetriesAfterRetryAfter(t *testing.T) {
+	var bodies []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(b))
+		if len(bodies) == 1 {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer srv.Close()
+	client, transport := newRateLimitTestClient()
+
+	req, _ := http.NewRequest(http.MethodPost, srv.URL+"/repos/o/r/dispatches", strings.NewReader(`{"event_type":"x"}`))
+	req.Header.Set("Authorization", "Bearer tok")
+	start := time.Now()
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatalf("Do: %v", err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("expected the retry to succeed, got status %d", resp.StatusCode)
+	}
+	if len(bodies) != 2 || bodies[1] != `{"event_type":"x"}` {
+		t.Errorf("expected the request body to be replayed, got %q", bodies)
+	}
+	if elapsed := time.Since(start); elapsed < time.Second {
+		t.Errorf("expected a wait of Retry-After, waited %s", elapsed)
+	}
+	if _, waits := transport.snapshot("Bearer tok"); waits != 1 {
+		t.Errorf("expected 1 wait, got %d", waits)
+	}
+}
+
+func TestRateLimitTransport_SecondaryRateLimit(t *testing.T) {
+	shortenRateLimitWaits(t)
+	requests := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		switch {
+		case r.URL.Path == "/denied":
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"message":"Resource not accessible by integration"}`))
+		case requests < 3:
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"message":"You have exceeded a secondary rate limit. Please wait a few minutes before you try again."}`))
+		default:
+			_, _ = w.Write([]byte(`{}`))
+		}
+	}))
+	defer srv.Close()
+	client, _ := newRateLimitTestClient()
+
+	resp, err := client.Get(srv.URL + "/repos/o/r")
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK || requests != 3 {
+		t.Errorf("expected success on the third request, got status %d after %d requests", resp.StatusCode, requests)
+	}
+
+	requests = 0
+	resp, err = client.Get(srv.URL + "/denied")
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusForbidden || requests != 1 {
+		t.Errorf("expected a plain 403 to be returned as is, got status %d after %d requests", resp.StatusCode, requests)
+	}
+	if !strings.Contains(string(body), "Resource not accessible") {
+		t.Errorf("expected the 403 body to be preserved, got %q", body)
+	}
+}
+
+func TestRateLimitTransport_ReturnsAnswerWhenWaitTooLong(t *testing.T) {
+	requests := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("X-RateLimit-Remaining", "0")
+		w.Header().Set("X-RateLimit-Reset", strconv.FormatInt(time.Now().Add(time.Hour).Unix(), 10))
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	defer srv.Close()
+	client, transport := newRateLimitTestClient()
+
+	start := time.Now()
+	resp, err := client.Get(srv.URL + "/repos/o/r")
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusForbidden || requests != 1 {
+		t.Errorf("expected the 403 after 1 request, got status %d after %d requests", resp.StatusCode, requests)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("expected no wait, waited %s", elapsed)
+	}
+
+	states, _ := transport.snapshot("")
+	if len(states) != 1 || states[0].Resource != "core" || states[0].Remaining != 0 {
+		t.Errorf("expected an exhausted core limit, got %+v", states)
+	}
+}
+
+func TestRateLimitTransport_HoldsBackExhaustedCredential(t *testing.T) {
+	transport := newRateLimitTransport(http.DefaultTransport)
+	h := http.Header{}
+	h.Set("X-RateLimit-Limit", "5000")
+	h.Set("X-RateLimit-Remaining", "0")
+	h.Set("X-RateLimit-Used", "5000")
+	h.Set("X-RateLimit-Reset", strconv.FormatInt(time.Now().Add(time.Minute).Unix(), 10))
+	h.Set("X-RateLimit-Resource", "core")
+
+	exhausted := rateLimitBucket{credential: credentialID("Bearer a"), resource: "core"}
+	transport.observe(exhausted, h)
+
+	if wait := transport.pendingWait(exhausted); wait <= 0 || wait > time.Minute {
+		t.Errorf("expected requests to wait for the reset, got %s", wait)
+	}
+	other := []rateLimitBucket{
+		{credential: credentialID("Bearer b"), resource: "core"},
+		{credential: credentialID("Bearer a"), resource: "search"},
+	}
+	for _, key := range other {
+		if wait := transport.pendingWait(key); wait != 0 {
+			t.Errorf("%s: expected no wait, got %s", key.resource, wait)
+		}
+	}
+}
+
+func TestRateLimitKey(t *testing.T) {
+	cases := map[string]string{
+		"https://api.github.com/repos/o/r/actions/runs/1": "core",
+		"https://api.github.com/graphql":                  "graphql",
+		"https://ghe.example.com/api/graphql":             "graphql",
+		"https://api.github.com/search/code?q=x":          "code_search",
+		"https://api.github.com/search/issues?q=x":        "search",
+	}
+	for url, want := range cases {
+		req, _ := http.NewRequest(http.MethodGet, url, nil)
+		if got := rateLimitKey(req).resource; got != want {
+			t.Errorf("%s: expected resource %q, got %q", url, want, got)
+		}
+	}
+}
+
+func TestRateLimitTransport_BoundsEachAttempt(t *testing.T) {
+	release := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.(http.Flusher).Flush()
+		// Stall the body until the client gives up.
+		select {
+		case <-r.Context().Done():
+		case <-release:
+		}
+	}))
+	defer srv.Close()
+	defer close(release)
+	client, _ := newRateLimitTestClient()
+
+	ctx := withAttemptTimeout(context.Background(), 50*time.Millisecond)
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, srv.URL+"/repos/o/r", nil)
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatalf("Do: %v", err)
+	}
+	defer resp.Body.Close()
+	start := time.Now()
+	if _, err := io.ReadAll(resp.Body); err == nil {
+		t.Fatal("expected reading a stalled body to fail")
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("expected the attempt deadline to end the read, took %s", elapsed)
+	}
+}
+
+func TestRateLimitTransport_PrunesResetLimits(t *testing.T) {
+	transport := newRateLimitTransport(http.DefaultTransport)
+	h := http.Header{}
+	h.Set("X-RateLimit-Remaining", "10")
+	h.Set("X-RateLimit-Reset", strconv.FormatInt(time.Now().Add(-time.Minute).Unix(), 10))
+	for i := range 100 {
+		transport.observe(rateLimitBucket{credential: credentialID("Bearer " + strconv.Itoa(i)), resource: "core"}, h)
+	}
+	transport.lastPrune = time.Time{}
+	transport.block(rateLimitBucket{credential: credentialID("Bearer live"), resource: "core"}, time.Hour)
+
+	transport.mu.Lock()
+	n := len(transport.limits)
+	transport.mu.Unlock()
+	if n != 1 {
+		t.Errorf("expected only the blocked credential to be kept, got %d entries", n)
+	}
+}
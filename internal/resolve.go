@@ -1,92 +1,424 @@
 package internal
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"sort"
+	"strconv"
 	"strings"
+
+	sdk "github.com/GoCodeAlone/workflow/plugin/external/sdk"
 )
 
-// resolveField performs basic template resolution on value, replacing
-// {{.field}} references with values looked up from triggerData, stepOutputs,
-// and current (in that priority order).
+// errUnresolved reports a template reference with no value.
+var errUnresolved = errors.New("no value")
+
+// resolveField performs template resolution on value, replacing {{...}}
+// placeholders with values looked up from triggerData, stepOutputs, and
+// current.
 //
 // Supported reference forms:
 //
-//	{{.field}}                     — look up "field" in triggerData
-//	{{.steps.stepName.field}}      — look up stepOutputs["stepName"]["field"]
-//	{{.current.field}}             — look up "field" in current
+//	{{.field}}                          — look up "field" in triggerData
+//	{{.steps.stepName.field}}           — look up stepOutputs["stepName"]["field"]
+//	{{.current.field}}                  — look up "field" in current
+//	{{.raw_payload.pull_request.head.sha}} — walk nested maps
+//	{{.steps.list.items[0].name}}       — index into arrays (also items.0.name)
+//
+// A reference may be followed by filters, applied left to right:
 //
-// If the placeholder cannot be resolved the original placeholder text is left
-// in place so misconfiguration is visible rather than silently swallowed.
+//	{{.base_branch | default "main"}}   — use "main" when the value is missing or empty
+//	{{.author | lower}}                 — also upper and trim
+//	{{.labels | join ", "}}             — join a list (default separator ",")
+//	{{.raw_payload.commits | json}}     — encode as JSON
+//
+// If a placeholder cannot be resolved the original placeholder text is left
+// in place so misconfiguration is visible rather than silently swallowed;
+// steps with strict_templates set fail instead (see strictTemplateStep).
 func resolveField(value string, triggerData map[string]any, stepOutputs map[string]map[string]any, current map[string]any) string {
+	resolved, _ := resolveTemplate(value, triggerData, stepOutputs, current)
+	return resolved
+}
+
+// resolveTemplate resolves every placeholder in value like resolveField and
+// also returns the first placeholder that could not be resolved, as an error.
+// Substituted values are not themselves scanned for placeholders.
+func resolveTemplate(value string, triggerData map[string]any, stepOutputs map[string]map[string]any, current map[string]any) (string, error) {
 	if !strings.Contains(value, "{{") {
-		return value
+		return value, nil
 	}
-
-	result := value
-	// Iterate until no more replacements can be made (handles multiple refs).
-	for strings.Contains(result, "{{") {
-		start := strings.Index(result, "{{")
-		end := strings.Index(result, "}}")
-		if end < start {
+	var b strings.Builder
+	var firstErr error
+	rest := value
+	for {
+		start := strings.Index(rest, "{{")
+		if start < 0 {
 			break
 		}
-		placeholder := result[start : end+2]
-		inner := strings.TrimSpace(result[start+2 : end])
-
-		resolved, ok := lookupRef(inner, triggerData, stepOutputs, current)
-		if ok {
-			result = strings.Replace(result, placeholder, fmt.Sprintf("%v", resolved), 1)
-		} else {
-			// Leave the unresolvable placeholder and stop to avoid an infinite loop.
+		end := strings.Index(rest[start:], "}}")
+		if end < 0 {
 			break
 		}
+		end += start
+		placeholder := rest[start : end+2]
+		b.WriteString(rest[:start])
+		v, err := evalTemplateExpr(rest[start+2:end], triggerData, stepOutputs, current)
+		if err != nil {
+			b.WriteString(placeholder)
+			if firstErr == nil {
+				firstErr = fmt.Errorf("unresolved template %s: %w", placeholder, err)
+			}
+		} else {
+			b.WriteString(formatTemplateValue(v))
+		}
+		rest = rest[end+2:]
 	}
-	return result
+	b.WriteString(rest)
+	return b.String(), firstErr
 }
 
-// lookupRef resolves a single template reference (the content between {{ and }}).
-func lookupRef(ref string, triggerData map[string]any, stepOutputs map[string]map[string]any, current map[string]any) (any, bool) {
-	// Strip leading dot.
-	ref = strings.TrimPrefix(ref, ".")
+// resolveValue resolves value like resolveField, except that a value made of a
+// single placeholder yields the referenced value with its original type (a
+// float64 or []any decoded from JSON, a bool, ...) rather than its text form.
+func resolveValue(value string, triggerData map[string]any, stepOutputs map[string]map[string]any, current map[string]any) any {
+	trimmed := strings.TrimSpace(value)
+	if strings.HasPrefix(trimmed, "{{") && strings.HasSuffix(trimmed, "}}") && strings.Count(trimmed, "{{") == 1 {
+		if v, err := evalTemplateExpr(trimmed[2:len(trimmed)-2], triggerData, stepOutputs, current); err == nil {
+			return v
+		}
+	}
+	return resolveField(value, triggerData, stepOutputs, current)
+}
 
-	parts := strings.SplitN(ref, ".", 3)
+// evalTemplateExpr evaluates the content of a placeholder: a reference
+// followed by optional filters.
+func evalTemplateExpr(expr string, triggerData map[string]any, stepOutputs map[string]map[string]any, current map[string]any) (any, error) {
+	stages, err := splitPipeline(expr)
+	if err != nil {
+		return nil, err
+	}
+	ref := stages[0]
+	if len(ref) != 1 || !strings.HasPrefix(ref[0], ".") {
+		return nil, fmt.Errorf("expected a reference such as .field, got %q", strings.Join(ref, " "))
+	}
+	for _, stage := range stages[1:] {
+		if err := checkTemplateFilter(stage[0], stage[1:]); err != nil {
+			return nil, err
+		}
+	}
+	v, found := lookupRef(ref[0], triggerData, stepOutputs, current)
+	for _, stage := range stages[1:] {
+		name, args := stage[0], stage[1:]
+		if name == "default" {
+			if !found || isEmptyTemplateValue(v) {
+				v, found = args[0], true
+			}
+			continue
+		}
+		if !found {
+			continue
+		}
+		if v, err = applyTemplateFilter(name, args, v); err != nil {
+			return nil, err
+		}
+	}
+	if !found {
+		return nil, errUnresolved
+	}
+	return v, nil
+}
 
-	switch parts[0] {
-	case "steps":
-		// {{.steps.<stepName>.<field>}}
-		if len(parts) < 3 {
-			return nil, false
+// checkTemplateFilter reports an unknown filter or a wrong argument count.
+func checkTemplateFilter(name string, args []string) error {
+	switch name {
+	case "default":
+		if len(args) != 1 {
+			return errors.New("default takes one argument")
+		}
+	case "lower", "upper", "trim", "json":
+		if len(args) != 0 {
+			return fmt.Errorf("%s takes no arguments", name)
+		}
+	case "join":
+		if len(args) > 1 {
+			return errors.New("join takes at most one argument")
+		}
+	default:
+		return fmt.Errorf("unknown filter %q", name)
+	}
+	return nil
+}
+
+// applyTemplateFilter applies a checked filter other than default to v.
+func applyTemplateFilter(name string, args []string, v any) (any, error) {
+	switch name {
+	case "lower":
+		return strings.ToLower(formatTemplateValue(v)), nil
+	case "upper":
+		return strings.ToUpper(formatTemplateValue(v)), nil
+	case "trim":
+		return strings.TrimSpace(formatTemplateValue(v)), nil
+	case "json":
+		b, err := json.Marshal(v)
+		if err != nil {
+			return nil, fmt.Errorf("json: %w", err)
+		}
+		return string(b), nil
+	default: // join
+		sep := ","
+		if len(args) == 1 {
+			sep = args[0]
+		}
+		var items []string
+		switch list := v.(type) {
+		case []any:
+			for _, item := range list {
+				items = append(items, formatTemplateValue(item))
+			}
+		case []string:
+			items = list
+		default:
+			return formatTemplateValue(v), nil
 		}
-		stepName, field := parts[1], parts[2]
-		if stepOutputs == nil {
+		return strings.Join(items, sep), nil
+	}
+}
+
+// splitPipeline splits a placeholder's content on "|" into stages, and each
+// stage into words. Words may be double-quoted Go string literals.
+func splitPipeline(expr string) ([][]string, error) {
+	var stages [][]string
+	var words []string
+	rest := strings.TrimSpace(expr)
+	for {
+		rest = strings.TrimLeft(rest, " \t")
+		switch {
+		case rest == "" || rest[0] == '|':
+			if len(words) == 0 {
+				return nil, errors.New("empty template expression")
+			}
+			stages = append(stages, words)
+			words = nil
+			if rest == "" {
+				return stages, nil
+			}
+			rest = rest[1:]
+		case rest[0] == '"':
+			end := 1
+			for end < len(rest) && rest[end] != '"' {
+				if rest[end] == '\\' {
+					end++
+				}
+				end++
+			}
+			if end >= len(rest) {
+				return nil, errors.New("unterminated string")
+			}
+			word, err := strconv.Unquote(rest[:end+1])
+			if err != nil {
+				return nil, fmt.Errorf("invalid string %s", rest[:end+1])
+			}
+			words = append(words, word)
+			rest = rest[end+1:]
+		default:
+			end := strings.IndexAny(rest, " \t|")
+			if end < 0 {
+				end = len(rest)
+			}
+			words = append(words, rest[:end])
+			rest = rest[end:]
+		}
+	}
+}
+
+// lookupRef resolves a single template reference such as .field,
+// .steps.name.field, or .current.items[0].name.
+func lookupRef(ref string, triggerData map[string]any, stepOutputs map[string]map[string]any, current map[string]any) (any, bool) {
+	path := strings.Split(strings.NewReplacer("[", ".", "]", "").Replace(strings.TrimPrefix(ref, ".")), ".")
+
+	switch path[0] {
+	case "steps":
+		// {{.steps.<stepName>.<field>...}}
+		if len(path) < 3 || stepOutputs == nil {
 			return nil, false
 		}
-		outputs, ok := stepOutputs[stepName]
+		outputs, ok := stepOutputs[path[1]]
 		if !ok {
 			return nil, false
 		}
-		v, ok := outputs[field]
-		return v, ok
+		return lookupPath(outputs, path[2:])
 
 	case "current":
-		// {{.current.<field>}}
-		if len(parts) < 2 {
-			return nil, false
-		}
-		field := strings.Join(parts[1:], ".")
-		if current == nil {
+		// {{.current.<field>...}}
+		if len(path) < 2 || current == nil {
 			return nil, false
 		}
-		v, ok := current[field]
-		return v, ok
+		return lookupPath(current, path[1:])
 
 	default:
-		// {{.field}} — look up directly in triggerData.
-		field := strings.Join(parts, ".")
+		// {{.field...}} — look up in triggerData.
 		if triggerData == nil {
 			return nil, false
 		}
-		v, ok := triggerData[field]
-		return v, ok
+		return lookupPath(triggerData, path)
+	}
+}
+
+// lookupPath walks path through nested maps and arrays. At each map a key
+// spelled with the remaining dots (e.g. "a.b") is preferred over nesting.
+func lookupPath(v any, path []string) (any, bool) {
+	if len(path) == 0 {
+		return v, true
+	}
+	switch node := v.(type) {
+	case map[string]any:
+		if len(path) > 1 {
+			if flat, ok := node[strings.Join(path, ".")]; ok {
+				return flat, true
+			}
+		}
+		next, ok := node[path[0]]
+		if !ok {
+			return nil, false
+		}
+		return lookupPath(next, path[1:])
+	case map[string]string:
+		if len(path) != 1 {
+			return nil, false
+		}
+		next, ok := node[path[0]]
+		return next, ok
+	case []any:
+		i, err := strconv.Atoi(path[0])
+		if err != nil || i < 0 || i >= len(node) {
+			return nil, false
+		}
+		return lookupPath(node[i], path[1:])
+	case []string:
+		i, err := strconv.Atoi(path[0])
+		if err != nil || i < 0 || i >= len(node) || len(path) != 1 {
+			return nil, false
+		}
+		return node[i], true
+	default:
+		return nil, false
+	}
+}
+
+// isEmptyTemplateValue reports whether v is nil or an empty string or list.
+func isEmptyTemplateValue(v any) bool {
+	switch v := v.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case []any:
+		return len(v) == 0
+	case []string:
+		return len(v) == 0
+	default:
+		return false
+	}
+}
+
+// formatTemplateValue renders a resolved value as text. Integral floats (as
+// decoded from JSON) are written without an exponent, and nil as "".
+func formatTemplateValue(v any) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+}
+
+// strictTemplateStep wraps a step whose config sets strict_templates. Before
+// the step runs, every placeholder in its config must resolve; otherwise the
+// step fails instead of sending literal {{...}} text to GitHub.
+type strictTemplateStep struct {
+	sdk.StepInstance
+	templates []configTemplate
+}
+
+// configTemplate is a config string that contains placeholders.
+type configTemplate struct {
+	key   string
+	value string
+}
+
+// withStrictTemplates wraps step in a strictTemplateStep when config sets
+// strict_templates, and checks the syntax of every placeholder in config.
+func withStrictTemplates(step sdk.StepInstance, config map[string]any) (sdk.StepInstance, error) {
+	strict, err := parseTemplateBool(config, "strict_templates")
+	if err != nil {
+		return nil, err
+	}
+	if strict.Ref != "" {
+		return nil, errors.New("config.strict_templates must be true or false")
+	}
+	if !strict.Value {
+		return step, nil
+	}
+	var templates []configTemplate
+	collectConfigTemplates("", config, &templates)
+	for _, t := range templates {
+		if _, err := resolveTemplate(t.value, nil, nil, nil); err != nil && !errors.Is(err, errUnresolved) {
+			return nil, fmt.Errorf("config.%s: %w", t.key, err)
+		}
+	}
+	return &strictTemplateStep{StepInstance: step, templates: templates}, nil
+}
+
+// collectConfigTemplates appends every string under v that contains a
+// placeholder, keyed by its dotted config path.
+func collectConfigTemplates(key string, v any, out *[]configTemplate) {
+	switch v := v.(type) {
+	case string:
+		if strings.Contains(v, "{{") {
+			*out = append(*out, configTemplate{key: key, value: v})
+		}
+	case map[string]any:
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			child := k
+			if key != "" {
+				child = key + "." + k
+			}
+			collectConfigTemplates(child, v[k], out)
+		}
+	case []any:
+		for i, item := range v {
+			collectConfigTemplates(fmt.Sprintf("%s[%d]", key, i), item, out)
+		}
+	case []string:
+		for i, item := range v {
+			collectConfigTemplates(fmt.Sprintf("%s[%d]", key, i), item, out)
+		}
+	}
+}
+
+func (s *strictTemplateStep) Execute(
+	ctx context.Context,
+	triggerData map[string]any,
+	stepOutputs map[string]map[string]any,
+	current map[string]any,
+	metadata map[string]any,
+	config map[string]any,
+) (*sdk.StepResult, error) {
+	for _, t := range s.templates {
+		if _, err := resolveTemplate(t.value, triggerData, stepOutputs, current); err != nil {
+			return errorResult(fmt.Sprintf("config.%s: %v", t.key, err)), nil
+		}
 	}
+	return s.StepInstance.Execute(ctx, triggerData, stepOutputs, current, metadata, config)
 }
@@ -0,0 +1,94 @@
+package internal
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestResolveField(t *testing.T) {
+	triggerData := map[string]any{
+		"author":      "  Octo-Cat ",
+		"base.branch": "flat",
+		"raw_payload": map[string]any{
+			"pull_request": map[string]any{
+				"number": float64(1234567),
+				"head":   map[string]any{"sha": "abc123"},
+			},
+			"commits": []any{map[string]any{"id": "c1"}, map[string]any{"id": "c2"}},
+		},
+		"empty": "",
+	}
+	stepOutputs := map[string]map[string]any{
+		"classify": {"labels": []any{"bug", "p1"}, "meta": map[string]any{"team": "infra"}},
+	}
+	current := map[string]any{"items": []string{"first", "second"}}
+
+	cases := map[string]string{
+		"{{.raw_payload.pull_request.head.sha}}":         "abc123",
+		"#{{.raw_payload.pull_request.number}}":          "#1234567",
+		"{{.raw_payload.commits[1].id}}":                 "c2",
+		"{{.raw_payload.commits.0.id}}":                  "c1",
+		"{{.steps.classify.meta.team}}":                  "infra",
+		"{{.current.items[1]}}":                          "second",
+		"{{.base.branch}}":                               "flat",
+		"{{.missing | default \"main\"}}":                "main",
+		"{{.empty | default main}}":                      "main",
+		"{{ .author | trim | lower }}":                   "octo-cat",
+		"{{.author | upper | trim}}":                     "OCTO-CAT",
+		"{{.steps.classify.labels | join \", \"}}":       "bug, p1",
+		"{{.steps.classify.labels | join}}":              "bug,p1",
+		"{{.steps.classify.labels | json}}":              `["bug","p1"]`,
+		"{{.missing}} and {{.raw_payload.commits.0.id}}": "{{.missing}} and c1",
+		"{{.author | bogus}}":                            "{{.author | bogus}}",
+	}
+	for in, want := range cases {
+		if got := resolveField(in, triggerData, stepOutputs, current); got != want {
+			t.Errorf("%s: expected %q, got %q", in, want, got)
+		}
+	}
+
+	if _, err := resolveTemplate("{{.missing}}", triggerData, stepOutputs, current); err == nil || !strings.Contains(err.Error(), "{{.missing}}") {
+		t.Errorf("expected an error naming the unresolved placeholder, got %v", err)
+	}
+	if v := resolveValue("{{.raw_payload.pull_request.number}}", triggerData, nil, nil); v != float64(1234567) {
+		t.Errorf("expected the referenced value with its type, got %#v", v)
+	}
+}
+
+func TestStrictTemplates(t *testing.T) {
+	p := &githubPlugin{}
+	config := map[string]any{
+		"owner":            "GoCodeAlone",
+		"repo":             "workflow",
+		"pr_number":        1,
+		"body":             "Deploying {{.steps.build.version}}",
+		"token":            "tok",
+		"strict_templates": true,
+	}
+	step, err := p.CreateStep("step.gh_pr_comment", "comment", config)
+	if err != nil {
+		t.Fatalf("CreateStep: %v", err)
+	}
+	result, err := step.Execute(context.Background(), nil, map[string]map[string]any{}, nil, nil, nil)
+	if err != nil {
+		t.Fatalf("Execute: %v", err)
+	}
+	if !result.StopPipeline || !strings.Contains(result.Output["error"].(string), "config.body") {
+		t.Errorf("expected the step to fail on config.body, got %v", result.Output)
+	}
+
+	config["body"] = "Deploying {{.steps.build.version | nope}}"
+	if _, err := p.CreateStep("step.gh_pr_comment", "comment", config); err == nil {
+		t.Error("expected an unknown filter to fail construction")
+	}
+
+	delete(config, "strict_templates")
+	step, err = p.CreateStep("step.gh_pr_comment", "comment", config)
+	if err != nil {
+		t.Fatalf("CreateStep: %v", err)
+	}
+	if _, ok := step.(*strictTemplateStep); ok {
+		t.Error("expected the step not to be wrapped without strict_templates")
+	}
+}
@@ -26,32 +26,152 @@ func (p *githubPlugin) ModuleSchemas() []sdk.ModuleSchemaData {
 				{
 					Name:        "secret",
 					Type:        "string",
-					Description: "Webhook secret used to verify the X-Hub-Signature-256 header. Leave empty to skip signature verification.",
+					Description: "Webhook secret used to verify the X-Hub-Signature-256 header. Leave empty (with no secrets) to skip signature verification.",
 					Required:    false,
 				},
+				{
+					Name:        "secrets",
+					Type:        "array",
+					Description: "Additional accepted secrets as {name, secret, expires_at} entries, tried after secret. Lets a secret be rotated without downtime; expires_at is an RFC 3339 time.",
+					Required:    false,
+				},
+				{
+					Name:         "allow_sha1_signature",
+					Type:         "boolean",
+					Description:  "Accept the legacy X-Hub-Signature (HMAC-SHA1) header when X-Hub-Signature-256 is absent, for older GitHub Enterprise Server versions.",
+					DefaultValue: "false",
+					Required:     false,
+				},
 				{
 					Name:        "events",
 					Type:        "array",
-					Description: "Event types to accept (e.g. push, pull_request). An empty list accepts all event types.",
+					Description: "Event types to accept (e.g. push, pull_request). An entry may name accepted actions, e.g. 'pull_request:opened,synchronize'. An empty list accepts all event types.",
+					Required:    false,
+				},
+				{
+					Name:        "repositories",
+					Type:        "array",
+					Description: "Repository globs to accept (e.g. 'my-org/*'). An empty list accepts all repositories.",
+					Required:    false,
+				},
+				{
+					Name:        "exclude_repositories",
+					Type:        "array",
+					Description: "Repository globs whose events are ignored.",
+					Required:    false,
+				},
+				{
+					Name:        "branches",
+					Type:        "array",
+					Description: "Branch or tag globs to accept, by short name or full ref (e.g. 'refs/heads/release/*'). Events without a branch or tag are not filtered.",
+					Required:    false,
+				},
+				{
+					Name:        "exclude_branches",
+					Type:        "array",
+					Description: "Branch or tag globs whose events are ignored.",
+					Required:    false,
+				},
+				{
+					Name:        "exclude_senders",
+					Type:        "array",
+					Description: "Sender login globs whose events are ignored (e.g. '*[bot]').",
+					Required:    false,
+				},
+				{
+					Name:        "labels",
+					Type:        "array",
+					Description: "Label globs; when set, only events carrying at least one matching label are accepted.",
+					Required:    false,
+				},
+				{
+					Name:        "exclude_labels",
+					Type:        "array",
+					Description: "Label globs; events carrying any matching label are ignored.",
 					Required:    false,
 				},
 				{
 					Name:         "topic",
 					Type:         "string",
-					Description:  "Message-bus topic to which normalised GitEvent payloads are published.",
+					Description:  "Default message-bus topic for normalised GitEvent payloads. May use {event_type}, {action}, {repository}, {owner}, and {repo} placeholders, e.g. 'git.events.{event_type}'.",
 					DefaultValue: "git.events",
 					Required:     false,
 				},
+				{
+					Name:        "routes",
+					Type:        "array",
+					Description: "Routing table of {event, repositories, topic} entries. event is an event type, optionally with actions ('pull_request:opened'), or '*'. The first matching route's topic template is used; unmatched events go to topic.",
+					Required:    false,
+				},
+				{
+					Name:         "path",
+					Type:         "string",
+					Description:  "HTTP route served by this module. step.gh_webhook_forward and step.gh_webhook_receive select the module whose path matches the request path.",
+					DefaultValue: "/webhooks/github",
+					Required:     false,
+				},
+				{
+					Name:         "dedup_window",
+					Type:         "string",
+					Description:  "How long X-GitHub-Delivery IDs are remembered; redeliveries within the window are answered with status 'duplicate' and not published. '0s' disables de-duplication.",
+					DefaultValue: "24h",
+					Required:     false,
+				},
+				{
+					Name:         "dedup_max_entries",
+					Type:         "number",
+					Description:  "Maximum number of remembered delivery IDs; the oldest are evicted first.",
+					DefaultValue: "10000",
+					Required:     false,
+				},
+				{
+					Name:        "state_dir",
+					Type:        "string",
+					Description: "Optional directory that persists remembered delivery IDs, and queued events when async_publish is set, across restarts.",
+					Required:    false,
+				},
+				{
+					Name:         "async_publish",
+					Type:         "boolean",
+					Description:  "Answer GitHub with 202 once an accepted event is written to a durable queue in state_dir, and publish it in the background with retries. Requires state_dir.",
+					DefaultValue: "false",
+					Required:     false,
+				},
+				{
+					Name:         "retry_initial_backoff",
+					Type:         "string",
+					Description:  "Delay before the first retry of a failed background publish; doubles with jitter on each failure.",
+					DefaultValue: "1s",
+					Required:     false,
+				},
+				{
+					Name:         "retry_max_backoff",
+					Type:         "string",
+					Description:  "Maximum delay between background publish retries.",
+					DefaultValue: "5m",
+					Required:     false,
+				},
 			},
 			Outputs: []sdk.ServiceIO{
 				{Name: "provider", Type: "string", Description: "Webhook provider (always 'github')"},
 				{Name: "event_type", Type: "string", Description: "GitHub event type (e.g. push, pull_request)"},
+				{Name: "action", Type: "string", Description: "Payload action (e.g. opened, completed)"},
+				{Name: "delivery_id", Type: "string", Description: "GitHub delivery ID from the X-GitHub-Delivery header"},
 				{Name: "repository", Type: "string", Description: "Repository full name (owner/repo)"},
-				{Name: "branch", Type: "string", Description: "Branch or ref name"},
+				{Name: "branch", Type: "string", Description: "Branch name; empty for tag pushes, tag refs, and releases, which set tag"},
+				{Name: "base_branch", Type: "string", Description: "Pull request or merge group base branch"},
+				{Name: "tag", Type: "string", Description: "Tag name for tag pushes, tag refs, and releases"},
 				{Name: "commit", Type: "string", Description: "Commit SHA"},
 				{Name: "author", Type: "string", Description: "Event author username"},
-				{Name: "message", Type: "string", Description: "Commit message or PR title"},
-				{Name: "url", Type: "string", Description: "URL to the commit or PR"},
+				{Name: "sender", Type: "string", Description: "Login that triggered the delivery"},
+				{Name: "message", Type: "string", Description: "Commit message, PR or issue title, comment body, or run/check name"},
+				{Name: "url", Type: "string", Description: "URL to the commit, PR, issue, comment, run, or check"},
+				{Name: "pr_number", Type: "number", Description: "Pull request number"},
+				{Name: "issue_number", Type: "number", Description: "Issue number"},
+				{Name: "labels", Type: "array", Description: "Issue, pull request, or runner label names"},
+				{Name: "environment", Type: "string", Description: "Deployment environment"},
+				{Name: "status", Type: "string", Description: "Run, check, review, deployment, or commit status state"},
+				{Name: "conclusion", Type: "string", Description: "Workflow run, job, or check conclusion"},
 				{Name: "raw_payload", Type: "object", Description: "Raw JSON webhook payload"},
 				{Name: "timestamp", Type: "string", Description: "Event timestamp in RFC3339 format"},
 			},
@@ -71,8 +191,7 @@ func (p *githubPlugin) ModuleSchemas() []sdk.ModuleSchemaData {
 				{
 					Name:        "installation_id",
 					Type:        "number",
-					Description: "GitHub App installation ID",
-					Required:    true,
+					Description: "GitHub App installation ID (optional; when omitted the installation is looked up from each step's owner)",
 				},
 				{
 					Name:        "private_key",
@@ -80,6 +199,31 @@ func (p *githubPlugin) ModuleSchemas() []sdk.ModuleSchemaData {
 					Description: "PEM-encoded RSA private key for the GitHub App (supports env var references e.g. ${GITHUB_APP_PRIVATE_KEY})",
 					Required:    true,
 				},
+				{
+					Name:        "repositories",
+					Type:        "array",
+					Description: "Repository names every minted installation token is limited to (optional)",
+				},
+				{
+					Name:        "permissions",
+					Type:        "map",
+					Description: "Permission levels (read, write, admin) every minted installation token is limited to, e.g. contents: read (optional)",
+				},
+				{
+					Name:        "api_base_url",
+					Type:        "string",
+					Description: "GitHub Enterprise Server API root the App is registered on, e.g. https://ghe.example.com/api/v3 (optional; defaults to https://api.github.com)",
+				},
+				{
+					Name:        "upload_url",
+					Type:        "string",
+					Description: "Release asset upload root (optional; defaults to /api/uploads on the api_base_url host)",
+				},
+				{
+					Name:        "graphql_url",
+					Type:        "string",
+					Description: "GraphQL endpoint (optional; defaults to /api/graphql on the api_base_url host)",
+				},
 			},
 		},
 		{
@@ -98,11 +98,14 @@ func TestModuleSchemas(t *testing.T) {
 			requiredFields[f.Name] = true
 		}
 	}
-	for _, want := range []string{"app_id", "installation_id", "private_key"} {
+	for _, want := range []string{"app_id", "private_key"} {
 		if !requiredFields[want] {
 			t.Errorf("github.app schema: field %q should be marked required", want)
 		}
 	}
+	if requiredFields["installation_id"] {
+		t.Error("github.app schema: installation_id should be optional (looked up per owner)")
+	}
 
 	// Cross-check against plugin.json moduleTypes.
 	data, err := os.ReadFile("../plugin.json")
@@ -246,24 +249,38 @@ func TestStepSchemaFieldContracts(t *testing.T) {
 		return m
 	}
 
-	// Every step schema with a token field must mark it required and sensitive.
+	// Every step schema with a token field must mark it sensitive and offer
+	// auth_module as the alternative credential source.
 	for _, s := range manifest.StepSchemas {
 		fields := fieldsByKey(s)
 		if tok, ok := fields["token"]; ok {
-			if !tok.Required && s.Type != "step.gh_upstream_release_monitor" {
-				t.Errorf("%s: token field must be required=true (step fails at runtime without it)", s.Type)
-			}
 			if !tok.Sensitive {
 				t.Errorf("%s: token field must be sensitive=true", s.Type)
 			}
+			if tok.Required {
+				t.Errorf("%s: token field must not be required=true (auth_module is an alternative)", s.Type)
+			}
+			if _, ok := fields["auth_module"]; !ok {
+				t.Errorf("%s: auth_module field missing alongside token", s.Type)
+			}
 		}
 	}
 
 	// Fields that accept both numeric literals and template expressions must be
 	// declared as string so schema-aware validators accept template syntax.
 	templateCapableFields := map[string]string{
-		"step.gh_action_status":  "run_id",
-		"step.gh_release_upload": "release_id",
+		"step.gh_action_status":     "run_id",
+		"step.gh_action_cancel":     "run_id",
+		"step.gh_action_rerun":      "run_id",
+		"step.gh_action_approve":    "run_id",
+		"step.gh_artifact_list":     "run_id",
+		"step.gh_artifact_download": "run_id",
+		"step.gh_release_upload":    "release_id",
+		"step.gh_pr_merge":          "pr_number",
+		"step.gh_pr_comment":        "pr_number",
+		"step.gh_pr_review":         "pr_number",
+		"step.gh_issue_close":       "issue_number",
+		"step.gh_issue_label":       "issue_number",
 	}
 	for stepType, fieldKey := range templateCapableFields {
 		s, ok := byType[stepType]
@@ -309,12 +326,12 @@ func TestStepSchemaFieldContracts(t *testing.T) {
 		}
 	}
 
-	// gh_secret_set: repo must be required (no org-secret path exists).
+	// gh_secret_set: repo is optional (organization secrets omit it).
 	if s, ok := byType["step.gh_secret_set"]; ok {
 		fields := fieldsByKey(s)
 		if repo, ok := fields["repo"]; ok {
-			if !repo.Required {
-				t.Errorf("step.gh_secret_set: repo field must be required=true (only repo secrets are supported)")
+			if repo.Required {
+				t.Errorf("step.gh_secret_set: repo field must be optional so organization secrets can omit it")
 			}
 		} else {
 			t.Errorf("step.gh_secret_set: repo field missing from schema")
@@ -0,0 +1,391 @@
+package internal
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/google/go-github/v69/github"
+)
+
+// Secret apps: the GitHub feature a secret belongs to.
+const (
+	secretAppActions    = "actions"
+	secretAppDependabot = "dependabot"
+	secretAppCodespaces = "codespaces"
+)
+
+// secretTarget selects where secrets are stored: an organization when repo
+// is empty, a repository, or a repository environment, for the Actions,
+// Dependabot, or Codespaces app.
+//
+// Config:
+//
+//	owner:       "GoCodeAlone"
+//	repo:        "workflow"     # omit for organization secrets
+//	environment: "production"   # Actions environment secrets; requires repo
+//	app:         "actions"      # actions (default), dependabot, codespaces
+type secretTarget struct {
+	Owner       string `yaml:"owner"`
+	Repo        string `yaml:"repo"`
+	Environment string `yaml:"environment"`
+	App         string `yaml:"app"`
+}
+
+// parseSecretTarget reads owner, repo, environment, and app from a raw
+// config.
+func parseSecretTarget(raw map[string]any) (secretTarget, error) {
+	var t secretTarget
+	t.Owner, _ = raw["owner"].(string)
+	if t.Owner == "" {
+		return t, errors.New("config.owner is required")
+	}
+	t.Repo, _ = raw["repo"].(string)
+	t.Environment, _ = raw["environment"].(string)
+	if t.Environment != "" && t.Repo == "" {
+		return t, errors.New("config.environment requires config.repo")
+	}
+	t.App, _ = raw["app"].(string)
+	if t.App == "" {
+		t.App = secretAppActions
+	}
+	if !strings.Contains(t.App, "{{") {
+		if err := validateSecretApp(t.App, t.Environment != ""); err != nil {
+			return t, err
+		}
+	}
+	return t, nil
+}
+
+// validateSecretApp checks app, and that only Actions has environment
+// secrets.
+func validateSecretApp(app string, environment bool) error {
+	switch app {
+	case secretAppActions:
+		return nil
+	case secretAppDependabot, secretAppCodespaces:
+		if environment {
+			return fmt.Errorf("config.environment is only supported for %s secrets", secretAppActions)
+		}
+		return nil
+	default:
+		return fmt.Errorf("config.app must be one of %s, %s, %s; got %q", secretAppActions, secretAppDependabot, secretAppCodespaces, app)
+	}
+}
+
+// parseNameList reads the secrets or variables a step acts on from either
+// name or names.
+func parseNameList(raw map[string]any) (templateList, error) {
+	names, err := parseTemplateList(raw, "names")
+	if err != nil {
+		return names, err
+	}
+	empty := len(names.Items) == 0 && names.Ref == ""
+	name, _ := raw["name"].(string)
+	switch {
+	case name != "" && !empty:
+		return names, errors.New("config.name and config.names are mutually exclusive")
+	case name != "":
+		names.Items = []string{name}
+	case empty:
+		return names, errors.New("config.name or config.names is required")
+	}
+	return names, nil
+}
+
+// isOrg reports whether the target is an organization.
+func (t secretTarget) isOrg() bool {
+	return t.Repo == ""
+}
+
+// resolve resolves the target's templates.
+func (t secretTarget) resolve(
+	triggerData map[string]any,
+	stepOutputs map[string]map[string]any,
+	current map[string]any,
+) (*secretScope, error) {
+	s := &secretScope{
+		owner:       resolveField(t.Owner, triggerData, stepOutputs, current),
+		repo:        resolveField(t.Repo, triggerData, stepOutputs, current),
+		environment: resolveField(t.Environment, triggerData, stepOutputs, current),
+		app:         resolveField(t.App, triggerData, stepOutputs, current),
+	}
+	if err := validateSecretApp(s.app, s.environment != ""); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// secretScope is a resolved secretTarget. Environment secrets are addressed
+// by repository ID, which is looked up on first use.
+type secretScope struct {
+	owner       string
+	repo        string
+	environment string
+	app         string
+	repoID      int64
+}
+
+// kind names the scope: "org", "repo", or "environment".
+func (s secretScope) kind() string {
+	switch {
+	case s.environment != "":
+		return "environment"
+	case s.repo != "":
+		return "repo"
+	default:
+		return "org"
+	}
+}
+
+// String describes the scope for error messages.
+func (s secretScope) String() string {
+	switch s.kind() {
+	case "environment":
+		return fmt.Sprintf("%s environment %s/%s:%s", s.app, s.owner, s.repo, s.environment)
+	case "repo":
+		return fmt.Sprintf("%s repository %s/%s", s.app, s.owner, s.repo)
+	default:
+		return fmt.Sprintf("%s organization %s", s.app, s.owner)
+	}
+}
+
+// output returns the scope's fields for a step result.
+func (s secretScope) output() map[string]any {
+	return map[string]any{
+		"owner":       s.owner,
+		"repo":        s.repo,
+		"environment": s.environment,
+		"app":         s.app,
+		"scope":       s.kind(),
+	}
+}
+
+// repositoryID returns the ID of the scope's repository.
+func (s *secretScope) repositoryID(ctx context.Context, gh *github.Client) (int, error) {
+	if s.repoID == 0 {
+		repo, _, err := gh.Repositories.Get(ctx, s.owner, s.repo)
+		if err != nil {
+			return 0, fmt.Errorf("get repository %s/%s: %w", s.owner, s.repo, err)
+		}
+		s.repoID = repo.GetID()
+	}
+	return int(s.repoID), nil
+}
+
+// publicKey fetches the key secret values must be encrypted with.
+func (s *secretScope) publicKey(ctx context.Context, gh *github.Client) (*github.PublicKey, error) {
+	var key *github.PublicKey
+	var err error
+	switch {
+	case s.environment != "":
+		var repoID int
+		if repoID, err = s.repositoryID(ctx, gh); err != nil {
+			return nil, err
+		}
+		key, _, err = gh.Actions.GetEnvPublicKey(ctx, repoID, s.environment)
+	case s.app == secretAppDependabot && s.repo != "":
+		key, _, err = gh.Dependabot.GetRepoPublicKey(ctx, s.owner, s.repo)
+	case s.app == secretAppDependabot:
+		key, _, err = gh.Dependabot.GetOrgPublicKey(ctx, s.owner)
+	case s.app == secretAppCodespaces && s.repo != "":
+		key, _, err = gh.Codespaces.GetRepoPublicKey(ctx, s.owner, s.repo)
+	case s.app == secretAppCodespaces:
+		key, _, err = gh.Codespaces.GetOrgPublicKey(ctx, s.owner)
+	case s.repo != "":
+		key, _, err = gh.Actions.GetRepoPublicKey(ctx, s.owner, s.repo)
+	default:
+		key, _, err = gh.Actions.GetOrgPublicKey(ctx, s.owner)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get %s public key: %w", s, err)
+	}
+	return key, nil
+}
+
+// orgSecretAccess is the visibility of an organization secret.
+type orgSecretAccess struct {
+	visibility string
+	repoIDs    []int64
+}
+
+// resolveOrgSecretAccess resolves the visibility and selected repositories
+// of organization secrets.
+func resolveOrgSecretAccess(
+	ctx context.Context,
+	gh *github.Client,
+	owner, visibility string,
+	selected templateList,
+	triggerData map[string]any,
+	stepOutputs map[string]map[string]any,
+	current map[string]any,
+) (orgSecretAccess, error) {
+	access := orgSecretAccess{visibility: resolveField(visibility, triggerData, stepOutputs, current)}
+	if err := validateSecretVisibility(access.visibility); err != nil {
+		return access, err
+	}
+	repos, err := selected.resolve("selected_repositories", triggerData, stepOutputs, current)
+	if err != nil {
+		return access, err
+	}
+	switch {
+	case access.visibility == "selected" && len(repos) == 0:
+		return access, errors.New("config.selected_repositories is required when visibility is selected")
+	case access.visibility != "selected" && len(repos) > 0:
+		return access, errors.New("config.selected_repositories requires visibility selected")
+	}
+	access.repoIDs, err = selectedRepositoryIDs(ctx, gh, owner, repos)
+	return access, err
+}
+
+// put encrypts value with key and creates or updates the secret name. access
+// applies to organization secrets only.
+func (s *secretScope) put(ctx context.Context, gh *github.Client, key *github.PublicKey, name, value string, access orgSecretAccess) error {
+	keyBytes, err := base64.StdEncoding.DecodeString(key.GetKey())
+	if err != nil || len(keyBytes) != 32 {
+		return fmt.Errorf("decode public key: invalid key %q", key.GetKey())
+	}
+	var recipientKey [32]byte
+	copy(recipientKey[:], keyBytes)
+	encrypted, err := sealWithPublicKey(recipientKey, []byte(value))
+	if err != nil {
+		return fmt.Errorf("encrypt secret: %w", err)
+	}
+	secret := &github.EncryptedSecret{
+		Name:           name,
+		KeyID:          key.GetKeyID(),
+		EncryptedValue: base64.StdEncoding.EncodeToString(encrypted),
+	}
+	if s.kind() == "org" {
+		secret.Visibility = access.visibility
+		secret.SelectedRepositoryIDs = access.repoIDs
+	}
+	switch {
+	case s.environment != "":
+		var repoID int
+		if repoID, err = s.repositoryID(ctx, gh); err != nil {
+			return err
+		}
+		_, err = gh.Actions.CreateOrUpdateEnvSecret(ctx, repoID, s.environment, secret)
+	case s.app == secretAppDependabot:
+		dependabot := &github.DependabotEncryptedSecret{
+			Name:                  secret.Name,
+			KeyID:                 secret.KeyID,
+			EncryptedValue:        secret.EncryptedValue,
+			Visibility:            secret.Visibility,
+			SelectedRepositoryIDs: github.DependabotSecretsSelectedRepoIDs(secret.SelectedRepositoryIDs),
+		}
+		if s.repo != "" {
+			_, err = gh.Dependabot.CreateOrUpdateRepoSecret(ctx, s.owner, s.repo, dependabot)
+		} else {
+			_, err = gh.Dependabot.CreateOrUpdateOrgSecret(ctx, s.owner, dependabot)
+		}
+	case s.app == secretAppCodespaces && s.repo != "":
+		_, err = gh.Codespaces.CreateOrUpdateRepoSecret(ctx, s.owner, s.repo, secret)
+	case s.app == secretAppCodespaces:
+		_, err = gh.Codespaces.CreateOrUpdateOrgSecret(ctx, s.owner, secret)
+	case s.repo != "":
+		_, err = gh.Actions.CreateOrUpdateRepoSecret(ctx, s.owner, s.repo, secret)
+	default:
+		_, err = gh.Actions.CreateOrUpdateOrgSecret(ctx, s.owner, secret)
+	}
+	if err != nil {
+		return fmt.Errorf("set %s secret %q: %w", s, name, err)
+	}
+	return nil
+}
+
+// list returns every secret in the scope. Only names, timestamps, and
+// visibility are returned by GitHub, never values.
+func (s *secretScope) list(ctx context.Context, gh *github.Client) ([]*github.Secret, error) {
+	var all []*github.Secret
+	opts := &github.ListOptions{PerPage: 100}
+	for page := 0; page < maxGitHubPaginationPages; page++ {
+		var batch *github.Secrets
+		var resp *github.Response
+		var err error
+		switch {
+		case s.environment != "":
+			var repoID int
+			if repoID, err = s.repositoryID(ctx, gh); err != nil {
+				return nil, err
+			}
+			batch, resp, err = gh.Actions.ListEnvSecrets(ctx, repoID, s.environment, opts)
+		case s.app == secretAppDependabot && s.repo != "":
+			batch, resp, err = gh.Dependabot.ListRepoSecrets(ctx, s.owner, s.repo, opts)
+		case s.app == secretAppDependabot:
+			batch, resp, err = gh.Dependabot.ListOrgSecrets(ctx, s.owner, opts)
+		case s.app == secretAppCodespaces && s.repo != "":
+			batch, resp, err = gh.Codespaces.ListRepoSecrets(ctx, s.owner, s.repo, opts)
+		case s.app == secretAppCodespaces:
+			batch, resp, err = gh.Codespaces.ListOrgSecrets(ctx, s.owner, opts)
+		case s.repo != "":
+			batch, resp, err = gh.Actions.ListRepoSecrets(ctx, s.owner, s.repo, opts)
+		default:
+			batch, resp, err = gh.Actions.ListOrgSecrets(ctx, s.owner, opts)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("list %s secrets: %w", s, err)
+		}
+		all = append(all, batch.Secrets...)
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+	return all, nil
+}
+
+// delete deletes the secret name. It reports false, without an error, when
+// the secret does not exist.
+func (s *secretScope) delete(ctx context.Context, gh *github.Client, name string) (bool, error) {
+	var err error
+	switch {
+	case s.environment != "":
+		var repoID int
+		if repoID, err = s.repositoryID(ctx, gh); err != nil {
+			return false, err
+		}
+		_, err = gh.Actions.DeleteEnvSecret(ctx, repoID, s.environment, name)
+	case s.app == secretAppDependabot && s.repo != "":
+		_, err = gh.Dependabot.DeleteRepoSecret(ctx, s.owner, s.repo, name)
+	case s.app == secretAppDependabot:
+		_, err = gh.Dependabot.DeleteOrgSecret(ctx, s.owner, name)
+	case s.app == secretAppCodespaces && s.repo != "":
+		_, err = gh.Codespaces.DeleteRepoSecret(ctx, s.owner, s.repo, name)
+	case s.app == secretAppCodespaces:
+		_, err = gh.Codespaces.DeleteOrgSecret(ctx, s.owner, name)
+	case s.repo != "":
+		_, err = gh.Actions.DeleteRepoSecret(ctx, s.owner, s.repo, name)
+	default:
+		_, err = gh.Actions.DeleteOrgSecret(ctx, s.owner, name)
+	}
+	switch {
+	case isGitHubNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("delete %s secret %q: %w", s, name, err)
+	}
+	return true, nil
+}
+
+// selectedRepositoryIDs returns the IDs of the owner's repositories named in
+// repos. An entry that is a number is taken as an ID.
+func selectedRepositoryIDs(ctx context.Context, gh *github.Client, owner string, repos []string) ([]int64, error) {
+	ids := make([]int64, 0, len(repos))
+	for _, repo := range repos {
+		if id, err := strconv.ParseInt(repo, 10, 64); err == nil {
+			ids = append(ids, id)
+			continue
+		}
+		r, _, err := gh.Repositories.Get(ctx, owner, repo)
+		if err != nil {
+			return nil, fmt.Errorf("get repository %s/%s: %w", owner, repo, err)
+		}
+		ids = append(ids, r.GetID())
+	}
+	return ids, nil
+}
@@ -0,0 +1,190 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	sdk "github.com/GoCodeAlone/workflow/plugin/external/sdk"
+)
+
+// actionApproveStep implements sdk.StepInstance.
+// It approves (or rejects) the deployments of a GitHub Actions workflow run
+// that wait for an environment's required reviewers. The token must belong to
+// one of the environment's reviewers.
+//
+// Config:
+//
+//	owner:        "GoCodeAlone"
+//	repo:         "workflow"
+//	run_id:       "{{.steps.trigger.run_id}}"
+//	token:        "${GITHUB_TOKEN}"
+//	environments: ["production"]   # default: every environment the token can approve
+//	state:        "approved"       # approved | rejected (default: approved)
+//	comment:      "Approved by release pipeline"
+type actionApproveStep struct {
+	name     string
+	config   actionApproveConfig
+	ghClient GitHubClient
+}
+
+// actionApproveConfig holds the parsed configuration for step.gh_action_approve.
+type actionApproveConfig struct {
+	Owner        string       `yaml:"owner"`
+	Repo         string       `yaml:"repo"`
+	RunID        templateInt  `yaml:"run_id"`
+	Environments templateList `yaml:"environments"`
+	State        string       `yaml:"state"`
+	Comment      string       `yaml:"comment"`
+	Auth         stepAuth
+}
+
+// newActionApproveStep parses config and returns an actionApproveStep.
+func newActionApproveStep(name string, config map[string]any, client GitHubClient) (*actionApproveStep, error) {
+	cfg, err := parseActionApproveConfig(config)
+	if err != nil {
+		return nil, fmt.Errorf("step.gh_action_approve %q: %w", name, err)
+	}
+	if client == nil {
+		client = newHTTPGitHubClient(cfg.Auth.apiBaseURL)
+	}
+	return &actionApproveStep{
+		name:     name,
+		config:   cfg,
+		ghClient: client,
+	}, nil
+}
+
+// parseActionApproveConfig converts a raw config map to actionApproveConfig.
+func parseActionApproveConfig(raw map[string]any) (actionApproveConfig, error) {
+	var cfg actionApproveConfig
+
+	cfg.Owner, _ = raw["owner"].(string)
+	if cfg.Owner == "" {
+		return cfg, fmt.Errorf("config.owner is required")
+	}
+
+	cfg.Repo, _ = raw["repo"].(string)
+	if cfg.Repo == "" {
+		return cfg, fmt.Errorf("config.repo is required")
+	}
+
+	var err error
+	cfg.RunID, err = parseTemplateInt(raw, "run_id", true)
+	if err != nil {
+		return cfg, err
+	}
+
+	cfg.Auth, err = parseStepAuth(raw)
+	if err != nil {
+		return cfg, err
+	}
+
+	if cfg.Environments, err = parseTemplateList(raw, "environments"); err != nil {
+		return cfg, err
+	}
+
+	cfg.State, _ = raw["state"].(string)
+	switch cfg.State {
+	case "":
+		cfg.State = "approved"
+	case "approved", "rejected":
+	default:
+		return cfg, fmt.Errorf("config.state must be approved or rejected, got %q", cfg.State)
+	}
+
+	cfg.Comment, _ = raw["comment"].(string)
+	return cfg, nil
+}
+
+// Execute reviews the pending deployments of the configured workflow run.
+func (s *actionApproveStep) Execute(
+	ctx context.Context,
+	triggerData map[string]any,
+	stepOutputs map[string]map[string]any,
+	current map[string]any,
+	_ map[string]any,
+	_ map[string]any,
+) (*sdk.StepResult, error) {
+	owner := resolveField(s.config.Owner, triggerData, stepOutputs, current)
+	repo := resolveField(s.config.Repo, triggerData, stepOutputs, current)
+	token, err := s.config.Auth.token(ctx, owner)
+	if err != nil {
+		return errorResult(err.Error()), nil
+	}
+	runID, err := s.config.RunID.resolve("run_id", triggerData, stepOutputs, current)
+	if err != nil {
+		return errorResult(err.Error()), nil
+	}
+
+	environments, err := s.config.Environments.resolve("environments", triggerData, stepOutputs, current)
+	if err != nil {
+		return errorResult(err.Error()), nil
+	}
+	// Environments that resolve to nothing must not widen the review to
+	// every environment the token can approve.
+	if len(environments) == 0 && (len(s.config.Environments.Items) > 0 || s.config.Environments.Ref != "") {
+		return errorResult("environments resolved to an empty list"), nil
+	}
+
+	pending, err := s.ghClient.ListPendingDeployments(ctx, owner, repo, runID, token)
+	if err != nil {
+		return errorResult(fmt.Sprintf("failed to list pending deployments of workflow run %d: %v", runID, err)), nil
+	}
+	if len(pending) == 0 {
+		return errorResult(fmt.Sprintf("workflow run %d has no pending deployments", runID)), nil
+	}
+
+	var selected []PendingDeployment
+	if len(environments) == 0 {
+		for _, p := range pending {
+			if p.CurrentUserCanApprove {
+				selected = append(selected, p)
+			}
+		}
+		if len(selected) == 0 {
+			return errorResult(fmt.Sprintf("the token cannot review any pending deployment of workflow run %d", runID)), nil
+		}
+	} else {
+		for _, env := range environments {
+			p, ok := findPendingDeployment(pending, env)
+			if !ok {
+				return errorResult(fmt.Sprintf("workflow run %d has no pending deployment to environment %q", runID, env)), nil
+			}
+			if !p.CurrentUserCanApprove {
+				return errorResult(fmt.Sprintf("the token cannot review deployments to environment %q", env)), nil
+			}
+			selected = append(selected, p)
+		}
+	}
+
+	ids := make([]int64, 0, len(selected))
+	names := make([]string, 0, len(selected))
+	for _, p := range selected {
+		ids = append(ids, p.Environment.ID)
+		names = append(names, p.Environment.Name)
+	}
+	comment := resolveField(s.config.Comment, triggerData, stepOutputs, current)
+	if err := s.ghClient.ReviewPendingDeployments(ctx, owner, repo, runID, ids, s.config.State, comment, token); err != nil {
+		return errorResult(fmt.Sprintf("failed to review pending deployments of workflow run %d: %v", runID, err)), nil
+	}
+
+	return &sdk.StepResult{
+		Output: map[string]any{
+			"run_id":       runID,
+			"state":        s.config.State,
+			"environments": outputList(names),
+		},
+	}, nil
+}
+
+// findPendingDeployment returns the pending deployment to the named
+// environment. Environment names are case-insensitive.
+func findPendingDeployment(pending []PendingDeployment, env string) (PendingDeployment, bool) {
+	for _, p := range pending {
+		if strings.EqualFold(p.Environment.Name, env) {
+			return p, true
+		}
+	}
+	return PendingDeployment{}, false
+}
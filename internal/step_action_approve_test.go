@@ -0,0 +1,143 @@
+package internal
+
+import (
+	"context"
+	"testing"
+)
+
+func pendingDeployment(id int64, name string, canApprove bool) PendingDeployment {
+	var p PendingDeployment
+	p.Environment.ID = id
+	p.Environment.Name = name
+	p.CurrentUserCanApprove = canApprove
+	return p
+}
+
+func TestActionApproveStep_DefaultApprovesReviewableEnvironments(t *testing.T) {
+	var gotIDs []int64
+	var gotState string
+	client := &mockGitHubClient{
+		pendingDeploymentsFunc: func(context.Context, string, string, int64, string) ([]PendingDeployment, error) {
+			return []PendingDeployment{
+				pendingDeployment(1, "staging", true),
+				pendingDeployment(2, "production", false),
+			}, nil
+		},
+		reviewDeploymentsFunc: func(_ context.Context, _, _ string, _ int64, ids []int64, state, _, _ string) error {
+			gotIDs, gotState = ids, state
+			return nil
+		},
+	}
+	step, err := newActionApproveStep("approve", map[string]any{
+		"owner":  "GoCodeAlone",
+		"repo":   "workflow",
+		"run_id": 7,
+		"token":  "gh-token",
+	}, client)
+	if err != nil {
+		t.Fatalf("newActionApproveStep: %v", err)
+	}
+
+	result, err := step.Execute(context.Background(), nil, nil, nil, nil, nil)
+	if err != nil {
+		t.Fatalf("Execute: %v", err)
+	}
+	if result.StopPipeline {
+		t.Fatalf("unexpected error: %v", result.Output["error"])
+	}
+	if len(gotIDs) != 1 || gotIDs[0] != 1 || gotState != "approved" {
+		t.Errorf("expected staging to be approved, got ids=%v state=%q", gotIDs, gotState)
+	}
+	requireEncodableOutput(t, result.Output)
+	envs, _ := result.Output["environments"].([]any)
+	if len(envs) != 1 || envs[0] != "staging" {
+		t.Errorf("expected environments=[staging], got %v", result.Output["environments"])
+	}
+}
+
+func TestActionApproveStep_NamedEnvironments(t *testing.T) {
+	pending := []PendingDeployment{
+		pendingDeployment(1, "staging", true),
+		pendingDeployment(2, "Production", false),
+	}
+	cases := map[string]struct {
+		environments any
+		envs         any
+		wantStop     bool
+	}{
+		"reviewable":      {environments: []any{"STAGING"}},
+		"not a reviewer":  {environments: []any{"production"}, wantStop: true},
+		"not pending":     {environments: []any{"qa"}, wantStop: true},
+		"partly blocked":  {environments: []any{"staging", "production"}, wantStop: true},
+		"comma-separated": {environments: "staging, production", wantStop: true},
+		"template list":   {environments: "{{.envs}}", envs: []any{"staging"}},
+		"template item":   {environments: []any{"{{.envs}}"}, envs: "staging"},
+		"empty template":  {environments: "{{.envs}}", envs: "", wantStop: true},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			reviewed := false
+			client := &mockGitHubClient{
+				pendingDeploymentsFunc: func(context.Context, string, string, int64, string) ([]PendingDeployment, error) {
+					return pending, nil
+				},
+				reviewDeploymentsFunc: func(context.Context, string, string, int64, []int64, string, string, string) error {
+					reviewed = true
+					return nil
+				},
+			}
+			step, err := newActionApproveStep("approve", map[string]any{
+				"owner":        "GoCodeAlone",
+				"repo":         "workflow",
+				"run_id":       7,
+				"environments": tc.environments,
+				"state":        "rejected",
+				"token":        "gh-token",
+			}, client)
+			if err != nil {
+				t.Fatalf("newActionApproveStep: %v", err)
+			}
+			result, err := step.Execute(context.Background(), map[string]any{"envs": tc.envs}, nil, nil, nil, nil)
+			if err != nil {
+				t.Fatalf("Execute: %v", err)
+			}
+			if result.StopPipeline != tc.wantStop {
+				t.Errorf("expected StopPipeline=%v, got %v (%v)", tc.wantStop, result.StopPipeline, result.Output["error"])
+			}
+			if reviewed == tc.wantStop {
+				t.Errorf("expected reviewed=%v", !tc.wantStop)
+			}
+		})
+	}
+}
+
+func TestActionApproveStep_NoPendingDeployments(t *testing.T) {
+	step, err := newActionApproveStep("approve", map[string]any{
+		"owner":  "GoCodeAlone",
+		"repo":   "workflow",
+		"run_id": 7,
+		"token":  "gh-token",
+	}, &mockGitHubClient{})
+	if err != nil {
+		t.Fatalf("newActionApproveStep: %v", err)
+	}
+	result, err := step.Execute(context.Background(), nil, nil, nil, nil, nil)
+	if err != nil {
+		t.Fatalf("Execute: %v", err)
+	}
+	if !result.StopPipeline {
+		t.Error("expected StopPipeline=true without pending deployments")
+	}
+}
+
+func TestActionApproveStep_InvalidState(t *testing.T) {
+	_, err := newActionApproveStep("approve", map[string]any{
+		"owner":  "GoCodeAlone",
+		"repo":   "workflow",
+		"run_id": 7,
+		"state":  "maybe",
+	}, &mockGitHubClient{})
+	if err == nil {
+		t.Error("expected error for an unknown state")
+	}
+}
@@ -0,0 +1,115 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/GoCodeAlone/workflow/plugin/external/sdk"
+)
+
+// actionCancelStep implements sdk.StepInstance.
+// It cancels a GitHub Actions workflow run.
+//
+// Config:
+//
+//	owner:  "GoCodeAlone"
+//	repo:   "workflow"
+//	run_id: "{{.steps.trigger.run_id}}"
+//	token:  "${GITHUB_TOKEN}"
+//	force:  false   # skip always() conditions and cleanup (default: false)
+type actionCancelStep struct {
+	name     string
+	config   actionCancelConfig
+	ghClient GitHubClient
+}
+
+// actionCancelConfig holds the parsed configuration for step.gh_action_cancel.
+type actionCancelConfig struct {
+	Owner string       `yaml:"owner"`
+	Repo  string       `yaml:"repo"`
+	RunID templateInt  `yaml:"run_id"`
+	Force templateBool `yaml:"force"`
+	Auth  stepAuth
+}
+
+// newActionCancelStep parses config and returns an actionCancelStep.
+func newActionCancelStep(name string, config map[string]any, client GitHubClient) (*actionCancelStep, error) {
+	cfg, err := parseActionCancelConfig(config)
+	if err != nil {
+		return nil, fmt.Errorf("step.gh_action_cancel %q: %w", name, err)
+	}
+	if client == nil {
+		client = newHTTPGitHubClient(cfg.Auth.apiBaseURL)
+	}
+	return &actionCancelStep{
+		name:     name,
+		config:   cfg,
+		ghClient: client,
+	}, nil
+}
+
+// parseActionCancelConfig converts a raw config map to actionCancelConfig.
+func parseActionCancelConfig(raw map[string]any) (actionCancelConfig, error) {
+	var cfg actionCancelConfig
+
+	cfg.Owner, _ = raw["owner"].(string)
+	if cfg.Owner == "" {
+		return cfg, fmt.Errorf("config.owner is required")
+	}
+
+	cfg.Repo, _ = raw["repo"].(string)
+	if cfg.Repo == "" {
+		return cfg, fmt.Errorf("config.repo is required")
+	}
+
+	var err error
+	cfg.RunID, err = parseTemplateInt(raw, "run_id", true)
+	if err != nil {
+		return cfg, err
+	}
+
+	cfg.Auth, err = parseStepAuth(raw)
+	if err != nil {
+		return cfg, err
+	}
+
+	cfg.Force, err = parseTemplateBool(raw, "force")
+	return cfg, err
+}
+
+// Execute cancels the configured workflow run.
+func (s *actionCancelStep) Execute(
+	ctx context.Context,
+	triggerData map[string]any,
+	stepOutputs map[string]map[string]any,
+	current map[string]any,
+	_ map[string]any,
+	_ map[string]any,
+) (*sdk.StepResult, error) {
+	owner := resolveField(s.config.Owner, triggerData, stepOutputs, current)
+	repo := resolveField(s.config.Repo, triggerData, stepOutputs, current)
+	token, err := s.config.Auth.token(ctx, owner)
+	if err != nil {
+		return errorResult(err.Error()), nil
+	}
+	runID, err := s.config.RunID.resolve("run_id", triggerData, stepOutputs, current)
+	if err != nil {
+		return errorResult(err.Error()), nil
+	}
+	force, err := s.config.Force.resolve("force", triggerData, stepOutputs, current)
+	if err != nil {
+		return errorResult(err.Error()), nil
+	}
+
+	if err := s.ghClient.CancelWorkflowRun(ctx, owner, repo, runID, force, token); err != nil {
+		return errorResult(fmt.Sprintf("failed to cancel workflow run %d: %v", runID, err)), nil
+	}
+
+	return &sdk.StepResult{
+		Output: map[string]any{
+			"run_id":    runID,
+			"cancelled": true,
+			"force":     force,
+		},
+	}, nil
+}
@@ -0,0 +1,74 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestActionCancelStep_Success(t *testing.T) {
+	var gotRunID int64
+	var gotForce bool
+	client := &mockGitHubClient{
+		cancelWorkflowRunFunc: func(_ context.Context, _, _ string, runID int64, force bool, _ string) error {
+			gotRunID, gotForce = runID, force
+			return nil
+		},
+	}
+	step, err := newActionCancelStep("cancel", map[string]any{
+		"owner":  "GoCodeAlone",
+		"repo":   "workflow",
+		"run_id": "{{.steps.trigger.run_id}}",
+		"force":  true,
+		"token":  "gh-token",
+	}, client)
+	if err != nil {
+		t.Fatalf("newActionCancelStep: %v", err)
+	}
+
+	stepOutputs := map[string]map[string]any{"trigger": {"run_id": int64(1001)}}
+	result, err := step.Execute(context.Background(), nil, stepOutputs, nil, nil, nil)
+	if err != nil {
+		t.Fatalf("Execute: %v", err)
+	}
+	if result.StopPipeline {
+		t.Fatalf("unexpected error: %v", result.Output["error"])
+	}
+	if gotRunID != 1001 || !gotForce {
+		t.Errorf("expected force-cancel of run 1001, got run %d force=%v", gotRunID, gotForce)
+	}
+	if result.Output["cancelled"] != true {
+		t.Errorf("expected cancelled=true, got %v", result.Output["cancelled"])
+	}
+}
+
+func TestActionCancelStep_APIError(t *testing.T) {
+	client := &mockGitHubClient{
+		cancelWorkflowRunFunc: func(context.Context, string, string, int64, bool, string) error {
+			return errors.New("unexpected status 409: Cannot cancel a workflow run that is completed")
+		},
+	}
+	step, err := newActionCancelStep("cancel", map[string]any{
+		"owner":  "GoCodeAlone",
+		"repo":   "workflow",
+		"run_id": 1,
+		"token":  "gh-token",
+	}, client)
+	if err != nil {
+		t.Fatalf("newActionCancelStep: %v", err)
+	}
+
+	result, err := step.Execute(context.Background(), nil, nil, nil, nil, nil)
+	if err != nil {
+		t.Fatalf("Execute: %v", err)
+	}
+	if !result.StopPipeline {
+		t.Error("expected StopPipeline=true when the cancel fails")
+	}
+}
+
+func TestActionCancelStep_MissingRunID(t *testing.T) {
+	if _, err := newActionCancelStep("cancel", map[string]any{"owner": "o", "repo": "r"}, &mockGitHubClient{}); err == nil {
+		t.Error("expected error for missing run_id")
+	}
+}
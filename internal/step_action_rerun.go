@@ -0,0 +1,128 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/GoCodeAlone/workflow/plugin/external/sdk"
+)
+
+// actionRerunStep implements sdk.StepInstance.
+// It re-runs a completed GitHub Actions workflow run, either entirely or only
+// its failed jobs and the jobs that depend on them. The re-run is a new
+// attempt of the same run, so step.gh_action_status can follow it with the
+// same run_id.
+//
+// Config:
+//
+//	owner:       "GoCodeAlone"
+//	repo:        "workflow"
+//	run_id:      "{{.steps.ci.run_id}}"
+//	token:       "${GITHUB_TOKEN}"
+//	failed_only: true    # re-run only failed jobs (default: false)
+//	enable_debug_logging: false
+type actionRerunStep struct {
+	name     string
+	config   actionRerunConfig
+	ghClient GitHubClient
+}
+
+// actionRerunConfig holds the parsed configuration for step.gh_action_rerun.
+type actionRerunConfig struct {
+	Owner        string       `yaml:"owner"`
+	Repo         string       `yaml:"repo"`
+	RunID        templateInt  `yaml:"run_id"`
+	FailedOnly   templateBool `yaml:"failed_only"`
+	DebugLogging templateBool `yaml:"enable_debug_logging"`
+	Auth         stepAuth
+}
+
+// newActionRerunStep parses config and returns an actionRerunStep.
+func newActionRerunStep(name string, config map[string]any, client GitHubClient) (*actionRerunStep, error) {
+	cfg, err := parseActionRerunConfig(config)
+	if err != nil {
+		return nil, fmt.Errorf("step.gh_action_rerun %q: %w", name, err)
+	}
+	if client == nil {
+		client = newHTTPGitHubClient(cfg.Auth.apiBaseURL)
+	}
+	return &actionRerunStep{
+		name:     name,
+		config:   cfg,
+		ghClient: client,
+	}, nil
+}
+
+// parseActionRerunConfig converts a raw config map to actionRerunConfig.
+func parseActionRerunConfig(raw map[string]any) (actionRerunConfig, error) {
+	var cfg actionRerunConfig
+
+	cfg.Owner, _ = raw["owner"].(string)
+	if cfg.Owner == "" {
+		return cfg, fmt.Errorf("config.owner is required")
+	}
+
+	cfg.Repo, _ = raw["repo"].(string)
+	if cfg.Repo == "" {
+		return cfg, fmt.Errorf("config.repo is required")
+	}
+
+	var err error
+	cfg.RunID, err = parseTemplateInt(raw, "run_id", true)
+	if err != nil {
+		return cfg, err
+	}
+
+	cfg.Auth, err = parseStepAuth(raw)
+	if err != nil {
+		return cfg, err
+	}
+
+	cfg.FailedOnly, err = parseTemplateBool(raw, "failed_only")
+	if err != nil {
+		return cfg, err
+	}
+	cfg.DebugLogging, err = parseTemplateBool(raw, "enable_debug_logging")
+	return cfg, err
+}
+
+// Execute re-runs the configured workflow run.
+func (s *actionRerunStep) Execute(
+	ctx context.Context,
+	triggerData map[string]any,
+	stepOutputs map[string]map[string]any,
+	current map[string]any,
+	_ map[string]any,
+	_ map[string]any,
+) (*sdk.StepResult, error) {
+	owner := resolveField(s.config.Owner, triggerData, stepOutputs, current)
+	repo := resolveField(s.config.Repo, triggerData, stepOutputs, current)
+	token, err := s.config.Auth.token(ctx, owner)
+	if err != nil {
+		return errorResult(err.Error()), nil
+	}
+	runID, err := s.config.RunID.resolve("run_id", triggerData, stepOutputs, current)
+	if err != nil {
+		return errorResult(err.Error()), nil
+	}
+	failedOnly, err := s.config.FailedOnly.resolve("failed_only", triggerData, stepOutputs, current)
+	if err != nil {
+		return errorResult(err.Error()), nil
+	}
+	debugLogging, err := s.config.DebugLogging.resolve("enable_debug_logging", triggerData, stepOutputs, current)
+	if err != nil {
+		return errorResult(err.Error()), nil
+	}
+
+	if err := s.ghClient.RerunWorkflowRun(ctx, owner, repo, runID, failedOnly, debugLogging, token); err != nil {
+		return errorResult(fmt.Sprintf("failed to re-run workflow run %d: %v", runID, err)), nil
+	}
+
+	return &sdk.StepResult{
+		Output: map[string]any{
+			"run_id":      runID,
+			"rerun":       true,
+			"failed_only": failedOnly,
+		},
+	}, nil
+}
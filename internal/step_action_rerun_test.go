@@ -0,0 +1,41 @@
+package internal
+
+import (
+	"context"
+	"testing"
+)
+
+func TestActionRerunStep_FailedOnly(t *testing.T) {
+	var gotFailedOnly, gotDebug bool
+	client := &mockGitHubClient{
+		rerunWorkflowRunFunc: func(_ context.Context, _, _ string, _ int64, failedOnly, debugLogging bool, _ string) error {
+			gotFailedOnly, gotDebug = failedOnly, debugLogging
+			return nil
+		},
+	}
+	step, err := newActionRerunStep("rerun", map[string]any{
+		"owner":                "GoCodeAlone",
+		"repo":                 "workflow",
+		"run_id":               42,
+		"failed_only":          true,
+		"enable_debug_logging": true,
+		"token":                "gh-token",
+	}, client)
+	if err != nil {
+		t.Fatalf("newActionRerunStep: %v", err)
+	}
+
+	result, err := step.Execute(context.Background(), nil, nil, nil, nil, nil)
+	if err != nil {
+		t.Fatalf("Execute: %v", err)
+	}
+	if result.StopPipeline {
+		t.Fatalf("unexpected error: %v", result.Output["error"])
+	}
+	if !gotFailedOnly || !gotDebug {
+		t.Errorf("expected failed_only and debug logging, got failed_only=%v debug=%v", gotFailedOnly, gotDebug)
+	}
+	if result.Output["run_id"] != int64(42) || result.Output["failed_only"] != true {
+		t.Errorf("unexpected output: %v", result.Output)
+	}
+}
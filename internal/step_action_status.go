@@ -3,16 +3,24 @@ package internal
 import (
 	"context"
 	"fmt"
-	"os"
-	"strconv"
 	"strings"
 	"time"
 
 	sdk "github.com/GoCodeAlone/workflow/plugin/external/sdk"
 )
 
+// maxLogTailLines caps log_tail_lines so a failure summary stays small.
+const maxLogTailLines = 1000
+
+// defaultRateLimitReserve is how many requests wait mode leaves in the rate
+// limit window before it sleeps until the window resets.
+const defaultRateLimitReserve = 50
+
 // actionStatusStep implements sdk.StepInstance.
 // It checks (and optionally polls) the status of a GitHub Actions workflow run.
+// It can also report the run's jobs, the check-run annotations of its failed
+// jobs, and the last lines of each failed job's log, so a pipeline can post a
+// useful failure summary.
 //
 // Config:
 //
@@ -23,6 +31,11 @@ import (
 //	wait:          true          # poll until complete (default: false)
 //	poll_interval: "10s"
 //	timeout:       "30m"
+//	max_poll_interval:  "2m"     # backoff ceiling while the run is unchanged (default: 2m)
+//	rate_limit_reserve: 50       # wait for the reset below this many requests (default: 50)
+//	include_jobs:        true    # output jobs (default: false)
+//	include_annotations: true    # output annotations of failed jobs (default: false)
+//	log_tail_lines:      50      # output the log tail of failed jobs (default: 0, off)
 type actionStatusStep struct {
 	name     string
 	config   actionStatusConfig
@@ -33,12 +46,18 @@ type actionStatusStep struct {
 type actionStatusConfig struct {
 	Owner        string        `yaml:"owner"`
 	Repo         string        `yaml:"repo"`
-	RunID        int64         `yaml:"run_id"`
-	RunIDRaw     string        // raw string value for dynamic {{.field}} resolution
-	Token        string        `yaml:"token"`
-	Wait         bool          `yaml:"wait"`
+	RunID        templateInt   `yaml:"run_id"`
+	Wait         templateBool  `yaml:"wait"`
 	PollInterval time.Duration `yaml:"poll_interval"`
 	Timeout      time.Duration `yaml:"timeout"`
+	Auth         stepAuth
+
+	MaxPollInterval  time.Duration `yaml:"max_poll_interval"`
+	RateLimitReserve int           `yaml:"rate_limit_reserve"`
+
+	IncludeJobs        bool `yaml:"include_jobs"`
+	IncludeAnnotations bool `yaml:"include_annotations"`
+	LogTailLines       int  `yaml:"log_tail_lines"`
 }
 
 // newActionStatusStep parses config and returns an actionStatusStep.
@@ -48,7 +67,7 @@ func newActionStatusStep(name string, config map[string]any, client GitHubClient
 		return nil, fmt.Errorf("step.gh_action_status %q: %w", name, err)
 	}
 	if client == nil {
-		client = newHTTPGitHubClient()
+		client = newHTTPGitHubClient(cfg.Auth.apiBaseURL)
 	}
 	return &actionStatusStep{
 		name:     name,
@@ -71,47 +90,59 @@ func parseActionStatusConfig(raw map[string]any) (actionStatusConfig, error) {
 		return cfg, fmt.Errorf("config.repo is required")
 	}
 
-	// run_id can be provided as int, int64, float64, or string.
-	// When the string contains a template reference (e.g. {{.steps.trigger.run_id}})
-	// the literal value is stored in RunIDRaw and resolved at Execute time.
-	switch v := raw["run_id"].(type) {
-	case int:
-		cfg.RunID = int64(v)
-	case int64:
-		cfg.RunID = v
-	case float64:
-		cfg.RunID = int64(v)
-	case string:
-		if v != "" {
-			if strings.Contains(v, "{{") {
-				cfg.RunIDRaw = v
-			} else {
-				n, err := strconv.ParseInt(v, 10, 64)
-				if err != nil {
-					return cfg, fmt.Errorf("config.run_id is not a valid integer: %w", err)
-				}
-				cfg.RunID = n
-			}
-		}
-	}
-	if cfg.RunID == 0 && cfg.RunIDRaw == "" {
-		return cfg, fmt.Errorf("config.run_id is required")
+	var err error
+	cfg.RunID, err = parseTemplateInt(raw, "run_id", true)
+	if err != nil {
+		return cfg, err
 	}
 
-	cfg.Token, _ = raw["token"].(string)
-	cfg.Token = os.ExpandEnv(cfg.Token)
+	cfg.Auth, err = parseStepAuth(raw)
+	if err != nil {
+		return cfg, err
+	}
 
-	cfg.Wait, _ = raw["wait"].(bool)
+	cfg.Wait, err = parseTemplateBool(raw, "wait")
+	if err != nil {
+		return cfg, err
+	}
 
 	pollStr, _ := raw["poll_interval"].(string)
 	if pollStr == "" {
 		pollStr = "10s"
 	}
-	var err error
 	cfg.PollInterval, err = time.ParseDuration(pollStr)
 	if err != nil {
 		return cfg, fmt.Errorf("config.poll_interval is invalid: %w", err)
 	}
+	if cfg.PollInterval <= 0 {
+		return cfg, fmt.Errorf("config.poll_interval must be positive")
+	}
+
+	maxPollStr, _ := raw["max_poll_interval"].(string)
+	if maxPollStr == "" {
+		maxPollStr = "2m"
+	}
+	cfg.MaxPollInterval, err = time.ParseDuration(maxPollStr)
+	if err != nil {
+		return cfg, fmt.Errorf("config.max_poll_interval is invalid: %w", err)
+	}
+	cfg.MaxPollInterval = max(cfg.MaxPollInterval, cfg.PollInterval)
+
+	cfg.RateLimitReserve = defaultRateLimitReserve
+	switch v := raw["rate_limit_reserve"].(type) {
+	case nil:
+	case int:
+		cfg.RateLimitReserve = v
+	case int64:
+		cfg.RateLimitReserve = int(v)
+	case float64:
+		cfg.RateLimitReserve = int(v)
+	default:
+		return cfg, fmt.Errorf("config.rate_limit_reserve must be an integer")
+	}
+	if cfg.RateLimitReserve < 0 {
+		return cfg, fmt.Errorf("config.rate_limit_reserve must not be negative")
+	}
 
 	timeoutStr, _ := raw["timeout"].(string)
 	if timeoutStr == "" {
@@ -122,6 +153,23 @@ func parseActionStatusConfig(raw map[string]any) (actionStatusConfig, error) {
 		return cfg, fmt.Errorf("config.timeout is invalid: %w", err)
 	}
 
+	cfg.IncludeJobs, _ = raw["include_jobs"].(bool)
+	cfg.IncludeAnnotations, _ = raw["include_annotations"].(bool)
+	switch v := raw["log_tail_lines"].(type) {
+	case nil:
+	case int:
+		cfg.LogTailLines = v
+	case int64:
+		cfg.LogTailLines = int(v)
+	case float64:
+		cfg.LogTailLines = int(v)
+	default:
+		return cfg, fmt.Errorf("config.log_tail_lines must be an integer")
+	}
+	if cfg.LogTailLines < 0 || cfg.LogTailLines > maxLogTailLines {
+		return cfg, fmt.Errorf("config.log_tail_lines must be between 0 and %d", maxLogTailLines)
+	}
+
 	return cfg, nil
 }
 
@@ -137,54 +185,99 @@ func (s *actionStatusStep) Execute(
 	_ map[string]any,
 	_ map[string]any,
 ) (*sdk.StepResult, error) {
-	token := s.config.Token
-	if token == "" {
-		return errorResult("GITHUB_TOKEN is not configured"), nil
-	}
-
 	// Resolve dynamic owner / repo.
 	owner := resolveField(s.config.Owner, triggerData, stepOutputs, current)
 	repo := resolveField(s.config.Repo, triggerData, stepOutputs, current)
 
-	// Resolve run_id — may be a static int or a dynamic template reference.
-	runID := s.config.RunID
-	if s.config.RunIDRaw != "" {
-		resolved := resolveField(s.config.RunIDRaw, triggerData, stepOutputs, current)
-		n, err := strconv.ParseInt(resolved, 10, 64)
-		if err != nil {
-			return errorResult(fmt.Sprintf("run_id resolved to non-integer value %q: %v", resolved, err)), nil
-		}
-		runID = n
+	token, err := s.config.Auth.token(ctx, owner)
+	if err != nil {
+		return errorResult(err.Error()), nil
+	}
+
+	runID, err := s.config.RunID.resolve("run_id", triggerData, stepOutputs, current)
+	if err != nil {
+		return errorResult(err.Error()), nil
 	}
-	if runID == 0 {
-		return errorResult("run_id resolved to zero — check pipeline context"), nil
+	wait, err := s.config.Wait.resolve("wait", triggerData, stepOutputs, current)
+	if err != nil {
+		return errorResult(err.Error()), nil
 	}
 
-	if !s.config.Wait {
-		return s.fetchStatusDynamic(ctx, owner, repo, runID, token)
+	if !wait {
+		result, err := s.fetchStatusDynamic(ctx, owner, repo, runID, token)
+		if err != nil || result.StopPipeline {
+			return result, err
+		}
+		return s.addRunDetails(ctx, owner, repo, runID, token, result), nil
 	}
 
-	// Poll with timeout.
+	// Poll with timeout. Each poll sends the last ETag, so an unchanged run is
+	// answered 304, which does not count against the rate limit. While the
+	// status is unchanged the interval doubles up to max_poll_interval, with
+	// jitter; a status change resets it to poll_interval.
+	//
+	// Rate-limit answers are waited out by the shared GitHub transport; the
+	// deadline keeps it from waiting past the step's timeout. The step itself
+	// only keeps rate_limit_reserve requests free for other callers.
 	deadline := time.Now().Add(s.config.Timeout)
+	ctx, cancel := context.WithDeadline(ctx, deadline)
+	defer cancel()
+	interval := s.config.PollInterval
+	var etag, lastStatus string
 	for {
-		result, err := s.fetchStatusDynamic(ctx, owner, repo, runID, token)
+		// Re-resolve per poll so app installation tokens are refreshed during long waits.
+		token, err = s.config.Auth.token(ctx, owner)
 		if err != nil {
-			return nil, err
+			return errorResult(err.Error()), nil
+		}
+		poll, err := s.ghClient.PollWorkflowRun(ctx, owner, repo, runID, etag, token)
+		if err != nil {
+			if time.Until(deadline) <= 0 {
+				return errorResult(fmt.Sprintf("timeout waiting for workflow run %d after %s", runID, s.config.Timeout)), nil
+			}
+			return errorResult(fmt.Sprintf("failed to get workflow run: %v", err)), nil
 		}
 
-		status, _ := result.Output["status"].(string)
-		if isTerminalStatus(status) {
-			return result, nil
+		var delay time.Duration
+		switch {
+		case poll.RateLimited:
+			// The transport did not wait because the limit outlasts the timeout.
+			return errorResult(fmt.Sprintf("rate limited while waiting for workflow run %d; the limit resets after the step's %s timeout", runID, s.config.Timeout)), nil
+		case poll.Run == nil:
+			interval = min(interval*2, s.config.MaxPollInterval)
+			delay = jitterBackoff(interval)
+		default:
+			if isTerminalStatus(poll.Run.Status) {
+				return s.addRunDetails(ctx, owner, repo, runID, token, runStatusResult(poll.Run)), nil
+			}
+			etag = poll.ETag
+			if poll.Run.Status == lastStatus {
+				interval = min(interval*2, s.config.MaxPollInterval)
+			} else {
+				interval = s.config.PollInterval
+			}
+			lastStatus = poll.Run.Status
+			delay = interval
+			if interval > s.config.PollInterval {
+				delay = jitterBackoff(interval)
+			}
+		}
+		// Leave rate_limit_reserve requests for other callers of the token.
+		if rl := poll.RateLimit; rl.Remaining >= 0 && rl.Remaining <= s.config.RateLimitReserve {
+			delay = max(delay, time.Until(rl.Reset))
 		}
 
-		if time.Now().After(deadline) {
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
 			return errorResult(fmt.Sprintf("timeout waiting for workflow run %d after %s", runID, s.config.Timeout)), nil
 		}
 
+		timer := time.NewTimer(min(delay, remaining))
 		select {
 		case <-ctx.Done():
+			timer.Stop()
 			return errorResult("context cancelled while waiting for workflow run"), nil
-		case <-time.After(s.config.PollInterval):
+		case <-timer.C:
 		}
 	}
 }
@@ -196,7 +289,11 @@ func (s *actionStatusStep) fetchStatusDynamic(ctx context.Context, owner, repo s
 	if err != nil {
 		return errorResult(fmt.Sprintf("failed to get workflow run: %v", err)), nil
 	}
+	return runStatusResult(run), nil
+}
 
+// runStatusResult builds the step output for a workflow run.
+func runStatusResult(run *WorkflowRun) *sdk.StepResult {
 	return &sdk.StepResult{
 		Output: map[string]any{
 			"run_id":     run.ID,
@@ -204,7 +301,106 @@ func (s *actionStatusStep) fetchStatusDynamic(ctx context.Context, owner, repo s
 			"conclusion": run.Conclusion,
 			"url":        run.HTMLURL,
 		},
-	}, nil
+	}
+}
+
+// addRunDetails adds the jobs, annotations, and failed-job log tails the step
+// is configured to report. Detail lookups are best effort: a failure is
+// reported in details_error and does not fail the step.
+func (s *actionStatusStep) addRunDetails(ctx context.Context, owner, repo string, runID int64, token string, result *sdk.StepResult) *sdk.StepResult {
+	if !s.config.IncludeJobs && !s.config.IncludeAnnotations && s.config.LogTailLines == 0 {
+		return result
+	}
+	jobs, err := s.ghClient.ListWorkflowJobs(ctx, owner, repo, runID, token)
+	if err != nil {
+		result.Output["details_error"] = fmt.Sprintf("list workflow jobs: %v", err)
+		return result
+	}
+
+	if s.config.IncludeJobs {
+		jobOutputs := make([]any, 0, len(jobs))
+		for _, job := range jobs {
+			out := map[string]any{
+				"id":          job.ID,
+				"name":        job.Name,
+				"status":      job.Status,
+				"conclusion":  job.Conclusion,
+				"runner_name": job.RunnerName,
+				"url":         job.HTMLURL,
+				"failed_step": failedStepName(job),
+			}
+			if !job.StartedAt.IsZero() {
+				out["started_at"] = job.StartedAt.UTC().Format(time.RFC3339)
+			}
+			if !job.CompletedAt.IsZero() {
+				out["completed_at"] = job.CompletedAt.UTC().Format(time.RFC3339)
+				if !job.StartedAt.IsZero() {
+					out["duration_seconds"] = int64(job.CompletedAt.Sub(job.StartedAt).Seconds())
+				}
+			}
+			jobOutputs = append(jobOutputs, out)
+		}
+		result.Output["jobs"] = jobOutputs
+	}
+
+	var detailErrs []string
+	annotations := []any{}
+	logs := map[string]any{}
+	for _, job := range jobs {
+		if !isFailedConclusion(job.Conclusion) {
+			continue
+		}
+		if s.config.IncludeAnnotations {
+			list, err := s.ghClient.ListCheckRunAnnotations(ctx, owner, repo, job.ID, token)
+			if err != nil {
+				detailErrs = append(detailErrs, fmt.Sprintf("annotations for job %q: %v", job.Name, err))
+			}
+			for _, a := range list {
+				annotations = append(annotations, map[string]any{
+					"job":        job.Name,
+					"path":       a.Path,
+					"start_line": a.StartLine,
+					"end_line":   a.EndLine,
+					"level":      a.AnnotationLevel,
+					"title":      a.Title,
+					"message":    a.Message,
+				})
+			}
+		}
+		if s.config.LogTailLines > 0 {
+			tail, err := s.ghClient.GetJobLogTail(ctx, owner, repo, job.ID, s.config.LogTailLines, token)
+			if err != nil {
+				detailErrs = append(detailErrs, fmt.Sprintf("log for job %q: %v", job.Name, err))
+				continue
+			}
+			logs[job.Name] = tail
+		}
+	}
+	if s.config.IncludeAnnotations {
+		result.Output["annotations"] = annotations
+	}
+	if s.config.LogTailLines > 0 {
+		result.Output["failed_job_logs"] = logs
+	}
+	if len(detailErrs) > 0 {
+		result.Output["details_error"] = strings.Join(detailErrs, "; ")
+	}
+	return result
+}
+
+// failedStepName returns the name of the first failed step of job.
+func failedStepName(job WorkflowJob) string {
+	for _, step := range job.Steps {
+		if isFailedConclusion(step.Conclusion) {
+			return step.Name
+		}
+	}
+	return ""
+}
+
+// isFailedConclusion reports whether a job or step conclusion is a failure.
+func isFailedConclusion(conclusion string) bool {
+	return conclusion == "failure" || conclusion == "timed_out"
 }
 
 // isTerminalStatus reports whether a workflow run status is in a terminal state.
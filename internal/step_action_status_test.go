@@ -3,6 +3,9 @@ package internal
 import (
 	"context"
 	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 )
@@ -199,6 +202,139 @@ func TestActionStatusStep_ContextCancelled(t *testing.T) {
 	}
 }
 
+func TestActionStatusStep_FailureDetails(t *testing.T) {
+	started := time.Date(2026, 5, 1, 10, 0, 0, 0, time.UTC)
+	var logJobs []int64
+	client := &mockGitHubClient{
+		getWorkflowRunFunc: func(_ context.Context, _, _ string, runID int64, _ string) (*WorkflowRun, error) {
+			return &WorkflowRun{ID: runID, Status: "completed", Conclusion: "failure"}, nil
+		},
+		listWorkflowJobsFunc: func(_ context.Context, _, _ string, _ int64, _ string) ([]WorkflowJob, error) {
+			return []WorkflowJob{
+				{ID: 1, Name: "lint", Status: "completed", Conclusion: "success", StartedAt: started, CompletedAt: started.Add(time.Minute)},
+				{ID: 2, Name: "test", Status: "completed", Conclusion: "failure", RunnerName: "runner-7",
+					StartedAt: started, CompletedAt: started.Add(90 * time.Second),
+					Steps: []WorkflowJobStep{
+						{Name: "Checkout", Conclusion: "success"},
+						{Name: "Run tests", Conclusion: "failure"},
+					}},
+			}, nil
+		},
+		annotationsFunc: func(_ context.Context, _, _ string, checkRunID int64, _ string) ([]CheckAnnotation, error) {
+			if checkRunID != 2 {
+				t.Errorf("expected annotations for job 2 only, got %d", checkRunID)
+			}
+			return []CheckAnnotation{{Path: "main_test.go", StartLine: 12, AnnotationLevel: "failure", Message: "expected 1, got 2"}}, nil
+		},
+		jobLogTailFunc: func(_ context.Context, _, _ string, jobID int64, lines int, _ string) (string, error) {
+			logJobs = append(logJobs, jobID)
+			if lines != 20 {
+				t.Errorf("expected 20 log lines, got %d", lines)
+			}
+			return "--- FAIL: TestX", nil
+		},
+	}
+
+	step, err := newActionStatusStep("test", map[string]any{
+		"owner":               "GoCodeAlone",
+		"repo":                "workflow",
+		"run_id":              5,
+		"token":               "gh-token",
+		"include_jobs":        true,
+		"include_annotations": true,
+		"log_tail_lines":      20,
+	}, client)
+	if err != nil {
+		t.Fatalf("newActionStatusStep: %v", err)
+	}
+
+	result, err := step.Execute(context.Background(), nil, nil, nil, nil, nil)
+	if err != nil {
+		t.Fatalf("Execute: %v", err)
+	}
+	requireEncodableOutput(t, result.Output)
+	jobs, _ := result.Output["jobs"].([]any)
+	if len(jobs) != 2 {
+		t.Fatalf("expected 2 jobs, got %v", result.Output["jobs"])
+	}
+	if job, _ := jobs[1].(map[string]any); job["failed_step"] != "Run tests" || job["runner_name"] != "runner-7" || job["duration_seconds"] != int64(90) {
+		t.Errorf("unexpected failed job output: %v", jobs[1])
+	}
+	annotations, _ := result.Output["annotations"].([]any)
+	if len(annotations) != 1 {
+		t.Fatalf("unexpected annotations: %v", result.Output["annotations"])
+	}
+	if annotation, _ := annotations[0].(map[string]any); annotation["job"] != "test" || annotation["message"] != "expected 1, got 2" {
+		t.Errorf("unexpected annotations: %v", result.Output["annotations"])
+	}
+	logs, _ := result.Output["failed_job_logs"].(map[string]any)
+	if logs["test"] != "--- FAIL: TestX" || len(logJobs) != 1 {
+		t.Errorf("expected the log tail of the failed job only, got %v", result.Output["failed_job_logs"])
+	}
+}
+
+func TestActionStatusStep_DetailsErrorDoesNotFail(t *testing.T) {
+	client := &mockGitHubClient{
+		listWorkflowJobsFunc: func(_ context.Context, _, _ string, _ int64, _ string) ([]WorkflowJob, error) {
+			return nil, errors.New("forbidden")
+		},
+	}
+
+	step, err := newActionStatusStep("test", map[string]any{
+		"owner":        "GoCodeAlone",
+		"repo":         "workflow",
+		"run_id":       5,
+		"token":        "gh-token",
+		"include_jobs": true,
+	}, client)
+	if err != nil {
+		t.Fatalf("newActionStatusStep: %v", err)
+	}
+
+	result, err := step.Execute(context.Background(), nil, nil, nil, nil, nil)
+	if err != nil {
+		t.Fatalf("Execute: %v", err)
+	}
+	if result.StopPipeline {
+		t.Error("expected StopPipeline=false when job details are unavailable")
+	}
+	if result.Output["details_error"] == nil {
+		t.Error("expected details_error to be set")
+	}
+}
+
+func TestTailLines(t *testing.T) {
+	tail, err := tailLines(strings.NewReader("one\ntwo\r\nthree\nfour\n"), 2)
+	if err != nil {
+		t.Fatalf("tailLines: %v", err)
+	}
+	if tail != "three\nfour" {
+		t.Errorf("expected last two lines, got %q", tail)
+	}
+
+	long := strings.Repeat("x", 100*1024) + "\nlast"
+	tail, err = tailLines(strings.NewReader(long), 5)
+	if err != nil {
+		t.Fatalf("tailLines: %v", err)
+	}
+	lines := strings.Split(tail, "\n")
+	if len(lines) != 2 || lines[1] != "last" || len(lines[0]) != 64*1024 {
+		t.Errorf("expected a truncated long line and the last line, got %d lines", len(lines))
+	}
+}
+
+func TestParseActionStatusConfig_LogTailLinesBounds(t *testing.T) {
+	_, err := parseActionStatusConfig(map[string]any{
+		"owner":          "GoCodeAlone",
+		"repo":           "workflow",
+		"run_id":         1,
+		"log_tail_lines": maxLogTailLines + 1,
+	})
+	if err == nil {
+		t.Error("expected error for log_tail_lines above the limit")
+	}
+}
+
 // --- config validation tests ---
 
 func TestParseActionStatusConfig_MissingOwner(t *testing.T) {
@@ -240,8 +376,8 @@ func TestParseActionStatusConfig_RunIDAsString(t *testing.T) {
 	if err != nil {
 		t.Fatalf("parseActionStatusConfig: %v", err)
 	}
-	if cfg.RunID != 456 {
-		t.Errorf("expected run_id=456, got %d", cfg.RunID)
+	if cfg.RunID.Value != 456 {
+		t.Errorf("expected run_id=456, got %d", cfg.RunID.Value)
 	}
 }
 
@@ -254,8 +390,8 @@ func TestParseActionStatusConfig_RunIDAsFloat(t *testing.T) {
 	if err != nil {
 		t.Fatalf("parseActionStatusConfig: %v", err)
 	}
-	if cfg.RunID != 789 {
-		t.Errorf("expected run_id=789, got %d", cfg.RunID)
+	if cfg.RunID.Value != 789 {
+		t.Errorf("expected run_id=789, got %d", cfg.RunID.Value)
 	}
 }
 
@@ -282,3 +418,201 @@ func TestParseActionStatusConfig_InvalidTimeout(t *testing.T) {
 		t.Error("expected error for invalid timeout")
 	}
 }
+
+func TestActionStatusStep_WaitReusesETag(t *testing.T) {
+	var etags []string
+	client := &mockGitHubClient{
+		pollWorkflowRunFunc: func(_ context.Context, _, _ string, _ int64, etag, _ string) (*WorkflowRunPoll, error) {
+			etags = append(etags, etag)
+			unknown := RateLimitStatus{Remaining: -1}
+			switch len(etags) {
+			case 1:
+				return &WorkflowRunPoll{Run: &WorkflowRun{ID: 1, Status: "in_progress"}, ETag: `"v1"`, RateLimit: unknown}, nil
+			case 2, 3:
+				return &WorkflowRunPoll{NotModified: true, ETag: etag, RateLimit: unknown}, nil
+			default:
+				return &WorkflowRunPoll{Run: &WorkflowRun{ID: 1, Status: "completed", Conclusion: "success"}, ETag: `"v2"`, RateLimit: unknown}, nil
+			}
+		},
+	}
+
+	step, err := newActionStatusStep("test", map[string]any{
+		"owner":             "GoCodeAlone",
+		"repo":              "workflow",
+		"run_id":            1,
+		"token":             "gh-token",
+		"wait":              true,
+		"poll_interval":     "1ms",
+		"max_poll_interval": "4ms",
+		"timeout":           "5s",
+	}, client)
+	if err != nil {
+		t.Fatalf("newActionStatusStep: %v", err)
+	}
+
+	result, err := step.Execute(context.Background(), nil, nil, nil, nil, nil)
+	if err != nil {
+		t.Fatalf("Execute: %v", err)
+	}
+	if result.StopPipeline || result.Output["conclusion"] != "success" {
+		t.Fatalf("expected a successful run, got %v", result.Output)
+	}
+	want := []string{"", `"v1"`, `"v1"`, `"v1"`}
+	if strings.Join(etags, ",") != strings.Join(want, ",") {
+		t.Errorf("expected ETags %q, got %q", want, etags)
+	}
+}
+
+func TestActionStatusStep_WaitKeepsRateLimitReserve(t *testing.T) {
+	const wait = 50 * time.Millisecond
+	var polls []time.Time
+	client := &mockGitHubClient{
+		pollWorkflowRunFunc: func(_ context.Context, _, _ string, _ int64, _, _ string) (*WorkflowRunPoll, error) {
+			polls = append(polls, time.Now())
+			if len(polls) == 1 {
+				return &WorkflowRunPoll{
+					Run:       &WorkflowRun{ID: 1, Status: "queued"},
+					RateLimit: RateLimitStatus{Remaining: 3, Reset: time.Now().Add(wait)},
+				}, nil
+			}
+			return &WorkflowRunPoll{Run: &WorkflowRun{ID: 1, Status: "completed", Conclusion: "success"}, RateLimit: RateLimitStatus{Remaining: -1}}, nil
+		},
+	}
+	step, err := newActionStatusStep("test", map[string]any{
+		"owner":              "GoCodeAlone",
+		"repo":               "workflow",
+		"run_id":             1,
+		"token":              "gh-token",
+		"wait":               true,
+		"poll_interval":      "1ms",
+		"rate_limit_reserve": 5,
+		"timeout":            "5s",
+	}, client)
+	if err != nil {
+		t.Fatalf("newActionStatusStep: %v", err)
+	}
+
+	result, err := step.Execute(context.Background(), nil, nil, nil, nil, nil)
+	if err != nil {
+		t.Fatalf("Execute: %v", err)
+	}
+	if result.StopPipeline {
+		t.Fatalf("unexpected error: %v", result.Output["error"])
+	}
+	if len(polls) != 2 {
+		t.Fatalf("expected 2 polls, got %d", len(polls))
+	}
+	if gap := polls[1].Sub(polls[0]); gap < wait-10*time.Millisecond {
+		t.Errorf("expected the step to wait about %s, waited %s", wait, gap)
+	}
+}
+
+func TestActionStatusStep_WaitBoundsRateLimitByTimeout(t *testing.T) {
+	var deadline time.Time
+	client := &mockGitHubClient{
+		pollWorkflowRunFunc: func(ctx context.Context, _, _ string, _ int64, _, _ string) (*WorkflowRunPoll, error) {
+			deadline, _ = ctx.Deadline()
+			// The transport hands back rate-limit answers it cannot wait out.
+			return &WorkflowRunPoll{RateLimited: true, RateLimit: RateLimitStatus{Remaining: 0, Reset: time.Now().Add(time.Hour)}}, nil
+		},
+	}
+	step, err := newActionStatusStep("test", map[string]any{
+		"owner":   "GoCodeAlone",
+		"repo":    "workflow",
+		"run_id":  1,
+		"token":   "gh-token",
+		"wait":    true,
+		"timeout": "1m",
+	}, client)
+	if err != nil {
+		t.Fatalf("newActionStatusStep: %v", err)
+	}
+
+	start := time.Now()
+	result, err := step.Execute(context.Background(), nil, nil, nil, nil, nil)
+	if err != nil {
+		t.Fatalf("Execute: %v", err)
+	}
+	if deadline.IsZero() || deadline.After(start.Add(time.Minute+time.Second)) {
+		t.Errorf("expected the poll to carry the step's deadline, got %v", deadline)
+	}
+	if !result.StopPipeline {
+		t.Fatal("expected StopPipeline when the rate limit outlasts the timeout")
+	}
+	if msg, _ := result.Output["error"].(string); !strings.Contains(msg, "rate limited") {
+		t.Errorf("expected a rate limit error, got %q", msg)
+	}
+}
+
+func TestHTTPGitHubClient_PollWorkflowRun(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-RateLimit-Remaining", "42")
+		w.Header().Set("X-RateLimit-Reset", "1700000000")
+		switch r.Header.Get("If-None-Match") {
+		case "":
+			w.Header().Set("ETag", `"abc"`)
+			_, _ = w.Write([]byte(`{"id":7,"status":"in_progress"}`))
+		case `"abc"`:
+			w.WriteHeader(http.StatusNotModified)
+		default:
+			w.Header().Set("Retry-After", "30")
+			w.WriteHeader(http.StatusForbidden)
+		}
+	}))
+	defer srv.Close()
+	client := &httpGitHubClient{baseURL: srv.URL, httpClient: srv.Client()}
+	ctx := context.Background()
+
+	poll, err := client.PollWorkflowRun(ctx, "o", "r", 7, "", "tok")
+	if err != nil {
+		t.Fatalf("PollWorkflowRun: %v", err)
+	}
+	if poll.Run == nil || poll.Run.Status != "in_progress" || poll.ETag != `"abc"` {
+		t.Errorf("unexpected first poll: %+v", poll)
+	}
+	if poll.RateLimit.Remaining != 42 || !poll.RateLimit.Reset.Equal(time.Unix(1700000000, 0)) {
+		t.Errorf("unexpected rate limit: %+v", poll.RateLimit)
+	}
+
+	poll, err = client.PollWorkflowRun(ctx, "o", "r", 7, `"abc"`, "tok")
+	if err != nil {
+		t.Fatalf("PollWorkflowRun: %v", err)
+	}
+	if !poll.NotModified || poll.Run != nil || poll.ETag != `"abc"` {
+		t.Errorf("expected a not-modified poll, got %+v", poll)
+	}
+
+	poll, err = client.PollWorkflowRun(ctx, "o", "r", 7, `"stale"`, "tok")
+	if err != nil {
+		t.Fatalf("PollWorkflowRun: %v", err)
+	}
+	if !poll.RateLimited || poll.RateLimit.RetryAfter != 30*time.Second {
+		t.Errorf("expected a rate-limited poll, got %+v", poll)
+	}
+}
+
+func TestParseActionStatusConfig_PollBounds(t *testing.T) {
+	cfg, err := parseActionStatusConfig(map[string]any{
+		"owner":             "GoCodeAlone",
+		"repo":              "workflow",
+		"run_id":            1,
+		"poll_interval":     "5m",
+		"max_poll_interval": "1m",
+	})
+	if err != nil {
+		t.Fatalf("parseActionStatusConfig: %v", err)
+	}
+	if cfg.MaxPollInterval != 5*time.Minute || cfg.RateLimitReserve != defaultRateLimitReserve {
+		t.Errorf("expected max_poll_interval raised to 5m and the default reserve, got %s and %d", cfg.MaxPollInterval, cfg.RateLimitReserve)
+	}
+
+	_, err = parseActionStatusConfig(map[string]any{
+		"owner":              "GoCodeAlone",
+		"repo":               "workflow",
+		"run_id":             1,
+		"rate_limit_reserve": -1,
+	})
+	if err == nil {
+		t.Error("expected error for a negative rate_limit_reserve")
+	}
+}
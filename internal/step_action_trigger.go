@@ -3,13 +3,17 @@ package internal
 import (
 	"context"
 	"fmt"
-	"os"
 
 	sdk "github.com/GoCodeAlone/workflow/plugin/external/sdk"
 )
 
 // actionTriggerStep implements sdk.StepInstance.
-// It triggers a GitHub Actions workflow run via the workflow_dispatch API.
+// It triggers a GitHub Actions workflow run via the workflow_dispatch API and
+// reports the run it started, so step.gh_action_status can follow it.
+//
+// The step asks GitHub to return the dispatched run and fetches it the way
+// the runner provider verifies its dispatches, so concurrent dispatches of
+// the same workflow and ref are never confused.
 //
 // Config:
 //
@@ -20,6 +24,7 @@ import (
 //	inputs:                     # optional workflow_dispatch inputs (map[string]string)
 //	  environment: "staging"
 //	token: "${GITHUB_TOKEN}"
+//	capture_run: true           # look up the dispatched run (default: true)
 type actionTriggerStep struct {
 	name     string
 	config   actionTriggerConfig
@@ -33,7 +38,9 @@ type actionTriggerConfig struct {
 	Workflow string            `yaml:"workflow"`
 	Ref      string            `yaml:"ref"`
 	Inputs   map[string]string `yaml:"inputs"`
-	Token    string            `yaml:"token"`
+	Auth     stepAuth
+
+	CaptureRun bool `yaml:"capture_run"`
 }
 
 // newActionTriggerStep parses config and returns an actionTriggerStep.
@@ -43,7 +50,7 @@ func newActionTriggerStep(name string, config map[string]any, client GitHubClien
 		return nil, fmt.Errorf("step.gh_action_trigger %q: %w", name, err)
 	}
 	if client == nil {
-		client = newHTTPGitHubClient()
+		client = newHTTPGitHubClient(cfg.Auth.apiBaseURL)
 	}
 	return &actionTriggerStep{
 		name:     name,
@@ -76,8 +83,11 @@ func parseActionTriggerConfig(raw map[string]any) (actionTriggerConfig, error) {
 		cfg.Ref = "main"
 	}
 
-	cfg.Token, _ = raw["token"].(string)
-	cfg.Token = os.ExpandEnv(cfg.Token)
+	auth, err := parseStepAuth(raw)
+	if err != nil {
+		return cfg, err
+	}
+	cfg.Auth = auth
 
 	if inputs, ok := raw["inputs"].(map[string]any); ok {
 		cfg.Inputs = make(map[string]string, len(inputs))
@@ -88,6 +98,11 @@ func parseActionTriggerConfig(raw map[string]any) (actionTriggerConfig, error) {
 		}
 	}
 
+	cfg.CaptureRun = true
+	if v, ok := raw["capture_run"].(bool); ok {
+		cfg.CaptureRun = v
+	}
+
 	return cfg, nil
 }
 
@@ -102,13 +117,12 @@ func (s *actionTriggerStep) Execute(
 	_ map[string]any,
 	_ map[string]any,
 ) (*sdk.StepResult, error) {
-	token := s.config.Token
-	if token == "" {
-		return errorResult("GITHUB_TOKEN is not configured"), nil
-	}
-
 	owner := resolveField(s.config.Owner, triggerData, stepOutputs, current)
 	repo := resolveField(s.config.Repo, triggerData, stepOutputs, current)
+	token, err := s.config.Auth.token(ctx, owner)
+	if err != nil {
+		return errorResult(err.Error()), nil
+	}
 	workflow := resolveField(s.config.Workflow, triggerData, stepOutputs, current)
 	ref := resolveField(s.config.Ref, triggerData, stepOutputs, current)
 
@@ -118,20 +132,50 @@ func (s *actionTriggerStep) Execute(
 		inputs[k] = resolveField(v, triggerData, stepOutputs, current)
 	}
 
-	err := s.ghClient.TriggerWorkflow(ctx, owner, repo, workflow, ref, inputs, token)
+	dispatch, err := s.ghClient.TriggerWorkflow(ctx, owner, repo, workflow, ref, inputs, token)
 	if err != nil {
 		return errorResult(fmt.Sprintf("failed to trigger workflow: %v", err)), nil
 	}
 
-	return &sdk.StepResult{
-		Output: map[string]any{
-			"triggered": true,
-			"owner":     owner,
-			"repo":      repo,
-			"workflow":  workflow,
-			"ref":       ref,
-		},
-	}, nil
+	output := map[string]any{
+		"triggered": true,
+		"owner":     owner,
+		"repo":      repo,
+		"workflow":  workflow,
+		"ref":       ref,
+	}
+	if !s.config.CaptureRun {
+		return &sdk.StepResult{Output: output}, nil
+	}
+
+	// The workflow has been dispatched, so a failed lookup is reported in
+	// run_lookup_error rather than failing the step and inviting a retry.
+	run, err := s.findRun(ctx, owner, repo, token, dispatch)
+	if err != nil {
+		output["run_lookup_error"] = err.Error()
+	}
+	if run != nil {
+		output["run_id"] = run.ID
+		output["html_url"] = run.HTMLURL
+		output["head_sha"] = run.HeadSHA
+	}
+	return &sdk.StepResult{Output: output}, nil
+}
+
+// findRun fetches the run GitHub returned with the dispatch, retrying like
+// the runner provider's DispatchWorkflow while the run is not served yet. A
+// non-nil run may come with an error when only its details are missing.
+func (s *actionTriggerStep) findRun(ctx context.Context, owner, repo, token string, dispatch *WorkflowDispatch) (*WorkflowRun, error) {
+	if dispatch == nil || dispatch.RunID <= 0 {
+		return nil, fmt.Errorf("GitHub did not return the dispatched workflow run")
+	}
+	run, err := fetchDispatchedRun(ctx, func(ctx context.Context) (*WorkflowRun, error) {
+		return s.ghClient.GetWorkflowRun(ctx, owner, repo, dispatch.RunID, token)
+	})
+	if err == nil {
+		return run, nil
+	}
+	return &WorkflowRun{ID: dispatch.RunID, HTMLURL: dispatch.HTMLURL}, fmt.Errorf("get workflow run %d: %w", dispatch.RunID, err)
 }
 
 // errorResult returns a StepResult that stops the pipeline with an error message.
@@ -146,3 +190,13 @@ func errorResult(msg string) *sdk.StepResult {
 		},
 	}
 }
+
+// outputList converts items to the []any a list step output must be: the SDK
+// encodes outputs with structpb, which rejects typed slices.
+func outputList[T any](items []T) []any {
+	out := make([]any, len(items))
+	for i, item := range items {
+		out[i] = item
+	}
+	return out
+}
@@ -2,22 +2,47 @@ package internal
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 )
 
 // --- mock GitHub client ---
 
 type mockGitHubClient struct {
-	triggerWorkflowFunc func(ctx context.Context, owner, repo, workflow, ref string, inputs map[string]string, token string) error
-	getWorkflowRunFunc  func(ctx context.Context, owner, repo string, runID int64, token string) (*WorkflowRun, error)
+	triggerWorkflowFunc    func(ctx context.Context, owner, repo, workflow, ref string, inputs map[string]string, token string) error
+	getWorkflowRunFunc     func(ctx context.Context, owner, repo string, runID int64, token string) (*WorkflowRun, error)
+	listWorkflowJobsFunc   func(ctx context.Context, owner, repo string, runID int64, token string) ([]WorkflowJob, error)
+	annotationsFunc        func(ctx context.Context, owner, repo string, checkRunID int64, token string) ([]CheckAnnotation, error)
+	jobLogTailFunc         func(ctx context.Context, owner, repo string, jobID int64, lines int, token string) (string, error)
+	cancelWorkflowRunFunc  func(ctx context.Context, owner, repo string, runID int64, force bool, token string) error
+	rerunWorkflowRunFunc   func(ctx context.Context, owner, repo string, runID int64, failedOnly, debugLogging bool, token string) error
+	pendingDeploymentsFunc func(ctx context.Context, owner, repo string, runID int64, token string) ([]PendingDeployment, error)
+	reviewDeploymentsFunc  func(ctx context.Context, owner, repo string, runID int64, environmentIDs []int64, state, comment, token string) error
+	listArtifactsFunc      func(ctx context.Context, owner, repo string, runID int64, token string) ([]WorkflowArtifact, error)
+	downloadArtifactFunc   func(ctx context.Context, owner, repo string, artifactID int64, dst io.Writer, maxBytes int64, token string) (int64, error)
+	rateLimitsFunc         func(ctx context.Context, token string) (map[string]RateLimitResource, error)
+	// pollWorkflowRunFunc answers PollWorkflowRun; nil polls GetWorkflowRun.
+	pollWorkflowRunFunc func(ctx context.Context, owner, repo string, runID int64, etag, token string) (*WorkflowRunPoll, error)
+	// dispatch is returned by TriggerWorkflow; nil reports run 1001.
+	dispatch *WorkflowDispatch
 }
 
-func (m *mockGitHubClient) TriggerWorkflow(ctx context.Context, owner, repo, workflow, ref string, inputs map[string]string, token string) error {
+func (m *mockGitHubClient) TriggerWorkflow(ctx context.Context, owner, repo, workflow, ref string, inputs map[string]string, token string) (*WorkflowDispatch, error) {
 	if m.triggerWorkflowFunc != nil {
-		return m.triggerWorkflowFunc(ctx, owner, repo, workflow, ref, inputs, token)
+		if err := m.triggerWorkflowFunc(ctx, owner, repo, workflow, ref, inputs, token); err != nil {
+			return nil, err
+		}
 	}
-	return nil
+	if m.dispatch != nil {
+		return m.dispatch, nil
+	}
+	return &WorkflowDispatch{RunID: 1001, HTMLURL: "https://github.com/owner/repo/actions/runs/1001"}, nil
 }
 
 func (m *mockGitHubClient) GetWorkflowRun(ctx context.Context, owner, repo string, runID int64, token string) (*WorkflowRun, error) {
@@ -27,6 +52,87 @@ func (m *mockGitHubClient) GetWorkflowRun(ctx context.Context, owner, repo strin
 	return &WorkflowRun{ID: runID, Status: "completed", Conclusion: "success"}, nil
 }
 
+func (m *mockGitHubClient) PollWorkflowRun(ctx context.Context, owner, repo string, runID int64, etag, token string) (*WorkflowRunPoll, error) {
+	if m.pollWorkflowRunFunc != nil {
+		return m.pollWorkflowRunFunc(ctx, owner, repo, runID, etag, token)
+	}
+	run, err := m.GetWorkflowRun(ctx, owner, repo, runID, token)
+	if err != nil {
+		return nil, err
+	}
+	return &WorkflowRunPoll{Run: run, RateLimit: RateLimitStatus{Remaining: -1}}, nil
+}
+
+func (m *mockGitHubClient) ListWorkflowJobs(ctx context.Context, owner, repo string, runID int64, token string) ([]WorkflowJob, error) {
+	if m.listWorkflowJobsFunc != nil {
+		return m.listWorkflowJobsFunc(ctx, owner, repo, runID, token)
+	}
+	return nil, nil
+}
+
+func (m *mockGitHubClient) ListCheckRunAnnotations(ctx context.Context, owner, repo string, checkRunID int64, token string) ([]CheckAnnotation, error) {
+	if m.annotationsFunc != nil {
+		return m.annotationsFunc(ctx, owner, repo, checkRunID, token)
+	}
+	return nil, nil
+}
+
+func (m *mockGitHubClient) GetJobLogTail(ctx context.Context, owner, repo string, jobID int64, lines int, token string) (string, error) {
+	if m.jobLogTailFunc != nil {
+		return m.jobLogTailFunc(ctx, owner, repo, jobID, lines, token)
+	}
+	return "", nil
+}
+
+func (m *mockGitHubClient) CancelWorkflowRun(ctx context.Context, owner, repo string, runID int64, force bool, token string) error {
+	if m.cancelWorkflowRunFunc != nil {
+		return m.cancelWorkflowRunFunc(ctx, owner, repo, runID, force, token)
+	}
+	return nil
+}
+
+func (m *mockGitHubClient) RerunWorkflowRun(ctx context.Context, owner, repo string, runID int64, failedOnly, debugLogging bool, token string) error {
+	if m.rerunWorkflowRunFunc != nil {
+		return m.rerunWorkflowRunFunc(ctx, owner, repo, runID, failedOnly, debugLogging, token)
+	}
+	return nil
+}
+
+func (m *mockGitHubClient) ListPendingDeployments(ctx context.Context, owner, repo string, runID int64, token string) ([]PendingDeployment, error) {
+	if m.pendingDeploymentsFunc != nil {
+		return m.pendingDeploymentsFunc(ctx, owner, repo, runID, token)
+	}
+	return nil, nil
+}
+
+func (m *mockGitHubClient) ReviewPendingDeployments(ctx context.Context, owner, repo string, runID int64, environmentIDs []int64, state, comment, token string) error {
+	if m.reviewDeploymentsFunc != nil {
+		return m.reviewDeploymentsFunc(ctx, owner, repo, runID, environmentIDs, state, comment, token)
+	}
+	return nil
+}
+
+func (m *mockGitHubClient) ListRunArtifacts(ctx context.Context, owner, repo string, runID int64, token string) ([]WorkflowArtifact, error) {
+	if m.listArtifactsFunc != nil {
+		return m.listArtifactsFunc(ctx, owner, repo, runID, token)
+	}
+	return nil, nil
+}
+
+func (m *mockGitHubClient) DownloadArtifact(ctx context.Context, owner, repo string, artifactID int64, dst io.Writer, maxBytes int64, token string) (int64, error) {
+	if m.downloadArtifactFunc != nil {
+		return m.downloadArtifactFunc(ctx, owner, repo, artifactID, dst, maxBytes, token)
+	}
+	return 0, errors.New("artifact not found")
+}
+
+func (m *mockGitHubClient) GetRateLimits(ctx context.Context, token string) (map[string]RateLimitResource, error) {
+	if m.rateLimitsFunc != nil {
+		return m.rateLimitsFunc(ctx, token)
+	}
+	return map[string]RateLimitResource{"core": {Limit: 5000, Remaining: 5000}}, nil
+}
+
 // --- step.gh_action_trigger tests ---
 
 func TestActionTriggerStep_Success(t *testing.T) {
@@ -162,6 +268,129 @@ func TestActionTriggerStep_DefaultRef(t *testing.T) {
 	}
 }
 
+func TestActionTriggerStep_RunFromDispatch(t *testing.T) {
+	client := &mockGitHubClient{
+		getWorkflowRunFunc: func(_ context.Context, _, _ string, runID int64, _ string) (*WorkflowRun, error) {
+			return &WorkflowRun{ID: runID, HTMLURL: "https://github.com/owner/repo/actions/runs/1001", HeadSHA: "abc123"}, nil
+		},
+	}
+
+	step, err := newActionTriggerStep("test", map[string]any{
+		"owner":    "GoCodeAlone",
+		"repo":     "workflow",
+		"workflow": "ci.yml",
+		"token":    "gh-token",
+	}, client)
+	if err != nil {
+		t.Fatalf("newActionTriggerStep: %v", err)
+	}
+
+	result, err := step.Execute(context.Background(), nil, nil, nil, nil, nil)
+	if err != nil {
+		t.Fatalf("Execute: %v", err)
+	}
+	if result.Output["run_id"] != int64(1001) {
+		t.Errorf("expected run_id=1001, got %v", result.Output["run_id"])
+	}
+	if result.Output["head_sha"] != "abc123" {
+		t.Errorf("expected head_sha=abc123, got %v", result.Output["head_sha"])
+	}
+	if _, ok := result.Output["run_lookup_error"]; ok {
+		t.Errorf("unexpected run_lookup_error: %v", result.Output["run_lookup_error"])
+	}
+}
+
+func TestActionTriggerStep_RetriesDispatchedRun(t *testing.T) {
+	old := workflowDispatchVerificationRetryInterval
+	workflowDispatchVerificationRetryInterval = time.Millisecond
+	t.Cleanup(func() { workflowDispatchVerificationRetryInterval = old })
+
+	fetches := 0
+	client := &mockGitHubClient{
+		getWorkflowRunFunc: func(_ context.Context, _, _ string, runID int64, _ string) (*WorkflowRun, error) {
+			fetches++
+			if fetches < 3 {
+				// GitHub has not served the new run yet.
+				return nil, errors.New("get workflow run: unexpected status 404")
+			}
+			return &WorkflowRun{ID: runID, HeadSHA: "def456"}, nil
+		},
+	}
+
+	step, err := newActionTriggerStep("test", map[string]any{
+		"owner":    "GoCodeAlone",
+		"repo":     "workflow",
+		"workflow": "deploy.yml",
+		"token":    "gh-token",
+	}, client)
+	if err != nil {
+		t.Fatalf("newActionTriggerStep: %v", err)
+	}
+
+	result, err := step.Execute(context.Background(), nil, nil, nil, nil, nil)
+	if err != nil {
+		t.Fatalf("Execute: %v", err)
+	}
+	if fetches != 3 {
+		t.Errorf("expected 3 fetches of the run, got %d", fetches)
+	}
+	if result.Output["run_id"] != int64(1001) || result.Output["head_sha"] != "def456" {
+		t.Errorf("expected run 1001 with head_sha=def456, got %v", result.Output)
+	}
+	if _, ok := result.Output["run_lookup_error"]; ok {
+		t.Errorf("unexpected run_lookup_error: %v", result.Output["run_lookup_error"])
+	}
+}
+
+func TestActionTriggerStep_NoRunDetails(t *testing.T) {
+	client := &mockGitHubClient{dispatch: &WorkflowDispatch{}}
+
+	step, err := newActionTriggerStep("test", map[string]any{
+		"owner":    "GoCodeAlone",
+		"repo":     "workflow",
+		"workflow": "ci.yml",
+		"token":    "gh-token",
+	}, client)
+	if err != nil {
+		t.Fatalf("newActionTriggerStep: %v", err)
+	}
+
+	result, err := step.Execute(context.Background(), nil, nil, nil, nil, nil)
+	if err != nil {
+		t.Fatalf("Execute: %v", err)
+	}
+	if result.StopPipeline {
+		t.Error("expected StopPipeline=false once the workflow is dispatched")
+	}
+	if msg, _ := result.Output["run_lookup_error"].(string); !strings.Contains(msg, "did not return") {
+		t.Errorf("expected run_lookup_error to explain the missing run, got %q", msg)
+	}
+	if _, ok := result.Output["run_id"]; ok {
+		t.Errorf("expected no run_id, got %v", result.Output["run_id"])
+	}
+}
+
+func TestHTTPGitHubClient_TriggerWorkflowRequestsRunDetails(t *testing.T) {
+	var payload map[string]any
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewDecoder(r.Body).Decode(&payload)
+		_, _ = w.Write([]byte(`{"workflow_run_id":42,"run_url":"https://api.github.com/repos/o/r/actions/runs/42","html_url":"https://github.com/o/r/actions/runs/42"}`))
+	}))
+	defer srv.Close()
+	client := &httpGitHubClient{baseURL: srv.URL, httpClient: srv.Client()}
+
+	dispatch, err := client.TriggerWorkflow(context.Background(), "o", "r", "ci.yml", "main", nil, "tok")
+	if err != nil {
+		t.Fatalf("TriggerWorkflow: %v", err)
+	}
+	if payload["return_run_details"] != true {
+		t.Errorf("expected return_run_details=true in the dispatch payload, got %v", payload)
+	}
+	if dispatch.RunID != 42 {
+		t.Errorf("expected run 42 from the dispatch response, got %+v", dispatch)
+	}
+}
+
 // --- config validation tests ---
 
 func TestParseActionTriggerConfig_MissingOwner(t *testing.T) {
@@ -0,0 +1,369 @@
+package internal
+
+import (
+	"archive/zip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	sdk "github.com/GoCodeAlone/workflow/plugin/external/sdk"
+)
+
+// Default extraction limits for step.gh_artifact_download.
+const (
+	defaultArtifactMaxFiles      = 1000
+	defaultArtifactMaxFileBytes  = 256 * 1024 * 1024
+	defaultArtifactMaxTotalBytes = 1024 * 1024 * 1024
+)
+
+// artifactDownloadStep implements sdk.StepInstance.
+// It downloads the artifacts of a GitHub Actions workflow run and extracts
+// them under destination. A single artifact selected by its exact name is
+// extracted into destination itself; otherwise each artifact is extracted into
+// a directory named after it.
+//
+// Archive entries must be canonical relative paths to regular files, and the
+// number of files and their sizes are capped, so a hostile archive cannot
+// write outside destination or fill the disk.
+//
+// Config:
+//
+//	owner:           "GoCodeAlone"
+//	repo:            "workflow"
+//	run_id:          "{{.steps.ci.run_id}}"
+//	name:            "dist"          # artifact name or pattern (default: all artifacts)
+//	destination:     "/var/lib/deploy/dist"
+//	max_files:       1000            # per step (default: 1000)
+//	max_file_bytes:  268435456       # per extracted file (default: 256 MiB)
+//	max_total_bytes: 1073741824      # per step (default: 1 GiB)
+//	token:           "${GITHUB_TOKEN}"
+type artifactDownloadStep struct {
+	name     string
+	config   artifactDownloadConfig
+	ghClient GitHubClient
+}
+
+// artifactDownloadConfig holds the parsed configuration for step.gh_artifact_download.
+type artifactDownloadConfig struct {
+	Owner         string      `yaml:"owner"`
+	Repo          string      `yaml:"repo"`
+	RunID         templateInt `yaml:"run_id"`
+	Name          string      `yaml:"name"`
+	Destination   string      `yaml:"destination"`
+	MaxFiles      int64       `yaml:"max_files"`
+	MaxFileBytes  int64       `yaml:"max_file_bytes"`
+	MaxTotalBytes int64       `yaml:"max_total_bytes"`
+	Auth          stepAuth
+}
+
+// newArtifactDownloadStep parses config and returns an artifactDownloadStep.
+func newArtifactDownloadStep(name string, config map[string]any, client GitHubClient) (*artifactDownloadStep, error) {
+	cfg, err := parseArtifactDownloadConfig(config)
+	if err != nil {
+		return nil, fmt.Errorf("step.gh_artifact_download %q: %w", name, err)
+	}
+	if client == nil {
+		client = newHTTPGitHubClient(cfg.Auth.apiBaseURL)
+	}
+	return &artifactDownloadStep{
+		name:     name,
+		config:   cfg,
+		ghClient: client,
+	}, nil
+}
+
+// parseArtifactDownloadConfig converts a raw config map to artifactDownloadConfig.
+func parseArtifactDownloadConfig(raw map[string]any) (artifactDownloadConfig, error) {
+	var cfg artifactDownloadConfig
+
+	cfg.Owner, _ = raw["owner"].(string)
+	if cfg.Owner == "" {
+		return cfg, fmt.Errorf("config.owner is required")
+	}
+
+	cfg.Repo, _ = raw["repo"].(string)
+	if cfg.Repo == "" {
+		return cfg, fmt.Errorf("config.repo is required")
+	}
+
+	var err error
+	cfg.RunID, err = parseTemplateInt(raw, "run_id", true)
+	if err != nil {
+		return cfg, err
+	}
+
+	cfg.Auth, err = parseStepAuth(raw)
+	if err != nil {
+		return cfg, err
+	}
+
+	cfg.Name, _ = raw["name"].(string)
+	cfg.Destination, _ = raw["destination"].(string)
+	if cfg.Destination == "" {
+		return cfg, fmt.Errorf("config.destination is required")
+	}
+
+	limits := []struct {
+		key  string
+		dest *int64
+		def  int64
+	}{
+		{"max_files", &cfg.MaxFiles, defaultArtifactMaxFiles},
+		{"max_file_bytes", &cfg.MaxFileBytes, defaultArtifactMaxFileBytes},
+		{"max_total_bytes", &cfg.MaxTotalBytes, defaultArtifactMaxTotalBytes},
+	}
+	for _, l := range limits {
+		*l.dest = l.def
+		switch v := raw[l.key].(type) {
+		case nil:
+		case int:
+			*l.dest = int64(v)
+		case int64:
+			*l.dest = v
+		case float64:
+			*l.dest = int64(v)
+		default:
+			return cfg, fmt.Errorf("config.%s must be an integer", l.key)
+		}
+		if *l.dest <= 0 {
+			return cfg, fmt.Errorf("config.%s must be positive", l.key)
+		}
+	}
+	return cfg, nil
+}
+
+// Execute downloads and extracts the selected artifacts of the configured
+// workflow run.
+func (s *artifactDownloadStep) Execute(
+	ctx context.Context,
+	triggerData map[string]any,
+	stepOutputs map[string]map[string]any,
+	current map[string]any,
+	_ map[string]any,
+	_ map[string]any,
+) (*sdk.StepResult, error) {
+	owner := resolveField(s.config.Owner, triggerData, stepOutputs, current)
+	repo := resolveField(s.config.Repo, triggerData, stepOutputs, current)
+	token, err := s.config.Auth.token(ctx, owner)
+	if err != nil {
+		return errorResult(err.Error()), nil
+	}
+	runID, err := s.config.RunID.resolve("run_id", triggerData, stepOutputs, current)
+	if err != nil {
+		return errorResult(err.Error()), nil
+	}
+	name := resolveField(s.config.Name, triggerData, stepOutputs, current)
+	destination := resolveField(s.config.Destination, triggerData, stepOutputs, current)
+
+	artifacts, err := s.ghClient.ListRunArtifacts(ctx, owner, repo, runID, token)
+	if err != nil {
+		return errorResult(fmt.Sprintf("failed to list artifacts of workflow run %d: %v", runID, err)), nil
+	}
+	var selected []WorkflowArtifact
+	for _, a := range filterArtifacts(artifacts, name) {
+		if a.Expired {
+			if name == a.Name {
+				return errorResult(fmt.Sprintf("artifact %q of workflow run %d has expired", a.Name, runID)), nil
+			}
+			continue
+		}
+		selected = append(selected, a)
+	}
+	if len(selected) == 0 {
+		if name == "" {
+			return errorResult(fmt.Sprintf("workflow run %d has no artifacts", runID)), nil
+		}
+		return errorResult(fmt.Sprintf("workflow run %d has no artifacts matching %q", runID, name)), nil
+	}
+	// A single artifact picked by its exact name goes straight into
+	// destination, as actions/download-artifact does.
+	intoDestination := name != "" && !isArtifactNamePattern(name) && len(selected) == 1
+
+	root, destination, err := openArtifactDestination(destination)
+	if err != nil {
+		return errorResult(err.Error()), nil
+	}
+	defer root.Close() //nolint:errcheck
+
+	ex := &artifactExtractor{
+		root:          root,
+		maxFiles:      s.config.MaxFiles,
+		maxFileBytes:  s.config.MaxFileBytes,
+		maxTotalBytes: s.config.MaxTotalBytes,
+	}
+	outputs := make([]any, 0, len(selected))
+	for _, a := range selected {
+		dir := "."
+		if !intoDestination {
+			if !validArtifactEntryPath(a.Name) || strings.Contains(a.Name, "/") {
+				return errorResult(fmt.Sprintf("artifact name %q cannot be used as a directory name", a.Name)), nil
+			}
+			dir = a.Name
+		}
+		filesBefore, bytesBefore := ex.files, ex.bytes
+		if err := s.download(ctx, owner, repo, a, dir, token, ex); err != nil {
+			return errorResult(fmt.Sprintf("failed to download artifact %q: %v", a.Name, err)), nil
+		}
+		outputs = append(outputs, map[string]any{
+			"id":    a.ID,
+			"name":  a.Name,
+			"path":  filepath.Join(destination, filepath.FromSlash(dir)),
+			"files": ex.files - filesBefore,
+			"bytes": ex.bytes - bytesBefore,
+		})
+	}
+
+	return &sdk.StepResult{
+		Output: map[string]any{
+			"run_id":      runID,
+			"destination": destination,
+			"artifacts":   outputs,
+			"files":       ex.files,
+			"bytes":       ex.bytes,
+		},
+	}, nil
+}
+
+// download fetches one artifact's archive into a temporary file and extracts
+// it into dir.
+func (s *artifactDownloadStep) download(ctx context.Context, owner, repo string, a WorkflowArtifact, dir, token string, ex *artifactExtractor) error {
+	remaining := ex.maxTotalBytes - ex.bytes
+	if a.SizeInBytes > remaining {
+		return fmt.Errorf("archive of %d bytes exceeds the remaining max_total_bytes", a.SizeInBytes)
+	}
+	archive, err := os.CreateTemp("", "github-artifact-*.zip")
+	if err != nil {
+		return fmt.Errorf("create archive file: %w", err)
+	}
+	defer func() {
+		_ = archive.Close()
+		_ = os.Remove(archive.Name())
+	}()
+
+	size, err := s.ghClient.DownloadArtifact(ctx, owner, repo, a.ID, archive, remaining, token)
+	if err != nil {
+		return err
+	}
+	// Insecure entry names are reported by extract with the entry name.
+	zr, err := zip.NewReader(archive, size)
+	if err != nil && !errors.Is(err, zip.ErrInsecurePath) {
+		return fmt.Errorf("open archive: %w", err)
+	}
+	return ex.extract(zr, dir)
+}
+
+// artifactExtractor extracts artifact archives into root, enforcing the file
+// count and size limits across every archive of a step.
+type artifactExtractor struct {
+	root          *os.Root
+	maxFiles      int64
+	maxFileBytes  int64
+	maxTotalBytes int64
+
+	files int64
+	bytes int64
+}
+
+// extract writes the entries of zr below dir. Sizes are counted while
+// copying rather than trusted from the archive headers.
+func (ex *artifactExtractor) extract(zr *zip.Reader, dir string) error {
+	if err := ex.root.MkdirAll(filepath.FromSlash(dir), 0o755); err != nil {
+		return fmt.Errorf("create %s: %w", dir, err)
+	}
+	for _, f := range zr.File {
+		name := strings.TrimSuffix(f.Name, "/")
+		if !validArtifactEntryPath(name) {
+			return fmt.Errorf("archive entry %q must be a canonical relative slash path", f.Name)
+		}
+		target := filepath.FromSlash(path.Join(dir, name))
+		if f.Mode().IsDir() {
+			if err := ex.root.MkdirAll(target, 0o755); err != nil {
+				return fmt.Errorf("create %s: %w", name, err)
+			}
+			continue
+		}
+		if !f.Mode().IsRegular() {
+			return fmt.Errorf("archive entry %q must be a regular file", f.Name)
+		}
+		if ex.files >= ex.maxFiles {
+			return fmt.Errorf("archive exceeds max_files of %d", ex.maxFiles)
+		}
+		limit := min(ex.maxFileBytes, ex.maxTotalBytes-ex.bytes)
+		if f.UncompressedSize64 > uint64(limit) {
+			return fmt.Errorf("archive entry %q exceeds the artifact size limits", f.Name)
+		}
+		n, err := ex.extractFile(f, target, limit)
+		if err != nil {
+			return fmt.Errorf("extract %s: %w", name, err)
+		}
+		ex.files++
+		ex.bytes += n
+	}
+	return nil
+}
+
+// extractFile copies f to target, failing once more than limit bytes have
+// been written.
+func (ex *artifactExtractor) extractFile(f *zip.File, target string, limit int64) (int64, error) {
+	if err := ex.root.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+		return 0, err
+	}
+	src, err := f.Open()
+	if err != nil {
+		return 0, err
+	}
+	defer src.Close() //nolint:errcheck
+	dst, err := ex.root.OpenFile(target, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o644)
+	if err != nil {
+		return 0, err
+	}
+	n, copyErr := io.Copy(dst, io.LimitReader(src, limit+1))
+	if err := errors.Join(copyErr, dst.Close()); err != nil {
+		return n, err
+	}
+	if n > limit {
+		return n, errors.New("entry exceeds the artifact size limits")
+	}
+	return n, nil
+}
+
+// validArtifactEntryPath reports whether value is a canonical relative slash
+// path that stays below the extraction directory.
+func validArtifactEntryPath(value string) bool {
+	return value != "" &&
+		path.Clean(value) == value &&
+		!path.IsAbs(value) &&
+		value != "." &&
+		value != ".." &&
+		!strings.HasPrefix(value, "../") &&
+		!strings.ContainsAny(value, "\\:\x00\r\n\t")
+}
+
+// openArtifactDestination creates destination if needed and opens it as a
+// root that extraction cannot escape.
+func openArtifactDestination(destination string) (*os.Root, string, error) {
+	destination, err := filepath.Abs(destination)
+	if err != nil {
+		return nil, "", fmt.Errorf("resolve destination: %w", err)
+	}
+	if err := os.MkdirAll(destination, 0o755); err != nil {
+		return nil, "", fmt.Errorf("create destination: %w", err)
+	}
+	info, err := os.Lstat(destination)
+	if err != nil {
+		return nil, "", fmt.Errorf("inspect destination: %w", err)
+	}
+	if !info.IsDir() || info.Mode()&os.ModeSymlink != 0 {
+		return nil, "", errors.New("destination must be a directory and not a symbolic link")
+	}
+	root, err := os.OpenRoot(destination)
+	if err != nil {
+		return nil, "", fmt.Errorf("open destination: %w", err)
+	}
+	return root, destination, nil
+}
@@ -0,0 +1,188 @@
+package internal
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// zipArchive builds a zip archive from name/content pairs.
+func zipArchive(t *testing.T, files map[string]string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for name, content := range files {
+		w, err := zw.Create(name)
+		if err != nil {
+			t.Fatalf("create %s: %v", name, err)
+		}
+		if _, err := io.WriteString(w, content); err != nil {
+			t.Fatalf("write %s: %v", name, err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatalf("close zip: %v", err)
+	}
+	return buf.Bytes()
+}
+
+// artifactClient serves the given archives keyed by artifact ID.
+func artifactClient(artifacts []WorkflowArtifact, archives map[int64][]byte) *mockGitHubClient {
+	return &mockGitHubClient{
+		listArtifactsFunc: func(context.Context, string, string, int64, string) ([]WorkflowArtifact, error) {
+			return artifacts, nil
+		},
+		downloadArtifactFunc: func(_ context.Context, _, _ string, id int64, dst io.Writer, maxBytes int64, _ string) (int64, error) {
+			data := archives[id]
+			if int64(len(data)) > maxBytes {
+				return 0, io.ErrShortBuffer
+			}
+			n, err := dst.Write(data)
+			return int64(n), err
+		},
+	}
+}
+
+func executeArtifactDownload(t *testing.T, client GitHubClient, config map[string]any) map[string]any {
+	t.Helper()
+	config["owner"] = "GoCodeAlone"
+	config["repo"] = "workflow"
+	config["run_id"] = 99
+	config["token"] = "gh-token"
+	step, err := newArtifactDownloadStep("download", config, client)
+	if err != nil {
+		t.Fatalf("newArtifactDownloadStep: %v", err)
+	}
+	result, err := step.Execute(context.Background(), nil, nil, nil, nil, nil)
+	if err != nil {
+		t.Fatalf("Execute: %v", err)
+	}
+	requireEncodableOutput(t, result.Output)
+	return result.Output
+}
+
+func TestArtifactDownloadStep_ExtractsArtifacts(t *testing.T) {
+	artifacts := []WorkflowArtifact{
+		{ID: 1, Name: "dist"},
+		{ID: 2, Name: "coverage"},
+		{ID: 3, Name: "old", Expired: true},
+	}
+	client := artifactClient(artifacts, map[int64][]byte{
+		1: zipArchive(t, map[string]string{"bin/app": "binary", "README": "readme"}),
+		2: zipArchive(t, map[string]string{"cover.out": "mode: set"}),
+	})
+
+	// Every unexpired artifact goes into a directory named after it.
+	dest := t.TempDir()
+	out := executeArtifactDownload(t, client, map[string]any{"destination": dest})
+	if out["error"] != nil {
+		t.Fatalf("unexpected error: %v", out["error"])
+	}
+	if out["files"] != int64(3) {
+		t.Errorf("expected 3 files, got %v", out["files"])
+	}
+	data, err := os.ReadFile(filepath.Join(dest, "dist", "bin", "app"))
+	if err != nil || string(data) != "binary" {
+		t.Errorf("expected dist/bin/app to be extracted, got %q (%v)", data, err)
+	}
+	if _, err := os.Stat(filepath.Join(dest, "old")); !os.IsNotExist(err) {
+		t.Errorf("expected the expired artifact to be skipped")
+	}
+
+	// An artifact picked by its exact name goes straight into destination.
+	dest = t.TempDir()
+	out = executeArtifactDownload(t, client, map[string]any{"destination": dest, "name": "coverage"})
+	if out["error"] != nil {
+		t.Fatalf("unexpected error: %v", out["error"])
+	}
+	if _, err := os.Stat(filepath.Join(dest, "cover.out")); err != nil {
+		t.Errorf("expected cover.out in destination: %v", err)
+	}
+}
+
+func TestArtifactDownloadStep_RejectsUnsafeArchives(t *testing.T) {
+	cases := map[string]struct {
+		files  map[string]string
+		config map[string]any
+		want   string
+	}{
+		"path traversal": {
+			files: map[string]string{"../escape": "x"},
+			want:  "canonical relative",
+		},
+		"absolute path": {
+			files: map[string]string{"/etc/passwd": "x"},
+			want:  "canonical relative",
+		},
+		"file too large": {
+			files:  map[string]string{"big": strings.Repeat("x", 100)},
+			config: map[string]any{"max_file_bytes": 10},
+			want:   "size limits",
+		},
+		"too many files": {
+			files:  map[string]string{"a": "1", "b": "2"},
+			config: map[string]any{"max_files": 1},
+			want:   "max_files",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			client := artifactClient([]WorkflowArtifact{{ID: 1, Name: "dist"}}, map[int64][]byte{
+				1: zipArchive(t, tc.files),
+			})
+			config := map[string]any{"destination": t.TempDir(), "name": "dist"}
+			for k, v := range tc.config {
+				config[k] = v
+			}
+			out := executeArtifactDownload(t, client, config)
+			msg, _ := out["error"].(string)
+			if !strings.Contains(msg, tc.want) {
+				t.Errorf("expected an error containing %q, got %q", tc.want, msg)
+			}
+		})
+	}
+}
+
+func TestArtifactDownloadStep_NoMatchingArtifacts(t *testing.T) {
+	client := artifactClient([]WorkflowArtifact{{ID: 1, Name: "dist"}}, nil)
+	out := executeArtifactDownload(t, client, map[string]any{"destination": t.TempDir(), "name": "docs-*"})
+	if msg, _ := out["error"].(string); !strings.Contains(msg, "no artifacts matching") {
+		t.Errorf("expected a no-match error, got %v", out["error"])
+	}
+}
+
+func TestArtifactListStep_FiltersByName(t *testing.T) {
+	client := artifactClient([]WorkflowArtifact{
+		{ID: 1, Name: "dist-linux"},
+		{ID: 2, Name: "dist-darwin"},
+		{ID: 3, Name: "coverage"},
+	}, nil)
+	step, err := newArtifactListStep("list", map[string]any{
+		"owner":  "GoCodeAlone",
+		"repo":   "workflow",
+		"run_id": "{{.steps.ci.run_id}}",
+		"name":   "dist-*",
+		"token":  "gh-token",
+	}, client)
+	if err != nil {
+		t.Fatalf("newArtifactListStep: %v", err)
+	}
+	stepOutputs := map[string]map[string]any{"ci": {"run_id": int64(99)}}
+	result, err := step.Execute(context.Background(), nil, stepOutputs, nil, nil, nil)
+	if err != nil {
+		t.Fatalf("Execute: %v", err)
+	}
+	if result.StopPipeline {
+		t.Fatalf("unexpected error: %v", result.Output["error"])
+	}
+	requireEncodableOutput(t, result.Output)
+	artifacts, _ := result.Output["artifacts"].([]any)
+	if result.Output["count"] != 2 || len(artifacts) != 2 {
+		t.Errorf("expected 2 artifacts, got %v", result.Output["artifacts"])
+	}
+}
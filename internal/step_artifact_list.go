@@ -0,0 +1,147 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/GoCodeAlone/workflow/plugin/external/sdk"
+)
+
+// artifactListStep implements sdk.StepInstance.
+// It lists the artifacts a GitHub Actions workflow run uploaded.
+//
+// Config:
+//
+//	owner:  "GoCodeAlone"
+//	repo:   "workflow"
+//	run_id: "{{.steps.ci.run_id}}"
+//	name:   "dist-*"    # optional artifact name pattern (* and ? wildcards)
+//	token:  "${GITHUB_TOKEN}"
+type artifactListStep struct {
+	name     string
+	config   artifactListConfig
+	ghClient GitHubClient
+}
+
+// artifactListConfig holds the parsed configuration for step.gh_artifact_list.
+type artifactListConfig struct {
+	Owner string      `yaml:"owner"`
+	Repo  string      `yaml:"repo"`
+	RunID templateInt `yaml:"run_id"`
+	Name  string      `yaml:"name"`
+	Auth  stepAuth
+}
+
+// newArtifactListStep parses config and returns an artifactListStep.
+func newArtifactListStep(name string, config map[string]any, client GitHubClient) (*artifactListStep, error) {
+	cfg, err := parseArtifactListConfig(config)
+	if err != nil {
+		return nil, fmt.Errorf("step.gh_artifact_list %q: %w", name, err)
+	}
+	if client == nil {
+		client = newHTTPGitHubClient(cfg.Auth.apiBaseURL)
+	}
+	return &artifactListStep{
+		name:     name,
+		config:   cfg,
+		ghClient: client,
+	}, nil
+}
+
+// parseArtifactListConfig converts a raw config map to artifactListConfig.
+func parseArtifactListConfig(raw map[string]any) (artifactListConfig, error) {
+	var cfg artifactListConfig
+
+	cfg.Owner, _ = raw["owner"].(string)
+	if cfg.Owner == "" {
+		return cfg, fmt.Errorf("config.owner is required")
+	}
+
+	cfg.Repo, _ = raw["repo"].(string)
+	if cfg.Repo == "" {
+		return cfg, fmt.Errorf("config.repo is required")
+	}
+
+	var err error
+	cfg.RunID, err = parseTemplateInt(raw, "run_id", true)
+	if err != nil {
+		return cfg, err
+	}
+
+	cfg.Auth, err = parseStepAuth(raw)
+	if err != nil {
+		return cfg, err
+	}
+
+	cfg.Name, _ = raw["name"].(string)
+	return cfg, nil
+}
+
+// Execute lists the artifacts of the configured workflow run.
+func (s *artifactListStep) Execute(
+	ctx context.Context,
+	triggerData map[string]any,
+	stepOutputs map[string]map[string]any,
+	current map[string]any,
+	_ map[string]any,
+	_ map[string]any,
+) (*sdk.StepResult, error) {
+	owner := resolveField(s.config.Owner, triggerData, stepOutputs, current)
+	repo := resolveField(s.config.Repo, triggerData, stepOutputs, current)
+	token, err := s.config.Auth.token(ctx, owner)
+	if err != nil {
+		return errorResult(err.Error()), nil
+	}
+	runID, err := s.config.RunID.resolve("run_id", triggerData, stepOutputs, current)
+	if err != nil {
+		return errorResult(err.Error()), nil
+	}
+
+	artifacts, err := s.ghClient.ListRunArtifacts(ctx, owner, repo, runID, token)
+	if err != nil {
+		return errorResult(fmt.Sprintf("failed to list artifacts of workflow run %d: %v", runID, err)), nil
+	}
+	pattern := resolveField(s.config.Name, triggerData, stepOutputs, current)
+	artifacts = filterArtifacts(artifacts, pattern)
+
+	outputs := make([]any, 0, len(artifacts))
+	for _, a := range artifacts {
+		outputs = append(outputs, map[string]any{
+			"id":            a.ID,
+			"name":          a.Name,
+			"size_in_bytes": a.SizeInBytes,
+			"expired":       a.Expired,
+			"created_at":    a.CreatedAt.Format(time.RFC3339),
+			"expires_at":    a.ExpiresAt.Format(time.RFC3339),
+		})
+	}
+	return &sdk.StepResult{
+		Output: map[string]any{
+			"run_id":    runID,
+			"artifacts": outputs,
+			"count":     len(outputs),
+		},
+	}, nil
+}
+
+// filterArtifacts returns the artifacts whose name matches pattern, in which
+// * and ? are wildcards. An empty pattern matches every artifact.
+func filterArtifacts(artifacts []WorkflowArtifact, pattern string) []WorkflowArtifact {
+	if pattern == "" {
+		return artifacts
+	}
+	var matched []WorkflowArtifact
+	for _, a := range artifacts {
+		if matchAnyGlob([]string{pattern}, a.Name, false) {
+			matched = append(matched, a)
+		}
+	}
+	return matched
+}
+
+// isArtifactNamePattern reports whether name contains wildcards.
+func isArtifactNamePattern(name string) bool {
+	return strings.ContainsAny(name, "*?")
+}
@@ -0,0 +1,113 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+)
+
+// stepAuth holds the credentials a step uses to call the GitHub API. A step
+// authenticates either with a static token or, when auth_module is set, with
+// the auto-refreshing installation token of a github.app module. The step's
+// resolved owner selects the installation when the module is not bound to a
+// single installation_id. token_repositories and token_permissions narrow the
+// installation token minted for the step. api_base_url, upload_url, and
+// graphql_url target a GitHub Enterprise Server instance; a step using
+// auth_module follows the module's endpoints for any it leaves unset.
+//
+// Config:
+//
+//	token:              "${GITHUB_TOKEN}"   # static token
+//	auth_module:        "my-app"            # name of a github.app module (takes precedence)
+//	token_repositories: ["workflow"]        # optional; auth_module only
+//	token_permissions:                      # optional; auth_module only
+//	  pull_requests: write
+//	api_base_url:       "https://ghe.example.com/api/v3"  # optional
+type stepAuth struct {
+	Token      string `yaml:"token"`
+	AuthModule string `yaml:"auth_module"`
+	Scope      tokenScope
+	Endpoints  githubEndpoints
+}
+
+// parseStepAuth extracts the token, auth_module, token scope, and endpoint
+// fields from a raw step config.
+func parseStepAuth(raw map[string]any) (stepAuth, error) {
+	var auth stepAuth
+	auth.Token, _ = raw["token"].(string)
+	auth.Token = os.ExpandEnv(auth.Token)
+	auth.AuthModule, _ = raw["auth_module"].(string)
+	scope, err := parseTokenScope(raw, "token_repositories", "token_permissions")
+	if err != nil {
+		return auth, err
+	}
+	if !scope.isZero() && auth.AuthModule == "" {
+		return auth, errors.New("config.token_repositories and config.token_permissions require auth_module")
+	}
+	auth.Scope = scope
+	auth.Endpoints, err = parseGitHubEndpoints(raw)
+	if err != nil {
+		return auth, err
+	}
+	return auth, nil
+}
+
+// appModule returns the github.app module referenced by auth_module.
+func (a stepAuth) appModule() (*githubAppModule, error) {
+	mod, ok := lookupGitHubAppModule(a.AuthModule)
+	if !ok {
+		return nil, fmt.Errorf("auth_module %q does not name a running github.app module", a.AuthModule)
+	}
+	return mod, nil
+}
+
+// token returns a bearer token for a single GitHub API call against owner.
+func (a stepAuth) token(ctx context.Context, owner string) (string, error) {
+	if a.AuthModule != "" {
+		mod, err := a.appModule()
+		if err != nil {
+			return "", err
+		}
+		return mod.GetScopedInstallationToken(ctx, owner, a.Scope)
+	}
+	if a.Token == "" {
+		return "", errors.New("GITHUB_TOKEN is not configured")
+	}
+	return a.Token, nil
+}
+
+// sdkClient returns a go-github client for the configured credentials. App
+// clients refresh the installation token on every request, so long-running
+// steps never hold an expired token.
+func (a stepAuth) sdkClient(owner string) (*SDKClient, error) {
+	if a.AuthModule != "" {
+		mod, err := a.appModule()
+		if err != nil {
+			return nil, err
+		}
+		return mod.GetScopedSDKClient(owner, a.Scope).withEndpoints(a.Endpoints.over(mod.config.Endpoints)), nil
+	}
+	if a.Token == "" {
+		return nil, errors.New("GITHUB_TOKEN is not configured")
+	}
+	return NewSDKClient(a.Token).withEndpoints(a.Endpoints), nil
+}
+
+// endpoints returns the step's own endpoints merged over those of its
+// auth_module. The module is looked up per call because modules register
+// after steps are built.
+func (a stepAuth) endpoints() githubEndpoints {
+	if a.AuthModule == "" {
+		return a.Endpoints
+	}
+	if mod, ok := lookupGitHubAppModule(a.AuthModule); ok {
+		return a.Endpoints.over(mod.config.Endpoints)
+	}
+	return a.Endpoints
+}
+
+// apiBaseURL returns the REST API root for the step's calls.
+func (a stepAuth) apiBaseURL() string {
+	return a.endpoints().apiBaseURL()
+}
@@ -3,7 +3,6 @@ package internal
 import (
 	"context"
 	"fmt"
-	"os"
 
 	"github.com/google/go-github/v69/github"
 
@@ -28,13 +27,13 @@ type deploymentCreateStep struct {
 }
 
 type deploymentCreateConfig struct {
-	Owner       string `yaml:"owner"`
-	Repo        string `yaml:"repo"`
-	Ref         string `yaml:"ref"`
-	Environment string `yaml:"environment"`
-	Description string `yaml:"description"`
-	AutoMerge   bool   `yaml:"auto_merge"`
-	Token       string `yaml:"token"`
+	Owner       string       `yaml:"owner"`
+	Repo        string       `yaml:"repo"`
+	Ref         string       `yaml:"ref"`
+	Environment string       `yaml:"environment"`
+	Description string       `yaml:"description"`
+	AutoMerge   templateBool `yaml:"auto_merge"`
+	Auth        stepAuth
 }
 
 func newDeploymentCreateStep(name string, raw map[string]any) (*deploymentCreateStep, error) {
@@ -56,9 +55,15 @@ func newDeploymentCreateStep(name string, raw map[string]any) (*deploymentCreate
 		cfg.Environment = "production"
 	}
 	cfg.Description, _ = raw["description"].(string)
-	cfg.AutoMerge, _ = raw["auto_merge"].(bool)
-	cfg.Token, _ = raw["token"].(string)
-	cfg.Token = os.ExpandEnv(cfg.Token)
+	var err error
+	if cfg.AutoMerge, err = parseTemplateBool(raw, "auto_merge"); err != nil {
+		return nil, fmt.Errorf("step.gh_deployment_create %q: %w", name, err)
+	}
+	auth, err := parseStepAuth(raw)
+	if err != nil {
+		return nil, fmt.Errorf("step.gh_deployment_create %q: %w", name, err)
+	}
+	cfg.Auth = auth
 	return &deploymentCreateStep{name: name, config: cfg}, nil
 }
 
@@ -70,22 +75,25 @@ func (s *deploymentCreateStep) Execute(
 	_ map[string]any,
 	_ map[string]any,
 ) (*sdk.StepResult, error) {
-	token := s.config.Token
-	if token == "" {
-		return errorResult("GITHUB_TOKEN is not configured"), nil
-	}
 	owner := resolveField(s.config.Owner, triggerData, stepOutputs, current)
 	repo := resolveField(s.config.Repo, triggerData, stepOutputs, current)
+	client, err := s.config.Auth.sdkClient(owner)
+	if err != nil {
+		return errorResult(err.Error()), nil
+	}
 	ref := resolveField(s.config.Ref, triggerData, stepOutputs, current)
 	env := resolveField(s.config.Environment, triggerData, stepOutputs, current)
 	desc := resolveField(s.config.Description, triggerData, stepOutputs, current)
+	autoMerge, err := s.config.AutoMerge.resolve("auto_merge", triggerData, stepOutputs, current)
+	if err != nil {
+		return errorResult(err.Error()), nil
+	}
 
-	client := NewSDKClient(token)
 	dep, _, err := client.GH.Repositories.CreateDeployment(ctx, owner, repo, &github.DeploymentRequest{
 		Ref:              github.Ptr(ref),
 		Environment:      github.Ptr(env),
 		Description:      github.Ptr(desc),
-		AutoMerge:        github.Ptr(s.config.AutoMerge),
+		AutoMerge:        github.Ptr(autoMerge),
 		RequiredContexts: &[]string{},
 	})
 	if err != nil {
@@ -7,8 +7,6 @@ import (
 	"fmt"
 	"io"
 	"net/http"
-	"os"
-	"time"
 
 	sdk "github.com/GoCodeAlone/workflow/plugin/external/sdk"
 )
@@ -18,9 +16,11 @@ import (
 //
 // Config:
 //
-//	query:     "query { viewer { login } }"
-//	variables: {owner: "GoCodeAlone", repo: "workflow"}
-//	token:     "${GITHUB_TOKEN}"
+//	query:       "query { viewer { login } }"
+//	variables:   {owner: "GoCodeAlone", repo: "workflow"}
+//	owner:       "GoCodeAlone"    # selects the github.app installation for auth_module
+//	token:       "${GITHUB_TOKEN}"
+//	graphql_url: "https://ghe.example.com/api/graphql"  # optional; derived from api_base_url
 type graphqlStep struct {
 	name   string
 	config graphqlConfig
@@ -29,7 +29,8 @@ type graphqlStep struct {
 type graphqlConfig struct {
 	Query     string         `yaml:"query"`
 	Variables map[string]any `yaml:"variables"`
-	Token     string         `yaml:"token"`
+	Owner     string         `yaml:"owner"`
+	Auth      stepAuth
 }
 
 func newGraphQLStep(name string, raw map[string]any) (*graphqlStep, error) {
@@ -39,8 +40,12 @@ func newGraphQLStep(name string, raw map[string]any) (*graphqlStep, error) {
 		return nil, fmt.Errorf("step.gh_graphql %q: config.query is required", name)
 	}
 	cfg.Variables, _ = raw["variables"].(map[string]any)
-	cfg.Token, _ = raw["token"].(string)
-	cfg.Token = os.ExpandEnv(cfg.Token)
+	cfg.Owner, _ = raw["owner"].(string)
+	auth, err := parseStepAuth(raw)
+	if err != nil {
+		return nil, fmt.Errorf("step.gh_graphql %q: %w", name, err)
+	}
+	cfg.Auth = auth
 	return &graphqlStep{name: name, config: cfg}, nil
 }
 
@@ -52,60 +57,72 @@ func (s *graphqlStep) Execute(
 	_ map[string]any,
 	_ map[string]any,
 ) (*sdk.StepResult, error) {
-	token := s.config.Token
-	if token == "" {
-		return errorResult("GITHUB_TOKEN is not configured"), nil
+	owner := resolveField(s.config.Owner, triggerData, stepOutputs, current)
+	token, err := s.config.Auth.token(ctx, owner)
+	if err != nil {
+		return errorResult(err.Error()), nil
 	}
 	query := resolveField(s.config.Query, triggerData, stepOutputs, current)
 
+	data, status, err := postGraphQL(ctx, s.config.Auth, token, query, s.config.Variables)
+	if err != nil {
+		return errorResult(err.Error()), nil
+	}
+	return &sdk.StepResult{
+		Output: map[string]any{
+			"data":   data,
+			"status": status,
+		},
+	}, nil
+}
+
+// postGraphQL executes query with variables against the GraphQL endpoint of
+// auth and returns the response data and HTTP status. GraphQL errors in the
+// response are returned as an error.
+func postGraphQL(ctx context.Context, auth stepAuth, token, query string, variables map[string]any) (map[string]any, int, error) {
 	payload := map[string]any{
 		"query": query,
 	}
-	if len(s.config.Variables) > 0 {
-		payload["variables"] = s.config.Variables
+	if len(variables) > 0 {
+		payload["variables"] = variables
 	}
 
 	body, err := json.Marshal(payload)
 	if err != nil {
-		return errorResult(fmt.Sprintf("marshal query: %v", err)), nil
+		return nil, 0, fmt.Errorf("marshal query: %w", err)
 	}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, "https://api.github.com/graphql", bytes.NewReader(body))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, auth.endpoints().graphqlURL(), bytes.NewReader(body))
 	if err != nil {
-		return errorResult(fmt.Sprintf("create request: %v", err)), nil
+		return nil, 0, fmt.Errorf("create request: %w", err)
 	}
 	req.Header.Set("Authorization", "Bearer "+token)
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("Accept", "application/vnd.github+json")
 	req.Header.Set("X-GitHub-Api-Version", "2022-11-28")
 
-	httpClient := &http.Client{Timeout: 30 * time.Second}
+	httpClient := newGitHubHTTPClient()
 	resp, err := httpClient.Do(req)
 	if err != nil {
-		return errorResult(fmt.Sprintf("execute graphql: %v", err)), nil
+		return nil, 0, fmt.Errorf("execute graphql: %w", err)
 	}
 	defer resp.Body.Close()
 
 	respBody, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return errorResult(fmt.Sprintf("read response: %v", err)), nil
+		return nil, resp.StatusCode, fmt.Errorf("read response: %w", err)
 	}
 
 	var result map[string]any
 	if err := json.Unmarshal(respBody, &result); err != nil {
-		return errorResult(fmt.Sprintf("parse response: %v", err)), nil
+		return nil, resp.StatusCode, fmt.Errorf("parse response: %w", err)
 	}
 
 	if errs, ok := result["errors"]; ok {
 		errData, _ := json.Marshal(errs)
-		return errorResult(fmt.Sprintf("graphql errors: %s", errData)), nil
+		return nil, resp.StatusCode, fmt.Errorf("graphql errors: %s", errData)
 	}
 
 	data, _ := result["data"].(map[string]any)
-	return &sdk.StepResult{
-		Output: map[string]any{
-			"data":   data,
-			"status": resp.StatusCode,
-		},
-	}, nil
+	return data, resp.StatusCode, nil
 }
@@ -3,7 +3,6 @@ package internal
 import (
 	"context"
 	"fmt"
-	"os"
 
 	"github.com/google/go-github/v69/github"
 
@@ -26,11 +25,11 @@ type issueCloseStep struct {
 }
 
 type issueCloseConfig struct {
-	Owner       string `yaml:"owner"`
-	Repo        string `yaml:"repo"`
-	IssueNumber int    `yaml:"issue_number"`
-	Comment     string `yaml:"comment"`
-	Token       string `yaml:"token"`
+	Owner       string      `yaml:"owner"`
+	Repo        string      `yaml:"repo"`
+	IssueNumber templateInt `yaml:"issue_number"`
+	Comment     string      `yaml:"comment"`
+	Auth        stepAuth
 }
 
 func newIssueCloseStep(name string, raw map[string]any) (*issueCloseStep, error) {
@@ -43,20 +42,17 @@ func newIssueCloseStep(name string, raw map[string]any) (*issueCloseStep, error)
 	if cfg.Repo == "" {
 		return nil, fmt.Errorf("step.gh_issue_close %q: config.repo is required", name)
 	}
-	switch v := raw["issue_number"].(type) {
-	case int:
-		cfg.IssueNumber = v
-	case int64:
-		cfg.IssueNumber = int(v)
-	case float64:
-		cfg.IssueNumber = int(v)
-	}
-	if cfg.IssueNumber == 0 {
-		return nil, fmt.Errorf("step.gh_issue_close %q: config.issue_number is required", name)
+	number, err := parseTemplateInt(raw, "issue_number", true)
+	if err != nil {
+		return nil, fmt.Errorf("step.gh_issue_close %q: %w", name, err)
 	}
+	cfg.IssueNumber = number
 	cfg.Comment, _ = raw["comment"].(string)
-	cfg.Token, _ = raw["token"].(string)
-	cfg.Token = os.ExpandEnv(cfg.Token)
+	auth, err := parseStepAuth(raw)
+	if err != nil {
+		return nil, fmt.Errorf("step.gh_issue_close %q: %w", name, err)
+	}
+	cfg.Auth = auth
 	return &issueCloseStep{name: name, config: cfg}, nil
 }
 
@@ -68,19 +64,21 @@ func (s *issueCloseStep) Execute(
 	_ map[string]any,
 	_ map[string]any,
 ) (*sdk.StepResult, error) {
-	token := s.config.Token
-	if token == "" {
-		return errorResult("GITHUB_TOKEN is not configured"), nil
-	}
 	owner := resolveField(s.config.Owner, triggerData, stepOutputs, current)
 	repo := resolveField(s.config.Repo, triggerData, stepOutputs, current)
-
-	client := NewSDKClient(token)
+	client, err := s.config.Auth.sdkClient(owner)
+	if err != nil {
+		return errorResult(err.Error()), nil
+	}
+	issueNumber, err := s.config.IssueNumber.resolve("issue_number", triggerData, stepOutputs, current)
+	if err != nil {
+		return errorResult(err.Error()), nil
+	}
 
 	// Add comment before closing if configured.
 	if s.config.Comment != "" {
 		comment := resolveField(s.config.Comment, triggerData, stepOutputs, current)
-		_, _, err := client.GH.Issues.CreateComment(ctx, owner, repo, s.config.IssueNumber,
+		_, _, err := client.GH.Issues.CreateComment(ctx, owner, repo, int(issueNumber),
 			&github.IssueComment{Body: github.Ptr(comment)})
 		if err != nil {
 			return errorResult(fmt.Sprintf("add close comment: %v", err)), nil
@@ -88,7 +86,7 @@ func (s *issueCloseStep) Execute(
 	}
 
 	state := "closed"
-	issue, _, err := client.GH.Issues.Edit(ctx, owner, repo, s.config.IssueNumber,
+	issue, _, err := client.GH.Issues.Edit(ctx, owner, repo, int(issueNumber),
 		&github.IssueRequest{State: &state})
 	if err != nil {
 		return errorResult(fmt.Sprintf("close issue: %v", err)), nil
@@ -3,7 +3,6 @@ package internal
 import (
 	"context"
 	"fmt"
-	"os"
 
 	"github.com/google/go-github/v69/github"
 
@@ -28,13 +27,13 @@ type issueCreateStep struct {
 }
 
 type issueCreateConfig struct {
-	Owner     string   `yaml:"owner"`
-	Repo      string   `yaml:"repo"`
-	Title     string   `yaml:"title"`
-	Body      string   `yaml:"body"`
-	Labels    []string `yaml:"labels"`
-	Assignees []string `yaml:"assignees"`
-	Token     string   `yaml:"token"`
+	Owner     string       `yaml:"owner"`
+	Repo      string       `yaml:"repo"`
+	Title     string       `yaml:"title"`
+	Body      string       `yaml:"body"`
+	Labels    templateList `yaml:"labels"`
+	Assignees templateList `yaml:"assignees"`
+	Auth      stepAuth
 }
 
 func newIssueCreateStep(name string, raw map[string]any) (*issueCreateStep, error) {
@@ -49,22 +48,18 @@ func newIssueCreateStep(name string, raw map[string]any) (*issueCreateStep, erro
 	}
 	cfg.Title, _ = raw["title"].(string)
 	cfg.Body, _ = raw["body"].(string)
-	if labels, ok := raw["labels"].([]any); ok {
-		for _, l := range labels {
-			if s, ok := l.(string); ok {
-				cfg.Labels = append(cfg.Labels, s)
-			}
-		}
+	var err error
+	if cfg.Labels, err = parseTemplateList(raw, "labels"); err != nil {
+		return nil, fmt.Errorf("step.gh_issue_create %q: %w", name, err)
 	}
-	if assignees, ok := raw["assignees"].([]any); ok {
-		for _, a := range assignees {
-			if s, ok := a.(string); ok {
-				cfg.Assignees = append(cfg.Assignees, s)
-			}
-		}
+	if cfg.Assignees, err = parseTemplateList(raw, "assignees"); err != nil {
+		return nil, fmt.Errorf("step.gh_issue_create %q: %w", name, err)
 	}
-	cfg.Token, _ = raw["token"].(string)
-	cfg.Token = os.ExpandEnv(cfg.Token)
+	auth, err := parseStepAuth(raw)
+	if err != nil {
+		return nil, fmt.Errorf("step.gh_issue_create %q: %w", name, err)
+	}
+	cfg.Auth = auth
 	return &issueCreateStep{name: name, config: cfg}, nil
 }
 
@@ -76,23 +71,30 @@ func (s *issueCreateStep) Execute(
 	_ map[string]any,
 	_ map[string]any,
 ) (*sdk.StepResult, error) {
-	token := s.config.Token
-	if token == "" {
-		return errorResult("GITHUB_TOKEN is not configured"), nil
-	}
 	owner := resolveField(s.config.Owner, triggerData, stepOutputs, current)
 	repo := resolveField(s.config.Repo, triggerData, stepOutputs, current)
+	client, err := s.config.Auth.sdkClient(owner)
+	if err != nil {
+		return errorResult(err.Error()), nil
+	}
 	title := resolveField(s.config.Title, triggerData, stepOutputs, current)
 	body := resolveField(s.config.Body, triggerData, stepOutputs, current)
+	labels, err := s.config.Labels.resolve("labels", triggerData, stepOutputs, current)
+	if err != nil {
+		return errorResult(err.Error()), nil
+	}
+	assignees, err := s.config.Assignees.resolve("assignees", triggerData, stepOutputs, current)
+	if err != nil {
+		return errorResult(err.Error()), nil
+	}
 
 	req := &github.IssueRequest{
 		Title:     github.Ptr(title),
 		Body:      github.Ptr(body),
-		Labels:    &s.config.Labels,
-		Assignees: &s.config.Assignees,
+		Labels:    &labels,
+		Assignees: &assignees,
 	}
 
-	client := NewSDKClient(token)
 	issue, _, err := client.GH.Issues.Create(ctx, owner, repo, req)
 	if err != nil {
 		return errorResult(fmt.Sprintf("create issue: %v", err)), nil
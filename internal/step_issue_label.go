@@ -3,7 +3,6 @@ package internal
 import (
 	"context"
 	"fmt"
-	"os"
 
 	sdk "github.com/GoCodeAlone/workflow/plugin/external/sdk"
 )
@@ -25,12 +24,12 @@ type issueLabelStep struct {
 }
 
 type issueLabelConfig struct {
-	Owner       string   `yaml:"owner"`
-	Repo        string   `yaml:"repo"`
-	IssueNumber int      `yaml:"issue_number"`
-	Add         []string `yaml:"add"`
-	Remove      []string `yaml:"remove"`
-	Token       string   `yaml:"token"`
+	Owner       string       `yaml:"owner"`
+	Repo        string       `yaml:"repo"`
+	IssueNumber templateInt  `yaml:"issue_number"`
+	Add         templateList `yaml:"add"`
+	Remove      templateList `yaml:"remove"`
+	Auth        stepAuth
 }
 
 func newIssueLabelStep(name string, raw map[string]any) (*issueLabelStep, error) {
@@ -43,33 +42,22 @@ func newIssueLabelStep(name string, raw map[string]any) (*issueLabelStep, error)
 	if cfg.Repo == "" {
 		return nil, fmt.Errorf("step.gh_issue_label %q: config.repo is required", name)
 	}
-	switch v := raw["issue_number"].(type) {
-	case int:
-		cfg.IssueNumber = v
-	case int64:
-		cfg.IssueNumber = int(v)
-	case float64:
-		cfg.IssueNumber = int(v)
+	number, err := parseTemplateInt(raw, "issue_number", true)
+	if err != nil {
+		return nil, fmt.Errorf("step.gh_issue_label %q: %w", name, err)
 	}
-	if cfg.IssueNumber == 0 {
-		return nil, fmt.Errorf("step.gh_issue_label %q: config.issue_number is required", name)
+	cfg.IssueNumber = number
+	if cfg.Add, err = parseTemplateList(raw, "add"); err != nil {
+		return nil, fmt.Errorf("step.gh_issue_label %q: %w", name, err)
 	}
-	if add, ok := raw["add"].([]any); ok {
-		for _, l := range add {
-			if s, ok := l.(string); ok {
-				cfg.Add = append(cfg.Add, s)
-			}
-		}
+	if cfg.Remove, err = parseTemplateList(raw, "remove"); err != nil {
+		return nil, fmt.Errorf("step.gh_issue_label %q: %w", name, err)
 	}
-	if remove, ok := raw["remove"].([]any); ok {
-		for _, l := range remove {
-			if s, ok := l.(string); ok {
-				cfg.Remove = append(cfg.Remove, s)
-			}
-		}
+	auth, err := parseStepAuth(raw)
+	if err != nil {
+		return nil, fmt.Errorf("step.gh_issue_label %q: %w", name, err)
 	}
-	cfg.Token, _ = raw["token"].(string)
-	cfg.Token = os.ExpandEnv(cfg.Token)
+	cfg.Auth = auth
 	return &issueLabelStep{name: name, config: cfg}, nil
 }
 
@@ -81,19 +69,30 @@ func (s *issueLabelStep) Execute(
 	_ map[string]any,
 	_ map[string]any,
 ) (*sdk.StepResult, error) {
-	token := s.config.Token
-	if token == "" {
-		return errorResult("GITHUB_TOKEN is not configured"), nil
-	}
 	owner := resolveField(s.config.Owner, triggerData, stepOutputs, current)
 	repo := resolveField(s.config.Repo, triggerData, stepOutputs, current)
+	client, err := s.config.Auth.sdkClient(owner)
+	if err != nil {
+		return errorResult(err.Error()), nil
+	}
+	issueNumber, err := s.config.IssueNumber.resolve("issue_number", triggerData, stepOutputs, current)
+	if err != nil {
+		return errorResult(err.Error()), nil
+	}
 
-	client := NewSDKClient(token)
+	add, err := s.config.Add.resolve("add", triggerData, stepOutputs, current)
+	if err != nil {
+		return errorResult(err.Error()), nil
+	}
+	remove, err := s.config.Remove.resolve("remove", triggerData, stepOutputs, current)
+	if err != nil {
+		return errorResult(err.Error()), nil
+	}
 
 	var added, removed []string
 
-	if len(s.config.Add) > 0 {
-		labels, _, err := client.GH.Issues.AddLabelsToIssue(ctx, owner, repo, s.config.IssueNumber, s.config.Add)
+	if len(add) > 0 {
+		labels, _, err := client.GH.Issues.AddLabelsToIssue(ctx, owner, repo, int(issueNumber), add)
 		if err != nil {
 			return errorResult(fmt.Sprintf("add labels: %v", err)), nil
 		}
@@ -102,8 +101,8 @@ func (s *issueLabelStep) Execute(
 		}
 	}
 
-	for _, label := range s.config.Remove {
-		_, err := client.GH.Issues.RemoveLabelForIssue(ctx, owner, repo, s.config.IssueNumber, label)
+	for _, label := range remove {
+		_, err := client.GH.Issues.RemoveLabelForIssue(ctx, owner, repo, int(issueNumber), label)
 		if err != nil {
 			return errorResult(fmt.Sprintf("remove label %q: %v", label, err)), nil
 		}
@@ -3,7 +3,6 @@ package internal
 import (
 	"context"
 	"fmt"
-	"os"
 
 	"github.com/google/go-github/v69/github"
 
@@ -26,11 +25,11 @@ type prCommentStep struct {
 }
 
 type prCommentConfig struct {
-	Owner    string `yaml:"owner"`
-	Repo     string `yaml:"repo"`
-	PRNumber int    `yaml:"pr_number"`
-	Body     string `yaml:"body"`
-	Token    string `yaml:"token"`
+	Owner    string      `yaml:"owner"`
+	Repo     string      `yaml:"repo"`
+	PRNumber templateInt `yaml:"pr_number"`
+	Body     string      `yaml:"body"`
+	Auth     stepAuth
 }
 
 func newPRCommentStep(name string, raw map[string]any) (*prCommentStep, error) {
@@ -43,20 +42,17 @@ func newPRCommentStep(name string, raw map[string]any) (*prCommentStep, error) {
 	if cfg.Repo == "" {
 		return nil, fmt.Errorf("step.gh_pr_comment %q: config.repo is required", name)
 	}
-	switch v := raw["pr_number"].(type) {
-	case int:
-		cfg.PRNumber = v
-	case int64:
-		cfg.PRNumber = int(v)
-	case float64:
-		cfg.PRNumber = int(v)
-	}
-	if cfg.PRNumber == 0 {
-		return nil, fmt.Errorf("step.gh_pr_comment %q: config.pr_number is required", name)
+	number, err := parseTemplateInt(raw, "pr_number", true)
+	if err != nil {
+		return nil, fmt.Errorf("step.gh_pr_comment %q: %w", name, err)
 	}
+	cfg.PRNumber = number
 	cfg.Body, _ = raw["body"].(string)
-	cfg.Token, _ = raw["token"].(string)
-	cfg.Token = os.ExpandEnv(cfg.Token)
+	auth, err := parseStepAuth(raw)
+	if err != nil {
+		return nil, fmt.Errorf("step.gh_pr_comment %q: %w", name, err)
+	}
+	cfg.Auth = auth
 	return &prCommentStep{name: name, config: cfg}, nil
 }
 
@@ -68,16 +64,19 @@ func (s *prCommentStep) Execute(
 	_ map[string]any,
 	_ map[string]any,
 ) (*sdk.StepResult, error) {
-	token := s.config.Token
-	if token == "" {
-		return errorResult("GITHUB_TOKEN is not configured"), nil
-	}
 	owner := resolveField(s.config.Owner, triggerData, stepOutputs, current)
 	repo := resolveField(s.config.Repo, triggerData, stepOutputs, current)
+	client, err := s.config.Auth.sdkClient(owner)
+	if err != nil {
+		return errorResult(err.Error()), nil
+	}
+	prNumber, err := s.config.PRNumber.resolve("pr_number", triggerData, stepOutputs, current)
+	if err != nil {
+		return errorResult(err.Error()), nil
+	}
 	body := resolveField(s.config.Body, triggerData, stepOutputs, current)
 
-	client := NewSDKClient(token)
-	comment, _, err := client.GH.Issues.CreateComment(ctx, owner, repo, s.config.PRNumber,
+	comment, _, err := client.GH.Issues.CreateComment(ctx, owner, repo, int(prNumber),
 		&github.IssueComment{Body: github.Ptr(body)})
 	if err != nil {
 		return errorResult(fmt.Sprintf("add PR comment: %v", err)), nil
@@ -3,7 +3,7 @@ package internal
 import (
 	"context"
 	"fmt"
-	"os"
+	"strings"
 
 	"github.com/google/go-github/v69/github"
 
@@ -11,7 +11,10 @@ import (
 )
 
 // prCreateStep implements sdk.StepInstance.
-// It creates a pull request in a GitHub repository.
+// It creates a pull request in a GitHub repository. With upsert, an open PR
+// from head to base is updated instead, so reruns of a bot pipeline do not
+// fail because the PR already exists. Reviewers, labels, assignees, and the
+// milestone are added to the new or existing PR.
 //
 // Config:
 //
@@ -21,7 +24,14 @@ import (
 //	body:  "Description"
 //	head:  "feature/my-branch"   # source branch
 //	base:  "main"                 # target branch
-//	draft: false
+//	draft: false                  # with upsert, also converts an existing PR
+//	upsert: true
+//	create_branch: true           # create head from base if missing
+//	reviewers: ["alice"]
+//	team_reviewers: ["platform"]
+//	labels: ["dependencies"]
+//	assignees: ["bob"]
+//	milestone: 4
 //	token: "${GITHUB_TOKEN}"
 type prCreateStep struct {
 	name   string
@@ -29,14 +39,22 @@ type prCreateStep struct {
 }
 
 type prCreateConfig struct {
-	Owner string `yaml:"owner"`
-	Repo  string `yaml:"repo"`
-	Title string `yaml:"title"`
-	Body  string `yaml:"body"`
-	Head  string `yaml:"head"`
-	Base  string `yaml:"base"`
-	Draft bool   `yaml:"draft"`
-	Token string `yaml:"token"`
+	Owner         string       `yaml:"owner"`
+	Repo          string       `yaml:"repo"`
+	Title         string       `yaml:"title"`
+	Body          string       `yaml:"body"`
+	Head          string       `yaml:"head"`
+	Base          string       `yaml:"base"`
+	Draft         templateBool `yaml:"draft"`
+	DraftSet      bool
+	Upsert        templateBool `yaml:"upsert"`
+	CreateBranch  templateBool `yaml:"create_branch"`
+	Reviewers     templateList `yaml:"reviewers"`
+	TeamReviewers templateList `yaml:"team_reviewers"`
+	Labels        templateList `yaml:"labels"`
+	Assignees     templateList `yaml:"assignees"`
+	Milestone     templateInt  `yaml:"milestone"`
+	Auth          stepAuth
 }
 
 func newPRCreateStep(name string, raw map[string]any) (*prCreateStep, error) {
@@ -59,9 +77,40 @@ func newPRCreateStep(name string, raw map[string]any) (*prCreateStep, error) {
 	if cfg.Base == "" {
 		cfg.Base = "main"
 	}
-	cfg.Draft, _ = raw["draft"].(bool)
-	cfg.Token, _ = raw["token"].(string)
-	cfg.Token = os.ExpandEnv(cfg.Token)
+	var err error
+	if cfg.Draft, err = parseTemplateBool(raw, "draft"); err != nil {
+		return nil, fmt.Errorf("step.gh_pr_create %q: %w", name, err)
+	}
+	_, cfg.DraftSet = raw["draft"]
+	if cfg.Upsert, err = parseTemplateBool(raw, "upsert"); err != nil {
+		return nil, fmt.Errorf("step.gh_pr_create %q: %w", name, err)
+	}
+	if cfg.CreateBranch, err = parseTemplateBool(raw, "create_branch"); err != nil {
+		return nil, fmt.Errorf("step.gh_pr_create %q: %w", name, err)
+	}
+	if cfg.Reviewers, err = parseTemplateList(raw, "reviewers"); err != nil {
+		return nil, fmt.Errorf("step.gh_pr_create %q: %w", name, err)
+	}
+	if cfg.TeamReviewers, err = parseTemplateList(raw, "team_reviewers"); err != nil {
+		return nil, fmt.Errorf("step.gh_pr_create %q: %w", name, err)
+	}
+	if cfg.Labels, err = parseTemplateList(raw, "labels"); err != nil {
+		return nil, fmt.Errorf("step.gh_pr_create %q: %w", name, err)
+	}
+	if cfg.Assignees, err = parseTemplateList(raw, "assignees"); err != nil {
+		return nil, fmt.Errorf("step.gh_pr_create %q: %w", name, err)
+	}
+	if cfg.Milestone, err = parseTemplateInt(raw, "milestone", false); err != nil {
+		return nil, fmt.Errorf("step.gh_pr_create %q: %w", name, err)
+	}
+	if cfg.Milestone.Value < 0 {
+		return nil, fmt.Errorf("step.gh_pr_create %q: config.milestone must not be negative", name)
+	}
+	auth, err := parseStepAuth(raw)
+	if err != nil {
+		return nil, fmt.Errorf("step.gh_pr_create %q: %w", name, err)
+	}
+	cfg.Auth = auth
 	return &prCreateStep{name: name, config: cfg}, nil
 }
 
@@ -73,35 +122,228 @@ func (s *prCreateStep) Execute(
 	_ map[string]any,
 	_ map[string]any,
 ) (*sdk.StepResult, error) {
-	token := s.config.Token
-	if token == "" {
-		return errorResult("GITHUB_TOKEN is not configured"), nil
-	}
 	owner := resolveField(s.config.Owner, triggerData, stepOutputs, current)
 	repo := resolveField(s.config.Repo, triggerData, stepOutputs, current)
+	client, err := s.config.Auth.sdkClient(owner)
+	if err != nil {
+		return errorResult(err.Error()), nil
+	}
 	title := resolveField(s.config.Title, triggerData, stepOutputs, current)
 	body := resolveField(s.config.Body, triggerData, stepOutputs, current)
 	head := resolveField(s.config.Head, triggerData, stepOutputs, current)
 	base := resolveField(s.config.Base, triggerData, stepOutputs, current)
+	draft, err := s.config.Draft.resolve("draft", triggerData, stepOutputs, current)
+	if err != nil {
+		return errorResult(err.Error()), nil
+	}
+	upsert, err := s.config.Upsert.resolve("upsert", triggerData, stepOutputs, current)
+	if err != nil {
+		return errorResult(err.Error()), nil
+	}
+	createBranch, err := s.config.CreateBranch.resolve("create_branch", triggerData, stepOutputs, current)
+	if err != nil {
+		return errorResult(err.Error()), nil
+	}
+
+	output := map[string]any{"created": false, "updated": false, "branch_created": false}
+	if createBranch {
+		created, err := ensureBranch(ctx, client.GH, owner, repo, head, base)
+		if err != nil {
+			return errorResult(err.Error()), nil
+		}
+		output["branch_created"] = created
+	}
+
+	var pr *github.PullRequest
+	if upsert {
+		if pr, err = findOpenPR(ctx, client.GH, owner, repo, head, base); err != nil {
+			return errorResult(err.Error()), nil
+		}
+	}
+	if pr == nil {
+		pr, _, err = client.GH.PullRequests.Create(ctx, owner, repo, &github.NewPullRequest{
+			Title: github.Ptr(title),
+			Body:  github.Ptr(body),
+			Head:  github.Ptr(head),
+			Base:  github.Ptr(base),
+			Draft: github.Ptr(draft),
+		})
+		if err != nil {
+			return errorResult(fmt.Sprintf("create PR: %v", err)), nil
+		}
+		output["created"] = true
+	} else {
+		updated, err := s.update(ctx, client.GH, owner, repo, pr, title, body, draft)
+		if err != nil {
+			return errorResult(err.Error()), nil
+		}
+		output["updated"] = updated
+	}
+
+	if err := s.decorate(ctx, client.GH, owner, repo, pr.GetNumber(), triggerData, stepOutputs, current); err != nil {
+		return errorResult(err.Error()), nil
+	}
+
+	output["number"] = pr.GetNumber()
+	output["url"] = pr.GetHTMLURL()
+	output["id"] = pr.GetID()
+	output["state"] = pr.GetState()
+	output["draft"] = pr.GetDraft()
+	output["head_sha"] = pr.GetHead().GetSHA()
+	return &sdk.StepResult{Output: output}, nil
+}
+
+// ensureBranch creates branch head in owner/repo at the tip of base unless it
+// already exists. It reports whether the branch was created.
+func ensureBranch(ctx context.Context, gh *github.Client, owner, repo, head, base string) (bool, error) {
+	if strings.Contains(head, ":") {
+		return false, fmt.Errorf("create_branch requires head to be a branch of %s/%s, got %q", owner, repo, head)
+	}
+	_, _, err := gh.Git.GetRef(ctx, owner, repo, "heads/"+head)
+	switch {
+	case err == nil:
+		return false, nil
+	case !isGitHubNotFound(err):
+		return false, fmt.Errorf("get branch %s: %w", head, err)
+	}
+	baseRef, _, err := gh.Git.GetRef(ctx, owner, repo, "heads/"+base)
+	if err != nil {
+		return false, fmt.Errorf("get branch %s: %w", base, err)
+	}
+	_, _, err = gh.Git.CreateRef(ctx, owner, repo, &github.Reference{
+		Ref:    github.Ptr("refs/heads/" + head),
+		Object: &github.GitObject{SHA: baseRef.GetObject().SHA},
+	})
+	if err != nil {
+		return false, fmt.Errorf("create branch %s: %w", head, err)
+	}
+	return true, nil
+}
 
-	client := NewSDKClient(token)
-	pr, _, err := client.GH.PullRequests.Create(ctx, owner, repo, &github.NewPullRequest{
-		Title: github.Ptr(title),
-		Body:  github.Ptr(body),
-		Head:  github.Ptr(head),
-		Base:  github.Ptr(base),
-		Draft: github.Ptr(s.config.Draft),
+// findOpenPR returns the open PR from head to base, or nil if there is none.
+// A head without an "owner:" prefix is a branch of owner/repo.
+func findOpenPR(ctx context.Context, gh *github.Client, owner, repo, head, base string) (*github.PullRequest, error) {
+	if !strings.Contains(head, ":") {
+		head = owner + ":" + head
+	}
+	prs, _, err := gh.PullRequests.List(ctx, owner, repo, &github.PullRequestListOptions{
+		State: "open",
+		Head:  head,
+		Base:  base,
 	})
 	if err != nil {
-		return errorResult(fmt.Sprintf("create PR: %v", err)), nil
+		return nil, fmt.Errorf("list PRs for %s: %w", head, err)
+	}
+	if len(prs) == 0 {
+		return nil, nil
+	}
+	return prs[0], nil
+}
+
+// update brings an existing PR's title, body, and, when draft is configured,
+// its draft state in line with the config. It reports whether anything
+// changed and updates pr in place.
+func (s *prCreateStep) update(ctx context.Context, gh *github.Client, owner, repo string, pr *github.PullRequest, title, body string, draft bool) (bool, error) {
+	edit := &github.PullRequest{}
+	changed := false
+	if title != "" && title != pr.GetTitle() {
+		edit.Title = github.Ptr(title)
+		changed = true
+	}
+	if body != "" && body != pr.GetBody() {
+		edit.Body = github.Ptr(body)
+		changed = true
+	}
+	if changed {
+		edited, _, err := gh.PullRequests.Edit(ctx, owner, repo, pr.GetNumber(), edit)
+		if err != nil {
+			return false, fmt.Errorf("update PR #%d: %w", pr.GetNumber(), err)
+		}
+		*pr = *edited
+	}
+
+	if !s.config.DraftSet || draft == pr.GetDraft() {
+		return changed, nil
+	}
+	// The REST API cannot change the draft state of an existing PR.
+	token, err := s.config.Auth.token(ctx, owner)
+	if err != nil {
+		return false, err
 	}
+	mutation := `mutation($id: ID!) {
+  markPullRequestReadyForReview(input: {pullRequestId: $id}) { pullRequest { isDraft } }
+}`
+	if draft {
+		mutation = `mutation($id: ID!) {
+  convertPullRequestToDraft(input: {pullRequestId: $id}) { pullRequest { isDraft } }
+}`
+	}
+	if _, _, err := postGraphQL(ctx, s.config.Auth, token, mutation, map[string]any{"id": pr.GetNodeID()}); err != nil {
+		return false, fmt.Errorf("set draft state of PR #%d: %w", pr.GetNumber(), err)
+	}
+	pr.Draft = github.Ptr(draft)
+	return true, nil
+}
 
-	return &sdk.StepResult{
-		Output: map[string]any{
-			"number": pr.GetNumber(),
-			"url":    pr.GetHTMLURL(),
-			"id":     pr.GetID(),
-			"state":  pr.GetState(),
-		},
-	}, nil
+// decorate adds the configured reviewers, labels, assignees, and milestone to
+// the PR. Reviewers, labels, and assignees are only ever added, so rerunning
+// the step is harmless.
+func (s *prCreateStep) decorate(
+	ctx context.Context,
+	gh *github.Client,
+	owner, repo string,
+	number int,
+	triggerData map[string]any,
+	stepOutputs map[string]map[string]any,
+	current map[string]any,
+) error {
+	reviewers, err := s.config.Reviewers.resolve("reviewers", triggerData, stepOutputs, current)
+	if err != nil {
+		return err
+	}
+	teamReviewers, err := s.config.TeamReviewers.resolve("team_reviewers", triggerData, stepOutputs, current)
+	if err != nil {
+		return err
+	}
+	labels, err := s.config.Labels.resolve("labels", triggerData, stepOutputs, current)
+	if err != nil {
+		return err
+	}
+	assignees, err := s.config.Assignees.resolve("assignees", triggerData, stepOutputs, current)
+	if err != nil {
+		return err
+	}
+	milestone := int64(0)
+	if s.config.Milestone.Value != 0 || s.config.Milestone.Ref != "" {
+		if milestone, err = s.config.Milestone.resolve("milestone", triggerData, stepOutputs, current); err != nil {
+			return err
+		}
+	}
+
+	if len(reviewers) > 0 || len(teamReviewers) > 0 {
+		_, _, err := gh.PullRequests.RequestReviewers(ctx, owner, repo, number, github.ReviewersRequest{
+			Reviewers:     reviewers,
+			TeamReviewers: teamReviewers,
+		})
+		if err != nil {
+			return fmt.Errorf("request reviewers for PR #%d: %w", number, err)
+		}
+	}
+	if len(labels) > 0 {
+		if _, _, err := gh.Issues.AddLabelsToIssue(ctx, owner, repo, number, labels); err != nil {
+			return fmt.Errorf("add labels to PR #%d: %w", number, err)
+		}
+	}
+	if len(assignees) > 0 {
+		if _, _, err := gh.Issues.AddAssignees(ctx, owner, repo, number, assignees); err != nil {
+			return fmt.Errorf("add assignees to PR #%d: %w", number, err)
+		}
+	}
+	if milestone > 0 {
+		_, _, err := gh.Issues.Edit(ctx, owner, repo, number, &github.IssueRequest{Milestone: github.Ptr(int(milestone))})
+		if err != nil {
+			return fmt.Errorf("set milestone of PR #%d: %w", number, err)
+		}
+	}
+	return nil
 }
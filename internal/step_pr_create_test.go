@@ -0,0 +1,194 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// fakePRCreateAPI serves the endpoints step.gh_pr_create uses for o/r. It
+// holds at most one PR, number 5, and records every write.
+type fakePRCreateAPI struct {
+	mu       sync.Mutex
+	branches map[string]string
+	pr       map[string]any
+	writes   []string
+	bodies   map[string]any
+}
+
+func newFakePRCreateAPI(t *testing.T) (*fakePRCreateAPI, *httptest.Server) {
+	t.Helper()
+	api := &fakePRCreateAPI{branches: map[string]string{"main": "base1"}, bodies: map[string]any{}}
+	srv := httptest.NewServer(api)
+	t.Cleanup(srv.Close)
+	return api, srv
+}
+
+func (a *fakePRCreateAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	var body map[string]any
+	if r.Method != http.MethodGet {
+		var raw any
+		_ = json.NewDecoder(r.Body).Decode(&raw)
+		body, _ = raw.(map[string]any)
+		key := r.Method + " " + r.URL.Path
+		a.writes = append(a.writes, key)
+		a.bodies[key] = raw
+	}
+	switch path := r.URL.Path; {
+	case r.Method == http.MethodGet && strings.HasPrefix(path, "/repos/o/r/git/ref/heads/"):
+		sha, ok := a.branches[strings.TrimPrefix(path, "/repos/o/r/git/ref/heads/")]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"ref": "refs/heads/x", "object": map[string]any{"sha": sha}})
+	case r.Method == http.MethodPost && path == "/repos/o/r/git/refs":
+		object, _ := body["sha"].(string)
+		a.branches[strings.TrimPrefix(body["ref"].(string), "refs/heads/")] = object
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(body)
+	case r.Method == http.MethodGet && path == "/repos/o/r/pulls":
+		q := r.URL.Query()
+		if a.pr == nil || q.Get("state") != "open" || q.Get("head") != "o:feature" || q.Get("base") != "main" {
+			_ = json.NewEncoder(w).Encode([]any{})
+			return
+		}
+		_ = json.NewEncoder(w).Encode([]any{a.pr})
+	case r.Method == http.MethodPost && path == "/repos/o/r/pulls":
+		a.pr = map[string]any{
+			"number": 5, "id": 500, "node_id": "PR_node5", "state": "open",
+			"title": body["title"], "body": body["body"], "draft": body["draft"],
+			"head": map[string]any{"sha": a.branches["feature"], "ref": "feature"},
+		}
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(a.pr)
+	case r.Method == http.MethodPatch && path == "/repos/o/r/pulls/5":
+		for k, v := range body {
+			a.pr[k] = v
+		}
+		_ = json.NewEncoder(w).Encode(a.pr)
+	case r.Method == http.MethodPost && path == "/graphql":
+		a.pr["draft"] = strings.Contains(body["query"].(string), "convertPullRequestToDraft")
+		_ = json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{}})
+	case r.Method == http.MethodPost && path == "/repos/o/r/pulls/5/requested_reviewers",
+		r.Method == http.MethodPost && path == "/repos/o/r/issues/5/assignees",
+		r.Method == http.MethodPatch && path == "/repos/o/r/issues/5":
+		_ = json.NewEncoder(w).Encode(map[string]any{"number": 5})
+	case r.Method == http.MethodPost && path == "/repos/o/r/issues/5/labels":
+		_ = json.NewEncoder(w).Encode([]any{})
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+// reset forgets the recorded writes.
+func (a *fakePRCreateAPI) reset() {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.writes = nil
+	a.bodies = map[string]any{}
+}
+
+func runPRCreate(t *testing.T, srv *httptest.Server, config map[string]any) map[string]any {
+	t.Helper()
+	config["owner"] = "o"
+	config["repo"] = "r"
+	config["head"] = "feature"
+	config["token"] = "tok"
+	config["api_base_url"] = srv.URL
+	step, err := newPRCreateStep("open_pr", config)
+	if err != nil {
+		t.Fatalf("newPRCreateStep: %v", err)
+	}
+	result, err := step.Execute(context.Background(), nil, nil, nil, nil, nil)
+	if err != nil {
+		t.Fatalf("Execute: %v", err)
+	}
+	if result.Output["error"] != nil {
+		t.Fatalf("unexpected error output %v", result.Output)
+	}
+	return result.Output
+}
+
+func TestPRCreateStep_Upsert(t *testing.T) {
+	api, srv := newFakePRCreateAPI(t)
+	config := func(title string, draft bool) map[string]any {
+		return map[string]any{
+			"title":          title,
+			"body":           "Bumps deps.",
+			"draft":          draft,
+			"upsert":         true,
+			"create_branch":  true,
+			"reviewers":      []any{"alice"},
+			"team_reviewers": []any{"platform"},
+			"labels":         []any{"dependencies"},
+			"assignees":      []any{"bob"},
+			"milestone":      4,
+		}
+	}
+
+	out := runPRCreate(t, srv, config("Bump deps", true))
+	if out["created"] != true || out["branch_created"] != true || out["number"] != 5 || out["draft"] != true || out["head_sha"] != "base1" {
+		t.Fatalf("unexpected output %v", out)
+	}
+	want := []string{
+		"POST /repos/o/r/git/refs",
+		"POST /repos/o/r/pulls",
+		"POST /repos/o/r/pulls/5/requested_reviewers",
+		"POST /repos/o/r/issues/5/labels",
+		"POST /repos/o/r/issues/5/assignees",
+		"PATCH /repos/o/r/issues/5",
+	}
+	if strings.Join(api.writes, "\n") != strings.Join(want, "\n") {
+		t.Errorf("writes = %v, want %v", api.writes, want)
+	}
+	if reviewers, _ := api.bodies["POST /repos/o/r/pulls/5/requested_reviewers"].(map[string]any); reviewers["team_reviewers"] == nil {
+		t.Errorf("expected team reviewers to be requested, got %v", reviewers)
+	}
+	if milestone, _ := api.bodies["PATCH /repos/o/r/issues/5"].(map[string]any); milestone["milestone"] != float64(4) {
+		t.Errorf("expected milestone 4, got %v", milestone)
+	}
+
+	// A rerun with the same config finds the PR and changes nothing.
+	api.reset()
+	out = runPRCreate(t, srv, config("Bump deps", true))
+	if out["created"] != false || out["updated"] != false || out["branch_created"] != false || out["number"] != 5 {
+		t.Fatalf("unexpected output %v", out)
+	}
+	for _, write := range api.writes {
+		if write == "POST /repos/o/r/pulls" || write == "PATCH /repos/o/r/pulls/5" || write == "POST /graphql" {
+			t.Errorf("unexpected write %s on an unchanged rerun", write)
+		}
+	}
+
+	// A new title updates the PR, and draft: false marks it ready.
+	api.reset()
+	out = runPRCreate(t, srv, config("Bump deps (2 packages)", false))
+	if out["updated"] != true || out["draft"] != false {
+		t.Fatalf("unexpected output %v", out)
+	}
+	if api.pr["title"] != "Bump deps (2 packages)" || api.pr["draft"] != false {
+		t.Errorf("PR not updated: %v", api.pr)
+	}
+	if patch, _ := api.bodies["PATCH /repos/o/r/pulls/5"].(map[string]any); patch["body"] != nil {
+		t.Errorf("expected only the title to be sent, got %v", patch)
+	}
+}
+
+func TestPRCreateStep_WithoutUpsertCreates(t *testing.T) {
+	api, srv := newFakePRCreateAPI(t)
+	api.branches["feature"] = "head1"
+	out := runPRCreate(t, srv, map[string]any{"title": "Add feature"})
+	if out["created"] != true || out["branch_created"] != false || out["head_sha"] != "head1" {
+		t.Fatalf("unexpected output %v", out)
+	}
+	if strings.Join(api.writes, ",") != "POST /repos/o/r/pulls" {
+		t.Errorf("expected only the create, got %v", api.writes)
+	}
+}
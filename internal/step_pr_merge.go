@@ -2,16 +2,33 @@ package internal
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"os"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/google/go-github/v69/github"
 
 	sdk "github.com/GoCodeAlone/workflow/plugin/external/sdk"
 )
 
+// PR merge modes.
+const (
+	prMergeModeMerge = "merge" // merge now through the REST API
+	prMergeModeAuto  = "auto"  // enable GitHub auto-merge
+	prMergeModeQueue = "queue" // add the PR to the base branch's merge queue
+)
+
 // prMergeStep implements sdk.StepInstance.
-// It merges a pull request in a GitHub repository.
+// It merges a pull request in a GitHub repository, enables auto-merge for it,
+// or adds it to the merge queue. With wait, the step first polls until the
+// PR is ready: its checks (all, or required_checks plus the base branch's
+// required status checks) succeeded, GitHub reports it mergeable rather than
+// blocked or behind, it has required_approvals approvals and no outstanding
+// change requests, and it is not a draft. A failed check or a merge conflict
+// fails the step at once.
 //
 // Config:
 //
@@ -20,6 +37,14 @@ import (
 //	pr_number:    123
 //	commit_title: "Merge PR"
 //	method:       "merge"   # merge, squash, rebase
+//	mode:         "merge"   # merge (default), auto, queue
+//	expected_head_sha: "{{.steps.build.sha}}"  # refuse if the head moved
+//	wait:         true      # poll until checks and reviews pass
+//	required_checks: ["ci"] # default: every check on the head commit
+//	required_approvals: 1
+//	poll_interval: "15s"
+//	timeout:      "30m"
+//	delete_branch: true     # mode merge only
 //	token:        "${GITHUB_TOKEN}"
 type prMergeStep struct {
 	name   string
@@ -27,12 +52,20 @@ type prMergeStep struct {
 }
 
 type prMergeConfig struct {
-	Owner       string `yaml:"owner"`
-	Repo        string `yaml:"repo"`
-	PRNumber    int    `yaml:"pr_number"`
-	CommitTitle string `yaml:"commit_title"`
-	Method      string `yaml:"method"`
-	Token       string `yaml:"token"`
+	Owner             string        `yaml:"owner"`
+	Repo              string        `yaml:"repo"`
+	PRNumber          templateInt   `yaml:"pr_number"`
+	CommitTitle       string        `yaml:"commit_title"`
+	Method            string        `yaml:"method"`
+	Mode              string        `yaml:"mode"`
+	ExpectedHeadSHA   string        `yaml:"expected_head_sha"`
+	Wait              templateBool  `yaml:"wait"`
+	RequiredChecks    templateList  `yaml:"required_checks"`
+	RequiredApprovals templateInt   `yaml:"required_approvals"`
+	PollInterval      time.Duration `yaml:"poll_interval"`
+	Timeout           time.Duration `yaml:"timeout"`
+	DeleteBranch      templateBool  `yaml:"delete_branch"`
+	Auth              stepAuth
 }
 
 func newPRMergeStep(name string, raw map[string]any) (*prMergeStep, error) {
@@ -45,27 +78,74 @@ func newPRMergeStep(name string, raw map[string]any) (*prMergeStep, error) {
 	if cfg.Repo == "" {
 		return nil, fmt.Errorf("step.gh_pr_merge %q: config.repo is required", name)
 	}
-	switch v := raw["pr_number"].(type) {
-	case int:
-		cfg.PRNumber = v
-	case int64:
-		cfg.PRNumber = int(v)
-	case float64:
-		cfg.PRNumber = int(v)
-	}
-	if cfg.PRNumber == 0 {
-		return nil, fmt.Errorf("step.gh_pr_merge %q: config.pr_number is required", name)
+	number, err := parseTemplateInt(raw, "pr_number", true)
+	if err != nil {
+		return nil, fmt.Errorf("step.gh_pr_merge %q: %w", name, err)
 	}
+	cfg.PRNumber = number
 	cfg.CommitTitle, _ = raw["commit_title"].(string)
 	cfg.Method, _ = raw["method"].(string)
 	if cfg.Method == "" {
 		cfg.Method = "merge"
 	}
-	cfg.Token, _ = raw["token"].(string)
-	cfg.Token = os.ExpandEnv(cfg.Token)
+	cfg.Mode, _ = raw["mode"].(string)
+	switch cfg.Mode {
+	case "":
+		cfg.Mode = prMergeModeMerge
+	case prMergeModeMerge, prMergeModeAuto, prMergeModeQueue:
+	default:
+		return nil, fmt.Errorf("step.gh_pr_merge %q: config.mode must be merge, auto, or queue; got %q", name, cfg.Mode)
+	}
+	cfg.ExpectedHeadSHA, _ = raw["expected_head_sha"].(string)
+	if cfg.Wait, err = parseTemplateBool(raw, "wait"); err != nil {
+		return nil, fmt.Errorf("step.gh_pr_merge %q: %w", name, err)
+	}
+	if cfg.RequiredChecks, err = parseTemplateList(raw, "required_checks"); err != nil {
+		return nil, fmt.Errorf("step.gh_pr_merge %q: %w", name, err)
+	}
+	if cfg.RequiredApprovals, err = parseTemplateInt(raw, "required_approvals", false); err != nil {
+		return nil, fmt.Errorf("step.gh_pr_merge %q: %w", name, err)
+	}
+	if cfg.RequiredApprovals.Value < 0 {
+		return nil, fmt.Errorf("step.gh_pr_merge %q: config.required_approvals must not be negative", name)
+	}
+	if cfg.PollInterval, err = parsePRMergeDuration(raw, "poll_interval", "15s"); err != nil {
+		return nil, fmt.Errorf("step.gh_pr_merge %q: %w", name, err)
+	}
+	if cfg.Timeout, err = parsePRMergeDuration(raw, "timeout", "30m"); err != nil {
+		return nil, fmt.Errorf("step.gh_pr_merge %q: %w", name, err)
+	}
+	if cfg.DeleteBranch, err = parseTemplateBool(raw, "delete_branch"); err != nil {
+		return nil, fmt.Errorf("step.gh_pr_merge %q: %w", name, err)
+	}
+	// A template is checked once it resolves.
+	if cfg.Mode != prMergeModeMerge && cfg.DeleteBranch.Value {
+		return nil, fmt.Errorf("step.gh_pr_merge %q: config.delete_branch requires mode merge; enable the repository's automatic branch deletion for auto and queue", name)
+	}
+	auth, err := parseStepAuth(raw)
+	if err != nil {
+		return nil, fmt.Errorf("step.gh_pr_merge %q: %w", name, err)
+	}
+	cfg.Auth = auth
 	return &prMergeStep{name: name, config: cfg}, nil
 }
 
+// parsePRMergeDuration reads a positive duration, or def when key is unset.
+func parsePRMergeDuration(raw map[string]any, key, def string) (time.Duration, error) {
+	str, _ := raw[key].(string)
+	if str == "" {
+		str = def
+	}
+	d, err := time.ParseDuration(str)
+	if err != nil {
+		return 0, fmt.Errorf("config.%s is invalid: %w", key, err)
+	}
+	if d <= 0 {
+		return 0, fmt.Errorf("config.%s must be positive", key)
+	}
+	return d, nil
+}
+
 func (s *prMergeStep) Execute(
 	ctx context.Context,
 	triggerData map[string]any,
@@ -74,27 +154,454 @@ func (s *prMergeStep) Execute(
 	_ map[string]any,
 	_ map[string]any,
 ) (*sdk.StepResult, error) {
-	token := s.config.Token
-	if token == "" {
-		return errorResult("GITHUB_TOKEN is not configured"), nil
-	}
 	owner := resolveField(s.config.Owner, triggerData, stepOutputs, current)
 	repo := resolveField(s.config.Repo, triggerData, stepOutputs, current)
+	client, err := s.config.Auth.sdkClient(owner)
+	if err != nil {
+		return errorResult(err.Error()), nil
+	}
+	prNumber, err := s.config.PRNumber.resolve("pr_number", triggerData, stepOutputs, current)
+	if err != nil {
+		return errorResult(err.Error()), nil
+	}
 	commitTitle := resolveField(s.config.CommitTitle, triggerData, stepOutputs, current)
-
-	client := NewSDKClient(token)
 	method := resolveField(s.config.Method, triggerData, stepOutputs, current)
-	result, _, err := client.GH.PullRequests.Merge(ctx, owner, repo, s.config.PRNumber,
-		commitTitle, &github.PullRequestOptions{MergeMethod: method})
+	expectedSHA := resolveField(s.config.ExpectedHeadSHA, triggerData, stepOutputs, current)
+	wait, err := s.config.Wait.resolve("wait", triggerData, stepOutputs, current)
+	if err != nil {
+		return errorResult(err.Error()), nil
+	}
+	deleteBranch, err := s.config.DeleteBranch.resolve("delete_branch", triggerData, stepOutputs, current)
+	if err != nil {
+		return errorResult(err.Error()), nil
+	}
+	if deleteBranch && s.config.Mode != prMergeModeMerge {
+		return errorResult(fmt.Sprintf("delete_branch requires mode merge, not %s; enable the repository's automatic branch deletion instead", s.config.Mode)), nil
+	}
+
+	pr, err := s.pullRequest(ctx, client.GH, owner, repo, int(prNumber), expectedSHA)
+	if err != nil {
+		return errorResult(err.Error()), nil
+	}
+
+	if wait && !pr.GetMerged() {
+		requiredChecks, err := s.config.RequiredChecks.resolve("required_checks", triggerData, stepOutputs, current)
+		if err != nil {
+			return errorResult(err.Error()), nil
+		}
+		approvals := int64(0)
+		if s.config.RequiredApprovals.Value != 0 || s.config.RequiredApprovals.Ref != "" {
+			if approvals, err = s.config.RequiredApprovals.resolve("required_approvals", triggerData, stepOutputs, current); err != nil {
+				return errorResult(err.Error()), nil
+			}
+		}
+		if pr, err = s.waitReady(ctx, client.GH, owner, repo, int(prNumber), expectedSHA, requiredChecks, int(approvals)); err != nil {
+			return errorResult(err.Error()), nil
+		}
+	}
+
+	if pr.GetMerged() {
+		return &sdk.StepResult{Output: map[string]any{
+			"merged":   true,
+			"message":  "pull request already merged",
+			"sha":      pr.GetMergeCommitSHA(),
+			"head_sha": pr.GetHead().GetSHA(),
+			"mode":     s.config.Mode,
+		}}, nil
+	}
+
+	// Pin the merge to the head that was checked, so a push racing the merge
+	// is refused by GitHub.
+	headSHA := expectedSHA
+	if headSHA == "" && wait {
+		headSHA = pr.GetHead().GetSHA()
+	}
+
+	output := map[string]any{
+		"merged":   false,
+		"head_sha": pr.GetHead().GetSHA(),
+		"mode":     s.config.Mode,
+	}
+	switch s.config.Mode {
+	case prMergeModeAuto:
+		if err := s.enableAutoMerge(ctx, owner, pr, method, commitTitle, headSHA); err != nil {
+			return errorResult(err.Error()), nil
+		}
+		output["auto_merge"] = true
+		return &sdk.StepResult{Output: output}, nil
+	case prMergeModeQueue:
+		position, err := s.enqueue(ctx, owner, pr, headSHA)
+		if err != nil {
+			return errorResult(err.Error()), nil
+		}
+		output["queued"] = true
+		output["queue_position"] = position
+		return &sdk.StepResult{Output: output}, nil
+	}
+
+	result, _, err := client.GH.PullRequests.Merge(ctx, owner, repo, int(prNumber),
+		commitTitle, &github.PullRequestOptions{MergeMethod: method, SHA: headSHA})
 	if err != nil {
 		return errorResult(fmt.Sprintf("merge PR: %v", err)), nil
 	}
+	output["merged"] = result.GetMerged()
+	output["message"] = result.GetMessage()
+	output["sha"] = result.GetSHA()
+
+	if deleteBranch && result.GetMerged() {
+		deleted, err := deleteHeadBranch(ctx, client.GH, owner, repo, pr)
+		if err != nil {
+			return errorResult(err.Error()), nil
+		}
+		output["branch_deleted"] = deleted
+	}
+	return &sdk.StepResult{Output: output}, nil
+}
+
+// pullRequest fetches the PR and checks it is open or merged and, when
+// expectedSHA is set, that its head is expectedSHA.
+func (s *prMergeStep) pullRequest(ctx context.Context, gh *github.Client, owner, repo string, number int, expectedSHA string) (*github.PullRequest, error) {
+	pr, _, err := gh.PullRequests.Get(ctx, owner, repo, number)
+	if err != nil {
+		return nil, fmt.Errorf("get PR #%d: %w", number, err)
+	}
+	if pr.GetMerged() {
+		return pr, nil
+	}
+	if pr.GetState() != "open" {
+		return nil, fmt.Errorf("PR #%d is %s", number, pr.GetState())
+	}
+	if expectedSHA != "" && !strings.EqualFold(pr.GetHead().GetSHA(), expectedSHA) {
+		return nil, fmt.Errorf("PR #%d head is %s, expected %s", number, pr.GetHead().GetSHA(), expectedSHA)
+	}
+	return pr, nil
+}
 
-	return &sdk.StepResult{
-		Output: map[string]any{
-			"merged":  result.GetMerged(),
-			"message": result.GetMessage(),
-			"sha":     result.GetSHA(),
-		},
-	}, nil
+// waitReady polls the PR until it is ready to merge or the timeout elapses.
+func (s *prMergeStep) waitReady(
+	ctx context.Context,
+	gh *github.Client,
+	owner, repo string,
+	number int,
+	expectedSHA string,
+	requiredChecks []string,
+	requiredApprovals int,
+) (*github.PullRequest, error) {
+	deadline := time.Now().Add(s.config.Timeout)
+	ctx, cancel := context.WithDeadline(ctx, deadline)
+	defer cancel()
+	branchChecks := map[string][]string{}
+	for {
+		pr, err := s.pullRequest(ctx, gh, owner, repo, number, expectedSHA)
+		if err != nil {
+			if time.Until(deadline) <= 0 {
+				return nil, fmt.Errorf("timeout waiting for PR #%d to be ready after %s", number, s.config.Timeout)
+			}
+			return nil, err
+		}
+		if pr.GetMerged() {
+			return pr, nil
+		}
+		// The base branch can change while the PR is open.
+		base := pr.GetBase().GetRef()
+		protected, ok := branchChecks[base]
+		if !ok {
+			if protected, err = branchRequiredChecks(ctx, gh, owner, repo, base); err != nil {
+				return nil, err
+			}
+			branchChecks[base] = protected
+		}
+		pending, err := prMergeBlockers(ctx, gh, owner, repo, pr, mergeCheckNames(requiredChecks, protected), requiredApprovals)
+		if err != nil {
+			if time.Until(deadline) <= 0 {
+				return nil, fmt.Errorf("timeout waiting for PR #%d to be ready after %s", number, s.config.Timeout)
+			}
+			return nil, err
+		}
+		if len(pending) == 0 {
+			return pr, nil
+		}
+
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			return nil, fmt.Errorf("timeout waiting for PR #%d to be ready after %s: %s", number, s.config.Timeout, strings.Join(pending, "; "))
+		}
+		timer := time.NewTimer(min(s.config.PollInterval, remaining))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			if time.Until(deadline) <= 0 {
+				return nil, fmt.Errorf("timeout waiting for PR #%d to be ready after %s: %s", number, s.config.Timeout, strings.Join(pending, "; "))
+			}
+			return nil, errors.New("context cancelled while waiting for PR")
+		case <-timer.C:
+		}
+	}
+}
+
+// branchRequiredChecks returns the status checks branch protection requires
+// on branch. An unprotected branch, or one whose protection the token cannot
+// read, requires none; GitHub then still reports the PR blocked until its
+// required checks pass.
+func branchRequiredChecks(ctx context.Context, gh *github.Client, owner, repo, branch string) ([]string, error) {
+	if branch == "" {
+		return nil, nil
+	}
+	required, _, err := gh.Repositories.GetRequiredStatusChecks(ctx, owner, repo, branch)
+	if err != nil {
+		var respErr *github.ErrorResponse
+		if errors.As(err, &respErr) && respErr.Response != nil &&
+			(respErr.Response.StatusCode == http.StatusNotFound || respErr.Response.StatusCode == http.StatusForbidden) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("get required status checks of %s: %w", branch, err)
+	}
+	var names []string
+	if required.Checks != nil {
+		for _, check := range *required.Checks {
+			names = append(names, check.Context)
+		}
+	}
+	if required.Contexts != nil {
+		names = append(names, *required.Contexts...)
+	}
+	return names, nil
+}
+
+// mergeCheckNames returns the sorted union of the configured and the branch
+// protection's required checks.
+func mergeCheckNames(lists ...[]string) []string {
+	seen := make(map[string]bool)
+	var names []string
+	for _, list := range lists {
+		for _, name := range list {
+			if name != "" && !seen[name] {
+				seen[name] = true
+				names = append(names, name)
+			}
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// prMergeBlockers returns what the PR is still waiting for. It returns an
+// error when the PR cannot become ready without new commits: a check failed
+// or the PR has merge conflicts. Without required checks it waits for every
+// check on the head commit, and for at least one to report.
+func prMergeBlockers(
+	ctx context.Context,
+	gh *github.Client,
+	owner, repo string,
+	pr *github.PullRequest,
+	requiredChecks []string,
+	requiredApprovals int,
+) ([]string, error) {
+	number := pr.GetNumber()
+	var pending []string
+	switch state := pr.GetMergeableState(); state {
+	case "dirty":
+		return nil, fmt.Errorf("PR #%d has merge conflicts", number)
+	case "blocked":
+		pending = append(pending, "PR is blocked by branch protection")
+	case "behind":
+		pending = append(pending, "PR branch is behind its base")
+	case "", "unknown":
+		pending = append(pending, "GitHub is computing whether the PR is mergeable")
+	}
+	if pr.GetDraft() {
+		pending = append(pending, "PR is a draft")
+	}
+
+	checks, err := commitCheckStates(ctx, gh, owner, repo, pr.GetHead().GetSHA())
+	if err != nil {
+		return nil, err
+	}
+	names := requiredChecks
+	if len(names) == 0 {
+		for checkName := range checks {
+			names = append(names, checkName)
+		}
+		sort.Strings(names)
+		if len(names) == 0 {
+			pending = append(pending, "no checks have reported")
+		}
+	}
+	for _, checkName := range names {
+		switch state, ok := checks[checkName]; {
+		case !ok:
+			pending = append(pending, fmt.Sprintf("check %s has not reported", checkName))
+		case state == "failure":
+			return nil, fmt.Errorf("PR #%d check %s failed", number, checkName)
+		case state == "pending":
+			pending = append(pending, fmt.Sprintf("check %s is pending", checkName))
+		}
+	}
+
+	approved, changesRequested, err := reviewStates(ctx, gh, owner, repo, number)
+	if err != nil {
+		return nil, err
+	}
+	if len(changesRequested) > 0 {
+		pending = append(pending, "changes requested by "+strings.Join(changesRequested, ", "))
+	}
+	if approved < requiredApprovals {
+		pending = append(pending, fmt.Sprintf("%d of %d approvals", approved, requiredApprovals))
+	}
+	return pending, nil
+}
+
+// commitCheckStates returns the state of every check run and commit status
+// on sha by name: success, pending, or failure. Neutral and skipped check
+// runs count as success.
+func commitCheckStates(ctx context.Context, gh *github.Client, owner, repo, sha string) (map[string]string, error) {
+	states := make(map[string]string)
+	opts := &github.ListCheckRunsOptions{ListOptions: github.ListOptions{PerPage: 100}}
+	for page := 0; page < maxGitHubPaginationPages; page++ {
+		runs, resp, err := gh.Checks.ListCheckRunsForRef(ctx, owner, repo, sha, opts)
+		if err != nil {
+			return nil, fmt.Errorf("list check runs for %s: %w", sha, err)
+		}
+		for _, run := range runs.CheckRuns {
+			state := "pending"
+			if run.GetStatus() == "completed" {
+				switch run.GetConclusion() {
+				case "success", "neutral", "skipped":
+					state = "success"
+				default:
+					state = "failure"
+				}
+			}
+			states[run.GetName()] = state
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	statusOpts := &github.ListOptions{PerPage: 100}
+	for page := 0; page < maxGitHubPaginationPages; page++ {
+		combined, resp, err := gh.Repositories.GetCombinedStatus(ctx, owner, repo, sha, statusOpts)
+		if err != nil {
+			return nil, fmt.Errorf("get commit status for %s: %w", sha, err)
+		}
+		for _, status := range combined.Statuses {
+			state := status.GetState()
+			if state == "error" {
+				state = "failure"
+			}
+			states[status.GetContext()] = state
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		statusOpts.Page = resp.NextPage
+	}
+	return states, nil
+}
+
+// reviewStates counts the reviewers whose latest review approves the PR and
+// lists those whose latest review requests changes.
+func reviewStates(ctx context.Context, gh *github.Client, owner, repo string, number int) (int, []string, error) {
+	latest := make(map[string]string)
+	opts := &github.ListOptions{PerPage: 100}
+	for page := 0; page < maxGitHubPaginationPages; page++ {
+		reviews, resp, err := gh.PullRequests.ListReviews(ctx, owner, repo, number, opts)
+		if err != nil {
+			return 0, nil, fmt.Errorf("list reviews of PR #%d: %w", number, err)
+		}
+		// Reviews are listed oldest first; comments do not change a verdict.
+		for _, review := range reviews {
+			switch state := review.GetState(); state {
+			case "APPROVED", "CHANGES_REQUESTED", "DISMISSED":
+				latest[review.GetUser().GetLogin()] = state
+			}
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+	approved := 0
+	var changesRequested []string
+	for login, state := range latest {
+		switch state {
+		case "APPROVED":
+			approved++
+		case "CHANGES_REQUESTED":
+			changesRequested = append(changesRequested, login)
+		}
+	}
+	sort.Strings(changesRequested)
+	return approved, changesRequested, nil
+}
+
+// enableAutoMerge turns on GitHub auto-merge for the PR.
+func (s *prMergeStep) enableAutoMerge(ctx context.Context, owner string, pr *github.PullRequest, method, commitTitle, headSHA string) error {
+	token, err := s.config.Auth.token(ctx, owner)
+	if err != nil {
+		return err
+	}
+	variables := map[string]any{
+		"id":     pr.GetNodeID(),
+		"method": strings.ToUpper(method),
+	}
+	if commitTitle != "" {
+		variables["headline"] = commitTitle
+	}
+	if headSHA != "" {
+		variables["sha"] = headSHA
+	}
+	const mutation = `mutation($id: ID!, $method: PullRequestMergeMethod, $headline: String, $sha: GitObjectID) {
+  enablePullRequestAutoMerge(input: {pullRequestId: $id, mergeMethod: $method, commitHeadline: $headline, expectedHeadOid: $sha}) {
+    pullRequest { number }
+  }
+}`
+	if _, _, err := postGraphQL(ctx, s.config.Auth, token, mutation, variables); err != nil {
+		return fmt.Errorf("enable auto-merge for PR #%d: %w", pr.GetNumber(), err)
+	}
+	return nil
+}
+
+// enqueue adds the PR to its base branch's merge queue and returns its
+// position.
+func (s *prMergeStep) enqueue(ctx context.Context, owner string, pr *github.PullRequest, headSHA string) (int64, error) {
+	token, err := s.config.Auth.token(ctx, owner)
+	if err != nil {
+		return 0, err
+	}
+	variables := map[string]any{"id": pr.GetNodeID()}
+	if headSHA != "" {
+		variables["sha"] = headSHA
+	}
+	const mutation = `mutation($id: ID!, $sha: GitObjectID) {
+  enqueuePullRequest(input: {pullRequestId: $id, expectedHeadOid: $sha}) {
+    mergeQueueEntry { position }
+  }
+}`
+	data, _, err := postGraphQL(ctx, s.config.Auth, token, mutation, variables)
+	if err != nil {
+		return 0, fmt.Errorf("enqueue PR #%d: %w", pr.GetNumber(), err)
+	}
+	entry, _ := lookupPath(data, []string{"enqueuePullRequest", "mergeQueueEntry", "position"})
+	position, _ := toInt64(entry)
+	return position, nil
+}
+
+// deleteHeadBranch deletes the PR's head branch when it lives in the base
+// repository. Branches of forks are left alone.
+func deleteHeadBranch(ctx context.Context, gh *github.Client, owner, repo string, pr *github.PullRequest) (bool, error) {
+	head := pr.GetHead()
+	if !strings.EqualFold(head.GetRepo().GetFullName(), owner+"/"+repo) {
+		return false, nil
+	}
+	_, err := gh.Git.DeleteRef(ctx, owner, repo, "heads/"+head.GetRef())
+	switch {
+	case isGitHubNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("delete branch %s: %w", head.GetRef(), err)
+	}
+	return true, nil
 }
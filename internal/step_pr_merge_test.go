@@ -0,0 +1,238 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// fakePRMergeAPI serves the REST and GraphQL endpoints step.gh_pr_merge uses
+// for PR 7 of o/r. Each poll of the PR advances mergeableStates, checkRuns,
+// and reviews to their next entry, if any. The base branch main requires
+// requiredContexts, or is unprotected when it is nil.
+type fakePRMergeAPI struct {
+	mu               sync.Mutex
+	polls            int
+	pr               map[string]any
+	mergeableStates  []string
+	requiredContexts []string
+	checkRuns        [][]map[string]any
+	reviews          [][]map[string]any
+	mergeBody        map[string]any
+	graphql          []map[string]any
+	deleted          []string
+}
+
+func newFakePRMergeAPI(t *testing.T) (*fakePRMergeAPI, *httptest.Server) {
+	t.Helper()
+	api := &fakePRMergeAPI{pr: map[string]any{
+		"number":  7,
+		"state":   "open",
+		"node_id": "PR_node7",
+		"head":    map[string]any{"sha": "abc123", "ref": "feature", "repo": map[string]any{"full_name": "o/r"}},
+		"base":    map[string]any{"ref": "main"},
+	}}
+	srv := httptest.NewServer(api)
+	t.Cleanup(srv.Close)
+	return api, srv
+}
+
+// at returns the entry of steps for the current poll.
+func at(steps [][]map[string]any, poll int) []map[string]any {
+	if len(steps) == 0 {
+		return []map[string]any{}
+	}
+	return steps[min(poll-1, len(steps)-1)]
+}
+
+func (a *fakePRMergeAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	switch path := r.URL.Path; {
+	case r.Method == http.MethodGet && path == "/repos/o/r/pulls/7":
+		a.polls++
+		a.pr["mergeable_state"] = "clean"
+		if len(a.mergeableStates) > 0 {
+			a.pr["mergeable_state"] = a.mergeableStates[min(a.polls-1, len(a.mergeableStates)-1)]
+		}
+		_ = json.NewEncoder(w).Encode(a.pr)
+	case path == "/repos/o/r/branches/main/protection/required_status_checks" && a.requiredContexts != nil:
+		_ = json.NewEncoder(w).Encode(map[string]any{"strict": true, "contexts": a.requiredContexts})
+	case path == "/repos/o/r/commits/abc123/check-runs":
+		runs := at(a.checkRuns, a.polls)
+		_ = json.NewEncoder(w).Encode(map[string]any{"total_count": len(runs), "check_runs": runs})
+	case path == "/repos/o/r/commits/abc123/status":
+		_ = json.NewEncoder(w).Encode(map[string]any{"state": "success", "statuses": []any{}})
+	case path == "/repos/o/r/pulls/7/reviews":
+		_ = json.NewEncoder(w).Encode(at(a.reviews, a.polls))
+	case r.Method == http.MethodPut && path == "/repos/o/r/pulls/7/merge":
+		_ = json.NewDecoder(r.Body).Decode(&a.mergeBody)
+		_ = json.NewEncoder(w).Encode(map[string]any{"merged": true, "sha": "merge1", "message": "Pull Request successfully merged"})
+	case r.Method == http.MethodDelete && strings.HasPrefix(path, "/repos/o/r/git/refs/"):
+		a.deleted = append(a.deleted, strings.TrimPrefix(path, "/repos/o/r/git/refs/"))
+		w.WriteHeader(http.StatusNoContent)
+	case r.Method == http.MethodPost && path == "/graphql":
+		var body map[string]any
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		a.graphql = append(a.graphql, body)
+		_ = json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{
+			"enqueuePullRequest": map[string]any{"mergeQueueEntry": map[string]any{"position": 3}},
+		}})
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func runPRMerge(t *testing.T, srv *httptest.Server, config map[string]any) map[string]any {
+	t.Helper()
+	config["owner"] = "o"
+	config["repo"] = "r"
+	config["pr_number"] = 7
+	config["token"] = "tok"
+	config["api_base_url"] = srv.URL
+	step, err := newPRMergeStep("merge", config)
+	if err != nil {
+		t.Fatalf("newPRMergeStep: %v", err)
+	}
+	result, err := step.Execute(context.Background(), nil, nil, nil, nil, nil)
+	if err != nil {
+		t.Fatalf("Execute: %v", err)
+	}
+	return result.Output
+}
+
+func TestPRMergeStep_WaitsForChecksAndReviews(t *testing.T) {
+	api, srv := newFakePRMergeAPI(t)
+	api.checkRuns = [][]map[string]any{
+		{{"name": "ci", "status": "in_progress"}},
+		{{"name": "ci", "status": "completed", "conclusion": "success"}, {"name": "lint", "status": "completed", "conclusion": "failure"}},
+	}
+	api.reviews = [][]map[string]any{
+		{},
+		{{"state": "CHANGES_REQUESTED", "user": map[string]any{"login": "alice"}}},
+		{{"state": "CHANGES_REQUESTED", "user": map[string]any{"login": "alice"}}, {"state": "APPROVED", "user": map[string]any{"login": "alice"}}},
+	}
+	out := runPRMerge(t, srv, map[string]any{
+		"wait":               true,
+		"required_checks":    []any{"ci"},
+		"required_approvals": 1,
+		"poll_interval":      "1ms",
+		"timeout":            "5s",
+		"delete_branch":      true,
+	})
+	if out["error"] != nil || out["merged"] != true || out["branch_deleted"] != true {
+		t.Fatalf("unexpected output %v", out)
+	}
+	if api.polls < 3 {
+		t.Errorf("expected at least 3 polls, got %d", api.polls)
+	}
+	if api.mergeBody["sha"] != "abc123" {
+		t.Errorf("expected the merge to be pinned to the checked head, got %v", api.mergeBody)
+	}
+	if len(api.deleted) != 1 || api.deleted[0] != "heads/feature" {
+		t.Errorf("expected heads/feature to be deleted, got %v", api.deleted)
+	}
+}
+
+func TestPRMergeStep_WaitsForChecksToStart(t *testing.T) {
+	api, srv := newFakePRMergeAPI(t)
+	api.mergeableStates = []string{"unknown", "blocked", "behind", "blocked", "clean"}
+	api.requiredContexts = []string{"deploy-preview"}
+	api.checkRuns = [][]map[string]any{
+		{},
+		{},
+		{{"name": "ci", "status": "completed", "conclusion": "success"}},
+		{{"name": "ci", "status": "completed", "conclusion": "success"}},
+		{{"name": "ci", "status": "completed", "conclusion": "success"}, {"name": "deploy-preview", "status": "completed", "conclusion": "success"}},
+	}
+	out := runPRMerge(t, srv, map[string]any{
+		"wait":            true,
+		"required_checks": []any{"ci"},
+		"poll_interval":   "1ms",
+		"timeout":         "5s",
+	})
+	if out["error"] != nil || out["merged"] != true {
+		t.Fatalf("unexpected output %v", out)
+	}
+	if api.polls != 5 {
+		t.Errorf("expected the merge to wait for the branch's required check and a clean state, got %d polls", api.polls)
+	}
+
+	api, srv = newFakePRMergeAPI(t)
+	api.checkRuns = [][]map[string]any{{}, {{"name": "ci", "status": "completed", "conclusion": "success"}}}
+	out = runPRMerge(t, srv, map[string]any{"wait": true, "poll_interval": "1ms", "timeout": "5s"})
+	if out["error"] != nil || out["merged"] != true || api.polls != 2 {
+		t.Errorf("expected the merge to wait for a check to report, got %v after %d polls", out, api.polls)
+	}
+}
+
+func TestPRMergeStep_FailsFast(t *testing.T) {
+	api, srv := newFakePRMergeAPI(t)
+	api.checkRuns = [][]map[string]any{{{"name": "ci", "status": "completed", "conclusion": "failure"}}}
+	out := runPRMerge(t, srv, map[string]any{"wait": true, "poll_interval": "1ms", "timeout": "5s"})
+	if msg, _ := out["error"].(string); !strings.Contains(msg, "check ci failed") || api.mergeBody != nil {
+		t.Errorf("expected a failed check to stop the step, got %v", out)
+	}
+
+	out = runPRMerge(t, srv, map[string]any{"expected_head_sha": "def456"})
+	if msg, _ := out["error"].(string); !strings.Contains(msg, "expected def456") || api.mergeBody != nil {
+		t.Errorf("expected a moved head to stop the step, got %v", out)
+	}
+}
+
+func TestPRMergeStep_AutoMergeAndQueue(t *testing.T) {
+	api, srv := newFakePRMergeAPI(t)
+	out := runPRMerge(t, srv, map[string]any{"mode": "auto", "method": "squash", "expected_head_sha": "abc123"})
+	if out["auto_merge"] != true || len(api.graphql) != 1 {
+		t.Fatalf("unexpected output %v", out)
+	}
+	vars, _ := api.graphql[0]["variables"].(map[string]any)
+	if !strings.Contains(api.graphql[0]["query"].(string), "enablePullRequestAutoMerge") ||
+		vars["id"] != "PR_node7" || vars["method"] != "SQUASH" || vars["sha"] != "abc123" {
+		t.Errorf("unexpected auto-merge request %v", api.graphql[0])
+	}
+
+	out = runPRMerge(t, srv, map[string]any{"mode": "queue"})
+	if out["queued"] != true || out["queue_position"] != int64(3) {
+		t.Errorf("unexpected output %v", out)
+	}
+	if api.mergeBody != nil {
+		t.Error("expected no REST merge in auto and queue modes")
+	}
+
+	step, err := newPRMergeStep("merge", map[string]any{
+		"owner": "o", "repo": "r", "pr_number": 7, "token": "tok", "api_base_url": srv.URL,
+		"mode": "queue", "delete_branch": "{{.cleanup}}",
+	})
+	if err != nil {
+		t.Fatalf("newPRMergeStep: %v", err)
+	}
+	result, err := step.Execute(context.Background(), map[string]any{"cleanup": true}, nil, nil, nil, nil)
+	if err != nil {
+		t.Fatalf("Execute: %v", err)
+	}
+	if msg, _ := result.Output["error"].(string); !strings.Contains(msg, "delete_branch requires mode merge") || len(api.graphql) != 2 {
+		t.Errorf("expected delete_branch to be rejected in queue mode, got %v", result.Output)
+	}
+}
+
+func TestPRMergeStep_InvalidConfig(t *testing.T) {
+	for _, config := range []map[string]any{
+		{"mode": "later"},
+		{"mode": "auto", "delete_branch": true},
+		{"timeout": "soon"},
+		{"required_approvals": -1},
+	} {
+		config["owner"] = "o"
+		config["repo"] = "r"
+		config["pr_number"] = 7
+		config["token"] = "tok"
+		if _, err := newPRMergeStep("merge", config); err == nil {
+			t.Errorf("%v: expected a constructor error", config)
+		}
+	}
+}
@@ -3,7 +3,6 @@ package internal
 import (
 	"context"
 	"fmt"
-	"os"
 
 	"github.com/google/go-github/v69/github"
 
@@ -27,12 +26,12 @@ type prReviewStep struct {
 }
 
 type prReviewConfig struct {
-	Owner    string `yaml:"owner"`
-	Repo     string `yaml:"repo"`
-	PRNumber int    `yaml:"pr_number"`
-	Event    string `yaml:"event"`
-	Body     string `yaml:"body"`
-	Token    string `yaml:"token"`
+	Owner    string      `yaml:"owner"`
+	Repo     string      `yaml:"repo"`
+	PRNumber templateInt `yaml:"pr_number"`
+	Event    string      `yaml:"event"`
+	Body     string      `yaml:"body"`
+	Auth     stepAuth
 }
 
 func newPRReviewStep(name string, raw map[string]any) (*prReviewStep, error) {
@@ -45,24 +44,21 @@ func newPRReviewStep(name string, raw map[string]any) (*prReviewStep, error) {
 	if cfg.Repo == "" {
 		return nil, fmt.Errorf("step.gh_pr_review %q: config.repo is required", name)
 	}
-	switch v := raw["pr_number"].(type) {
-	case int:
-		cfg.PRNumber = v
-	case int64:
-		cfg.PRNumber = int(v)
-	case float64:
-		cfg.PRNumber = int(v)
-	}
-	if cfg.PRNumber == 0 {
-		return nil, fmt.Errorf("step.gh_pr_review %q: config.pr_number is required", name)
+	number, err := parseTemplateInt(raw, "pr_number", true)
+	if err != nil {
+		return nil, fmt.Errorf("step.gh_pr_review %q: %w", name, err)
 	}
+	cfg.PRNumber = number
 	cfg.Event, _ = raw["event"].(string)
 	if cfg.Event == "" {
 		cfg.Event = "COMMENT"
 	}
 	cfg.Body, _ = raw["body"].(string)
-	cfg.Token, _ = raw["token"].(string)
-	cfg.Token = os.ExpandEnv(cfg.Token)
+	auth, err := parseStepAuth(raw)
+	if err != nil {
+		return nil, fmt.Errorf("step.gh_pr_review %q: %w", name, err)
+	}
+	cfg.Auth = auth
 	return &prReviewStep{name: name, config: cfg}, nil
 }
 
@@ -74,17 +70,20 @@ func (s *prReviewStep) Execute(
 	_ map[string]any,
 	_ map[string]any,
 ) (*sdk.StepResult, error) {
-	token := s.config.Token
-	if token == "" {
-		return errorResult("GITHUB_TOKEN is not configured"), nil
-	}
 	owner := resolveField(s.config.Owner, triggerData, stepOutputs, current)
 	repo := resolveField(s.config.Repo, triggerData, stepOutputs, current)
+	client, err := s.config.Auth.sdkClient(owner)
+	if err != nil {
+		return errorResult(err.Error()), nil
+	}
+	prNumber, err := s.config.PRNumber.resolve("pr_number", triggerData, stepOutputs, current)
+	if err != nil {
+		return errorResult(err.Error()), nil
+	}
 	body := resolveField(s.config.Body, triggerData, stepOutputs, current)
 	event := resolveField(s.config.Event, triggerData, stepOutputs, current)
 
-	client := NewSDKClient(token)
-	review, _, err := client.GH.PullRequests.CreateReview(ctx, owner, repo, s.config.PRNumber,
+	review, _, err := client.GH.PullRequests.CreateReview(ctx, owner, repo, int(prNumber),
 		&github.PullRequestReviewRequest{
 			Body:  github.Ptr(body),
 			Event: github.Ptr(event),
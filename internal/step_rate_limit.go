@@ -0,0 +1,120 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	sdk "github.com/GoCodeAlone/workflow/plugin/external/sdk"
+)
+
+// rateLimitStep implements sdk.StepInstance.
+// It reports the GitHub API rate limits of its credentials, so pipelines can
+// throttle themselves before a batch of API calls, together with what the
+// plugin's shared transport has seen: how often it waited for a limit and
+// which resources it currently holds back.
+//
+// Config:
+//
+//	owner: "GoCodeAlone"   # selects the installation when auth_module is set
+//	token: "${GITHUB_TOKEN}"
+type rateLimitStep struct {
+	name      string
+	config    rateLimitConfig
+	ghClient  GitHubClient
+	transport *rateLimitTransport
+}
+
+// rateLimitConfig holds the parsed configuration for step.gh_rate_limit.
+type rateLimitConfig struct {
+	Owner string `yaml:"owner"`
+	Auth  stepAuth
+}
+
+// newRateLimitStep parses config and returns a rateLimitStep.
+func newRateLimitStep(name string, config map[string]any, client GitHubClient) (*rateLimitStep, error) {
+	cfg, err := parseRateLimitConfig(config)
+	if err != nil {
+		return nil, fmt.Errorf("step.gh_rate_limit %q: %w", name, err)
+	}
+	if client == nil {
+		client = newHTTPGitHubClient(cfg.Auth.apiBaseURL)
+	}
+	return &rateLimitStep{
+		name:      name,
+		config:    cfg,
+		ghClient:  client,
+		transport: sharedGitHubTransport,
+	}, nil
+}
+
+// parseRateLimitConfig converts a raw config map to rateLimitConfig.
+func parseRateLimitConfig(raw map[string]any) (rateLimitConfig, error) {
+	var cfg rateLimitConfig
+
+	cfg.Owner, _ = raw["owner"].(string)
+
+	var err error
+	cfg.Auth, err = parseStepAuth(raw)
+	if err != nil {
+		return cfg, err
+	}
+	if cfg.Auth.AuthModule != "" && cfg.Owner == "" {
+		return cfg, fmt.Errorf("config.owner is required with auth_module")
+	}
+	return cfg, nil
+}
+
+// Execute fetches the current rate limits.
+func (s *rateLimitStep) Execute(
+	ctx context.Context,
+	triggerData map[string]any,
+	stepOutputs map[string]map[string]any,
+	current map[string]any,
+	_ map[string]any,
+	_ map[string]any,
+) (*sdk.StepResult, error) {
+	owner := resolveField(s.config.Owner, triggerData, stepOutputs, current)
+	token, err := s.config.Auth.token(ctx, owner)
+	if err != nil {
+		return errorResult(err.Error()), nil
+	}
+
+	limits, err := s.ghClient.GetRateLimits(ctx, token)
+	if err != nil {
+		return errorResult(fmt.Sprintf("failed to get rate limits: %v", err)), nil
+	}
+
+	resources := make(map[string]any, len(limits))
+	for name, l := range limits {
+		resources[name] = map[string]any{
+			"limit":     l.Limit,
+			"remaining": l.Remaining,
+			"used":      l.Used,
+			"reset":     time.Unix(l.Reset, 0).UTC().Format(time.RFC3339),
+		}
+	}
+
+	states, waits := s.transport.snapshot("Bearer " + token)
+	now := time.Now()
+	throttled := []any{}
+	for _, state := range states {
+		if state.BlockedUntil.After(now) {
+			throttled = append(throttled, state.Resource)
+			if r, ok := resources[state.Resource].(map[string]any); ok {
+				r["blocked_until"] = state.BlockedUntil.UTC().Format(time.RFC3339)
+			}
+		}
+	}
+
+	output := map[string]any{
+		"resources":      resources,
+		"throttled":      throttled,
+		"throttle_waits": waits,
+	}
+	if core, ok := limits["core"]; ok {
+		output["core_remaining"] = core.Remaining
+		output["core_reset"] = time.Unix(core.Reset, 0).UTC().Format(time.RFC3339)
+	}
+	return &sdk.StepResult{Output: output}, nil
+}
@@ -0,0 +1,80 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestRateLimitStep_Success(t *testing.T) {
+	reset := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	var capturedToken string
+	client := &mockGitHubClient{
+		rateLimitsFunc: func(_ context.Context, token string) (map[string]RateLimitResource, error) {
+			capturedToken = token
+			return map[string]RateLimitResource{
+				"core":    {Limit: 5000, Remaining: 120, Used: 4880, Reset: reset.Unix()},
+				"graphql": {Limit: 5000, Remaining: 5000, Reset: reset.Unix()},
+			}, nil
+		},
+	}
+	step, err := newRateLimitStep("test", map[string]any{"token": "gh-token"}, client)
+	if err != nil {
+		t.Fatalf("newRateLimitStep: %v", err)
+	}
+	transport := newRateLimitTransport(http.DefaultTransport)
+	transport.block(rateLimitBucket{credential: credentialID("Bearer gh-token"), resource: "graphql"}, time.Hour)
+	step.transport = transport
+
+	result, err := step.Execute(context.Background(), nil, nil, nil, nil, nil)
+	if err != nil {
+		t.Fatalf("Execute: %v", err)
+	}
+	if result.StopPipeline {
+		t.Fatalf("unexpected error: %v", result.Output["error"])
+	}
+	requireEncodableOutput(t, result.Output)
+	if capturedToken != "gh-token" {
+		t.Errorf("expected token gh-token, got %q", capturedToken)
+	}
+	if result.Output["core_remaining"] != 120 || result.Output["core_reset"] != "2026-01-02T03:04:05Z" {
+		t.Errorf("unexpected core limit: %v, %v", result.Output["core_remaining"], result.Output["core_reset"])
+	}
+	resources, _ := result.Output["resources"].(map[string]any)
+	graphql, _ := resources["graphql"].(map[string]any)
+	if _, ok := graphql["blocked_until"]; !ok {
+		t.Errorf("expected graphql to report blocked_until, got %v", graphql)
+	}
+	throttled, _ := result.Output["throttled"].([]any)
+	if len(throttled) != 1 || throttled[0] != "graphql" {
+		t.Errorf("expected graphql to be throttled, got %v", result.Output["throttled"])
+	}
+}
+
+func TestRateLimitStep_ClientError(t *testing.T) {
+	client := &mockGitHubClient{
+		rateLimitsFunc: func(context.Context, string) (map[string]RateLimitResource, error) {
+			return nil, errors.New("unexpected status 401: Bad credentials")
+		},
+	}
+	step, err := newRateLimitStep("test", map[string]any{"token": "bad"}, client)
+	if err != nil {
+		t.Fatalf("newRateLimitStep: %v", err)
+	}
+	result, err := step.Execute(context.Background(), nil, nil, nil, nil, nil)
+	if err != nil {
+		t.Fatalf("Execute: %v", err)
+	}
+	if !result.StopPipeline {
+		t.Error("expected StopPipeline on client error")
+	}
+}
+
+func TestRateLimitStep_AuthModuleRequiresOwner(t *testing.T) {
+	_, err := newRateLimitStep("test", map[string]any{"auth_module": "gh-app"}, &mockGitHubClient{})
+	if err == nil {
+		t.Error("expected error when auth_module is set without owner")
+	}
+}
@@ -3,7 +3,6 @@ package internal
 import (
 	"context"
 	"fmt"
-	"os"
 
 	"github.com/google/go-github/v69/github"
 
@@ -29,14 +28,14 @@ type releaseCreateStep struct {
 }
 
 type releaseCreateConfig struct {
-	Owner      string `yaml:"owner"`
-	Repo       string `yaml:"repo"`
-	Tag        string `yaml:"tag"`
-	Name       string `yaml:"name"`
-	Body       string `yaml:"body"`
-	Draft      bool   `yaml:"draft"`
-	Prerelease bool   `yaml:"prerelease"`
-	Token      string `yaml:"token"`
+	Owner      string       `yaml:"owner"`
+	Repo       string       `yaml:"repo"`
+	Tag        string       `yaml:"tag"`
+	Name       string       `yaml:"name"`
+	Body       string       `yaml:"body"`
+	Draft      templateBool `yaml:"draft"`
+	Prerelease templateBool `yaml:"prerelease"`
+	Auth       stepAuth
 }
 
 func newReleaseCreateStep(name string, raw map[string]any) (*releaseCreateStep, error) {
@@ -55,10 +54,18 @@ func newReleaseCreateStep(name string, raw map[string]any) (*releaseCreateStep,
 	}
 	cfg.Name, _ = raw["name"].(string)
 	cfg.Body, _ = raw["body"].(string)
-	cfg.Draft, _ = raw["draft"].(bool)
-	cfg.Prerelease, _ = raw["prerelease"].(bool)
-	cfg.Token, _ = raw["token"].(string)
-	cfg.Token = os.ExpandEnv(cfg.Token)
+	var err error
+	if cfg.Draft, err = parseTemplateBool(raw, "draft"); err != nil {
+		return nil, fmt.Errorf("step.gh_release_create %q: %w", name, err)
+	}
+	if cfg.Prerelease, err = parseTemplateBool(raw, "prerelease"); err != nil {
+		return nil, fmt.Errorf("step.gh_release_create %q: %w", name, err)
+	}
+	auth, err := parseStepAuth(raw)
+	if err != nil {
+		return nil, fmt.Errorf("step.gh_release_create %q: %w", name, err)
+	}
+	cfg.Auth = auth
 	return &releaseCreateStep{name: name, config: cfg}, nil
 }
 
@@ -70,23 +77,30 @@ func (s *releaseCreateStep) Execute(
 	_ map[string]any,
 	_ map[string]any,
 ) (*sdk.StepResult, error) {
-	token := s.config.Token
-	if token == "" {
-		return errorResult("GITHUB_TOKEN is not configured"), nil
-	}
 	owner := resolveField(s.config.Owner, triggerData, stepOutputs, current)
 	repo := resolveField(s.config.Repo, triggerData, stepOutputs, current)
+	client, err := s.config.Auth.sdkClient(owner)
+	if err != nil {
+		return errorResult(err.Error()), nil
+	}
 	tag := resolveField(s.config.Tag, triggerData, stepOutputs, current)
 	relName := resolveField(s.config.Name, triggerData, stepOutputs, current)
 	body := resolveField(s.config.Body, triggerData, stepOutputs, current)
+	draft, err := s.config.Draft.resolve("draft", triggerData, stepOutputs, current)
+	if err != nil {
+		return errorResult(err.Error()), nil
+	}
+	prerelease, err := s.config.Prerelease.resolve("prerelease", triggerData, stepOutputs, current)
+	if err != nil {
+		return errorResult(err.Error()), nil
+	}
 
-	client := NewSDKClient(token)
 	rel, _, err := client.GH.Repositories.CreateRelease(ctx, owner, repo, &github.RepositoryRelease{
 		TagName:    github.Ptr(tag),
 		Name:       github.Ptr(relName),
 		Body:       github.Ptr(body),
-		Draft:      github.Ptr(s.config.Draft),
-		Prerelease: github.Ptr(s.config.Prerelease),
+		Draft:      github.Ptr(draft),
+		Prerelease: github.Ptr(prerelease),
 	})
 	if err != nil {
 		return errorResult(fmt.Sprintf("create release: %v", err)), nil
@@ -94,12 +108,12 @@ func (s *releaseCreateStep) Execute(
 
 	return &sdk.StepResult{
 		Output: map[string]any{
-			"release_id":  rel.GetID(),
-			"url":         rel.GetHTMLURL(),
-			"upload_url":  rel.GetUploadURL(),
-			"tag":         rel.GetTagName(),
-			"draft":       rel.GetDraft(),
-			"prerelease":  rel.GetPrerelease(),
+			"release_id": rel.GetID(),
+			"url":        rel.GetHTMLURL(),
+			"upload_url": rel.GetUploadURL(),
+			"tag":        rel.GetTagName(),
+			"draft":      rel.GetDraft(),
+			"prerelease": rel.GetPrerelease(),
 		},
 	}, nil
 }
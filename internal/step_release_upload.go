@@ -4,8 +4,6 @@ import (
 	"context"
 	"fmt"
 	"os"
-	"strconv"
-	"strings"
 
 	"github.com/google/go-github/v69/github"
 
@@ -23,19 +21,19 @@ import (
 //	file:       "bin/server-linux-amd64"
 //	name:       "server-linux-amd64"   # asset display name
 //	token:      "${GITHUB_TOKEN}"
+//	upload_url: "https://ghe.example.com/api/uploads"  # optional; derived from api_base_url
 type releaseUploadStep struct {
 	name   string
 	config releaseUploadConfig
 }
 
 type releaseUploadConfig struct {
-	Owner        string `yaml:"owner"`
-	Repo         string `yaml:"repo"`
-	ReleaseID    int64  `yaml:"release_id"`
-	ReleaseIDRaw string `yaml:"-"` // raw string for dynamic {{.field}} resolution
-	File         string `yaml:"file"`
-	Name         string `yaml:"name"`
-	Token        string `yaml:"token"`
+	Owner     string      `yaml:"owner"`
+	Repo      string      `yaml:"repo"`
+	ReleaseID templateInt `yaml:"release_id"`
+	File      string      `yaml:"file"`
+	Name      string      `yaml:"name"`
+	Auth      stepAuth
 }
 
 func newReleaseUploadStep(name string, raw map[string]any) (*releaseUploadStep, error) {
@@ -48,36 +46,21 @@ func newReleaseUploadStep(name string, raw map[string]any) (*releaseUploadStep,
 	if cfg.Repo == "" {
 		return nil, fmt.Errorf("step.gh_release_upload %q: config.repo is required", name)
 	}
-	switch v := raw["release_id"].(type) {
-	case int:
-		cfg.ReleaseID = int64(v)
-	case int64:
-		cfg.ReleaseID = v
-	case float64:
-		cfg.ReleaseID = int64(v)
-	case string:
-		if v != "" {
-			if strings.Contains(v, "{{") && strings.Contains(v, "}}") {
-				cfg.ReleaseIDRaw = v
-			} else {
-				n, err := strconv.ParseInt(v, 10, 64)
-				if err != nil {
-					return nil, fmt.Errorf("step.gh_release_upload %q: config.release_id is not a valid integer: %w", name, err)
-				}
-				cfg.ReleaseID = n
-			}
-		}
-	}
-	if cfg.ReleaseID == 0 && cfg.ReleaseIDRaw == "" {
-		return nil, fmt.Errorf("step.gh_release_upload %q: config.release_id is required", name)
+	releaseID, err := parseTemplateInt(raw, "release_id", true)
+	if err != nil {
+		return nil, fmt.Errorf("step.gh_release_upload %q: %w", name, err)
 	}
+	cfg.ReleaseID = releaseID
 	cfg.File, _ = raw["file"].(string)
 	if cfg.File == "" {
 		return nil, fmt.Errorf("step.gh_release_upload %q: config.file is required", name)
 	}
 	cfg.Name, _ = raw["name"].(string)
-	cfg.Token, _ = raw["token"].(string)
-	cfg.Token = os.ExpandEnv(cfg.Token)
+	auth, err := parseStepAuth(raw)
+	if err != nil {
+		return nil, fmt.Errorf("step.gh_release_upload %q: %w", name, err)
+	}
+	cfg.Auth = auth
 	return &releaseUploadStep{name: name, config: cfg}, nil
 }
 
@@ -89,30 +72,21 @@ func (s *releaseUploadStep) Execute(
 	_ map[string]any,
 	_ map[string]any,
 ) (*sdk.StepResult, error) {
-	token := s.config.Token
-	if token == "" {
-		return errorResult("GITHUB_TOKEN is not configured"), nil
-	}
 	owner := resolveField(s.config.Owner, triggerData, stepOutputs, current)
 	repo := resolveField(s.config.Repo, triggerData, stepOutputs, current)
+	client, err := s.config.Auth.sdkClient(owner)
+	if err != nil {
+		return errorResult(err.Error()), nil
+	}
 	filePath := resolveField(s.config.File, triggerData, stepOutputs, current)
 	assetName := resolveField(s.config.Name, triggerData, stepOutputs, current)
 	if assetName == "" {
 		assetName = filePath
 	}
 
-	// Resolve release_id — may be a static int or a dynamic template reference.
-	releaseID := s.config.ReleaseID
-	if s.config.ReleaseIDRaw != "" {
-		resolved := resolveField(s.config.ReleaseIDRaw, triggerData, stepOutputs, current)
-		n, err := strconv.ParseInt(resolved, 10, 64)
-		if err != nil {
-			return errorResult(fmt.Sprintf("release_id resolved to non-integer value %q: %v", resolved, err)), nil
-		}
-		releaseID = n
-	}
-	if releaseID == 0 {
-		return errorResult("release_id resolved to zero — check pipeline context"), nil
+	releaseID, err := s.config.ReleaseID.resolve("release_id", triggerData, stepOutputs, current)
+	if err != nil {
+		return errorResult(err.Error()), nil
 	}
 
 	f, err := os.Open(filePath) //nolint:gosec // G304: path from step config, trusted
@@ -126,8 +100,7 @@ func (s *releaseUploadStep) Execute(
 		return errorResult(fmt.Sprintf("stat file %q: %v", filePath, err)), nil
 	}
 
-	client := NewSDKClient(token)
-	asset, _, err := client.GH.Repositories.UploadReleaseAsset(ctx, owner, repo, releaseID,
+	asset, _, err := client.GH.Repositories.UploadReleaseAsset(withAttemptTimeout(ctx, githubTransferTimeout), owner, repo, releaseID,
 		&github.UploadOptions{Name: assetName},
 		f)
 	if err != nil {
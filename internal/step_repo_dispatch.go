@@ -4,10 +4,9 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"os"
 
-	"github.com/google/go-github/v69/github"
 	sdk "github.com/GoCodeAlone/workflow/plugin/external/sdk"
+	"github.com/google/go-github/v69/github"
 )
 
 // repoDispatchStep implements sdk.StepInstance.
@@ -30,7 +29,7 @@ type repoDispatchConfig struct {
 	Repo      string         `yaml:"repo"`
 	EventType string         `yaml:"event_type"`
 	Payload   map[string]any `yaml:"payload"`
-	Token     string         `yaml:"token"`
+	Auth      stepAuth
 }
 
 func newRepoDispatchStep(name string, raw map[string]any) (*repoDispatchStep, error) {
@@ -48,8 +47,11 @@ func newRepoDispatchStep(name string, raw map[string]any) (*repoDispatchStep, er
 		return nil, fmt.Errorf("step.gh_repo_dispatch %q: config.event_type is required", name)
 	}
 	cfg.Payload, _ = raw["payload"].(map[string]any)
-	cfg.Token, _ = raw["token"].(string)
-	cfg.Token = os.ExpandEnv(cfg.Token)
+	auth, err := parseStepAuth(raw)
+	if err != nil {
+		return nil, fmt.Errorf("step.gh_repo_dispatch %q: %w", name, err)
+	}
+	cfg.Auth = auth
 	return &repoDispatchStep{name: name, config: cfg}, nil
 }
 
@@ -61,12 +63,12 @@ func (s *repoDispatchStep) Execute(
 	_ map[string]any,
 	_ map[string]any,
 ) (*sdk.StepResult, error) {
-	token := s.config.Token
-	if token == "" {
-		return errorResult("GITHUB_TOKEN is not configured"), nil
-	}
 	owner := resolveField(s.config.Owner, triggerData, stepOutputs, current)
 	repo := resolveField(s.config.Repo, triggerData, stepOutputs, current)
+	client, err := s.config.Auth.sdkClient(owner)
+	if err != nil {
+		return errorResult(err.Error()), nil
+	}
 	eventType := resolveField(s.config.EventType, triggerData, stepOutputs, current)
 
 	opts := github.DispatchRequestOptions{
@@ -81,8 +83,7 @@ func (s *repoDispatchStep) Execute(
 		opts.ClientPayload = &raw
 	}
 
-	client := NewSDKClient(token)
-	_, _, err := client.GH.Repositories.Dispatch(ctx, owner, repo, opts)
+	_, _, err = client.GH.Repositories.Dispatch(ctx, owner, repo, opts)
 	if err != nil {
 		return errorResult(fmt.Sprintf("repo dispatch: %v", err)), nil
 	}
@@ -0,0 +1,91 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	sdk "github.com/GoCodeAlone/workflow/plugin/external/sdk"
+)
+
+// secretDeleteStep implements sdk.StepInstance.
+// It deletes secrets from an organization, repository, or environment (see
+// secretTarget). Secrets that do not exist are reported as missing rather
+// than failing the step, so pruning is safe to rerun.
+//
+// Config:
+//
+//	owner: "GoCodeAlone"
+//	repo:  "workflow"                      # omit for org-level secrets
+//	names: ["OLD_API_KEY", "LEGACY_DSN"]   # or name: "OLD_API_KEY"
+//	token: "${GITHUB_TOKEN}"
+type secretDeleteStep struct {
+	name   string
+	config secretDeleteConfig
+}
+
+type secretDeleteConfig struct {
+	Target secretTarget `yaml:",inline"`
+	Names  templateList `yaml:"names"`
+	Auth   stepAuth
+}
+
+func newSecretDeleteStep(name string, raw map[string]any) (*secretDeleteStep, error) {
+	var cfg secretDeleteConfig
+	var err error
+	if cfg.Target, err = parseSecretTarget(raw); err != nil {
+		return nil, fmt.Errorf("step.gh_secret_delete %q: %w", name, err)
+	}
+	if cfg.Names, err = parseNameList(raw); err != nil {
+		return nil, fmt.Errorf("step.gh_secret_delete %q: %w", name, err)
+	}
+	if cfg.Auth, err = parseStepAuth(raw); err != nil {
+		return nil, fmt.Errorf("step.gh_secret_delete %q: %w", name, err)
+	}
+	return &secretDeleteStep{name: name, config: cfg}, nil
+}
+
+func (s *secretDeleteStep) Execute(
+	ctx context.Context,
+	triggerData map[string]any,
+	stepOutputs map[string]map[string]any,
+	current map[string]any,
+	_ map[string]any,
+	_ map[string]any,
+) (*sdk.StepResult, error) {
+	owner := resolveField(s.config.Target.Owner, triggerData, stepOutputs, current)
+	client, err := s.config.Auth.sdkClient(owner)
+	if err != nil {
+		return errorResult(err.Error()), nil
+	}
+	scope, err := s.config.Target.resolve(triggerData, stepOutputs, current)
+	if err != nil {
+		return errorResult(err.Error()), nil
+	}
+	names, err := s.config.Names.resolve("names", triggerData, stepOutputs, current)
+	if err != nil {
+		return errorResult(err.Error()), nil
+	}
+
+	deleted, missing := []string{}, []string{}
+	for _, secretName := range names {
+		found, err := scope.delete(ctx, client.GH, secretName)
+		if err != nil {
+			if len(deleted) > 0 {
+				err = fmt.Errorf("%w (already deleted: %s)", err, strings.Join(deleted, ", "))
+			}
+			return errorResult(err.Error()), nil
+		}
+		if found {
+			deleted = append(deleted, secretName)
+		} else {
+			missing = append(missing, secretName)
+		}
+	}
+
+	output := scope.output()
+	output["deleted"] = outputList(deleted)
+	output["missing"] = outputList(missing)
+	output["count"] = len(deleted)
+	return &sdk.StepResult{Output: output}, nil
+}
@@ -0,0 +1,47 @@
+package internal
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestSecretDeleteStep_ReportsMissingSecrets(t *testing.T) {
+	api, srv := newFakeSecretsAPI(t)
+	collection := "/repositories/108/environments/production/secrets"
+	api.secrets[collection] = map[string]*fakeSecret{
+		"OLD_KEY": {value: "x", updatedAt: time.Now()},
+		"KEEP":    {value: "y", updatedAt: time.Now()},
+	}
+	step, err := newSecretDeleteStep("prune", map[string]any{
+		"owner":        "GoCodeAlone",
+		"repo":         "workflow",
+		"environment":  "production",
+		"names":        []any{"OLD_KEY", "GONE"},
+		"token":        "tok",
+		"api_base_url": srv.URL,
+	})
+	if err != nil {
+		t.Fatalf("newSecretDeleteStep: %v", err)
+	}
+	out := runSecretStep(t, step)
+	if !reflect.DeepEqual(out["deleted"], []any{"OLD_KEY"}) || !reflect.DeepEqual(out["missing"], []any{"GONE"}) || out["count"] != 1 {
+		t.Errorf("unexpected output %v", out)
+	}
+	if api.secret(collection, "OLD_KEY") != nil || api.secret(collection, "KEEP") == nil {
+		t.Errorf("expected only OLD_KEY to be deleted")
+	}
+}
+
+func TestSecretDeleteStep_InvalidConfig(t *testing.T) {
+	for _, config := range []map[string]any{
+		{"owner": "o", "repo": "r"},
+		{"owner": "o", "repo": "r", "name": "A", "names": []any{"B"}},
+		{"repo": "r", "name": "A"},
+	} {
+		config["token"] = "tok"
+		if _, err := newSecretDeleteStep("prune", config); err == nil {
+			t.Errorf("%v: expected a constructor error", config)
+		}
+	}
+}
@@ -0,0 +1,102 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	sdk "github.com/GoCodeAlone/workflow/plugin/external/sdk"
+)
+
+// secretListStep implements sdk.StepInstance.
+// It lists the secrets of an organization, repository, or environment (see
+// secretTarget). GitHub never returns secret values; the step reports names
+// and timestamps only. With older_than, secrets not updated within that
+// duration are also reported as stale, e.g. for a pruning or rotation
+// pipeline.
+//
+// Config:
+//
+//	owner:      "GoCodeAlone"
+//	repo:       "workflow"     # omit for org-level secrets
+//	older_than: "2160h"        # optional; report secrets older than 90 days
+//	token:      "${GITHUB_TOKEN}"
+type secretListStep struct {
+	name   string
+	config secretListConfig
+}
+
+type secretListConfig struct {
+	Target    secretTarget  `yaml:",inline"`
+	OlderThan time.Duration `yaml:"older_than"`
+	Auth      stepAuth
+}
+
+func newSecretListStep(name string, raw map[string]any) (*secretListStep, error) {
+	var cfg secretListConfig
+	var err error
+	if cfg.Target, err = parseSecretTarget(raw); err != nil {
+		return nil, fmt.Errorf("step.gh_secret_list %q: %w", name, err)
+	}
+	if olderThan, _ := raw["older_than"].(string); olderThan != "" {
+		if cfg.OlderThan, err = time.ParseDuration(olderThan); err != nil || cfg.OlderThan <= 0 {
+			return nil, fmt.Errorf("step.gh_secret_list %q: config.older_than must be a positive duration, got %q", name, olderThan)
+		}
+	}
+	if cfg.Auth, err = parseStepAuth(raw); err != nil {
+		return nil, fmt.Errorf("step.gh_secret_list %q: %w", name, err)
+	}
+	return &secretListStep{name: name, config: cfg}, nil
+}
+
+func (s *secretListStep) Execute(
+	ctx context.Context,
+	triggerData map[string]any,
+	stepOutputs map[string]map[string]any,
+	current map[string]any,
+	_ map[string]any,
+	_ map[string]any,
+) (*sdk.StepResult, error) {
+	owner := resolveField(s.config.Target.Owner, triggerData, stepOutputs, current)
+	client, err := s.config.Auth.sdkClient(owner)
+	if err != nil {
+		return errorResult(err.Error()), nil
+	}
+	scope, err := s.config.Target.resolve(triggerData, stepOutputs, current)
+	if err != nil {
+		return errorResult(err.Error()), nil
+	}
+	secrets, err := scope.list(ctx, client.GH)
+	if err != nil {
+		return errorResult(err.Error()), nil
+	}
+
+	cutoff := time.Now().Add(-s.config.OlderThan)
+	items := make([]any, 0, len(secrets))
+	names := make([]string, 0, len(secrets))
+	stale := []string{}
+	for _, secret := range secrets {
+		item := map[string]any{
+			"name":       secret.Name,
+			"created_at": secret.CreatedAt.UTC().Format(time.RFC3339),
+			"updated_at": secret.UpdatedAt.UTC().Format(time.RFC3339),
+		}
+		if secret.Visibility != "" {
+			item["visibility"] = secret.Visibility
+		}
+		items = append(items, item)
+		names = append(names, secret.Name)
+		if s.config.OlderThan > 0 && secret.UpdatedAt.Before(cutoff) {
+			stale = append(stale, secret.Name)
+		}
+	}
+
+	output := scope.output()
+	output["secrets"] = items
+	output["names"] = outputList(names)
+	output["count"] = len(names)
+	if s.config.OlderThan > 0 {
+		output["stale"] = outputList(stale)
+	}
+	return &sdk.StepResult{Output: output}, nil
+}
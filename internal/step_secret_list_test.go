@@ -0,0 +1,34 @@
+package internal
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestSecretListStep_NamesAndStaleSecrets(t *testing.T) {
+	api, srv := newFakeSecretsAPI(t)
+	api.secrets["/orgs/GoCodeAlone/dependabot/secrets"] = map[string]*fakeSecret{
+		"FRESH": {value: "never-listed", updatedAt: time.Now().UTC()},
+		"OLD":   {value: "never-listed", updatedAt: time.Now().UTC().Add(-100 * 24 * time.Hour)},
+	}
+	step, err := newSecretListStep("list", map[string]any{
+		"owner":        "GoCodeAlone",
+		"app":          "dependabot",
+		"older_than":   "2160h",
+		"token":        "tok",
+		"api_base_url": srv.URL,
+	})
+	if err != nil {
+		t.Fatalf("newSecretListStep: %v", err)
+	}
+	out := runSecretStep(t, step)
+	if !reflect.DeepEqual(out["names"], []any{"FRESH", "OLD"}) || !reflect.DeepEqual(out["stale"], []any{"OLD"}) || out["count"] != 2 {
+		t.Errorf("unexpected output %v", out)
+	}
+	for _, item := range out["secrets"].([]any) {
+		if item := item.(map[string]any); item["value"] != nil || item["updated_at"] == "" {
+			t.Errorf("unexpected secret item %v", item)
+		}
+	}
+}
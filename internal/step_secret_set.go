@@ -3,19 +3,20 @@ package internal
 import (
 	"context"
 	"crypto/rand"
-	"encoding/base64"
 	"fmt"
 	"os"
+	"sort"
+	"strings"
 
-	"github.com/google/go-github/v69/github"
 	"golang.org/x/crypto/nacl/box"
 
 	sdk "github.com/GoCodeAlone/workflow/plugin/external/sdk"
 )
 
 // secretSetStep implements sdk.StepInstance.
-// It sets a repository or organization secret, encrypting the value with
-// the repo's public key before upload (as required by GitHub's API).
+// It creates or updates one secret, or many from a map, in an organization,
+// repository, or environment (see secretTarget). Values are encrypted with
+// the target's public key, fetched once per run, as GitHub's API requires.
 //
 // Config:
 //
@@ -23,6 +24,10 @@ import (
 //	repo:   "workflow"          # omit for org-level secrets
 //	name:   "DATABASE_URL"
 //	value:  "${DATABASE_URL}"   # env var reference or literal
+//	secrets:                    # instead of name/value
+//	  API_KEY: "${API_KEY}"
+//	visibility: "selected"      # org secrets: all, private (default), selected
+//	selected_repositories: ["workflow", "workflow-plugin-github"]
 //	token:  "${GITHUB_TOKEN}"
 type secretSetStep struct {
 	name   string
@@ -30,30 +35,73 @@ type secretSetStep struct {
 }
 
 type secretSetConfig struct {
-	Owner string `yaml:"owner"`
-	Repo  string `yaml:"repo"`
-	Name  string `yaml:"name"`
-	Value string `yaml:"value"`
-	Token string `yaml:"token"`
+	Target               secretTarget      `yaml:",inline"`
+	Name                 string            `yaml:"name"`
+	Value                string            `yaml:"value"`
+	Secrets              map[string]string `yaml:"secrets"`
+	Visibility           string            `yaml:"visibility"`
+	SelectedRepositories templateList      `yaml:"selected_repositories"`
+	Auth                 stepAuth
 }
 
 func newSecretSetStep(name string, raw map[string]any) (*secretSetStep, error) {
 	var cfg secretSetConfig
-	cfg.Owner, _ = raw["owner"].(string)
-	if cfg.Owner == "" {
-		return nil, fmt.Errorf("step.gh_secret_set %q: config.owner is required", name)
+	var err error
+	cfg.Target, err = parseSecretTarget(raw)
+	if err != nil {
+		return nil, fmt.Errorf("step.gh_secret_set %q: %w", name, err)
 	}
-	cfg.Repo, _ = raw["repo"].(string)
 	cfg.Name, _ = raw["name"].(string)
-	if cfg.Name == "" {
-		return nil, fmt.Errorf("step.gh_secret_set %q: config.name is required", name)
-	}
 	cfg.Value, _ = raw["value"].(string)
-	cfg.Token, _ = raw["token"].(string)
-	cfg.Token = os.ExpandEnv(cfg.Token)
+	if secrets, ok := raw["secrets"].(map[string]any); ok {
+		cfg.Secrets = make(map[string]string, len(secrets))
+		for key, value := range secrets {
+			str, ok := value.(string)
+			if !ok {
+				return nil, fmt.Errorf("step.gh_secret_set %q: config.secrets.%s must be a string", name, key)
+			}
+			cfg.Secrets[key] = str
+		}
+	}
+	switch {
+	case cfg.Name == "" && len(cfg.Secrets) == 0:
+		return nil, fmt.Errorf("step.gh_secret_set %q: config.name or config.secrets is required", name)
+	case cfg.Name != "" && len(cfg.Secrets) > 0:
+		return nil, fmt.Errorf("step.gh_secret_set %q: config.name and config.secrets are mutually exclusive", name)
+	}
+	cfg.Visibility, _ = raw["visibility"].(string)
+	if cfg.SelectedRepositories, err = parseTemplateList(raw, "selected_repositories"); err != nil {
+		return nil, fmt.Errorf("step.gh_secret_set %q: %w", name, err)
+	}
+	if !cfg.Target.isOrg() && (cfg.Visibility != "" || len(cfg.SelectedRepositories.Items) > 0 || cfg.SelectedRepositories.Ref != "") {
+		return nil, fmt.Errorf("step.gh_secret_set %q: config.visibility and config.selected_repositories only apply to organization secrets", name)
+	}
+	if cfg.Visibility == "" {
+		cfg.Visibility = "private"
+	}
+	if !strings.Contains(cfg.Visibility, "{{") {
+		if err := validateSecretVisibility(cfg.Visibility); err != nil {
+			return nil, fmt.Errorf("step.gh_secret_set %q: %w", name, err)
+		}
+	}
+	auth, err := parseStepAuth(raw)
+	if err != nil {
+		return nil, fmt.Errorf("step.gh_secret_set %q: %w", name, err)
+	}
+	cfg.Auth = auth
 	return &secretSetStep{name: name, config: cfg}, nil
 }
 
+// validateSecretVisibility checks an organization secret visibility.
+func validateSecretVisibility(visibility string) error {
+	switch visibility {
+	case "all", "private", "selected":
+		return nil
+	default:
+		return fmt.Errorf("config.visibility must be all, private, or selected; got %q", visibility)
+	}
+}
+
 func (s *secretSetStep) Execute(
 	ctx context.Context,
 	triggerData map[string]any,
@@ -62,67 +110,63 @@ func (s *secretSetStep) Execute(
 	_ map[string]any,
 	_ map[string]any,
 ) (*sdk.StepResult, error) {
-	token := s.config.Token
-	if token == "" {
-		return errorResult("GITHUB_TOKEN is not configured"), nil
-	}
-	owner := resolveField(s.config.Owner, triggerData, stepOutputs, current)
-	repo := resolveField(s.config.Repo, triggerData, stepOutputs, current)
-	secretName := resolveField(s.config.Name, triggerData, stepOutputs, current)
-	secretValue := os.ExpandEnv(resolveField(s.config.Value, triggerData, stepOutputs, current))
-
-	client := NewSDKClient(token)
-
-	// Fetch the repo's public key for secret encryption.
-	pubKey, _, err := client.GH.Actions.GetRepoPublicKey(ctx, owner, repo)
+	owner := resolveField(s.config.Target.Owner, triggerData, stepOutputs, current)
+	client, err := s.config.Auth.sdkClient(owner)
 	if err != nil {
-		return errorResult(fmt.Sprintf("get repo public key: %v", err)), nil
+		return errorResult(err.Error()), nil
 	}
-
-	// Decode the base64-encoded public key.
-	keyBytes, err := base64.StdEncoding.DecodeString(pubKey.GetKey())
+	scope, err := s.config.Target.resolve(triggerData, stepOutputs, current)
 	if err != nil {
-		return errorResult(fmt.Sprintf("decode public key: %v", err)), nil
+		return errorResult(err.Error()), nil
 	}
 
-	// Encrypt the secret value using libsodium-compatible NaCl box sealing.
-	var recipientKey [32]byte
-	copy(recipientKey[:], keyBytes)
-	encrypted, err := sealWithPublicKey(recipientKey, []byte(secretValue))
-	if err != nil {
-		return errorResult(fmt.Sprintf("encrypt secret: %v", err)), nil
+	secrets := make(map[string]string, len(s.config.Secrets)+1)
+	if s.config.Name != "" {
+		secretName := resolveField(s.config.Name, triggerData, stepOutputs, current)
+		secrets[secretName] = os.ExpandEnv(resolveField(s.config.Value, triggerData, stepOutputs, current))
 	}
+	for secretName, value := range s.config.Secrets {
+		secrets[secretName] = os.ExpandEnv(resolveField(value, triggerData, stepOutputs, current))
+	}
+	names := make([]string, 0, len(secrets))
+	for secretName := range secrets {
+		names = append(names, secretName)
+	}
+	sort.Strings(names)
 
-	encryptedBase64 := base64.StdEncoding.EncodeToString(encrypted)
-	_, err = client.GH.Actions.CreateOrUpdateRepoSecret(ctx, owner, repo, &github.EncryptedSecret{
-		Name:           secretName,
-		KeyID:          pubKey.GetKeyID(),
-		EncryptedValue: encryptedBase64,
-	})
+	var access orgSecretAccess
+	if scope.kind() == "org" {
+		access, err = resolveOrgSecretAccess(ctx, client.GH, scope.owner, s.config.Visibility, s.config.SelectedRepositories, triggerData, stepOutputs, current)
+		if err != nil {
+			return errorResult(err.Error()), nil
+		}
+	}
+
+	key, err := scope.publicKey(ctx, client.GH)
 	if err != nil {
-		return errorResult(fmt.Sprintf("set secret: %v", err)), nil
+		return errorResult(err.Error()), nil
+	}
+	for i, secretName := range names {
+		if err := scope.put(ctx, client.GH, key, secretName, secrets[secretName], access); err != nil {
+			if i > 0 {
+				err = fmt.Errorf("%w (already set: %s)", err, strings.Join(names[:i], ", "))
+			}
+			return errorResult(err.Error()), nil
+		}
 	}
 
-	return &sdk.StepResult{
-		Output: map[string]any{
-			"name":  secretName,
-			"owner": owner,
-			"repo":  repo,
-			"set":   true,
-		},
-	}, nil
+	output := scope.output()
+	output["names"] = outputList(names)
+	output["count"] = len(names)
+	output["set"] = true
+	if s.config.Name != "" {
+		output["name"] = names[0]
+	}
+	return &sdk.StepResult{Output: output}, nil
 }
 
-// sealWithPublicKey encrypts plaintext using NaCl box anonymous sealing.
+// sealWithPublicKey encrypts plaintext as a libsodium sealed box
+// (crypto_box_seal), the format GitHub decrypts secret values from.
 func sealWithPublicKey(recipientPubKey [32]byte, plaintext []byte) ([]byte, error) {
-	ephemeralPub, ephemeralPriv, err := box.GenerateKey(rand.Reader)
-	if err != nil {
-		return nil, fmt.Errorf("generate ephemeral key: %w", err)
-	}
-	var nonce [24]byte
-	if _, err := rand.Read(nonce[:]); err != nil {
-		return nil, fmt.Errorf("generate nonce: %w", err)
-	}
-	encrypted := box.Seal(ephemeralPub[:], plaintext, &nonce, &recipientPubKey, ephemeralPriv)
-	return encrypted, nil
+	return box.SealAnonymous(nil, plaintext, &recipientPubKey, rand.Reader)
 }
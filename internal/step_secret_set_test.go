@@ -0,0 +1,299 @@
+package internal
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"golang.org/x/crypto/nacl/box"
+
+	sdk "github.com/GoCodeAlone/workflow/plugin/external/sdk"
+)
+
+// fakeSecret is a secret stored by fakeSecretsAPI.
+type fakeSecret struct {
+	value      string
+	visibility string
+	repoIDs    []int64
+	updatedAt  time.Time
+}
+
+// fakeVariable is an Actions variable stored by fakeSecretsAPI.
+type fakeVariable struct {
+	value      string
+	visibility string
+	repoIDs    []int64
+}
+
+// fakeSecretsAPI serves GitHub's secret endpoints for every scope and app,
+// and the Actions variable endpoints. Secrets and variables are keyed by
+// their collection path (e.g. /orgs/o/actions/secrets) and name; uploaded
+// secret values are decrypted with the API's private key.
+type fakeSecretsAPI struct {
+	t    *testing.T
+	pub  *[32]byte
+	priv *[32]byte
+
+	mu        sync.Mutex
+	secrets   map[string]map[string]*fakeSecret
+	variables map[string]map[string]*fakeVariable
+	requests  []string
+}
+
+func newFakeSecretsAPI(t *testing.T) (*fakeSecretsAPI, *httptest.Server) {
+	t.Helper()
+	pub, priv, err := box.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("GenerateKey: %v", err)
+	}
+	api := &fakeSecretsAPI{t: t, pub: pub, priv: priv, secrets: map[string]map[string]*fakeSecret{}, variables: map[string]map[string]*fakeVariable{}}
+	srv := httptest.NewServer(api)
+	t.Cleanup(srv.Close)
+	return api, srv
+}
+
+func (a *fakeSecretsAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.requests = append(a.requests, r.Method+" "+r.URL.Path)
+	path := r.URL.Path
+
+	if strings.HasPrefix(path, "/repos/") && strings.Count(path, "/") == 3 {
+		name := path[strings.LastIndex(path, "/")+1:]
+		_ = json.NewEncoder(w).Encode(map[string]any{"id": 100 + len(name), "name": name})
+		return
+	}
+	if strings.Contains(path, "/variables") {
+		a.serveVariables(w, r)
+		return
+	}
+	idx := strings.Index(path, "/secrets")
+	if idx < 0 {
+		http.NotFound(w, r)
+		return
+	}
+	collection, rest := path[:idx+len("/secrets")], strings.TrimPrefix(path[idx+len("/secrets"):], "/")
+	switch {
+	case rest == "public-key" && r.Method == http.MethodGet:
+		_ = json.NewEncoder(w).Encode(map[string]any{"key_id": "key-1", "key": base64.StdEncoding.EncodeToString(a.pub[:])})
+	case rest == "" && r.Method == http.MethodGet:
+		var list []map[string]any
+		for name, secret := range a.secrets[collection] {
+			list = append(list, map[string]any{"name": name, "created_at": secret.updatedAt.Format(time.RFC3339), "updated_at": secret.updatedAt.Format(time.RFC3339)})
+		}
+		sort.Slice(list, func(i, j int) bool { return list[i]["name"].(string) < list[j]["name"].(string) })
+		_ = json.NewEncoder(w).Encode(map[string]any{"total_count": len(list), "secrets": list})
+	case rest != "" && r.Method == http.MethodPut:
+		var body struct {
+			KeyID          string  `json:"key_id"`
+			EncryptedValue string  `json:"encrypted_value"`
+			Visibility     string  `json:"visibility"`
+			RepoIDs        []int64 `json:"selected_repository_ids"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil || body.KeyID != "key-1" {
+			http.Error(w, "bad request", http.StatusUnprocessableEntity)
+			return
+		}
+		sealed, _ := base64.StdEncoding.DecodeString(body.EncryptedValue)
+		value, ok := box.OpenAnonymous(nil, sealed, a.pub, a.priv)
+		if !ok {
+			a.t.Errorf("secret %s/%s is not a sealed box for the public key", collection, rest)
+		}
+		if a.secrets[collection] == nil {
+			a.secrets[collection] = map[string]*fakeSecret{}
+		}
+		status := http.StatusCreated
+		if _, exists := a.secrets[collection][rest]; exists {
+			status = http.StatusNoContent
+		}
+		a.secrets[collection][rest] = &fakeSecret{value: string(value), visibility: body.Visibility, repoIDs: body.RepoIDs, updatedAt: time.Now().UTC()}
+		w.WriteHeader(status)
+	case rest != "" && r.Method == http.MethodDelete:
+		if _, ok := a.secrets[collection][rest]; !ok {
+			http.NotFound(w, r)
+			return
+		}
+		delete(a.secrets[collection], rest)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+// serveVariables serves the Actions variable endpoints.
+func (a *fakeSecretsAPI) serveVariables(w http.ResponseWriter, r *http.Request) {
+	path := r.URL.Path
+	idx := strings.Index(path, "/variables")
+	collection, name := path[:idx+len("/variables")], strings.TrimPrefix(path[idx+len("/variables"):], "/")
+	var body struct {
+		Name       string  `json:"name"`
+		Value      string  `json:"value"`
+		Visibility string  `json:"visibility"`
+		RepoIDs    []int64 `json:"selected_repository_ids"`
+	}
+	if r.Method == http.MethodPost || r.Method == http.MethodPatch {
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			http.Error(w, "bad request", http.StatusUnprocessableEntity)
+			return
+		}
+	}
+	variable := a.variables[collection][name]
+	switch {
+	case name == "" && r.Method == http.MethodGet:
+		var list []map[string]any
+		for name, v := range a.variables[collection] {
+			list = append(list, map[string]any{"name": name, "value": v.value, "visibility": v.visibility})
+		}
+		sort.Slice(list, func(i, j int) bool { return list[i]["name"].(string) < list[j]["name"].(string) })
+		_ = json.NewEncoder(w).Encode(map[string]any{"total_count": len(list), "variables": list})
+	case name == "" && r.Method == http.MethodPost:
+		if a.variables[collection][body.Name] != nil {
+			http.Error(w, "already exists", http.StatusConflict)
+			return
+		}
+		if a.variables[collection] == nil {
+			a.variables[collection] = map[string]*fakeVariable{}
+		}
+		a.variables[collection][body.Name] = &fakeVariable{value: body.Value, visibility: body.Visibility, repoIDs: body.RepoIDs}
+		w.WriteHeader(http.StatusCreated)
+	case variable == nil:
+		http.NotFound(w, r)
+	case r.Method == http.MethodGet:
+		_ = json.NewEncoder(w).Encode(map[string]any{"name": name, "value": variable.value, "updated_at": "2024-01-02T03:04:05Z"})
+	case r.Method == http.MethodPatch:
+		variable.value = body.Value
+		if body.Visibility != "" {
+			variable.visibility = body.Visibility
+		}
+		w.WriteHeader(http.StatusNoContent)
+	case r.Method == http.MethodDelete:
+		delete(a.variables[collection], name)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+// secret returns the stored secret, or nil.
+func (a *fakeSecretsAPI) secret(collection, name string) *fakeSecret {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.secrets[collection][name]
+}
+
+// variable returns the stored variable, or nil.
+func (a *fakeSecretsAPI) variable(collection, name string) *fakeVariable {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.variables[collection][name]
+}
+
+// runSecretStep executes step without pipeline context and fails the test on
+// a step error.
+func runSecretStep(t *testing.T, step sdk.StepInstance) map[string]any {
+	t.Helper()
+	result, err := step.Execute(context.Background(), nil, nil, nil, nil, nil)
+	if err != nil {
+		t.Fatalf("Execute: %v", err)
+	}
+	if msg, _ := result.Output["error"].(string); msg != "" {
+		t.Fatalf("unexpected step error: %s", msg)
+	}
+	requireEncodableOutput(t, result.Output)
+	return result.Output
+}
+
+func TestSecretSetStep_Targets(t *testing.T) {
+	api, srv := newFakeSecretsAPI(t)
+	t.Setenv("DEPLOY_KEY", "s3cret")
+	cases := []struct {
+		config     map[string]any
+		collection string
+	}{
+		{map[string]any{"repo": "workflow"}, "/repos/GoCodeAlone/workflow/actions/secrets"},
+		{map[string]any{"repo": "workflow", "environment": "production"}, "/repositories/108/environments/production/secrets"},
+		{map[string]any{"repo": "workflow", "app": "dependabot"}, "/repos/GoCodeAlone/workflow/dependabot/secrets"},
+		{map[string]any{"app": "codespaces"}, "/orgs/GoCodeAlone/codespaces/secrets"},
+	}
+	for _, tc := range cases {
+		config := map[string]any{"owner": "GoCodeAlone", "name": "DEPLOY_KEY", "value": "${DEPLOY_KEY}", "token": "tok", "api_base_url": srv.URL}
+		for k, v := range tc.config {
+			config[k] = v
+		}
+		step, err := newSecretSetStep("set", config)
+		if err != nil {
+			t.Fatalf("%v: newSecretSetStep: %v", tc.config, err)
+		}
+		out := runSecretStep(t, step)
+		if got := api.secret(tc.collection, "DEPLOY_KEY"); got == nil || got.value != "s3cret" {
+			t.Errorf("%v: expected DEPLOY_KEY in %s, got %+v", tc.config, tc.collection, got)
+		}
+		if out["name"] != "DEPLOY_KEY" || out["set"] != true {
+			t.Errorf("%v: unexpected output %v", tc.config, out)
+		}
+	}
+}
+
+func TestSecretSetStep_BulkOrgSecrets(t *testing.T) {
+	api, srv := newFakeSecretsAPI(t)
+	step, err := newSecretSetStep("set", map[string]any{
+		"owner":                 "GoCodeAlone",
+		"secrets":               map[string]any{"API_KEY": "k", "DB_URL": "{{.db_url}}"},
+		"visibility":            "selected",
+		"selected_repositories": []any{"workflow", "42"},
+		"token":                 "tok",
+		"api_base_url":          srv.URL,
+	})
+	if err != nil {
+		t.Fatalf("newSecretSetStep: %v", err)
+	}
+	result, err := step.Execute(context.Background(), map[string]any{"db_url": "postgres://db"}, nil, nil, nil, nil)
+	if err != nil || result.Output["error"] != nil {
+		t.Fatalf("Execute: %v %v", err, result.Output["error"])
+	}
+
+	collection := "/orgs/GoCodeAlone/actions/secrets"
+	db := api.secret(collection, "DB_URL")
+	if db == nil || db.value != "postgres://db" || db.visibility != "selected" || !reflect.DeepEqual(db.repoIDs, []int64{108, 42}) {
+		t.Errorf("unexpected DB_URL secret %+v", db)
+	}
+	requireEncodableOutput(t, result.Output)
+	if !reflect.DeepEqual(result.Output["names"], []any{"API_KEY", "DB_URL"}) || result.Output["scope"] != "org" {
+		t.Errorf("unexpected output %v", result.Output)
+	}
+	keyFetches := 0
+	for _, req := range api.requests {
+		if strings.HasSuffix(req, "/public-key") {
+			keyFetches++
+		}
+	}
+	if keyFetches != 1 {
+		t.Errorf("expected one public key fetch, got %d", keyFetches)
+	}
+}
+
+func TestSecretSetStep_InvalidConfig(t *testing.T) {
+	for _, config := range []map[string]any{
+		{"owner": "o", "repo": "r"},
+		{"owner": "o", "repo": "r", "name": "A", "secrets": map[string]any{"B": "b"}},
+		{"owner": "o", "name": "A", "environment": "production"},
+		{"owner": "o", "repo": "r", "name": "A", "environment": "production", "app": "dependabot"},
+		{"owner": "o", "name": "A", "app": "pages"},
+		{"owner": "o", "repo": "r", "name": "A", "visibility": "all"},
+		{"owner": "o", "name": "A", "visibility": "public"},
+	} {
+		config["token"] = "tok"
+		if _, err := newSecretSetStep("set", config); err == nil {
+			t.Errorf("%v: expected a constructor error", config)
+		}
+	}
+}
@@ -0,0 +1,354 @@
+package internal
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	sdk "github.com/GoCodeAlone/workflow/plugin/external/sdk"
+)
+
+// secretSyncStateVersion is the format version of secret sync state files.
+const secretSyncStateVersion = 1
+
+// secretSyncStep implements sdk.StepInstance.
+// It makes the secrets of an organization, repository, or environment (see
+// secretTarget) match a desired set. Values are never readable from GitHub,
+// so the step keeps a keyed digest of every value it uploaded, together with
+// the secret's updated_at, in a state file under state_dir. A secret is only
+// re-encrypted and uploaded when it is missing, its desired value or access
+// changed, or it was updated outside this step since the last sync.
+//
+// Config:
+//
+//	owner:     "GoCodeAlone"
+//	repo:      "workflow"              # omit for org-level secrets
+//	secrets:
+//	  API_KEY: "${API_KEY}"
+//	state_dir: "/var/lib/gh-secrets"   # digest storage
+//	delete_unmanaged: true             # delete secrets not in secrets
+//	keep: ["CODECOV_TOKEN"]            # never deleted
+//	dry_run: false
+//	token:     "${GITHUB_TOKEN}"
+type secretSyncStep struct {
+	name   string
+	config secretSyncConfig
+	now    func() time.Time
+}
+
+type secretSyncConfig struct {
+	Target               secretTarget      `yaml:",inline"`
+	Secrets              map[string]string `yaml:"secrets"`
+	StateDir             string            `yaml:"state_dir"`
+	DeleteUnmanaged      templateBool      `yaml:"delete_unmanaged"`
+	Keep                 templateList      `yaml:"keep"`
+	DryRun               templateBool      `yaml:"dry_run"`
+	Visibility           string            `yaml:"visibility"`
+	SelectedRepositories templateList      `yaml:"selected_repositories"`
+	Auth                 stepAuth
+}
+
+// secretSyncState is the state file for one secret scope.
+type secretSyncState struct {
+	Version int `json:"version"`
+	// Key is the HMAC key of the value digests, so that the file does not
+	// hold plain hashes of secret values.
+	Key       string                          `json:"key"`
+	Secrets   map[string]secretSyncStateEntry `json:"secrets"`
+	UpdatedAt time.Time                       `json:"updated_at"`
+}
+
+// secretSyncStateEntry records the last upload of one secret.
+type secretSyncStateEntry struct {
+	Digest    string `json:"digest"`
+	UpdatedAt string `json:"updated_at"`
+}
+
+func newSecretSyncStep(name string, raw map[string]any) (*secretSyncStep, error) {
+	var cfg secretSyncConfig
+	var err error
+	if cfg.Target, err = parseSecretTarget(raw); err != nil {
+		return nil, fmt.Errorf("step.gh_secret_sync %q: %w", name, err)
+	}
+	secrets, _ := raw["secrets"].(map[string]any)
+	if len(secrets) == 0 {
+		return nil, fmt.Errorf("step.gh_secret_sync %q: config.secrets is required", name)
+	}
+	cfg.Secrets = make(map[string]string, len(secrets))
+	for key, value := range secrets {
+		str, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("step.gh_secret_sync %q: config.secrets.%s must be a string", name, key)
+		}
+		cfg.Secrets[key] = str
+	}
+	stateDir, _ := raw["state_dir"].(string)
+	cfg.StateDir = strings.TrimSpace(os.ExpandEnv(stateDir))
+	if cfg.StateDir == "" {
+		return nil, fmt.Errorf("step.gh_secret_sync %q: config.state_dir is required", name)
+	}
+	if cfg.DeleteUnmanaged, err = parseTemplateBool(raw, "delete_unmanaged"); err != nil {
+		return nil, fmt.Errorf("step.gh_secret_sync %q: %w", name, err)
+	}
+	if cfg.Keep, err = parseTemplateList(raw, "keep"); err != nil {
+		return nil, fmt.Errorf("step.gh_secret_sync %q: %w", name, err)
+	}
+	if cfg.DryRun, err = parseTemplateBool(raw, "dry_run"); err != nil {
+		return nil, fmt.Errorf("step.gh_secret_sync %q: %w", name, err)
+	}
+	cfg.Visibility, _ = raw["visibility"].(string)
+	if cfg.SelectedRepositories, err = parseTemplateList(raw, "selected_repositories"); err != nil {
+		return nil, fmt.Errorf("step.gh_secret_sync %q: %w", name, err)
+	}
+	if !cfg.Target.isOrg() && (cfg.Visibility != "" || len(cfg.SelectedRepositories.Items) > 0 || cfg.SelectedRepositories.Ref != "") {
+		return nil, fmt.Errorf("step.gh_secret_sync %q: config.visibility and config.selected_repositories only apply to organization secrets", name)
+	}
+	if cfg.Visibility == "" {
+		cfg.Visibility = "private"
+	}
+	if !strings.Contains(cfg.Visibility, "{{") {
+		if err := validateSecretVisibility(cfg.Visibility); err != nil {
+			return nil, fmt.Errorf("step.gh_secret_sync %q: %w", name, err)
+		}
+	}
+	if cfg.Auth, err = parseStepAuth(raw); err != nil {
+		return nil, fmt.Errorf("step.gh_secret_sync %q: %w", name, err)
+	}
+	return &secretSyncStep{name: name, config: cfg, now: time.Now}, nil
+}
+
+// Execute compares the desired secrets with the scope and the state file,
+// then uploads, and optionally deletes, only what differs. A failed run
+// leaves the state file untouched, so the next run re-uploads every secret
+// whose upload was not recorded.
+func (s *secretSyncStep) Execute(
+	ctx context.Context,
+	triggerData map[string]any,
+	stepOutputs map[string]map[string]any,
+	current map[string]any,
+	_ map[string]any,
+	_ map[string]any,
+) (*sdk.StepResult, error) {
+	owner := resolveField(s.config.Target.Owner, triggerData, stepOutputs, current)
+	client, err := s.config.Auth.sdkClient(owner)
+	if err != nil {
+		return errorResult(err.Error()), nil
+	}
+	scope, err := s.config.Target.resolve(triggerData, stepOutputs, current)
+	if err != nil {
+		return errorResult(err.Error()), nil
+	}
+	deleteUnmanaged, err := s.config.DeleteUnmanaged.resolve("delete_unmanaged", triggerData, stepOutputs, current)
+	if err != nil {
+		return errorResult(err.Error()), nil
+	}
+	dryRun, err := s.config.DryRun.resolve("dry_run", triggerData, stepOutputs, current)
+	if err != nil {
+		return errorResult(err.Error()), nil
+	}
+	keepNames, err := s.config.Keep.resolve("keep", triggerData, stepOutputs, current)
+	if err != nil {
+		return errorResult(err.Error()), nil
+	}
+	keep := make(map[string]bool, len(keepNames))
+	for _, keepName := range keepNames {
+		keep[keepName] = true
+	}
+
+	desired := make(map[string]string, len(s.config.Secrets))
+	for secretName, value := range s.config.Secrets {
+		desired[secretName] = os.ExpandEnv(resolveField(value, triggerData, stepOutputs, current))
+	}
+	names := make([]string, 0, len(desired))
+	for secretName := range desired {
+		names = append(names, secretName)
+	}
+	sort.Strings(names)
+
+	var access orgSecretAccess
+	if scope.kind() == "org" {
+		access, err = resolveOrgSecretAccess(ctx, client.GH, scope.owner, s.config.Visibility, s.config.SelectedRepositories, triggerData, stepOutputs, current)
+		if err != nil {
+			return errorResult(err.Error()), nil
+		}
+	}
+
+	root, stateDir, err := openWebhookStateDir(s.config.StateDir)
+	if err != nil {
+		return errorResult(err.Error()), nil
+	}
+	defer root.Close() //nolint:errcheck
+	stateName := fmt.Sprintf("secret-sync-%s-%s_%s_%s.json", scope.app, stateNamePart(scope.owner), stateNamePart(scope.repo), stateNamePart(scope.environment))
+	state, err := readSecretSyncState(root, stateName)
+	if err != nil {
+		return errorResult(err.Error()), nil
+	}
+
+	existing, err := s.remoteUpdatedAt(ctx, scope, client)
+	if err != nil {
+		return errorResult(err.Error()), nil
+	}
+
+	created, updated, unchanged, drifted := []string{}, []string{}, []string{}, []string{}
+	digests := make(map[string]string, len(names))
+	for _, secretName := range names {
+		digests[secretName] = state.digest(secretName, desired[secretName], access)
+		remoteUpdatedAt, exists := existing[secretName]
+		entry, recorded := state.Secrets[secretName]
+		switch {
+		case !exists:
+			created = append(created, secretName)
+		case !recorded || entry.Digest != digests[secretName]:
+			updated = append(updated, secretName)
+		case entry.UpdatedAt != remoteUpdatedAt:
+			updated = append(updated, secretName)
+			drifted = append(drifted, secretName)
+		default:
+			unchanged = append(unchanged, secretName)
+		}
+	}
+	unmanaged := []string{}
+	for secretName := range existing {
+		if _, ok := desired[secretName]; !ok && !keep[secretName] {
+			unmanaged = append(unmanaged, secretName)
+		}
+	}
+	sort.Strings(unmanaged)
+	deleted := []string{}
+	if deleteUnmanaged {
+		deleted, unmanaged = unmanaged, []string{}
+	}
+
+	output := scope.output()
+	output["created"] = outputList(created)
+	output["updated"] = outputList(updated)
+	output["deleted"] = outputList(deleted)
+	output["unchanged"] = outputList(unchanged)
+	output["drifted"] = outputList(drifted)
+	output["unmanaged"] = outputList(unmanaged)
+	output["changed"] = len(created)+len(updated)+len(deleted) > 0
+	output["dry_run"] = dryRun
+	if dryRun {
+		return &sdk.StepResult{Output: output}, nil
+	}
+
+	upload := append(append([]string{}, created...), updated...)
+	sort.Strings(upload)
+	if len(upload) > 0 {
+		key, err := scope.publicKey(ctx, client.GH)
+		if err != nil {
+			return errorResult(err.Error()), nil
+		}
+		for _, secretName := range upload {
+			if err := scope.put(ctx, client.GH, key, secretName, desired[secretName], access); err != nil {
+				return errorResult(err.Error()), nil
+			}
+		}
+	}
+	for _, secretName := range deleted {
+		if _, err := scope.delete(ctx, client.GH, secretName); err != nil {
+			return errorResult(err.Error()), nil
+		}
+	}
+
+	// Record the updated_at GitHub assigned to each upload, so a later change
+	// made outside this step is detected as drift.
+	if len(upload) > 0 {
+		if existing, err = s.remoteUpdatedAt(ctx, scope, client); err != nil {
+			return errorResult(err.Error()), nil
+		}
+	}
+	entries := make(map[string]secretSyncStateEntry, len(names))
+	for _, secretName := range names {
+		entries[secretName] = secretSyncStateEntry{Digest: digests[secretName], UpdatedAt: existing[secretName]}
+	}
+	state.Secrets = entries
+	state.UpdatedAt = s.now().UTC()
+	if err := writeSecretSyncState(root, stateDir, stateName, state); err != nil {
+		return errorResult(err.Error()), nil
+	}
+	return &sdk.StepResult{Output: output}, nil
+}
+
+// remoteUpdatedAt returns the updated_at of every secret in scope by name.
+func (s *secretSyncStep) remoteUpdatedAt(ctx context.Context, scope *secretScope, client *SDKClient) (map[string]string, error) {
+	secrets, err := scope.list(ctx, client.GH)
+	if err != nil {
+		return nil, err
+	}
+	out := make(map[string]string, len(secrets))
+	for _, secret := range secrets {
+		out[secret.Name] = secret.UpdatedAt.UTC().Format(time.RFC3339)
+	}
+	return out, nil
+}
+
+// digest returns the keyed digest of a secret's name, value, and
+// organization access.
+func (st *secretSyncState) digest(name, value string, access orgSecretAccess) string {
+	key, _ := hex.DecodeString(st.Key)
+	mac := hmac.New(sha256.New, key)
+	ids := make([]string, len(access.repoIDs))
+	for i, id := range access.repoIDs {
+		ids[i] = strconv.FormatInt(id, 10)
+	}
+	sort.Strings(ids)
+	for _, part := range []string{name, value, access.visibility, strings.Join(ids, ",")} {
+		mac.Write([]byte(part))
+		mac.Write([]byte{0})
+	}
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// readSecretSyncState returns the stored state, or a new state with a fresh
+// digest key when the scope has not been synced before.
+func readSecretSyncState(root *os.Root, name string) (*secretSyncState, error) {
+	data, err := root.ReadFile(name)
+	if errors.Is(err, os.ErrNotExist) {
+		key := make([]byte, 32)
+		if _, err := rand.Read(key); err != nil {
+			return nil, fmt.Errorf("generate secret sync digest key: %w", err)
+		}
+		return &secretSyncState{
+			Version: secretSyncStateVersion,
+			Key:     hex.EncodeToString(key),
+			Secrets: map[string]secretSyncStateEntry{},
+		}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read secret sync state: %w", err)
+	}
+	var state secretSyncState
+	if err := json.Unmarshal(data, &state); err != nil {
+		return nil, fmt.Errorf("decode secret sync state: %w", err)
+	}
+	if state.Version != secretSyncStateVersion {
+		return nil, fmt.Errorf("secret sync state has unsupported version %d", state.Version)
+	}
+	if key, err := hex.DecodeString(state.Key); err != nil || len(key) == 0 {
+		return nil, errors.New("secret sync state has an invalid digest key")
+	}
+	return &state, nil
+}
+
+// writeSecretSyncState durably stores state.
+func writeSecretSyncState(root *os.Root, stateDir, name string, state *secretSyncState) error {
+	data, err := json.Marshal(state)
+	if err != nil {
+		return fmt.Errorf("encode secret sync state: %w", err)
+	}
+	if err := writeWebhookStateFile(root, stateDir, name, data); err != nil {
+		return fmt.Errorf("write secret sync state: %w", err)
+	}
+	return nil
+}
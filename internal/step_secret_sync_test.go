@@ -0,0 +1,106 @@
+package internal
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSecretSyncStep_UploadsOnlyChanges(t *testing.T) {
+	api, srv := newFakeSecretsAPI(t)
+	collection := "/repos/GoCodeAlone/workflow/actions/secrets"
+	api.secrets[collection] = map[string]*fakeSecret{
+		"STALE":   {value: "old", updatedAt: time.Now().UTC()},
+		"KEEP_ME": {value: "kept", updatedAt: time.Now().UTC()},
+	}
+	stateDir := t.TempDir()
+	sync := func(secrets map[string]any, extra map[string]any) map[string]any {
+		t.Helper()
+		config := map[string]any{
+			"owner":            "GoCodeAlone",
+			"repo":             "workflow",
+			"secrets":          secrets,
+			"state_dir":        stateDir,
+			"delete_unmanaged": true,
+			"keep":             []any{"KEEP_ME"},
+			"token":            "tok",
+			"api_base_url":     srv.URL,
+		}
+		for k, v := range extra {
+			config[k] = v
+		}
+		step, err := newSecretSyncStep("sync", config)
+		if err != nil {
+			t.Fatalf("newSecretSyncStep: %v", err)
+		}
+		return runSecretStep(t, step)
+	}
+	puts := func() int {
+		api.mu.Lock()
+		defer api.mu.Unlock()
+		n := 0
+		for _, req := range api.requests {
+			if strings.HasPrefix(req, "PUT ") {
+				n++
+			}
+		}
+		return n
+	}
+	expect := func(out map[string]any, key string, want ...string) {
+		t.Helper()
+		if !reflect.DeepEqual(out[key], outputList(want)) {
+			t.Errorf("%s: expected %v, got %v", key, want, out[key])
+		}
+	}
+
+	out := sync(map[string]any{"A": "1", "B": "2"}, nil)
+	expect(out, "created", "A", "B")
+	expect(out, "deleted", "STALE")
+	if api.secret(collection, "STALE") != nil || api.secret(collection, "KEEP_ME") == nil {
+		t.Error("expected STALE to be deleted and KEEP_ME to be kept")
+	}
+
+	before := puts()
+	out = sync(map[string]any{"A": "1", "B": "2"}, nil)
+	expect(out, "unchanged", "A", "B")
+	if puts() != before || out["changed"] != false {
+		t.Errorf("expected no uploads for unchanged values, output %v", out)
+	}
+
+	out = sync(map[string]any{"A": "1", "B": "3"}, nil)
+	expect(out, "updated", "B")
+	expect(out, "unchanged", "A")
+	if got := api.secret(collection, "B"); got == nil || got.value != "3" {
+		t.Errorf("expected B to be updated, got %+v", got)
+	}
+
+	api.mu.Lock()
+	api.secrets[collection]["A"].updatedAt = time.Now().UTC().Add(time.Hour)
+	api.mu.Unlock()
+	out = sync(map[string]any{"A": "1", "B": "3"}, nil)
+	expect(out, "updated", "A")
+	expect(out, "drifted", "A")
+
+	before = puts()
+	out = sync(map[string]any{"A": "1", "B": "3", "C": "4"}, map[string]any{"dry_run": true, "delete_unmanaged": false, "keep": nil})
+	expect(out, "created", "C")
+	expect(out, "unmanaged", "KEEP_ME")
+	if puts() != before || api.secret(collection, "C") != nil {
+		t.Error("expected a dry run not to upload")
+	}
+}
+
+func TestSecretSyncStep_InvalidConfig(t *testing.T) {
+	for _, config := range []map[string]any{
+		{"owner": "o", "repo": "r", "state_dir": "/tmp/x"},
+		{"owner": "o", "repo": "r", "secrets": map[string]any{"A": "a"}},
+		{"owner": "o", "repo": "r", "secrets": map[string]any{"A": 1}, "state_dir": "/tmp/x"},
+		{"owner": "o", "repo": "r", "secrets": map[string]any{"A": "a"}, "state_dir": "/tmp/x", "visibility": "all"},
+	} {
+		config["token"] = "tok"
+		if _, err := newSecretSyncStep("sync", config); err == nil {
+			t.Errorf("%v: expected a constructor error", config)
+		}
+	}
+}
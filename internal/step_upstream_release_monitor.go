@@ -3,7 +3,6 @@ package internal
 import (
 	"context"
 	"fmt"
-	"os"
 	"time"
 
 	"github.com/google/go-github/v69/github"
@@ -21,7 +20,7 @@ type upstreamReleaseMonitorConfig struct {
 	UpstreamOwner string `yaml:"upstream_owner"`
 	UpstreamRepo  string `yaml:"upstream_repo"`
 	PinnedTag     string `yaml:"pinned_tag"`
-	Token         string `yaml:"token"`
+	Auth          stepAuth
 }
 
 type upstreamReleaseInfo struct {
@@ -35,7 +34,11 @@ type upstreamReleaseClient interface {
 	LatestRelease(ctx context.Context, owner, repo, token string) (upstreamReleaseInfo, error)
 }
 
-type githubUpstreamReleaseClient struct{}
+// githubUpstreamReleaseClient reads releases through the REST API of the
+// deployment endpoints returns.
+type githubUpstreamReleaseClient struct {
+	endpoints func() githubEndpoints
+}
 
 func newUpstreamReleaseMonitorStep(name string, raw map[string]any, client upstreamReleaseClient) (*upstreamReleaseMonitorStep, error) {
 	cfg, err := parseUpstreamReleaseMonitorConfig(raw)
@@ -43,7 +46,7 @@ func newUpstreamReleaseMonitorStep(name string, raw map[string]any, client upstr
 		return nil, fmt.Errorf("step.gh_upstream_release_monitor %q: %w", name, err)
 	}
 	if client == nil {
-		client = githubUpstreamReleaseClient{}
+		client = githubUpstreamReleaseClient{endpoints: cfg.Auth.endpoints}
 	}
 	return &upstreamReleaseMonitorStep{name: name, config: cfg, ghClient: client}, nil
 }
@@ -62,8 +65,11 @@ func parseUpstreamReleaseMonitorConfig(raw map[string]any) (upstreamReleaseMonit
 	if cfg.PinnedTag == "" {
 		return cfg, fmt.Errorf("config.pinned_tag is required")
 	}
-	cfg.Token, _ = raw["token"].(string)
-	cfg.Token = os.ExpandEnv(cfg.Token)
+	auth, err := parseStepAuth(raw)
+	if err != nil {
+		return cfg, err
+	}
+	cfg.Auth = auth
 	return cfg, nil
 }
 
@@ -79,7 +85,17 @@ func (s *upstreamReleaseMonitorStep) Execute(
 	repo := resolveField(s.config.UpstreamRepo, triggerData, stepOutputs, current)
 	pinnedTag := resolveField(s.config.PinnedTag, triggerData, stepOutputs, current)
 
-	release, err := s.ghClient.LatestRelease(ctx, owner, repo, s.config.Token)
+	// The token is optional for public upstreams; only an auth_module must resolve.
+	token := s.config.Auth.Token
+	if s.config.Auth.AuthModule != "" {
+		var err error
+		token, err = s.config.Auth.token(ctx, owner)
+		if err != nil {
+			return errorResult(err.Error()), nil
+		}
+	}
+
+	release, err := s.ghClient.LatestRelease(ctx, owner, repo, token)
 	if err != nil {
 		return errorResult(fmt.Sprintf("get latest upstream release: %v", err)), nil
 	}
@@ -103,11 +119,14 @@ func (s *upstreamReleaseMonitorStep) Execute(
 	}, nil
 }
 
-func (githubUpstreamReleaseClient) LatestRelease(ctx context.Context, owner, repo, token string) (upstreamReleaseInfo, error) {
-	client := github.NewClient(nil)
+func (c githubUpstreamReleaseClient) LatestRelease(ctx context.Context, owner, repo, token string) (upstreamReleaseInfo, error) {
+	client := github.NewClient(newGitHubHTTPClient())
 	if token != "" {
 		client = client.WithAuthToken(token)
 	}
+	if c.endpoints != nil {
+		client = (&SDKClient{GH: client}).withEndpoints(c.endpoints()).GH
+	}
 
 	requestCtx, cancel := githubReleaseLookupContext(ctx)
 	defer cancel()
@@ -0,0 +1,91 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	sdk "github.com/GoCodeAlone/workflow/plugin/external/sdk"
+)
+
+// variableDeleteStep implements sdk.StepInstance.
+// It deletes Actions configuration variables from an organization,
+// repository, or environment (see secretTarget). Variables that do not exist
+// are reported as missing rather than failing the step.
+//
+// Config:
+//
+//	owner: "GoCodeAlone"
+//	repo:  "workflow"          # omit for org-level variables
+//	names: ["OLD_REGION"]      # or name: "OLD_REGION"
+//	token: "${GITHUB_TOKEN}"
+type variableDeleteStep struct {
+	name   string
+	config variableDeleteConfig
+}
+
+type variableDeleteConfig struct {
+	Target secretTarget `yaml:",inline"`
+	Names  templateList `yaml:"names"`
+	Auth   stepAuth
+}
+
+func newVariableDeleteStep(name string, raw map[string]any) (*variableDeleteStep, error) {
+	var cfg variableDeleteConfig
+	var err error
+	if cfg.Target, err = parseVariableTarget(raw); err != nil {
+		return nil, fmt.Errorf("step.gh_variable_delete %q: %w", name, err)
+	}
+	if cfg.Names, err = parseNameList(raw); err != nil {
+		return nil, fmt.Errorf("step.gh_variable_delete %q: %w", name, err)
+	}
+	if cfg.Auth, err = parseStepAuth(raw); err != nil {
+		return nil, fmt.Errorf("step.gh_variable_delete %q: %w", name, err)
+	}
+	return &variableDeleteStep{name: name, config: cfg}, nil
+}
+
+func (s *variableDeleteStep) Execute(
+	ctx context.Context,
+	triggerData map[string]any,
+	stepOutputs map[string]map[string]any,
+	current map[string]any,
+	_ map[string]any,
+	_ map[string]any,
+) (*sdk.StepResult, error) {
+	owner := resolveField(s.config.Target.Owner, triggerData, stepOutputs, current)
+	client, err := s.config.Auth.sdkClient(owner)
+	if err != nil {
+		return errorResult(err.Error()), nil
+	}
+	scope, err := s.config.Target.resolve(triggerData, stepOutputs, current)
+	if err != nil {
+		return errorResult(err.Error()), nil
+	}
+	names, err := s.config.Names.resolve("names", triggerData, stepOutputs, current)
+	if err != nil {
+		return errorResult(err.Error()), nil
+	}
+
+	deleted, missing := []string{}, []string{}
+	for _, variableName := range names {
+		found, err := scope.deleteVariable(ctx, client.GH, variableName)
+		if err != nil {
+			if len(deleted) > 0 {
+				err = fmt.Errorf("%w (already deleted: %s)", err, strings.Join(deleted, ", "))
+			}
+			return errorResult(err.Error()), nil
+		}
+		if found {
+			deleted = append(deleted, variableName)
+		} else {
+			missing = append(missing, variableName)
+		}
+	}
+
+	output := scope.output()
+	output["deleted"] = outputList(deleted)
+	output["missing"] = outputList(missing)
+	output["count"] = len(deleted)
+	return &sdk.StepResult{Output: output}, nil
+}
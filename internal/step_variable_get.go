@@ -0,0 +1,113 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/go-github/v69/github"
+
+	sdk "github.com/GoCodeAlone/workflow/plugin/external/sdk"
+)
+
+// variableGetStep implements sdk.StepInstance.
+// It reads one Actions configuration variable from an organization,
+// repository, or environment (see secretTarget). A missing variable is
+// reported with found: false unless required is set.
+//
+// Config:
+//
+//	owner:    "GoCodeAlone"
+//	repo:     "workflow"       # omit for org-level variables
+//	name:     "DEPLOY_REGION"
+//	required: true             # fail the step when the variable is missing
+//	token:    "${GITHUB_TOKEN}"
+type variableGetStep struct {
+	name   string
+	config variableGetConfig
+}
+
+type variableGetConfig struct {
+	Target   secretTarget `yaml:",inline"`
+	Name     string       `yaml:"name"`
+	Required templateBool `yaml:"required"`
+	Auth     stepAuth
+}
+
+func newVariableGetStep(name string, raw map[string]any) (*variableGetStep, error) {
+	var cfg variableGetConfig
+	var err error
+	if cfg.Target, err = parseVariableTarget(raw); err != nil {
+		return nil, fmt.Errorf("step.gh_variable_get %q: %w", name, err)
+	}
+	cfg.Name, _ = raw["name"].(string)
+	if cfg.Name == "" {
+		return nil, fmt.Errorf("step.gh_variable_get %q: config.name is required", name)
+	}
+	if cfg.Required, err = parseTemplateBool(raw, "required"); err != nil {
+		return nil, fmt.Errorf("step.gh_variable_get %q: %w", name, err)
+	}
+	if cfg.Auth, err = parseStepAuth(raw); err != nil {
+		return nil, fmt.Errorf("step.gh_variable_get %q: %w", name, err)
+	}
+	return &variableGetStep{name: name, config: cfg}, nil
+}
+
+func (s *variableGetStep) Execute(
+	ctx context.Context,
+	triggerData map[string]any,
+	stepOutputs map[string]map[string]any,
+	current map[string]any,
+	_ map[string]any,
+	_ map[string]any,
+) (*sdk.StepResult, error) {
+	owner := resolveField(s.config.Target.Owner, triggerData, stepOutputs, current)
+	client, err := s.config.Auth.sdkClient(owner)
+	if err != nil {
+		return errorResult(err.Error()), nil
+	}
+	scope, err := s.config.Target.resolve(triggerData, stepOutputs, current)
+	if err != nil {
+		return errorResult(err.Error()), nil
+	}
+	required, err := s.config.Required.resolve("required", triggerData, stepOutputs, current)
+	if err != nil {
+		return errorResult(err.Error()), nil
+	}
+	variableName := resolveField(s.config.Name, triggerData, stepOutputs, current)
+	variable, err := scope.getVariable(ctx, client.GH, variableName)
+	if err != nil {
+		return errorResult(err.Error()), nil
+	}
+	if variable == nil && required {
+		return errorResult(fmt.Sprintf("variable %q of %s not found", variableName, scope)), nil
+	}
+
+	output := scope.output()
+	output["name"] = variableName
+	output["found"] = variable != nil
+	if variable != nil {
+		for key, value := range variableOutput(variable) {
+			output[key] = value
+		}
+	}
+	return &sdk.StepResult{Output: output}, nil
+}
+
+// variableOutput returns a variable's fields for a step result.
+func variableOutput(variable *github.ActionsVariable) map[string]any {
+	out := map[string]any{
+		"name":  variable.Name,
+		"value": variable.Value,
+	}
+	if variable.CreatedAt != nil {
+		out["created_at"] = variable.CreatedAt.UTC().Format(time.RFC3339)
+	}
+	if variable.UpdatedAt != nil {
+		out["updated_at"] = variable.UpdatedAt.UTC().Format(time.RFC3339)
+	}
+	if variable.GetVisibility() != "" {
+		out["visibility"] = variable.GetVisibility()
+	}
+	return out
+}
@@ -0,0 +1,78 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/GoCodeAlone/workflow/plugin/external/sdk"
+)
+
+// variableListStep implements sdk.StepInstance.
+// It lists the Actions configuration variables of an organization,
+// repository, or environment (see secretTarget), with their values.
+//
+// Config:
+//
+//	owner: "GoCodeAlone"
+//	repo:  "workflow"      # omit for org-level variables
+//	token: "${GITHUB_TOKEN}"
+type variableListStep struct {
+	name   string
+	config variableListConfig
+}
+
+type variableListConfig struct {
+	Target secretTarget `yaml:",inline"`
+	Auth   stepAuth
+}
+
+func newVariableListStep(name string, raw map[string]any) (*variableListStep, error) {
+	var cfg variableListConfig
+	var err error
+	if cfg.Target, err = parseVariableTarget(raw); err != nil {
+		return nil, fmt.Errorf("step.gh_variable_list %q: %w", name, err)
+	}
+	if cfg.Auth, err = parseStepAuth(raw); err != nil {
+		return nil, fmt.Errorf("step.gh_variable_list %q: %w", name, err)
+	}
+	return &variableListStep{name: name, config: cfg}, nil
+}
+
+func (s *variableListStep) Execute(
+	ctx context.Context,
+	triggerData map[string]any,
+	stepOutputs map[string]map[string]any,
+	current map[string]any,
+	_ map[string]any,
+	_ map[string]any,
+) (*sdk.StepResult, error) {
+	owner := resolveField(s.config.Target.Owner, triggerData, stepOutputs, current)
+	client, err := s.config.Auth.sdkClient(owner)
+	if err != nil {
+		return errorResult(err.Error()), nil
+	}
+	scope, err := s.config.Target.resolve(triggerData, stepOutputs, current)
+	if err != nil {
+		return errorResult(err.Error()), nil
+	}
+	variables, err := scope.listVariables(ctx, client.GH)
+	if err != nil {
+		return errorResult(err.Error()), nil
+	}
+
+	items := make([]any, 0, len(variables))
+	names := make([]string, 0, len(variables))
+	values := make(map[string]any, len(variables))
+	for _, variable := range variables {
+		items = append(items, variableOutput(variable))
+		names = append(names, variable.Name)
+		values[variable.Name] = variable.Value
+	}
+
+	output := scope.output()
+	output["variables"] = items
+	output["names"] = outputList(names)
+	output["values"] = values
+	output["count"] = len(names)
+	return &sdk.StepResult{Output: output}, nil
+}
@@ -0,0 +1,167 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	sdk "github.com/GoCodeAlone/workflow/plugin/external/sdk"
+)
+
+// variableSetStep implements sdk.StepInstance.
+// It creates or updates one Actions configuration variable, or many from a
+// map, in an organization, repository, or environment (see secretTarget).
+// Variables are not secret: values are stored and returned in plain text.
+//
+// Config:
+//
+//	owner:  "GoCodeAlone"
+//	repo:   "workflow"          # omit for org-level variables
+//	name:   "DEPLOY_REGION"
+//	value:  "us-east-1"
+//	variables:                  # instead of name/value
+//	  DEPLOY_REGION: "us-east-1"
+//	visibility: "selected"      # org variables: all, private, selected
+//	selected_repositories: ["workflow"]
+//	token:  "${GITHUB_TOKEN}"
+type variableSetStep struct {
+	name   string
+	config variableSetConfig
+}
+
+type variableSetConfig struct {
+	Target               secretTarget      `yaml:",inline"`
+	Name                 string            `yaml:"name"`
+	Value                string            `yaml:"value"`
+	Variables            map[string]string `yaml:"variables"`
+	Visibility           string            `yaml:"visibility"`
+	SelectedRepositories templateList      `yaml:"selected_repositories"`
+	Auth                 stepAuth
+}
+
+func newVariableSetStep(name string, raw map[string]any) (*variableSetStep, error) {
+	var cfg variableSetConfig
+	var err error
+	if cfg.Target, err = parseVariableTarget(raw); err != nil {
+		return nil, fmt.Errorf("step.gh_variable_set %q: %w", name, err)
+	}
+	cfg.Name, _ = raw["name"].(string)
+	cfg.Value, _ = raw["value"].(string)
+	if variables, ok := raw["variables"].(map[string]any); ok {
+		cfg.Variables = make(map[string]string, len(variables))
+		for key, value := range variables {
+			str, ok := value.(string)
+			if !ok {
+				return nil, fmt.Errorf("step.gh_variable_set %q: config.variables.%s must be a string", name, key)
+			}
+			cfg.Variables[key] = str
+		}
+	}
+	switch {
+	case cfg.Name == "" && len(cfg.Variables) == 0:
+		return nil, fmt.Errorf("step.gh_variable_set %q: config.name or config.variables is required", name)
+	case cfg.Name != "" && len(cfg.Variables) > 0:
+		return nil, fmt.Errorf("step.gh_variable_set %q: config.name and config.variables are mutually exclusive", name)
+	}
+	cfg.Visibility, _ = raw["visibility"].(string)
+	if cfg.SelectedRepositories, err = parseTemplateList(raw, "selected_repositories"); err != nil {
+		return nil, fmt.Errorf("step.gh_variable_set %q: %w", name, err)
+	}
+	if !cfg.Target.isOrg() && (cfg.Visibility != "" || len(cfg.SelectedRepositories.Items) > 0 || cfg.SelectedRepositories.Ref != "") {
+		return nil, fmt.Errorf("step.gh_variable_set %q: config.visibility and config.selected_repositories only apply to organization variables", name)
+	}
+	if cfg.Visibility != "" && !strings.Contains(cfg.Visibility, "{{") {
+		if err := validateSecretVisibility(cfg.Visibility); err != nil {
+			return nil, fmt.Errorf("step.gh_variable_set %q: %w", name, err)
+		}
+	}
+	if cfg.Auth, err = parseStepAuth(raw); err != nil {
+		return nil, fmt.Errorf("step.gh_variable_set %q: %w", name, err)
+	}
+	return &variableSetStep{name: name, config: cfg}, nil
+}
+
+func (s *variableSetStep) Execute(
+	ctx context.Context,
+	triggerData map[string]any,
+	stepOutputs map[string]map[string]any,
+	current map[string]any,
+	_ map[string]any,
+	_ map[string]any,
+) (*sdk.StepResult, error) {
+	owner := resolveField(s.config.Target.Owner, triggerData, stepOutputs, current)
+	client, err := s.config.Auth.sdkClient(owner)
+	if err != nil {
+		return errorResult(err.Error()), nil
+	}
+	scope, err := s.config.Target.resolve(triggerData, stepOutputs, current)
+	if err != nil {
+		return errorResult(err.Error()), nil
+	}
+
+	variables := make(map[string]string, len(s.config.Variables)+1)
+	if s.config.Name != "" {
+		variableName := resolveField(s.config.Name, triggerData, stepOutputs, current)
+		variables[variableName] = resolveField(s.config.Value, triggerData, stepOutputs, current)
+	}
+	for variableName, value := range s.config.Variables {
+		variables[variableName] = resolveField(value, triggerData, stepOutputs, current)
+	}
+	names := make([]string, 0, len(variables))
+	for variableName := range variables {
+		names = append(names, variableName)
+	}
+	sort.Strings(names)
+
+	var access orgSecretAccess
+	if scope.kind() == "org" {
+		if s.config.Visibility != "" {
+			access.visibility = resolveField(s.config.Visibility, triggerData, stepOutputs, current)
+			if err := validateSecretVisibility(access.visibility); err != nil {
+				return errorResult(err.Error()), nil
+			}
+		}
+		repos, err := s.config.SelectedRepositories.resolve("selected_repositories", triggerData, stepOutputs, current)
+		if err != nil {
+			return errorResult(err.Error()), nil
+		}
+		switch {
+		case access.visibility == "selected" && len(repos) == 0:
+			return errorResult("config.selected_repositories is required when visibility is selected"), nil
+		case access.visibility != "selected" && len(repos) > 0:
+			return errorResult("config.selected_repositories requires visibility selected"), nil
+		}
+		if len(repos) > 0 {
+			if access.repoIDs, err = selectedRepositoryIDs(ctx, client.GH, scope.owner, repos); err != nil {
+				return errorResult(err.Error()), nil
+			}
+		}
+	}
+
+	created, updated := []string{}, []string{}
+	for i, variableName := range names {
+		isNew, err := scope.putVariable(ctx, client.GH, variableName, variables[variableName], access)
+		if err != nil {
+			if i > 0 {
+				err = fmt.Errorf("%w (already set: %s)", err, strings.Join(names[:i], ", "))
+			}
+			return errorResult(err.Error()), nil
+		}
+		if isNew {
+			created = append(created, variableName)
+		} else {
+			updated = append(updated, variableName)
+		}
+	}
+
+	output := scope.output()
+	output["names"] = outputList(names)
+	output["created"] = outputList(created)
+	output["updated"] = outputList(updated)
+	output["count"] = len(names)
+	if s.config.Name != "" {
+		output["name"] = names[0]
+	}
+	return &sdk.StepResult{Output: output}, nil
+}
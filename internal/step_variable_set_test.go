@@ -0,0 +1,100 @@
+package internal
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestVariableSteps_Lifecycle(t *testing.T) {
+	api, srv := newFakeSecretsAPI(t)
+	target := func(config map[string]any) map[string]any {
+		config["owner"] = "GoCodeAlone"
+		config["repo"] = "workflow"
+		config["environment"] = "staging"
+		config["token"] = "tok"
+		config["api_base_url"] = srv.URL
+		return config
+	}
+	collection := "/repos/GoCodeAlone/workflow/environments/staging/variables"
+
+	set, err := newVariableSetStep("set", target(map[string]any{"variables": map[string]any{"REGION": "us-east-1", "TIER": "small"}}))
+	if err != nil {
+		t.Fatalf("newVariableSetStep: %v", err)
+	}
+	out := runSecretStep(t, set)
+	if !reflect.DeepEqual(out["created"], []any{"REGION", "TIER"}) || len(out["updated"].([]any)) != 0 {
+		t.Errorf("unexpected first set output %v", out)
+	}
+	out = runSecretStep(t, set)
+	if !reflect.DeepEqual(out["updated"], []any{"REGION", "TIER"}) || len(out["created"].([]any)) != 0 {
+		t.Errorf("unexpected second set output %v", out)
+	}
+
+	get, err := newVariableGetStep("get", target(map[string]any{"name": "REGION"}))
+	if err != nil {
+		t.Fatalf("newVariableGetStep: %v", err)
+	}
+	if out = runSecretStep(t, get); out["found"] != true || out["value"] != "us-east-1" || out["updated_at"] != "2024-01-02T03:04:05Z" {
+		t.Errorf("unexpected get output %v", out)
+	}
+
+	list, err := newVariableListStep("list", target(map[string]any{}))
+	if err != nil {
+		t.Fatalf("newVariableListStep: %v", err)
+	}
+	if out = runSecretStep(t, list); !reflect.DeepEqual(out["values"], map[string]any{"REGION": "us-east-1", "TIER": "small"}) {
+		t.Errorf("unexpected list output %v", out)
+	}
+
+	del, err := newVariableDeleteStep("delete", target(map[string]any{"names": "TIER,GONE"}))
+	if err != nil {
+		t.Fatalf("newVariableDeleteStep: %v", err)
+	}
+	out = runSecretStep(t, del)
+	if !reflect.DeepEqual(out["deleted"], []any{"TIER"}) || !reflect.DeepEqual(out["missing"], []any{"GONE"}) {
+		t.Errorf("unexpected delete output %v", out)
+	}
+	if api.variable(collection, "TIER") != nil || api.variable(collection, "REGION") == nil {
+		t.Error("expected only TIER to be deleted")
+	}
+
+	get, err = newVariableGetStep("get", target(map[string]any{"name": "TIER", "required": true}))
+	if err != nil {
+		t.Fatalf("newVariableGetStep: %v", err)
+	}
+	if result, _ := get.Execute(t.Context(), nil, nil, nil, nil, nil); !result.StopPipeline {
+		t.Errorf("expected a required missing variable to fail, got %v", result.Output)
+	}
+}
+
+func TestVariableSetStep_OrgVisibility(t *testing.T) {
+	api, srv := newFakeSecretsAPI(t)
+	step, err := newVariableSetStep("set", map[string]any{
+		"owner":                 "GoCodeAlone",
+		"name":                  "REGION",
+		"value":                 "eu-west-1",
+		"visibility":            "selected",
+		"selected_repositories": []any{"workflow"},
+		"token":                 "tok",
+		"api_base_url":          srv.URL,
+	})
+	if err != nil {
+		t.Fatalf("newVariableSetStep: %v", err)
+	}
+	runSecretStep(t, step)
+	v := api.variable("/orgs/GoCodeAlone/actions/variables", "REGION")
+	if v == nil || v.value != "eu-west-1" || v.visibility != "selected" || !reflect.DeepEqual(v.repoIDs, []int64{108}) {
+		t.Errorf("unexpected org variable %+v", v)
+	}
+
+	for _, config := range []map[string]any{
+		{"owner": "o", "name": "A", "app": "dependabot"},
+		{"owner": "o", "repo": "r", "name": "A", "visibility": "all"},
+		{"owner": "o", "name": "A", "variables": map[string]any{"B": "b"}},
+	} {
+		config["token"] = "tok"
+		if _, err := newVariableSetStep("set", config); err == nil {
+			t.Errorf("%v: expected a constructor error", config)
+		}
+	}
+}
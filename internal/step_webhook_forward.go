@@ -0,0 +1,48 @@
+package internal
+
+import (
+	"context"
+
+	sdk "github.com/GoCodeAlone/workflow/plugin/external/sdk"
+)
+
+// webhookForwardStep implements sdk.StepInstance.
+// It returns the loopback URL at which the plugin serves the git.webhook
+// module for this request, as backend_url. A following step.http_proxy
+// forwards the untouched request there, so the module validates the
+// signature against the raw body whatever the delivery's content type.
+//
+// Config:
+//
+//	module: "github-webhooks"   # optional; defaults to the module serving the request path
+type webhookForwardStep struct {
+	name   string
+	module string
+}
+
+func newWebhookForwardStep(name string, raw map[string]any) (*webhookForwardStep, error) {
+	module, _ := raw["module"].(string)
+	return &webhookForwardStep{name: name, module: module}, nil
+}
+
+func (s *webhookForwardStep) Execute(
+	_ context.Context,
+	triggerData map[string]any,
+	_ map[string]map[string]any,
+	_ map[string]any,
+	_ map[string]any,
+	_ map[string]any,
+) (*sdk.StepResult, error) {
+	mod, err := requestWebhookModule(s.module, triggerData)
+	if err != nil {
+		return errorResult(err.Error()), nil
+	}
+	backendURL, err := webhookForwardURL(mod.name)
+	if err != nil {
+		return errorResult(err.Error()), nil
+	}
+	return &sdk.StepResult{Output: map[string]any{
+		"module":      mod.name,
+		"backend_url": backendURL,
+	}}, nil
+}
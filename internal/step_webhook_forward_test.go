@@ -0,0 +1,124 @@
+package internal
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+// deliverThroughRoute runs a delivery through the shipped /webhooks/github
+// route the way the engine does: step.gh_webhook_forward resolves the
+// module's loopback URL, and step.http_proxy posts the untouched body there
+// with the forwarded headers. It returns the response status and body.
+func deliverThroughRoute(t *testing.T, contentType, body string, headers map[string]string) (int, string) {
+	t.Helper()
+	step, err := newWebhookForwardStep("route", map[string]any{})
+	if err != nil {
+		t.Fatalf("newWebhookForwardStep: %v", err)
+	}
+	result, err := step.Execute(context.Background(), map[string]any{"method": http.MethodPost, "path": defaultWebhookPath}, nil, nil, nil, nil)
+	if err != nil {
+		t.Fatalf("Execute: %v", err)
+	}
+	requireEncodableOutput(t, result.Output)
+	backendURL, _ := result.Output["backend_url"].(string)
+	if backendURL == "" {
+		t.Fatalf("expected a backend_url, got %#v", result.Output)
+	}
+
+	req, err := http.NewRequest(http.MethodPost, backendURL, strings.NewReader(body))
+	if err != nil {
+		t.Fatalf("NewRequest: %v", err)
+	}
+	req.Header.Set("Content-Type", contentType)
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("forward delivery: %v", err)
+	}
+	defer resp.Body.Close()
+	respBody, _ := io.ReadAll(resp.Body)
+	return resp.StatusCode, string(respBody)
+}
+
+func TestWebhookRoute_SignedDelivery(t *testing.T) {
+	secret := "route-secret"
+	_, pub := newRunningWebhookModule(t, "route-hooks", map[string]any{"secret": secret})
+	event := `{"ref":"refs/heads/main","after":"abc123","repository":{"full_name":"owner/repo"}}`
+	form := url.Values{"payload": {event}}.Encode()
+
+	headers := map[string]string{"X-GitHub-Event": "push", "X-GitHub-Delivery": "delivery-1", "X-Hub-Signature-256": signBody(secret, []byte(form))}
+	if status, body := deliverThroughRoute(t, "application/x-www-form-urlencoded", form, headers); status != http.StatusOK || !strings.Contains(body, "accepted") {
+		t.Fatalf("expected a signed form delivery to be accepted, got %d %s", status, body)
+	}
+
+	headers["X-GitHub-Delivery"] = "delivery-2"
+	headers["X-Hub-Signature-256"] = signBody(secret, []byte(event))
+	if status, body := deliverThroughRoute(t, "application/json", event, headers); status != http.StatusOK || !strings.Contains(body, "accepted") {
+		t.Fatalf("expected a signed JSON delivery to be accepted, got %d %s", status, body)
+	}
+	if len(pub.messages) != 2 {
+		t.Errorf("expected 2 published messages, got %d", len(pub.messages))
+	}
+	if !bytes.Contains(pub.messages[1].payload, []byte(`"repository":"owner/repo"`)) {
+		t.Errorf("unexpected event: %s", pub.messages[1].payload)
+	}
+
+	headers["X-GitHub-Delivery"] = "delivery-3"
+	headers["X-Hub-Signature-256"] = signBody("wrong", []byte(event))
+	if status, body := deliverThroughRoute(t, "application/json", event, headers); status != http.StatusUnauthorized {
+		t.Errorf("expected 401 for a bad signature, got %d %s", status, body)
+	}
+}
+
+func TestWebhookForward_UnknownModule(t *testing.T) {
+	step, err := newWebhookForwardStep("route", map[string]any{"module": "missing-hooks"})
+	if err != nil {
+		t.Fatalf("newWebhookForwardStep: %v", err)
+	}
+	result, err := step.Execute(context.Background(), map[string]any{"path": defaultWebhookPath}, nil, nil, nil, nil)
+	if err != nil {
+		t.Fatalf("Execute: %v", err)
+	}
+	if !result.StopPipeline || result.Output["backend_url"] != nil {
+		t.Errorf("expected an unknown module to stop the pipeline, got %#v", result.Output)
+	}
+
+	url, err := webhookForwardURL("missing-hooks")
+	if err != nil {
+		t.Fatalf("webhookForwardURL: %v", err)
+	}
+	resp, err := http.Post(url, "application/json", strings.NewReader(`{}`))
+	if err != nil {
+		t.Fatalf("post: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusNotFound {
+		t.Errorf("expected 404 for a module that is not running, got %d", resp.StatusCode)
+	}
+	tokenless := url[:strings.LastIndex(url[:strings.LastIndex(url, "/")], "/")] + "/missing-hooks"
+	if resp, err := http.Post(tokenless, "application/json", strings.NewReader(`{}`)); err == nil {
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusNotFound {
+			t.Errorf("expected 404 without the forwarding token, got %d", resp.StatusCode)
+		}
+	}
+}
+
+func TestWebhookRouteConfig_UsesForwardStep(t *testing.T) {
+	fragment, err := (&githubPlugin{}).ConfigFragment()
+	if err != nil {
+		t.Fatalf("ConfigFragment: %v", err)
+	}
+	for _, want := range []string{"path: " + defaultWebhookPath, "type: step.gh_webhook_forward", "type: step.http_proxy", "backend_url_key: backend_url", "X-Hub-Signature-256"} {
+		if !strings.Contains(string(fragment), want) {
+			t.Errorf("config fragment missing %q:\n%s", want, fragment)
+		}
+	}
+}
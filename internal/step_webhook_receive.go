@@ -0,0 +1,202 @@
+package internal
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	sdk "github.com/GoCodeAlone/workflow/plugin/external/sdk"
+)
+
+// webhookReceiveStep implements sdk.StepInstance.
+// It hands an HTTP-triggered GitHub webhook request to a git.webhook module,
+// which validates the signature, filters and normalizes the event, and
+// publishes it to the module's topic. The step answers the request with the
+// module's status code.
+//
+// Headers are read from the output of a preceding step.request_parse
+// (parse_headers: [X-GitHub-Event, X-GitHub-Delivery, X-Hub-Signature-256,
+// X-Hub-Signature])
+// unless overridden in config. The signature covers the raw request body,
+// which reaches plugin steps only when an earlier engine step cached it:
+// step.request_parse with parse_body does so for form-encoded deliveries
+// (content type application/x-www-form-urlencoded), step.webhook_verify for
+// any delivery. JSON deliveries are otherwise passed as the parsed body, so a
+// module with secrets rejects them unless the raw body is given in payload.
+//
+// Config:
+//
+//	module:             "github-webhooks"   # optional; defaults to the module serving the request path
+//	event_type:         "push"              # optional; defaults to the X-GitHub-Event header
+//	delivery_id:        ""                  # optional; defaults to the X-GitHub-Delivery header
+//	signature:          ""                  # optional; defaults to the X-Hub-Signature-256 header
+//	signature_sha1:     ""                  # optional; defaults to the X-Hub-Signature header
+//	payload:            ""                  # optional raw request body
+//	signature_verified: true                # optional; skips the module's own HMAC check
+type webhookReceiveStep struct {
+	name   string
+	config webhookReceiveConfig
+}
+
+type webhookReceiveConfig struct {
+	Module            string `yaml:"module"`
+	EventType         string `yaml:"event_type"`
+	DeliveryID        string `yaml:"delivery_id"`
+	Signature         string `yaml:"signature"`
+	SignatureSHA1     string `yaml:"signature_sha1"`
+	Payload           string `yaml:"payload"`
+	SignatureVerified bool   `yaml:"signature_verified"`
+}
+
+func newWebhookReceiveStep(name string, raw map[string]any) (*webhookReceiveStep, error) {
+	var cfg webhookReceiveConfig
+	cfg.Module, _ = raw["module"].(string)
+	cfg.EventType, _ = raw["event_type"].(string)
+	cfg.DeliveryID, _ = raw["delivery_id"].(string)
+	cfg.Signature, _ = raw["signature"].(string)
+	cfg.SignatureSHA1, _ = raw["signature_sha1"].(string)
+	cfg.Payload, _ = raw["payload"].(string)
+	cfg.SignatureVerified, _ = raw["signature_verified"].(bool)
+	return &webhookReceiveStep{name: name, config: cfg}, nil
+}
+
+func (s *webhookReceiveStep) Execute(
+	_ context.Context,
+	triggerData map[string]any,
+	stepOutputs map[string]map[string]any,
+	current map[string]any,
+	metadata map[string]any,
+	_ map[string]any,
+) (*sdk.StepResult, error) {
+	mod, err := requestWebhookModule(s.config.Module, triggerData)
+	if err != nil {
+		return errorResult(err.Error()), nil
+	}
+
+	headers, _ := current["headers"].(map[string]any)
+	delivery := webhookDelivery{
+		EventType:         s.field(s.config.EventType, "X-GitHub-Event", headers, triggerData, stepOutputs, current),
+		DeliveryID:        s.field(s.config.DeliveryID, "X-GitHub-Delivery", headers, triggerData, stepOutputs, current),
+		Signature:         s.field(s.config.Signature, "X-Hub-Signature-256", headers, triggerData, stepOutputs, current),
+		SignatureSHA1:     s.field(s.config.SignatureSHA1, "X-Hub-Signature", headers, triggerData, stepOutputs, current),
+		SignatureVerified: s.config.SignatureVerified,
+	}
+	if s.config.Payload != "" {
+		delivery.Body = []byte(resolveField(s.config.Payload, triggerData, stepOutputs, current))
+	} else if raw, ok := rawRequestBody(metadata); ok {
+		delivery.Body = raw
+	} else {
+		// A re-encoded body never matches the signature GitHub computed.
+		if len(mod.config.Secrets) > 0 && !delivery.SignatureVerified && (delivery.Signature != "" || delivery.SignatureSHA1 != "") {
+			return webhookResponse(http.StatusUnsupportedMediaType, "",
+				"raw request body unavailable for signature validation; send the webhook as application/x-www-form-urlencoded or run step.webhook_verify first"), nil
+		}
+		body, ok := triggerData["body"].(map[string]any)
+		if !ok {
+			return webhookResponse(http.StatusBadRequest, "", "missing JSON request body"), nil
+		}
+		delivery.Body, err = json.Marshal(body)
+		if err != nil {
+			return webhookResponse(http.StatusBadRequest, "", fmt.Sprintf("marshal request body: %v", err)), nil
+		}
+	}
+
+	outcome := mod.receive(delivery)
+	if outcome.Err != "" {
+		return webhookResponse(outcome.Status, "", outcome.Err), nil
+	}
+
+	result := webhookResponse(outcome.Status, outcome.Result, "")
+	result.Output["module"] = mod.name
+	result.Output["event_type"] = delivery.EventType
+	result.Output["delivery_id"] = delivery.DeliveryID
+	if outcome.Event != nil {
+		result.Output["topic"] = outcome.Topic
+		result.Output["secret_name"] = outcome.Secret
+		result.Output["action"] = outcome.Event.Action
+		result.Output["repository"] = outcome.Event.Repository
+		result.Output["branch"] = outcome.Event.Branch
+		result.Output["base_branch"] = outcome.Event.BaseBranch
+		result.Output["tag"] = outcome.Event.Tag
+		result.Output["commit"] = outcome.Event.Commit
+		result.Output["author"] = outcome.Event.Author
+		result.Output["sender"] = outcome.Event.Sender
+		result.Output["pr_number"] = outcome.Event.PRNumber
+		result.Output["issue_number"] = outcome.Event.IssueNumber
+		result.Output["labels"] = outputList(outcome.Event.Labels)
+		result.Output["conclusion"] = outcome.Event.Conclusion
+	}
+	return result, nil
+}
+
+// requestWebhookModule selects the git.webhook module that owns a request:
+// the module called name, or else the one serving the request path.
+func requestWebhookModule(name string, triggerData map[string]any) (*webhookModule, error) {
+	if name != "" {
+		mod, ok := lookupWebhookModule(name)
+		if !ok {
+			return nil, fmt.Errorf("module %q does not name a running git.webhook module", name)
+		}
+		return mod, nil
+	}
+	path, _ := triggerData["path"].(string)
+	if path == "" {
+		path = defaultWebhookPath
+	}
+	return lookupWebhookModuleByPath(path)
+}
+
+// rawRequestBody returns the raw request body an engine step cached in the
+// pipeline metadata. Plugin steps receive it base64-encoded.
+func rawRequestBody(metadata map[string]any) ([]byte, bool) {
+	switch v := metadata["_raw_body"].(type) {
+	case []byte:
+		return v, len(v) > 0
+	case string:
+		body, err := base64.StdEncoding.DecodeString(v)
+		return body, err == nil && len(body) > 0
+	}
+	return nil, false
+}
+
+// field returns the resolved config value, falling back to the named request
+// header from a preceding step.request_parse.
+func (s *webhookReceiveStep) field(value, header string, headers map[string]any, triggerData map[string]any, stepOutputs map[string]map[string]any, current map[string]any) string {
+	if value != "" {
+		return resolveField(value, triggerData, stepOutputs, current)
+	}
+	for k, v := range headers {
+		if strings.EqualFold(k, header) {
+			str, _ := v.(string)
+			return str
+		}
+	}
+	return ""
+}
+
+// webhookResponse builds a step result that answers the webhook request.
+// Rejected deliveries stop the pipeline.
+func webhookResponse(status int, result, errMsg string) *sdk.StepResult {
+	if errMsg != "" {
+		return &sdk.StepResult{
+			StopPipeline: true,
+			Output: map[string]any{
+				"response_status":  status,
+				"response_body":    fmt.Sprintf(`{"error":%q}`, errMsg),
+				"response_headers": map[string]any{"Content-Type": "application/json"},
+				"error":            errMsg,
+			},
+		}
+	}
+	return &sdk.StepResult{
+		Output: map[string]any{
+			"status":           result,
+			"response_status":  status,
+			"response_body":    fmt.Sprintf(`{"status":%q}`, result),
+			"response_headers": map[string]any{"Content-Type": "application/json"},
+		},
+	}
+}
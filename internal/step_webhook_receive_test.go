@@ -0,0 +1,276 @@
+package internal
+
+import (
+	"context"
+	"encoding/base64"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// newRunningWebhookModule returns an initialised git.webhook module with a
+// fake publisher, unregistered when the test ends.
+func newRunningWebhookModule(t *testing.T, name string, config map[string]any) (*webhookModule, *fakePublisher) {
+	t.Helper()
+	m, err := newWebhookModule(name, config)
+	if err != nil {
+		t.Fatalf("newWebhookModule: %v", err)
+	}
+	pub := &fakePublisher{}
+	m.SetMessagePublisher(pub)
+	if err := m.Init(); err != nil {
+		t.Fatalf("Init: %v", err)
+	}
+	t.Cleanup(func() { _ = m.Stop(context.Background()) })
+	return m, pub
+}
+
+func executeWebhookReceive(t *testing.T, config, triggerData, current map[string]any) map[string]any {
+	t.Helper()
+	step, err := newWebhookReceiveStep("receive", config)
+	if err != nil {
+		t.Fatalf("newWebhookReceiveStep: %v", err)
+	}
+	result, err := step.Execute(context.Background(), triggerData, nil, current, nil, nil)
+	if err != nil {
+		t.Fatalf("Execute: %v", err)
+	}
+	requireEncodableOutput(t, result.Output)
+	return result.Output
+}
+
+// requireEncodableOutput fails the test unless output survives the structpb
+// encoding the SDK applies to every step result.
+func requireEncodableOutput(t *testing.T, output map[string]any) {
+	t.Helper()
+	if _, err := structpb.NewStruct(output); err != nil {
+		t.Fatalf("step output cannot be encoded: %v\n%#v", err, output)
+	}
+}
+
+func pushTrigger(path string) map[string]any {
+	return map[string]any{
+		"method": http.MethodPost,
+		"path":   path,
+		"body": map[string]any{
+			"ref":        "refs/heads/main",
+			"after":      "abc123",
+			"repository": map[string]any{"full_name": "owner/repo"},
+		},
+	}
+}
+
+func pushHeaders() map[string]any {
+	return map[string]any{"headers": map[string]any{
+		"X-GitHub-Event":    "push",
+		"X-GitHub-Delivery": "delivery-1",
+	}}
+}
+
+func TestWebhookReceive_RoutesByPath(t *testing.T) {
+	_, pubA := newRunningWebhookModule(t, "hooks-a", map[string]any{"path": "/webhooks/github/a", "topic": "a.events"})
+	_, pubB := newRunningWebhookModule(t, "hooks-b", map[string]any{"path": "/webhooks/github/b", "topic": "b.events"})
+
+	out := executeWebhookReceive(t, map[string]any{}, pushTrigger("/webhooks/github/b"), pushHeaders())
+	if out["status"] != "accepted" {
+		t.Fatalf("expected status=accepted, got %#v", out)
+	}
+	if out["module"] != "hooks-b" {
+		t.Errorf("expected module=hooks-b, got %v", out["module"])
+	}
+	if out["repository"] != "owner/repo" || out["branch"] != "main" || out["delivery_id"] != "delivery-1" {
+		t.Errorf("unexpected event fields: %#v", out)
+	}
+	if len(pubA.messages) != 0 {
+		t.Errorf("expected no messages for hooks-a, got %d", len(pubA.messages))
+	}
+	if len(pubB.messages) != 1 || pubB.messages[0].topic != "b.events" {
+		t.Fatalf("expected one message on b.events, got %#v", pubB.messages)
+	}
+}
+
+func TestWebhookReceive_LabelsOutput(t *testing.T) {
+	newRunningWebhookModule(t, "label-hooks", map[string]any{"path": "/hooks/labels"})
+	trigger := map[string]any{
+		"method": http.MethodPost,
+		"path":   "/hooks/labels",
+		"body": map[string]any{
+			"action":     "labeled",
+			"issue":      map[string]any{"number": 3, "labels": []any{map[string]any{"name": "bug"}, map[string]any{"name": "ci"}}},
+			"repository": map[string]any{"full_name": "owner/repo"},
+		},
+	}
+	current := map[string]any{"headers": map[string]any{"X-GitHub-Event": "issues", "X-GitHub-Delivery": "delivery-labels"}}
+
+	out := executeWebhookReceive(t, map[string]any{}, trigger, current)
+	labels, _ := out["labels"].([]any)
+	if out["status"] != "accepted" || len(labels) != 2 || labels[0] != "bug" || labels[1] != "ci" {
+		t.Errorf("expected labels [bug ci], got %#v", out)
+	}
+}
+
+func TestWebhookReceive_ExplicitModule(t *testing.T) {
+	_, pub := newRunningWebhookModule(t, "explicit-hooks", map[string]any{"path": "/hooks/explicit"})
+
+	out := executeWebhookReceive(t, map[string]any{"module": "explicit-hooks", "event_type": "push"}, pushTrigger("/elsewhere"), nil)
+	if out["status"] != "accepted" {
+		t.Fatalf("expected status=accepted, got %#v", out)
+	}
+	if len(pub.messages) != 1 {
+		t.Errorf("expected 1 published message, got %d", len(pub.messages))
+	}
+}
+
+func TestWebhookReceive_UnknownModule(t *testing.T) {
+	out := executeWebhookReceive(t, map[string]any{"module": "missing-hooks"}, pushTrigger(defaultWebhookPath), pushHeaders())
+	if out["error"] == nil {
+		t.Errorf("expected error output, got %#v", out)
+	}
+}
+
+func TestWebhookReceive_AmbiguousPath(t *testing.T) {
+	newRunningWebhookModule(t, "dup-1", map[string]any{"path": "/hooks/dup"})
+	newRunningWebhookModule(t, "dup-2", map[string]any{"path": "/hooks/dup"})
+
+	out := executeWebhookReceive(t, map[string]any{}, pushTrigger("/hooks/dup"), pushHeaders())
+	if errMsg, _ := out["error"].(string); !strings.Contains(errMsg, "set module explicitly") {
+		t.Errorf("expected ambiguity error, got %#v", out)
+	}
+}
+
+func TestWebhookReceive_SignatureFromRawPayload(t *testing.T) {
+	secret := "my-secret"
+	newRunningWebhookModule(t, "signed-hooks", map[string]any{"path": "/hooks/signed", "secret": secret})
+	raw := `{"ref": "refs/heads/main", "repository": {"full_name": "owner/repo"}}`
+
+	current := pushHeaders()
+	current["headers"].(map[string]any)["X-Hub-Signature-256"] = signBody(secret, []byte(raw))
+	out := executeWebhookReceive(t, map[string]any{"payload": raw}, pushTrigger("/hooks/signed"), current)
+	if out["status"] != "accepted" {
+		t.Fatalf("expected status=accepted, got %#v", out)
+	}
+
+	current["headers"].(map[string]any)["X-Hub-Signature-256"] = signBody("wrong", []byte(raw))
+	out = executeWebhookReceive(t, map[string]any{"payload": raw}, pushTrigger("/hooks/signed"), current)
+	if out["response_status"] != http.StatusUnauthorized {
+		t.Errorf("expected 401 for a bad signature, got %#v", out)
+	}
+}
+
+func TestWebhookReceive_MissingSignatureStopsPipeline(t *testing.T) {
+	newRunningWebhookModule(t, "secret-hooks", map[string]any{"path": "/hooks/secret", "secret": "s3cret"})
+
+	step, err := newWebhookReceiveStep("receive", map[string]any{})
+	if err != nil {
+		t.Fatalf("newWebhookReceiveStep: %v", err)
+	}
+	result, err := step.Execute(context.Background(), pushTrigger("/hooks/secret"), nil, pushHeaders(), nil, nil)
+	if err != nil {
+		t.Fatalf("Execute: %v", err)
+	}
+	if !result.StopPipeline {
+		t.Error("expected StopPipeline=true for an unsigned delivery")
+	}
+	if result.Output["response_status"] != http.StatusUnauthorized {
+		t.Errorf("expected response_status=401, got %v", result.Output["response_status"])
+	}
+}
+
+func TestWebhookReceive_SignatureVerifiedUpstream(t *testing.T) {
+	_, pub := newRunningWebhookModule(t, "verified-hooks", map[string]any{"path": "/hooks/verified", "secret": "s3cret"})
+
+	out := executeWebhookReceive(t, map[string]any{"signature_verified": true}, pushTrigger("/hooks/verified"), pushHeaders())
+	if out["status"] != "accepted" {
+		t.Fatalf("expected status=accepted, got %#v", out)
+	}
+	if len(pub.messages) != 1 {
+		t.Errorf("expected 1 published message, got %d", len(pub.messages))
+	}
+}
+
+// receiveCachedBody runs a delivery through step.gh_webhook_receive on a
+// custom route the way the engine does: step.request_parse exposes the
+// headers and, for a form-encoded body it did not parse, caches the raw body,
+// which plugin steps receive base64-encoded in metadata.
+func receiveCachedBody(t *testing.T, path, body string, headers map[string]any) map[string]any {
+	t.Helper()
+	triggerData := map[string]any{"method": http.MethodPost, "path": path}
+	metadata := map[string]any{}
+	if form, err := url.ParseQuery(body); err == nil && form.Has("payload") {
+		metadata["_raw_body"] = base64.StdEncoding.EncodeToString([]byte(body))
+	} else {
+		triggerData["body"] = pushTrigger(path)["body"]
+	}
+	step, err := newWebhookReceiveStep("receive", map[string]any{})
+	if err != nil {
+		t.Fatalf("newWebhookReceiveStep: %v", err)
+	}
+	result, err := step.Execute(context.Background(), triggerData, nil, map[string]any{"headers": headers}, metadata, nil)
+	if err != nil {
+		t.Fatalf("Execute: %v", err)
+	}
+	requireEncodableOutput(t, result.Output)
+	return result.Output
+}
+
+func TestWebhookReceive_SignedDelivery(t *testing.T) {
+	secret := "route-secret"
+	_, pub := newRunningWebhookModule(t, "route-hooks", map[string]any{"secret": secret, "path": "/hooks/signed"})
+	event := `{"ref":"refs/heads/main","after":"abc123","repository":{"full_name":"owner/repo"}}`
+	form := url.Values{"payload": {event}}.Encode()
+
+	headers := pushHeaders()["headers"].(map[string]any)
+	headers["X-Hub-Signature-256"] = signBody(secret, []byte(form))
+	out := receiveCachedBody(t, "/hooks/signed", form, headers)
+	if out["status"] != "accepted" {
+		t.Fatalf("expected a signed form delivery to be accepted, got %#v", out)
+	}
+	if out["repository"] != "owner/repo" || out["branch"] != "main" {
+		t.Errorf("unexpected event fields: %#v", out)
+	}
+	if len(pub.messages) != 1 {
+		t.Errorf("expected 1 published message, got %d", len(pub.messages))
+	}
+
+	headers["X-GitHub-Delivery"] = "delivery-2"
+	headers["X-Hub-Signature-256"] = signBody("wrong", []byte(form))
+	if out := receiveCachedBody(t, "/hooks/signed", form, headers); out["response_status"] != http.StatusUnauthorized {
+		t.Errorf("expected 401 for a bad signature, got %#v", out)
+	}
+
+	headers["X-Hub-Signature-256"] = signBody(secret, []byte(event))
+	if out := receiveCachedBody(t, "/hooks/signed", event, headers); out["response_status"] != http.StatusUnsupportedMediaType {
+		t.Errorf("expected 415 for a signed JSON delivery without its raw body, got %#v", out)
+	}
+}
+
+func TestWebhookReceive_RotatedSecret(t *testing.T) {
+	newRunningWebhookModule(t, "rotating-hooks", map[string]any{
+		"path":   "/hooks/rotating",
+		"secret": "current",
+		"secrets": []any{
+			map[string]any{"name": "previous", "secret": "old", "expires_at": time.Now().Add(time.Hour).Format(time.RFC3339)},
+		},
+		"allow_sha1_signature": true,
+	})
+	form := url.Values{"payload": {`{"ref":"refs/heads/main","repository":{"full_name":"owner/repo"}}`}}.Encode()
+
+	headers := pushHeaders()["headers"].(map[string]any)
+	headers["X-Hub-Signature-256"] = signBody("old", []byte(form))
+	out := receiveCachedBody(t, "/hooks/rotating", form, headers)
+	if out["status"] != "accepted" || out["secret_name"] != "previous" {
+		t.Fatalf("expected the previous secret to be accepted, got %#v", out)
+	}
+
+	delete(headers, "X-Hub-Signature-256")
+	headers["X-GitHub-Delivery"] = "delivery-2"
+	headers["X-Hub-Signature"] = signBodySHA1("current", []byte(form))
+	out = receiveCachedBody(t, "/hooks/rotating", form, headers)
+	if out["status"] != "accepted" || out["secret_name"] != "secret" {
+		t.Errorf("expected the SHA-1 fallback to be accepted, got %#v", out)
+	}
+}
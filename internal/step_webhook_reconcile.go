@@ -0,0 +1,506 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/go-github/v69/github"
+
+	sdk "github.com/GoCodeAlone/workflow/plugin/external/sdk"
+)
+
+const webhookReconcileStateVersion = 1
+
+// defaultWebhookReconcileLookback bounds how far back a reconcile run looks.
+// GitHub keeps hook deliveries for three days.
+const defaultWebhookReconcileLookback = 24 * time.Hour
+
+// defaultWebhookReconcileMaxAttempts is how many times the step retries one
+// delivery, across runs, before it gives up on it.
+const defaultWebhookReconcileMaxAttempts = 3
+
+// webhookReconcileStep implements sdk.StepInstance.
+// It recovers webhook deliveries that GitHub failed to deliver, for example
+// while the webhook endpoint was down. The step lists the hook's recent
+// deliveries newer than the watermark stored in state_dir, finds the
+// deliveries (by GUID) with no successful attempt, and either asks GitHub to
+// redeliver them or fetches their payloads and publishes them through a
+// git.webhook module, which normalizes, filters, routes, and de-duplicates
+// them exactly as it does live deliveries. Run it on a schedule.
+//
+// Each redelivery is a new failed attempt when the endpoint keeps failing, so
+// the step counts its attempts per GUID in the state file and gives up after
+// max_attempts. Deliveries the endpoint answered with a 4xx status were
+// rejected on purpose (a bad signature, an unsupported event) and are not
+// retried at all.
+//
+// The hook is a repository hook when repo is set, an organization hook when
+// only owner is set, and the App's own webhook when app is true (auth_module
+// is then required and the step authenticates as the App).
+//
+// Config:
+//
+//	owner:     "GoCodeAlone"
+//	repo:      "workflow"             # optional; omit for an organization hook
+//	hook_id:   12345                  # required unless app is true
+//	app:       false                  # reconcile the github.app webhook instead
+//	mode:      "redeliver"            # redeliver (default) or publish
+//	module:    "github-webhooks"      # git.webhook module; required for publish
+//	state_dir: "/var/lib/gh-webhooks" # watermark storage
+//	lookback:  "24h"                  # ignore deliveries older than this
+//	max_attempts: 3                   # retries per delivery before giving up
+//	token:     "${GITHUB_TOKEN}"
+type webhookReconcileStep struct {
+	name   string
+	config webhookReconcileConfig
+	// newClient returns the API client for owner; tests replace it to target
+	// an httptest server.
+	newClient func(owner string) (*SDKClient, error)
+	now       func() time.Time
+}
+
+type webhookReconcileConfig struct {
+	Owner       string        `yaml:"owner"`
+	Repo        string        `yaml:"repo"`
+	HookID      int64         `yaml:"hook_id"`
+	App         bool          `yaml:"app"`
+	Mode        string        `yaml:"mode"`
+	Module      string        `yaml:"module"`
+	StateDir    string        `yaml:"state_dir"`
+	Lookback    time.Duration `yaml:"lookback"`
+	MaxAttempts templateInt   `yaml:"max_attempts"`
+	Auth        stepAuth
+}
+
+// webhookReconcileState is the watermark file for one hook.
+type webhookReconcileState struct {
+	Version        int   `json:"version"`
+	LastDeliveryID int64 `json:"last_delivery_id"`
+	// Attempts counts the step's retries of each delivery GUID that has not
+	// succeeded yet.
+	Attempts  map[string]webhookReconcileAttempts `json:"attempts,omitempty"`
+	UpdatedAt time.Time                           `json:"updated_at"`
+}
+
+// webhookReconcileAttempts records the retries of one delivery GUID.
+type webhookReconcileAttempts struct {
+	Count         int       `json:"count"`
+	LastAttemptAt time.Time `json:"last_attempt_at"`
+}
+
+func newWebhookReconcileStep(name string, raw map[string]any) (*webhookReconcileStep, error) {
+	cfg, err := parseWebhookReconcileConfig(raw)
+	if err != nil {
+		return nil, fmt.Errorf("step.gh_webhook_reconcile %q: %w", name, err)
+	}
+	s := &webhookReconcileStep{name: name, config: cfg, now: time.Now}
+	s.newClient = s.apiClient
+	return s, nil
+}
+
+// parseWebhookReconcileConfig converts a raw config map to webhookReconcileConfig.
+func parseWebhookReconcileConfig(raw map[string]any) (webhookReconcileConfig, error) {
+	var cfg webhookReconcileConfig
+	cfg.App, _ = raw["app"].(bool)
+	cfg.Owner, _ = raw["owner"].(string)
+	cfg.Repo, _ = raw["repo"].(string)
+	if !cfg.App && cfg.Owner == "" {
+		return cfg, fmt.Errorf("config.owner is required unless config.app is set")
+	}
+
+	switch v := raw["hook_id"].(type) {
+	case nil:
+	case int:
+		cfg.HookID = int64(v)
+	case int64:
+		cfg.HookID = v
+	case float64:
+		cfg.HookID = int64(v)
+	case string:
+		if v != "" {
+			n, err := strconv.ParseInt(v, 10, 64)
+			if err != nil {
+				return cfg, fmt.Errorf("config.hook_id is not a valid integer: %w", err)
+			}
+			cfg.HookID = n
+		}
+	default:
+		return cfg, fmt.Errorf("config.hook_id must be an integer")
+	}
+	if !cfg.App && cfg.HookID <= 0 {
+		return cfg, fmt.Errorf("config.hook_id is required")
+	}
+
+	cfg.Mode, _ = raw["mode"].(string)
+	switch cfg.Mode {
+	case "":
+		cfg.Mode = "redeliver"
+	case "redeliver", "publish":
+	default:
+		return cfg, fmt.Errorf("config.mode must be redeliver or publish, got %q", cfg.Mode)
+	}
+	cfg.Module, _ = raw["module"].(string)
+	if cfg.Mode == "publish" && cfg.Module == "" {
+		return cfg, fmt.Errorf("config.module is required when config.mode is publish")
+	}
+
+	stateDir, _ := raw["state_dir"].(string)
+	cfg.StateDir = strings.TrimSpace(os.ExpandEnv(stateDir))
+	if cfg.StateDir == "" {
+		return cfg, fmt.Errorf("config.state_dir is required")
+	}
+
+	cfg.Lookback = defaultWebhookReconcileLookback
+	if lookback, _ := raw["lookback"].(string); lookback != "" {
+		d, err := time.ParseDuration(lookback)
+		if err != nil {
+			return cfg, fmt.Errorf("config.lookback is invalid: %w", err)
+		}
+		if d <= 0 {
+			return cfg, fmt.Errorf("config.lookback must be positive")
+		}
+		cfg.Lookback = d
+	}
+
+	var err error
+	if cfg.MaxAttempts, err = parseTemplateInt(raw, "max_attempts", false); err != nil {
+		return cfg, err
+	}
+	switch {
+	case cfg.MaxAttempts.Value < 0:
+		return cfg, fmt.Errorf("config.max_attempts must be positive")
+	case cfg.MaxAttempts.Value == 0 && cfg.MaxAttempts.Ref == "":
+		cfg.MaxAttempts.Value = defaultWebhookReconcileMaxAttempts
+	}
+
+	auth, err := parseStepAuth(raw)
+	if err != nil {
+		return cfg, err
+	}
+	if cfg.App && auth.AuthModule == "" {
+		return cfg, fmt.Errorf("config.app requires config.auth_module")
+	}
+	cfg.Auth = auth
+	return cfg, nil
+}
+
+// apiClient authenticates as the App for App webhooks and with the step's
+// credentials otherwise.
+func (s *webhookReconcileStep) apiClient(owner string) (*SDKClient, error) {
+	if s.config.App {
+		mod, err := s.config.Auth.appModule()
+		if err != nil {
+			return nil, err
+		}
+		return mod.GetAppSDKClient()
+	}
+	return s.config.Auth.sdkClient(owner)
+}
+
+// hookDeliveryAPI is the delivery API of one repository, organization, or
+// App hook.
+type hookDeliveryAPI struct {
+	list      func(ctx context.Context, opts *github.ListCursorOptions) ([]*github.HookDelivery, *github.Response, error)
+	get       func(ctx context.Context, id int64) (*github.HookDelivery, *github.Response, error)
+	redeliver func(ctx context.Context, id int64) (*github.HookDelivery, *github.Response, error)
+	// stateName is the watermark file name for the hook.
+	stateName string
+}
+
+// deliveryAPI binds the delivery endpoints for the configured hook.
+func (s *webhookReconcileStep) deliveryAPI(client *SDKClient, owner, repo string) hookDeliveryAPI {
+	gh := client.GH
+	hookID := s.config.HookID
+	switch {
+	case s.config.App:
+		return hookDeliveryAPI{
+			list:      gh.Apps.ListHookDeliveries,
+			get:       gh.Apps.GetHookDelivery,
+			redeliver: gh.Apps.RedeliverHookDelivery,
+			stateName: fmt.Sprintf("webhook-reconcile-app-%s.json", stateNamePart(s.config.Auth.AuthModule)),
+		}
+	case repo != "":
+		return hookDeliveryAPI{
+			list: func(ctx context.Context, opts *github.ListCursorOptions) ([]*github.HookDelivery, *github.Response, error) {
+				return gh.Repositories.ListHookDeliveries(ctx, owner, repo, hookID, opts)
+			},
+			get: func(ctx context.Context, id int64) (*github.HookDelivery, *github.Response, error) {
+				return gh.Repositories.GetHookDelivery(ctx, owner, repo, hookID, id)
+			},
+			redeliver: func(ctx context.Context, id int64) (*github.HookDelivery, *github.Response, error) {
+				return gh.Repositories.RedeliverHookDelivery(ctx, owner, repo, hookID, id)
+			},
+			stateName: fmt.Sprintf("webhook-reconcile-repo-%s_%s_%d.json", stateNamePart(owner), stateNamePart(repo), hookID),
+		}
+	default:
+		return hookDeliveryAPI{
+			list: func(ctx context.Context, opts *github.ListCursorOptions) ([]*github.HookDelivery, *github.Response, error) {
+				return gh.Organizations.ListHookDeliveries(ctx, owner, hookID, opts)
+			},
+			get: func(ctx context.Context, id int64) (*github.HookDelivery, *github.Response, error) {
+				return gh.Organizations.GetHookDelivery(ctx, owner, hookID, id)
+			},
+			redeliver: func(ctx context.Context, id int64) (*github.HookDelivery, *github.Response, error) {
+				return gh.Organizations.RedeliverHookDelivery(ctx, owner, hookID, id)
+			},
+			stateName: fmt.Sprintf("webhook-reconcile-org-%s_%d.json", stateNamePart(owner), hookID),
+		}
+	}
+}
+
+// stateNamePart lowercases an owner, repository, or module name for use in a
+// watermark file name. Owner logins never contain "_", so
+// "<owner>_<repo>_<hook>" is unambiguous; characters GitHub does not allow in
+// names become "_".
+func stateNamePart(s string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9', r == '.', r == '_', r == '-':
+			return r
+		case r >= 'A' && r <= 'Z':
+			return r + ('a' - 'A')
+		default:
+			return '_'
+		}
+	}, s)
+}
+
+// Execute reconciles the hook's deliveries since the stored watermark.
+func (s *webhookReconcileStep) Execute(
+	ctx context.Context,
+	triggerData map[string]any,
+	stepOutputs map[string]map[string]any,
+	current map[string]any,
+	_ map[string]any,
+	_ map[string]any,
+) (*sdk.StepResult, error) {
+	owner := resolveField(s.config.Owner, triggerData, stepOutputs, current)
+	repo := resolveField(s.config.Repo, triggerData, stepOutputs, current)
+
+	var mod *webhookModule
+	if s.config.Mode == "publish" {
+		var ok bool
+		if mod, ok = lookupWebhookModule(s.config.Module); !ok {
+			return errorResult(fmt.Sprintf("module %q does not name a running git.webhook module", s.config.Module)), nil
+		}
+	}
+
+	maxAttempts, err := s.config.MaxAttempts.resolve("max_attempts", triggerData, stepOutputs, current)
+	if err != nil {
+		return errorResult(err.Error()), nil
+	}
+
+	client, err := s.newClient(owner)
+	if err != nil {
+		return errorResult(err.Error()), nil
+	}
+	api := s.deliveryAPI(client, owner, repo)
+
+	root, stateDir, err := openWebhookStateDir(s.config.StateDir)
+	if err != nil {
+		return errorResult(err.Error()), nil
+	}
+	defer root.Close() //nolint:errcheck
+	state, err := readWebhookReconcileState(root, api.stateName)
+	if err != nil {
+		return errorResult(err.Error()), nil
+	}
+	watermark := state.LastDeliveryID
+
+	deliveries, err := s.listSince(ctx, api, watermark)
+	if err != nil {
+		return errorResult(fmt.Sprintf("list hook deliveries: %v", err)), nil
+	}
+
+	// A delivery GUID is recovered when any attempt succeeded. Otherwise its
+	// latest attempt is redelivered or published, oldest GUID first.
+	succeeded := make(map[string]bool)
+	latest := make(map[string]*github.HookDelivery)
+	highest := watermark
+	for _, d := range deliveries {
+		highest = max(highest, d.GetID())
+		guid := d.GetGUID()
+		if code := d.GetStatusCode(); code >= 200 && code < 300 {
+			succeeded[guid] = true
+		}
+		if prev, ok := latest[guid]; !ok || d.GetID() > prev.GetID() {
+			latest[guid] = d
+		}
+	}
+	var failed []*github.HookDelivery
+	for guid, d := range latest {
+		if !succeeded[guid] {
+			failed = append(failed, d)
+		}
+	}
+	sort.Slice(failed, func(i, j int) bool { return failed[i].GetID() < failed[j].GetID() })
+
+	// Forget the attempts of deliveries that succeeded or that GitHub no
+	// longer lists.
+	dirty := highest > watermark
+	horizon := s.now().Add(-s.config.Lookback)
+	for guid, attempts := range state.Attempts {
+		if succeeded[guid] || attempts.LastAttemptAt.Before(horizon) {
+			delete(state.Attempts, guid)
+			dirty = true
+		}
+	}
+
+	var redelivered, published, ignored int
+	recovered, rejected, abandoned := []string{}, []string{}, []string{}
+	for _, d := range failed {
+		guid := d.GetGUID()
+		if code := d.GetStatusCode(); code >= 400 && code < 500 {
+			rejected = append(rejected, guid)
+			continue
+		}
+		attempts := state.Attempts[guid]
+		if int64(attempts.Count) >= maxAttempts {
+			abandoned = append(abandoned, guid)
+			continue
+		}
+		if state.Attempts == nil {
+			state.Attempts = make(map[string]webhookReconcileAttempts)
+		}
+		state.Attempts[guid] = webhookReconcileAttempts{Count: attempts.Count + 1, LastAttemptAt: s.now().UTC()}
+		dirty = true
+		if err := s.reconcileDelivery(ctx, api, mod, d, &redelivered, &published, &ignored); err != nil {
+			// Resume from this delivery on the next run.
+			state.LastDeliveryID = d.GetID() - 1
+			if saveErr := writeWebhookReconcileState(root, stateDir, api.stateName, state, s.now()); saveErr != nil {
+				err = errors.Join(err, saveErr)
+			}
+			return errorResult(fmt.Sprintf("reconcile delivery %s: %v", guid, err)), nil
+		}
+		recovered = append(recovered, guid)
+	}
+
+	if dirty {
+		state.LastDeliveryID = highest
+		if err := writeWebhookReconcileState(root, stateDir, api.stateName, state, s.now()); err != nil {
+			return errorResult(err.Error()), nil
+		}
+	}
+
+	return &sdk.StepResult{
+		Output: map[string]any{
+			"checked":      len(deliveries),
+			"failed":       len(failed),
+			"redelivered":  redelivered,
+			"published":    published,
+			"ignored":      ignored,
+			"delivery_ids": outputList(recovered),
+			"rejected":     outputList(rejected),
+			"abandoned":    outputList(abandoned),
+			"watermark":    highest,
+		},
+	}, nil
+}
+
+// listSince returns the hook's deliveries newer than watermark and within the
+// lookback window. GitHub lists deliveries newest first.
+func (s *webhookReconcileStep) listSince(ctx context.Context, api hookDeliveryAPI, watermark int64) ([]*github.HookDelivery, error) {
+	horizon := s.now().Add(-s.config.Lookback)
+	opts := &github.ListCursorOptions{PerPage: 100}
+	var deliveries []*github.HookDelivery
+	for {
+		page, resp, err := api.list(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+		for _, d := range page {
+			if d.GetID() <= watermark || d.GetDeliveredAt().Before(horizon) {
+				return deliveries, nil
+			}
+			deliveries = append(deliveries, d)
+		}
+		if resp == nil || resp.Cursor == "" || len(page) == 0 {
+			return deliveries, nil
+		}
+		opts.Cursor = resp.Cursor
+	}
+}
+
+// reconcileDelivery redelivers d, or fetches its payload and hands it to mod.
+func (s *webhookReconcileStep) reconcileDelivery(ctx context.Context, api hookDeliveryAPI, mod *webhookModule, d *github.HookDelivery, redelivered, published, ignored *int) error {
+	if mod == nil {
+		_, _, err := api.redeliver(ctx, d.GetID())
+		var accepted *github.AcceptedError
+		if err != nil && !errors.As(err, &accepted) {
+			return fmt.Errorf("redeliver: %w", err)
+		}
+		*redelivered++
+		return nil
+	}
+
+	full, _, err := api.get(ctx, d.GetID())
+	if err != nil {
+		return fmt.Errorf("get delivery: %w", err)
+	}
+	payload := full.GetRequest().GetRawPayload()
+	if len(payload) == 0 {
+		return errors.New("delivery has no payload")
+	}
+	eventType := full.GetEvent()
+	if eventType == "" {
+		eventType = d.GetEvent()
+	}
+	// The payload comes from the GitHub API, not the webhook endpoint, so
+	// there is no signature to check.
+	outcome := mod.receive(webhookDelivery{
+		EventType:         eventType,
+		DeliveryID:        d.GetGUID(),
+		Body:              payload,
+		SignatureVerified: true,
+	})
+	switch {
+	case outcome.Err != "":
+		return errors.New(outcome.Err)
+	case outcome.Result == "accepted" || outcome.Result == "queued":
+		*published++
+	default:
+		*ignored++
+	}
+	return nil
+}
+
+// readWebhookReconcileState returns the stored watermark and attempt counts,
+// or an empty state when the hook has not been reconciled before.
+func readWebhookReconcileState(root *os.Root, name string) (webhookReconcileState, error) {
+	var state webhookReconcileState
+	data, err := root.ReadFile(name)
+	if errors.Is(err, os.ErrNotExist) {
+		return state, nil
+	}
+	if err != nil {
+		return state, fmt.Errorf("read reconcile watermark: %w", err)
+	}
+	if err := json.Unmarshal(data, &state); err != nil {
+		return state, fmt.Errorf("decode reconcile watermark: %w", err)
+	}
+	if state.Version != webhookReconcileStateVersion {
+		return state, fmt.Errorf("reconcile watermark has unsupported version %d", state.Version)
+	}
+	return state, nil
+}
+
+// writeWebhookReconcileState durably stores the watermark and attempt counts.
+func writeWebhookReconcileState(root *os.Root, stateDir, name string, state webhookReconcileState, now time.Time) error {
+	state.Version = webhookReconcileStateVersion
+	state.UpdatedAt = now.UTC()
+	data, err := json.Marshal(state)
+	if err != nil {
+		return fmt.Errorf("encode reconcile watermark: %w", err)
+	}
+	if err := writeWebhookStateFile(root, stateDir, name, data); err != nil {
+		return fmt.Errorf("write reconcile watermark: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,275 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeHookDeliveries serves the repository hook deliveries API for
+// GoCodeAlone/workflow hook 1.
+type fakeHookDeliveries struct {
+	mu          sync.Mutex
+	deliveries  []map[string]any // newest first
+	payloads    map[int64]string
+	redelivered []int64
+	// failRedeliveries records every redelivery as a new failed attempt, as
+	// GitHub does while the endpoint keeps failing.
+	failRedeliveries bool
+}
+
+func (f *fakeHookDeliveries) server(t *testing.T) *httptest.Server {
+	t.Helper()
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /repos/GoCodeAlone/workflow/hooks/1/deliveries", func(w http.ResponseWriter, _ *http.Request) {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		_ = json.NewEncoder(w).Encode(f.deliveries)
+	})
+	mux.HandleFunc("GET /repos/GoCodeAlone/workflow/hooks/1/deliveries/{id}", func(w http.ResponseWriter, r *http.Request) {
+		id, _ := strconv.ParseInt(r.PathValue("id"), 10, 64)
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		for _, d := range f.deliveries {
+			if d["id"] == id {
+				full := map[string]any{"request": map[string]any{"payload": json.RawMessage(f.payloads[id])}}
+				for k, v := range d {
+					full[k] = v
+				}
+				_ = json.NewEncoder(w).Encode(full)
+				return
+			}
+		}
+		http.NotFound(w, r)
+	})
+	mux.HandleFunc("POST /repos/GoCodeAlone/workflow/hooks/1/deliveries/{id}/attempts", func(w http.ResponseWriter, r *http.Request) {
+		id, _ := strconv.ParseInt(r.PathValue("id"), 10, 64)
+		f.mu.Lock()
+		f.redelivered = append(f.redelivered, id)
+		if f.failRedeliveries {
+			for _, d := range f.deliveries {
+				if d["id"] == id {
+					attempt := hookDelivery(f.deliveries[0]["id"].(int64)+1, d["guid"].(string), "push", 502, time.Now())
+					f.deliveries = append([]map[string]any{attempt}, f.deliveries...)
+					break
+				}
+			}
+		}
+		f.mu.Unlock()
+		w.WriteHeader(http.StatusAccepted)
+	})
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func hookDelivery(id int64, guid, event string, status int, at time.Time) map[string]any {
+	return map[string]any{
+		"id":           id,
+		"guid":         guid,
+		"event":        event,
+		"status_code":  status,
+		"delivered_at": at.Format(time.RFC3339),
+	}
+}
+
+func newTestWebhookReconcileStep(t *testing.T, srv *httptest.Server, config map[string]any) *webhookReconcileStep {
+	t.Helper()
+	step, err := newWebhookReconcileStep("reconcile", config)
+	if err != nil {
+		t.Fatalf("newWebhookReconcileStep: %v", err)
+	}
+	base, err := url.Parse(srv.URL + "/")
+	if err != nil {
+		t.Fatalf("parse server URL: %v", err)
+	}
+	step.newClient = func(string) (*SDKClient, error) {
+		c := NewSDKClient("gh-token")
+		c.GH.BaseURL = base
+		return c, nil
+	}
+	return step
+}
+
+func executeWebhookReconcile(t *testing.T, step *webhookReconcileStep) map[string]any {
+	t.Helper()
+	result, err := step.Execute(context.Background(), nil, nil, nil, nil, nil)
+	if err != nil {
+		t.Fatalf("Execute: %v", err)
+	}
+	if result.StopPipeline {
+		t.Fatalf("unexpected error: %v", result.Output["error"])
+	}
+	requireEncodableOutput(t, result.Output)
+	return result.Output
+}
+
+func TestWebhookReconcile_PublishesFailedDeliveries(t *testing.T) {
+	now := time.Now()
+	api := &fakeHookDeliveries{
+		deliveries: []map[string]any{
+			hookDelivery(6, "guid-c", "push", 200, now),
+			hookDelivery(5, "guid-a", "push", 500, now),
+			hookDelivery(4, "guid-b", "push", 200, now),
+			hookDelivery(3, "guid-c", "push", 502, now),
+		},
+		payloads: map[int64]string{
+			5: `{"ref":"refs/heads/main","after":"abc","repository":{"full_name":"GoCodeAlone/workflow"}}`,
+		},
+	}
+	srv := api.server(t)
+	_, pub := newRunningWebhookModule(t, "reconcile-webhooks", map[string]any{"path": "/webhooks/reconcile"})
+
+	step := newTestWebhookReconcileStep(t, srv, map[string]any{
+		"owner":     "GoCodeAlone",
+		"repo":      "workflow",
+		"hook_id":   1,
+		"mode":      "publish",
+		"module":    "reconcile-webhooks",
+		"state_dir": t.TempDir(),
+		"token":     "gh-token",
+	})
+
+	out := executeWebhookReconcile(t, step)
+	if out["checked"] != 4 || out["failed"] != 1 || out["published"] != 1 {
+		t.Errorf("expected checked=4 failed=1 published=1, got %v", out)
+	}
+	if out["watermark"] != int64(6) {
+		t.Errorf("expected watermark=6, got %v", out["watermark"])
+	}
+	if len(pub.messages) != 1 {
+		t.Fatalf("expected 1 published message, got %d", len(pub.messages))
+	}
+	var event GitEvent
+	if err := json.Unmarshal(pub.messages[0].payload, &event); err != nil {
+		t.Fatalf("unmarshal event: %v", err)
+	}
+	if event.DeliveryID != "guid-a" || event.Branch != "main" || event.Repository != "GoCodeAlone/workflow" {
+		t.Errorf("unexpected event: %+v", event)
+	}
+
+	// The watermark skips deliveries that were already reconciled.
+	out = executeWebhookReconcile(t, step)
+	if out["checked"] != 0 || len(pub.messages) != 1 {
+		t.Errorf("expected nothing to reconcile on the second run, got %v", out)
+	}
+}
+
+func TestWebhookReconcile_Redelivers(t *testing.T) {
+	now := time.Now()
+	api := &fakeHookDeliveries{
+		deliveries: []map[string]any{
+			hookDelivery(9, "guid-new", "push", 0, now),
+			hookDelivery(8, "guid-ok", "push", 200, now),
+			hookDelivery(7, "guid-old", "push", 500, now.Add(-48*time.Hour)),
+		},
+	}
+	srv := api.server(t)
+	step := newTestWebhookReconcileStep(t, srv, map[string]any{
+		"owner":     "GoCodeAlone",
+		"repo":      "workflow",
+		"hook_id":   "1",
+		"state_dir": t.TempDir(),
+		"token":     "gh-token",
+	})
+
+	out := executeWebhookReconcile(t, step)
+	if out["checked"] != 2 || out["redelivered"] != 1 {
+		t.Errorf("expected checked=2 redelivered=1, got %v", out)
+	}
+	if len(api.redelivered) != 1 || api.redelivered[0] != 9 {
+		t.Errorf("expected delivery 9 to be redelivered, got %v", api.redelivered)
+	}
+}
+
+func TestWebhookReconcile_GivesUpOnPermanentFailures(t *testing.T) {
+	now := time.Now()
+	api := &fakeHookDeliveries{
+		deliveries: []map[string]any{
+			hookDelivery(3, "guid-rejected", "push", 401, now),
+			hookDelivery(2, "guid-broken", "push", 500, now),
+		},
+		failRedeliveries: true,
+	}
+	srv := api.server(t)
+	step := newTestWebhookReconcileStep(t, srv, map[string]any{
+		"owner":        "GoCodeAlone",
+		"repo":         "workflow",
+		"hook_id":      1,
+		"state_dir":    t.TempDir(),
+		"max_attempts": 2,
+		"token":        "gh-token",
+	})
+
+	for run := 1; run <= 2; run++ {
+		out := executeWebhookReconcile(t, step)
+		if out["redelivered"] != 1 {
+			t.Fatalf("run %d: expected one redelivery, got %v", run, out)
+		}
+		if run == 1 && (len(out["rejected"].([]any)) != 1 || out["rejected"].([]any)[0] != "guid-rejected") {
+			t.Errorf("expected the 4xx delivery to be rejected, got %v", out)
+		}
+	}
+	out := executeWebhookReconcile(t, step)
+	if out["redelivered"] != 0 || len(out["abandoned"].([]any)) != 1 || out["abandoned"].([]any)[0] != "guid-broken" {
+		t.Errorf("expected the delivery to be abandoned after 2 attempts, got %v", out)
+	}
+	for _, id := range api.redelivered {
+		if id == 3 {
+			t.Error("expected the delivery our endpoint rejected not to be redelivered")
+		}
+	}
+	if len(api.redelivered) != 2 {
+		t.Errorf("expected 2 redeliveries in total, got %v", api.redelivered)
+	}
+}
+
+func TestWebhookReconcile_ConfigValidation(t *testing.T) {
+	cases := map[string]map[string]any{
+		"missing state_dir":   {"owner": "o", "hook_id": 1},
+		"missing hook_id":     {"owner": "o", "state_dir": "/tmp"},
+		"publish no module":   {"owner": "o", "hook_id": 1, "state_dir": "/tmp", "mode": "publish"},
+		"unknown mode":        {"owner": "o", "hook_id": 1, "state_dir": "/tmp", "mode": "replay"},
+		"app no auth_module":  {"app": true, "state_dir": "/tmp"},
+		"invalid lookback":    {"owner": "o", "hook_id": 1, "state_dir": "/tmp", "lookback": "-1h"},
+		"missing owner":       {"hook_id": 1, "state_dir": "/tmp"},
+		"non-numeric hook_id": {"owner": "o", "hook_id": "abc", "state_dir": "/tmp"},
+		"negative attempts":   {"owner": "o", "hook_id": 1, "state_dir": "/tmp", "max_attempts": -1},
+	}
+	for name, config := range cases {
+		if _, err := newWebhookReconcileStep("reconcile", config); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
+
+func TestWebhookReconcile_StateDirExpandsEnv(t *testing.T) {
+	t.Setenv("RECONCILE_STATE_ROOT", "/var/lib/hooks")
+	step, err := newWebhookReconcileStep("reconcile", map[string]any{"owner": "o", "hook_id": 1, "state_dir": "${RECONCILE_STATE_ROOT}/reconcile"})
+	if err != nil {
+		t.Fatalf("newWebhookReconcileStep: %v", err)
+	}
+	if step.config.StateDir != "/var/lib/hooks/reconcile" {
+		t.Errorf("expected the environment variable to be expanded, got %q", step.config.StateDir)
+	}
+}
+
+func TestWebhookReconcile_AppStatePerModule(t *testing.T) {
+	names := map[string]bool{}
+	for _, module := range []string{"app-a", "app-b"} {
+		step, err := newWebhookReconcileStep("reconcile", map[string]any{"app": true, "auth_module": module, "state_dir": t.TempDir()})
+		if err != nil {
+			t.Fatalf("newWebhookReconcileStep: %v", err)
+		}
+		names[step.deliveryAPI(NewSDKClient(""), "", "").stateName] = true
+	}
+	if len(names) != 2 {
+		t.Errorf("expected a watermark file per auth_module, got %v", names)
+	}
+}
@@ -0,0 +1,253 @@
+package internal
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// templateInt is an integer config value given either as a literal or as a
+// template reference (e.g. {{.steps.normalize.pr_number}}) that is resolved
+// at Execute time.
+type templateInt struct {
+	Value    int64
+	Ref      string
+	required bool
+}
+
+// parseTemplateInt reads raw[key], which can be an int, int64, float64, or a
+// string holding a decimal integer or a template reference. A required field
+// must be present and non-zero.
+func parseTemplateInt(raw map[string]any, key string, required bool) (templateInt, error) {
+	f := templateInt{required: required}
+	switch v := raw[key].(type) {
+	case nil:
+	case string:
+		v = strings.TrimSpace(v)
+		switch {
+		case v == "":
+		case strings.Contains(v, "{{"):
+			f.Ref = v
+		default:
+			n, err := strconv.ParseInt(v, 10, 64)
+			if err != nil {
+				return f, fmt.Errorf("config.%s is not a valid integer: %w", key, err)
+			}
+			f.Value = n
+		}
+	default:
+		n, err := toInt64(v)
+		if err != nil {
+			return f, fmt.Errorf("config.%s %v", key, err)
+		}
+		f.Value = n
+	}
+	if required && f.Value == 0 && f.Ref == "" {
+		return f, fmt.Errorf("config.%s is required", key)
+	}
+	return f, nil
+}
+
+// resolve returns the literal value, or the integer the template reference
+// resolves to. key names the field in error messages.
+func (f templateInt) resolve(key string, triggerData map[string]any, stepOutputs map[string]map[string]any, current map[string]any) (int64, error) {
+	n := f.Value
+	if f.Ref != "" {
+		resolved := resolveValue(f.Ref, triggerData, stepOutputs, current)
+		var err error
+		if s, ok := resolved.(string); ok {
+			n, err = strconv.ParseInt(strings.TrimSpace(s), 10, 64)
+		} else {
+			n, err = toInt64(resolved)
+		}
+		if err != nil {
+			return 0, fmt.Errorf("%s resolved to non-integer value %q: %v", key, fmt.Sprint(resolved), err)
+		}
+	}
+	if n == 0 && f.required {
+		return 0, fmt.Errorf("%s resolved to zero — check pipeline context", key)
+	}
+	return n, nil
+}
+
+// toInt64 converts a decoded YAML or JSON number to an int64.
+func toInt64(v any) (int64, error) {
+	switch v := v.(type) {
+	case int:
+		return int64(v), nil
+	case int32:
+		return int64(v), nil
+	case int64:
+		return v, nil
+	case float64:
+		if v != math.Trunc(v) || math.Abs(v) > math.MaxInt64 {
+			return 0, fmt.Errorf("must be an integer, got %v", v)
+		}
+		return int64(v), nil
+	case json.Number:
+		return v.Int64()
+	default:
+		return 0, fmt.Errorf("must be an integer, got %T", v)
+	}
+}
+
+// templateBool is a boolean config value given either as a literal (true,
+// false, or their string forms) or as a template reference.
+type templateBool struct {
+	Value bool
+	Ref   string
+}
+
+// parseTemplateBool reads raw[key]. A missing key is false.
+func parseTemplateBool(raw map[string]any, key string) (templateBool, error) {
+	var f templateBool
+	switch v := raw[key].(type) {
+	case nil:
+	case bool:
+		f.Value = v
+	case string:
+		v = strings.TrimSpace(v)
+		switch {
+		case v == "":
+		case strings.Contains(v, "{{"):
+			f.Ref = v
+		default:
+			b, err := strconv.ParseBool(v)
+			if err != nil {
+				return f, fmt.Errorf("config.%s must be true or false", key)
+			}
+			f.Value = b
+		}
+	default:
+		return f, fmt.Errorf("config.%s must be true or false", key)
+	}
+	return f, nil
+}
+
+// resolve returns the literal value, or the boolean the template reference
+// resolves to. An empty resolved value is false.
+func (f templateBool) resolve(key string, triggerData map[string]any, stepOutputs map[string]map[string]any, current map[string]any) (bool, error) {
+	if f.Ref == "" {
+		return f.Value, nil
+	}
+	switch v := resolveValue(f.Ref, triggerData, stepOutputs, current).(type) {
+	case bool:
+		return v, nil
+	case nil:
+		return false, nil
+	case string:
+		v = strings.TrimSpace(v)
+		if v == "" {
+			return false, nil
+		}
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			return false, fmt.Errorf("%s resolved to non-boolean value %q", key, v)
+		}
+		return b, nil
+	default:
+		return false, fmt.Errorf("%s resolved to non-boolean value %q", key, fmt.Sprint(v))
+	}
+}
+
+// templateList is a string list config value. It is given either as a list
+// whose items may each contain template references, or as a single string:
+// a comma-separated list, or a template reference to a list or a
+// comma-separated string.
+type templateList struct {
+	Items []string
+	Ref   string
+}
+
+// parseTemplateList reads raw[key].
+func parseTemplateList(raw map[string]any, key string) (templateList, error) {
+	var f templateList
+	switch v := raw[key].(type) {
+	case nil:
+	case []any:
+		for i, item := range v {
+			s, ok := item.(string)
+			if !ok {
+				return f, fmt.Errorf("config.%s[%d] must be a string", key, i)
+			}
+			f.Items = append(f.Items, s)
+		}
+	case []string:
+		f.Items = append(f.Items, v...)
+	case string:
+		if strings.Contains(v, "{{") {
+			f.Ref = v
+		} else {
+			f.Items = splitList(v)
+		}
+	default:
+		return f, fmt.Errorf("config.%s must be a string or string array", key)
+	}
+	return f, nil
+}
+
+// resolve returns the list with every template reference resolved. An item
+// that references a list contributes each of its entries. Empty items are
+// dropped.
+func (f templateList) resolve(key string, triggerData map[string]any, stepOutputs map[string]map[string]any, current map[string]any) ([]string, error) {
+	var out []string
+	for _, item := range f.Items {
+		v := resolveValue(item, triggerData, stepOutputs, current)
+		if s, ok := v.(string); ok && !strings.Contains(s, "{{") {
+			v = []string{s}
+		}
+		var err error
+		if out, err = appendListValue(out, key, v); err != nil {
+			return nil, err
+		}
+	}
+	if f.Ref == "" {
+		return out, nil
+	}
+	return appendListValue(out, key, resolveValue(f.Ref, triggerData, stepOutputs, current))
+}
+
+// appendListValue appends the entries of a resolved list value to out. A
+// string is treated as a comma-separated list.
+func appendListValue(out []string, key string, v any) ([]string, error) {
+	switch v := v.(type) {
+	case nil:
+	case string:
+		if strings.Contains(v, "{{") {
+			return nil, fmt.Errorf("%s has an unresolved reference in %q", key, v)
+		}
+		out = append(out, splitList(v)...)
+	case []string:
+		for _, s := range v {
+			if s = strings.TrimSpace(s); s != "" {
+				out = append(out, s)
+			}
+		}
+	case []any:
+		for _, item := range v {
+			s, ok := item.(string)
+			if !ok {
+				return nil, fmt.Errorf("%s resolved to a list with non-string item %q", key, fmt.Sprint(item))
+			}
+			if s = strings.TrimSpace(s); s != "" {
+				out = append(out, s)
+			}
+		}
+	default:
+		return nil, fmt.Errorf("%s resolved to non-list value %q", key, fmt.Sprint(v))
+	}
+	return out, nil
+}
+
+// splitList splits a comma-separated list, dropping empty entries.
+func splitList(s string) []string {
+	var out []string
+	for part := range strings.SplitSeq(s, ",") {
+		if part = strings.TrimSpace(part); part != "" {
+			out = append(out, part)
+		}
+	}
+	return out
+}
@@ -0,0 +1,160 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestTemplateInt(t *testing.T) {
+	stepOutputs := map[string]map[string]any{
+		"normalize": {"pr_number": float64(1234567), "text": "42", "fraction": 1.5, "zero": 0},
+	}
+	cases := []struct {
+		raw  any
+		want int64
+		err  bool
+	}{
+		{raw: 7, want: 7},
+		{raw: float64(8), want: 8},
+		{raw: "9", want: 9},
+		{raw: "{{.steps.normalize.pr_number}}", want: 1234567},
+		{raw: "{{.steps.normalize.text}}", want: 42},
+		{raw: "{{.steps.normalize.fraction}}", err: true},
+		{raw: "{{.steps.normalize.zero}}", err: true},
+		{raw: "{{.steps.missing.pr_number}}", err: true},
+	}
+	for _, tc := range cases {
+		f, err := parseTemplateInt(map[string]any{"pr_number": tc.raw}, "pr_number", true)
+		if err != nil {
+			t.Fatalf("%v: parseTemplateInt: %v", tc.raw, err)
+		}
+		got, err := f.resolve("pr_number", nil, stepOutputs, nil)
+		if tc.err {
+			if err == nil {
+				t.Errorf("%v: expected an error, got %d", tc.raw, got)
+			}
+			continue
+		}
+		if err != nil || got != tc.want {
+			t.Errorf("%v: expected %d, got %d (%v)", tc.raw, tc.want, got, err)
+		}
+	}
+
+	for _, raw := range []map[string]any{{}, {"pr_number": "abc"}, {"pr_number": 1.5}, {"pr_number": true}} {
+		if _, err := parseTemplateInt(raw, "pr_number", true); err == nil {
+			t.Errorf("%v: expected a constructor error", raw)
+		}
+	}
+}
+
+func TestTemplateBool(t *testing.T) {
+	triggerData := map[string]any{"draft": true, "text": "false", "bad": "maybe"}
+	cases := []struct {
+		raw  any
+		want bool
+		err  bool
+	}{
+		{raw: true, want: true},
+		{raw: "true", want: true},
+		{raw: nil, want: false},
+		{raw: "{{.draft}}", want: true},
+		{raw: "{{.text}}", want: false},
+		{raw: "{{.bad}}", err: true},
+		{raw: "{{.missing}}", err: true},
+	}
+	for _, tc := range cases {
+		f, err := parseTemplateBool(map[string]any{"draft": tc.raw}, "draft")
+		if err != nil {
+			t.Fatalf("%v: parseTemplateBool: %v", tc.raw, err)
+		}
+		got, err := f.resolve("draft", triggerData, nil, nil)
+		if (err != nil) != tc.err || (!tc.err && got != tc.want) {
+			t.Errorf("%v: expected %v (error %v), got %v (%v)", tc.raw, tc.want, tc.err, got, err)
+		}
+	}
+	if _, err := parseTemplateBool(map[string]any{"draft": 1}, "draft"); err == nil {
+		t.Error("expected a constructor error for a number")
+	}
+}
+
+func TestTemplateList(t *testing.T) {
+	stepOutputs := map[string]map[string]any{
+		"classify": {"labels": []any{"bug", "p1"}, "csv": "a, b,,c", "team": "infra"},
+	}
+	cases := []struct {
+		raw  any
+		want []string
+		err  bool
+	}{
+		{raw: []any{"bug", " ", "{{.steps.classify.team}}"}, want: []string{"bug", "infra"}},
+		{raw: "{{.steps.classify.labels}}", want: []string{"bug", "p1"}},
+		{raw: []any{"triage", "{{.steps.classify.labels}}"}, want: []string{"triage", "bug", "p1"}},
+		{raw: "{{.steps.classify.csv}}", want: []string{"a", "b", "c"}},
+		{raw: "x,y", want: []string{"x", "y"}},
+		{raw: []any{"{{.steps.classify.missing}}"}, err: true},
+	}
+	for _, tc := range cases {
+		f, err := parseTemplateList(map[string]any{"labels": tc.raw}, "labels")
+		if err != nil {
+			t.Fatalf("%v: parseTemplateList: %v", tc.raw, err)
+		}
+		got, err := f.resolve("labels", nil, stepOutputs, nil)
+		if tc.err {
+			if err == nil {
+				t.Errorf("%v: expected an error, got %q", tc.raw, got)
+			}
+			continue
+		}
+		if err != nil || !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("%v: expected %q, got %q (%v)", tc.raw, tc.want, got, err)
+		}
+	}
+	if _, err := parseTemplateList(map[string]any{"labels": []any{1}}, "labels"); err == nil {
+		t.Error("expected a constructor error for a non-string item")
+	}
+}
+
+func TestIssueLabelStep_TemplatedNumberAndLabels(t *testing.T) {
+	var path string
+	var added []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.Method + " " + r.URL.Path
+		body, _ := io.ReadAll(r.Body)
+		_ = json.Unmarshal(body, &added)
+		_, _ = w.Write([]byte(`[{"name":"bug"},{"name":"p1"}]`))
+	}))
+	defer srv.Close()
+
+	step, err := newIssueLabelStep("label", map[string]any{
+		"owner":        "GoCodeAlone",
+		"repo":         "workflow",
+		"issue_number": "{{.steps.normalize.pr_number}}",
+		"add":          "{{.steps.classify.labels}}",
+		"token":        "tok",
+		"api_base_url": srv.URL,
+	})
+	if err != nil {
+		t.Fatalf("newIssueLabelStep: %v", err)
+	}
+	result, err := step.Execute(context.Background(), nil, map[string]map[string]any{
+		"normalize": {"pr_number": float64(42)},
+		"classify":  {"labels": []any{"bug", "p1"}},
+	}, nil, nil, nil)
+	if err != nil {
+		t.Fatalf("Execute: %v", err)
+	}
+	if errMsg, _ := result.Output["error"].(string); errMsg != "" {
+		t.Fatalf("unexpected step error: %s", errMsg)
+	}
+	if path != "POST /repos/GoCodeAlone/workflow/issues/42/labels" {
+		t.Errorf("unexpected request %q", path)
+	}
+	if !reflect.DeepEqual(added, []string{"bug", "p1"}) {
+		t.Errorf("expected labels [bug p1], got %q", added)
+	}
+}
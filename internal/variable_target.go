@@ -0,0 +1,137 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/go-github/v69/github"
+)
+
+// parseVariableTarget reads the target of Actions configuration variables:
+// an organization, repository, or repository environment, as for secrets.
+// Variables exist for Actions only, so app is rejected.
+func parseVariableTarget(raw map[string]any) (secretTarget, error) {
+	if _, ok := raw["app"]; ok {
+		return secretTarget{}, errors.New("config.app does not apply to variables, which exist for actions only")
+	}
+	return parseSecretTarget(raw)
+}
+
+// listVariables returns every Actions variable in the scope.
+func (s *secretScope) listVariables(ctx context.Context, gh *github.Client) ([]*github.ActionsVariable, error) {
+	var all []*github.ActionsVariable
+	opts := &github.ListOptions{PerPage: 30}
+	for page := 0; page < maxGitHubPaginationPages; page++ {
+		var batch *github.ActionsVariables
+		var resp *github.Response
+		var err error
+		switch s.kind() {
+		case "environment":
+			batch, resp, err = gh.Actions.ListEnvVariables(ctx, s.owner, s.repo, s.environment, opts)
+		case "repo":
+			batch, resp, err = gh.Actions.ListRepoVariables(ctx, s.owner, s.repo, opts)
+		default:
+			batch, resp, err = gh.Actions.ListOrgVariables(ctx, s.owner, opts)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("list variables of %s: %w", s, err)
+		}
+		all = append(all, batch.Variables...)
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+	return all, nil
+}
+
+// getVariable returns the variable name, or nil when it does not exist.
+func (s *secretScope) getVariable(ctx context.Context, gh *github.Client, name string) (*github.ActionsVariable, error) {
+	var variable *github.ActionsVariable
+	var err error
+	switch s.kind() {
+	case "environment":
+		variable, _, err = gh.Actions.GetEnvVariable(ctx, s.owner, s.repo, s.environment, name)
+	case "repo":
+		variable, _, err = gh.Actions.GetRepoVariable(ctx, s.owner, s.repo, name)
+	default:
+		variable, _, err = gh.Actions.GetOrgVariable(ctx, s.owner, name)
+	}
+	switch {
+	case isGitHubNotFound(err):
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf("get variable %q of %s: %w", name, s, err)
+	}
+	return variable, nil
+}
+
+// putVariable updates the variable name, creating it when it does not exist,
+// and reports whether it was created. access applies to organization
+// variables only; an empty visibility keeps an existing variable's and
+// creates a private one.
+func (s *secretScope) putVariable(ctx context.Context, gh *github.Client, name, value string, access orgSecretAccess) (bool, error) {
+	variable := &github.ActionsVariable{Name: name, Value: value}
+	if s.kind() == "org" {
+		if access.visibility != "" {
+			variable.Visibility = github.Ptr(access.visibility)
+		}
+		if access.repoIDs != nil {
+			ids := github.SelectedRepoIDs(access.repoIDs)
+			variable.SelectedRepositoryIDs = &ids
+		}
+	}
+	var err error
+	switch s.kind() {
+	case "environment":
+		_, err = gh.Actions.UpdateEnvVariable(ctx, s.owner, s.repo, s.environment, variable)
+	case "repo":
+		_, err = gh.Actions.UpdateRepoVariable(ctx, s.owner, s.repo, variable)
+	default:
+		_, err = gh.Actions.UpdateOrgVariable(ctx, s.owner, variable)
+	}
+	if err == nil {
+		return false, nil
+	}
+	if !isGitHubNotFound(err) {
+		return false, fmt.Errorf("update variable %q of %s: %w", name, s, err)
+	}
+
+	switch s.kind() {
+	case "environment":
+		_, err = gh.Actions.CreateEnvVariable(ctx, s.owner, s.repo, s.environment, variable)
+	case "repo":
+		_, err = gh.Actions.CreateRepoVariable(ctx, s.owner, s.repo, variable)
+	default:
+		if variable.Visibility == nil {
+			variable.Visibility = github.Ptr("private")
+		}
+		_, err = gh.Actions.CreateOrgVariable(ctx, s.owner, variable)
+	}
+	if err != nil {
+		return false, fmt.Errorf("create variable %q of %s: %w", name, s, err)
+	}
+	return true, nil
+}
+
+// deleteVariable deletes the variable name. It reports false, without an
+// error, when the variable does not exist.
+func (s *secretScope) deleteVariable(ctx context.Context, gh *github.Client, name string) (bool, error) {
+	var err error
+	switch s.kind() {
+	case "environment":
+		_, err = gh.Actions.DeleteEnvVariable(ctx, s.owner, s.repo, s.environment, name)
+	case "repo":
+		_, err = gh.Actions.DeleteRepoVariable(ctx, s.owner, s.repo, name)
+	default:
+		_, err = gh.Actions.DeleteOrgVariable(ctx, s.owner, name)
+	}
+	switch {
+	case isGitHubNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("delete variable %q of %s: %w", name, s, err)
+	}
+	return true, nil
+}
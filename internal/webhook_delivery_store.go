@@ -0,0 +1,422 @@
+package internal
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const webhookDeliveryJournalVersion = 2
+const webhookDeliveryJournalName = "webhook-deliveries.jsonl"
+
+// webhookDeliveryCompactMinLines is the journal length below which it is
+// never compacted, however many of its entries have expired.
+const webhookDeliveryCompactMinLines = 1000
+
+// Defaults for git.webhook delivery de-duplication.
+const (
+	defaultWebhookDedupWindow     = 24 * time.Hour
+	defaultWebhookDedupMaxEntries = 10000
+)
+
+// webhookDeliveryJournalHeader is the first line of the delivery journal.
+// Every further line is a webhookDeliveryJournalEntry, appended as
+// deliveries are accepted.
+type webhookDeliveryJournalHeader struct {
+	Version int `json:"version"`
+}
+
+type webhookDeliveryJournalEntry struct {
+	ID         string    `json:"id"`
+	ReceivedAt time.Time `json:"received_at"`
+}
+
+// webhookDeliveryStore remembers X-GitHub-Delivery IDs for a bounded window
+// so redeliveries are reported as duplicates instead of being published
+// again. With a state_dir the IDs survive restarts: each accepted ID is
+// appended to a journal, which is rewritten with only the remembered IDs once
+// expired entries make up half of it.
+type webhookDeliveryStore struct {
+	window     time.Duration
+	maxEntries int
+	stateDir   string
+	now        func() time.Time
+
+	mu sync.Mutex
+	// seen maps delivery IDs to the time they were first accepted.
+	seen map[string]time.Time
+	// order lists seen IDs oldest first for window expiry and size eviction.
+	order []string
+	// inFlight holds IDs that are being processed but not yet recorded.
+	inFlight  map[string]bool
+	stateRoot *os.Root
+	// journal is the append handle of the journal; nil until the journal
+	// has been (re)written, which the next commit then does.
+	journal *os.File
+	// journalLines counts the entries in the journal.
+	journalLines int
+}
+
+func newWebhookDeliveryStore(window time.Duration, maxEntries int, stateDir string) *webhookDeliveryStore {
+	return &webhookDeliveryStore{
+		window:     window,
+		maxEntries: maxEntries,
+		stateDir:   stateDir,
+		now:        time.Now,
+		seen:       make(map[string]time.Time),
+		inFlight:   make(map[string]bool),
+	}
+}
+
+// enabled reports whether de-duplication is active.
+func (s *webhookDeliveryStore) enabled() bool {
+	return s != nil && s.window > 0
+}
+
+// open prepares state_dir and loads previously recorded deliveries.
+func (s *webhookDeliveryStore) open() error {
+	if !s.enabled() || s.stateDir == "" {
+		return nil
+	}
+	root, stateDir, err := openWebhookStateDir(s.stateDir)
+	if err != nil {
+		return err
+	}
+	entries, err := readWebhookDeliveryJournal(root)
+	if err != nil {
+		_ = root.Close()
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.stateDir = stateDir
+	s.stateRoot = root
+	for _, entry := range entries {
+		if entry.ID == "" || entry.ReceivedAt.IsZero() {
+			continue
+		}
+		if _, exists := s.seen[entry.ID]; exists {
+			continue
+		}
+		s.seen[entry.ID] = entry.ReceivedAt.UTC()
+		s.order = append(s.order, entry.ID)
+	}
+	sort.SliceStable(s.order, func(i, j int) bool { return s.seen[s.order[i]].Before(s.seen[s.order[j]]) })
+	s.pruneLocked()
+	// Start from a compact journal, which also drops a final entry cut
+	// short by a crash before anything is appended after it.
+	if err := s.compactLocked(); err != nil {
+		s.stateRoot = nil
+		_ = root.Close()
+		return err
+	}
+	return nil
+}
+
+func readWebhookDeliveryJournal(root *os.Root) ([]webhookDeliveryJournalEntry, error) {
+	info, err := root.Lstat(webhookDeliveryJournalName)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("inspect delivery journal: %w", err)
+	}
+	if !info.Mode().IsRegular() {
+		return nil, errors.New("delivery journal must be a regular file and not a symbolic link")
+	}
+	data, err := root.ReadFile(webhookDeliveryJournalName)
+	if err != nil {
+		return nil, fmt.Errorf("read delivery journal: %w", err)
+	}
+	if len(data) == 0 {
+		return nil, nil
+	}
+	// Every line ends in a newline; a last line without one is an append
+	// that a crash cut short, and is dropped.
+	lines := bytes.Split(data, []byte("\n"))
+	lines = lines[:len(lines)-1]
+	if len(lines) == 0 {
+		return nil, errors.New("delivery journal has no header")
+	}
+	var header webhookDeliveryJournalHeader
+	if err := decodeWebhookJournalLine(lines[0], &header); err != nil {
+		return nil, fmt.Errorf("decode delivery journal header: %w", err)
+	}
+	if header.Version != webhookDeliveryJournalVersion {
+		return nil, fmt.Errorf("delivery journal version must be %d", webhookDeliveryJournalVersion)
+	}
+	entries := make([]webhookDeliveryJournalEntry, 0, len(lines)-1)
+	for i, line := range lines[1:] {
+		var entry webhookDeliveryJournalEntry
+		if err := decodeWebhookJournalLine(line, &entry); err != nil {
+			return nil, fmt.Errorf("decode delivery journal line %d: %w", i+2, err)
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+// decodeWebhookJournalLine decodes one JSON document from line, rejecting
+// unknown fields and trailing data.
+func decodeWebhookJournalLine(line []byte, v any) error {
+	decoder := json.NewDecoder(bytes.NewReader(line))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(v); err != nil {
+		return err
+	}
+	var trailing json.RawMessage
+	if err := decoder.Decode(&trailing); !errors.Is(err, io.EOF) {
+		return errors.New("line must contain exactly one JSON document")
+	}
+	return nil
+}
+
+// close releases the state_dir handle.
+func (s *webhookDeliveryStore) close() error {
+	if s == nil {
+		return nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.stateRoot == nil {
+		return nil
+	}
+	var err error
+	if s.journal != nil {
+		err = s.journal.Close()
+		s.journal = nil
+	}
+	err = errors.Join(err, s.stateRoot.Close())
+	s.stateRoot = nil
+	return err
+}
+
+// begin reserves id for processing. It reports false when id was already
+// accepted within the window or is being processed concurrently. A reserved
+// id must be finished with commit or release.
+func (s *webhookDeliveryStore) begin(id string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.pruneLocked()
+	if _, ok := s.seen[id]; ok || s.inFlight[id] {
+		return false
+	}
+	s.inFlight[id] = true
+	return true
+}
+
+// release drops a reservation without recording it, so a redelivery of a
+// failed delivery is processed again.
+func (s *webhookDeliveryStore) release(id string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.inFlight, id)
+}
+
+// commit records a reserved id as accepted and persists the store.
+func (s *webhookDeliveryStore) commit(id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.inFlight, id)
+	s.seen[id] = s.now().UTC()
+	s.order = append(s.order, id)
+	s.pruneLocked()
+	if err := s.persistLocked(); err != nil {
+		delete(s.seen, id)
+		s.order = s.order[:len(s.order)-1]
+		return err
+	}
+	return nil
+}
+
+// pruneLocked drops IDs older than the window and evicts the oldest IDs
+// beyond maxEntries.
+func (s *webhookDeliveryStore) pruneLocked() {
+	cutoff := s.now().Add(-s.window)
+	drop := 0
+	for drop < len(s.order) && (s.seen[s.order[drop]].Before(cutoff) || len(s.order)-drop > s.maxEntries) {
+		delete(s.seen, s.order[drop])
+		drop++
+	}
+	if drop > 0 {
+		s.order = append(s.order[:0], s.order[drop:]...)
+	}
+}
+
+// persistLocked appends the newest remembered id to the journal, and
+// compacts the journal once most of its entries have expired.
+func (s *webhookDeliveryStore) persistLocked() error {
+	if s.stateRoot == nil {
+		return nil
+	}
+	if s.journal == nil {
+		return s.compactLocked()
+	}
+	id := s.order[len(s.order)-1]
+	line, err := json.Marshal(webhookDeliveryJournalEntry{ID: id, ReceivedAt: s.seen[id]})
+	if err != nil {
+		return fmt.Errorf("encode delivery journal entry: %w", err)
+	}
+	line = append(line, '\n')
+	if _, err := s.journal.Write(line); err == nil {
+		err = s.journal.Sync()
+	}
+	if err != nil {
+		// The journal may end in a partial line now; rewrite it on the next
+		// commit rather than append after it.
+		_ = s.journal.Close()
+		s.journal = nil
+		return fmt.Errorf("append to delivery journal: %w", err)
+	}
+	s.journalLines++
+	if s.journalLines >= 2*max(len(s.order), webhookDeliveryCompactMinLines/2) {
+		// The delivery is recorded; a failed compaction is retried later.
+		if err := s.compactLocked(); err != nil {
+			log.Printf("git.webhook: %v", err)
+		}
+	}
+	return nil
+}
+
+// compactLocked durably replaces the journal with the remembered IDs and
+// reopens it for appending.
+func (s *webhookDeliveryStore) compactLocked() error {
+	if s.journal != nil {
+		_ = s.journal.Close()
+		s.journal = nil
+	}
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	if err := encoder.Encode(webhookDeliveryJournalHeader{Version: webhookDeliveryJournalVersion}); err != nil {
+		return fmt.Errorf("encode delivery journal: %w", err)
+	}
+	for _, id := range s.order {
+		if err := encoder.Encode(webhookDeliveryJournalEntry{ID: id, ReceivedAt: s.seen[id]}); err != nil {
+			return fmt.Errorf("encode delivery journal: %w", err)
+		}
+	}
+	if err := writeWebhookStateFile(s.stateRoot, s.stateDir, webhookDeliveryJournalName, buf.Bytes()); err != nil {
+		return fmt.Errorf("compact delivery journal: %w", err)
+	}
+	journal, err := s.stateRoot.OpenFile(webhookDeliveryJournalName, os.O_WRONLY|os.O_APPEND, 0)
+	if err != nil {
+		return fmt.Errorf("open delivery journal: %w", err)
+	}
+	s.journal = journal
+	s.journalLines = len(s.order)
+	return nil
+}
+
+// openWebhookStateDir creates stateDir if needed and opens it as a root.
+// It returns the absolute path used for directory syncs.
+func openWebhookStateDir(stateDir string) (*os.Root, string, error) {
+	stateDir, err := filepath.Abs(stateDir)
+	if err != nil {
+		return nil, "", fmt.Errorf("resolve state_dir: %w", err)
+	}
+	info, err := os.Lstat(stateDir)
+	if errors.Is(err, os.ErrNotExist) {
+		if err := os.MkdirAll(stateDir, 0o700); err != nil {
+			return nil, "", fmt.Errorf("create state_dir: %w", err)
+		}
+		info, err = os.Lstat(stateDir)
+	}
+	if err != nil {
+		return nil, "", fmt.Errorf("inspect state_dir: %w", err)
+	}
+	if !info.IsDir() || info.Mode()&os.ModeSymlink != 0 {
+		return nil, "", errors.New("state_dir must be a directory and not a symbolic link")
+	}
+	root, err := os.OpenRoot(stateDir)
+	if err != nil {
+		return nil, "", fmt.Errorf("open state_dir root: %w", err)
+	}
+	return root, stateDir, nil
+}
+
+// webhookStagingFilePrefix names the staging files writeWebhookStateFile
+// renames into place.
+const webhookStagingFilePrefix = ".staging-"
+
+// writeWebhookStateFile durably replaces name in root with data through a
+// synced staging file.
+func writeWebhookStateFile(root *os.Root, stateDir, name string, data []byte) error {
+	randomSuffix := make([]byte, 16)
+	if _, err := rand.Read(randomSuffix); err != nil {
+		return fmt.Errorf("generate staging name: %w", err)
+	}
+	temporaryName := webhookStagingFilePrefix + hex.EncodeToString(randomSuffix)
+	temporary, err := root.OpenFile(temporaryName, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+	if err != nil {
+		return fmt.Errorf("create staging file: %w", err)
+	}
+	committed := false
+	defer func() {
+		_ = temporary.Close()
+		if !committed {
+			_ = root.Remove(temporaryName)
+		}
+	}()
+	if _, err := temporary.Write(data); err != nil {
+		return fmt.Errorf("write staging file: %w", err)
+	}
+	if err := temporary.Sync(); err != nil {
+		return fmt.Errorf("sync staging file: %w", err)
+	}
+	if err := temporary.Close(); err != nil {
+		return fmt.Errorf("close staging file: %w", err)
+	}
+	if err := root.Rename(temporaryName, name); err != nil {
+		return fmt.Errorf("rename staging file: %w", err)
+	}
+	committed = true
+	return syncJITOwnershipJournalDirectoryPlatform(root, stateDir)
+}
+
+// parseWebhookDedupConfig reads dedup_window, dedup_max_entries, and state_dir.
+func parseWebhookDedupConfig(raw map[string]any, cfg *webhookConfig) error {
+	cfg.DedupWindow = defaultWebhookDedupWindow
+	switch v := raw["dedup_window"].(type) {
+	case nil:
+	case string:
+		window, err := time.ParseDuration(strings.TrimSpace(v))
+		if err != nil {
+			return fmt.Errorf("config.dedup_window is invalid: %w", err)
+		}
+		if window < 0 {
+			return fmt.Errorf("config.dedup_window must not be negative")
+		}
+		cfg.DedupWindow = window
+	default:
+		return fmt.Errorf("config.dedup_window must be a duration string")
+	}
+
+	cfg.DedupMaxEntries = defaultWebhookDedupMaxEntries
+	switch v := raw["dedup_max_entries"].(type) {
+	case int:
+		cfg.DedupMaxEntries = v
+	case int64:
+		cfg.DedupMaxEntries = int(v)
+	case float64:
+		cfg.DedupMaxEntries = int(v)
+	}
+	if cfg.DedupMaxEntries <= 0 {
+		return fmt.Errorf("config.dedup_max_entries must be positive")
+	}
+
+	stateDir, _ := raw["state_dir"].(string)
+	cfg.StateDir = strings.TrimSpace(os.ExpandEnv(stateDir))
+	return nil
+}
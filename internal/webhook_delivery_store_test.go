@@ -0,0 +1,176 @@
+package internal
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestWebhookDeliveryStore_WindowExpiry(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	store := newWebhookDeliveryStore(time.Hour, 10, "")
+	store.now = func() time.Time { return now }
+
+	if !store.begin("a") {
+		t.Fatal("expected first delivery to be new")
+	}
+	if err := store.commit("a"); err != nil {
+		t.Fatalf("commit: %v", err)
+	}
+	if store.begin("a") {
+		t.Error("expected repeat within window to be a duplicate")
+	}
+
+	now = now.Add(2 * time.Hour)
+	if !store.begin("a") {
+		t.Error("expected delivery to be new again after the window")
+	}
+}
+
+func TestWebhookDeliveryStore_InFlightIsDuplicate(t *testing.T) {
+	store := newWebhookDeliveryStore(time.Hour, 10, "")
+	if !store.begin("a") {
+		t.Fatal("expected first delivery to be new")
+	}
+	if store.begin("a") {
+		t.Error("expected concurrent delivery to be a duplicate")
+	}
+	store.release("a")
+	if !store.begin("a") {
+		t.Error("expected released delivery to be new again")
+	}
+}
+
+func TestWebhookDeliveryStore_EvictsOldestBeyondMaxEntries(t *testing.T) {
+	store := newWebhookDeliveryStore(time.Hour, 3, "")
+	for i := 0; i < 5; i++ {
+		id := fmt.Sprintf("d%d", i)
+		store.begin(id)
+		if err := store.commit(id); err != nil {
+			t.Fatalf("commit: %v", err)
+		}
+	}
+	if len(store.seen) != 3 {
+		t.Fatalf("expected 3 remembered deliveries, got %d", len(store.seen))
+	}
+	if !store.begin("d0") {
+		t.Error("expected evicted delivery d0 to be new")
+	}
+	if store.begin("d4") {
+		t.Error("expected newest delivery d4 to be a duplicate")
+	}
+}
+
+func TestWebhookDeliveryStore_PersistsAcrossRestart(t *testing.T) {
+	dir := t.TempDir()
+	first := newWebhookDeliveryStore(time.Hour, 10, dir)
+	if err := first.open(); err != nil {
+		t.Fatalf("open: %v", err)
+	}
+	first.begin("persisted")
+	if err := first.commit("persisted"); err != nil {
+		t.Fatalf("commit: %v", err)
+	}
+	if err := first.close(); err != nil {
+		t.Fatalf("close: %v", err)
+	}
+
+	second := newWebhookDeliveryStore(time.Hour, 10, dir)
+	if err := second.open(); err != nil {
+		t.Fatalf("reopen: %v", err)
+	}
+	defer second.close() //nolint:errcheck
+	if second.begin("persisted") {
+		t.Error("expected persisted delivery to be a duplicate after restart")
+	}
+}
+
+func TestWebhookDeliveryStore_AppendsAndCompactsJournal(t *testing.T) {
+	dir := t.TempDir()
+	journalLines := func() int {
+		t.Helper()
+		data, err := os.ReadFile(filepath.Join(dir, webhookDeliveryJournalName))
+		if err != nil {
+			t.Fatalf("read journal: %v", err)
+		}
+		return bytes.Count(data, []byte("\n"))
+	}
+	store := newWebhookDeliveryStore(time.Hour, 10, dir)
+	if err := store.open(); err != nil {
+		t.Fatalf("open: %v", err)
+	}
+	defer store.close() //nolint:errcheck
+	for i := 0; i < 3; i++ {
+		id := fmt.Sprintf("d%d", i)
+		store.begin(id)
+		if err := store.commit(id); err != nil {
+			t.Fatalf("commit: %v", err)
+		}
+	}
+	if n := journalLines(); n != 4 {
+		t.Fatalf("expected a header and 3 appended entries, got %d lines", n)
+	}
+
+	for i := 3; i < webhookDeliveryCompactMinLines; i++ {
+		id := fmt.Sprintf("d%d", i)
+		store.begin(id)
+		if err := store.commit(id); err != nil {
+			t.Fatalf("commit: %v", err)
+		}
+	}
+	if n := journalLines(); n > 11 {
+		t.Errorf("expected the journal to be compacted to the 10 remembered IDs, got %d lines", n)
+	}
+	last := fmt.Sprintf("d%d", webhookDeliveryCompactMinLines-1)
+	if store.begin(last) {
+		t.Errorf("expected %s to be a duplicate after compaction", last)
+	}
+}
+
+func TestWebhookDeliveryStore_DropsTornJournalEntry(t *testing.T) {
+	dir := t.TempDir()
+	first := newWebhookDeliveryStore(time.Hour, 10, dir)
+	if err := first.open(); err != nil {
+		t.Fatalf("open: %v", err)
+	}
+	first.begin("kept")
+	if err := first.commit("kept"); err != nil {
+		t.Fatalf("commit: %v", err)
+	}
+	if err := first.close(); err != nil {
+		t.Fatalf("close: %v", err)
+	}
+	journal, err := os.OpenFile(filepath.Join(dir, webhookDeliveryJournalName), os.O_WRONLY|os.O_APPEND, 0)
+	if err != nil {
+		t.Fatalf("open journal: %v", err)
+	}
+	_, _ = journal.WriteString(`{"id":"torn","rece`)
+	_ = journal.Close()
+
+	second := newWebhookDeliveryStore(time.Hour, 10, dir)
+	if err := second.open(); err != nil {
+		t.Fatalf("reopen after a torn append: %v", err)
+	}
+	second.begin("next")
+	if err := second.commit("next"); err != nil {
+		t.Fatalf("commit: %v", err)
+	}
+	if err := second.close(); err != nil {
+		t.Fatalf("close: %v", err)
+	}
+
+	third := newWebhookDeliveryStore(time.Hour, 10, dir)
+	if err := third.open(); err != nil {
+		t.Fatalf("reopen: %v", err)
+	}
+	defer third.close() //nolint:errcheck
+	if third.begin("kept") || third.begin("next") {
+		t.Error("expected the complete entries to survive the torn append")
+	}
+	if !third.begin("torn") {
+		t.Error("expected the torn entry to be dropped")
+	}
+}
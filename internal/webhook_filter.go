@@ -0,0 +1,177 @@
+package internal
+
+import (
+	"fmt"
+	"path"
+	"strings"
+)
+
+// webhookFilter decides which deliveries a git.webhook module publishes.
+// Every configured predicate must pass; an empty predicate accepts all.
+type webhookFilter struct {
+	// actions maps accepted event types to their accepted actions. A nil
+	// action set accepts every action of that event type.
+	actions map[string]map[string]bool
+
+	repositories        []string
+	excludeRepositories []string
+	branches            []string
+	excludeBranches     []string
+	excludeSenders      []string
+	labels              []string
+	excludeLabels       []string
+}
+
+// parseWebhookFilterConfig reads the action-qualified events list and the
+// repository, branch, sender, and label predicates.
+func parseWebhookFilterConfig(raw map[string]any, cfg *webhookConfig) error {
+	f := &cfg.Filter
+	for _, entry := range cfg.Events {
+		eventType, actionList, qualified := strings.Cut(strings.TrimSpace(entry), ":")
+		eventType = strings.TrimSpace(eventType)
+		if eventType == "" {
+			return fmt.Errorf("config.events entry %q has no event type", entry)
+		}
+		if f.actions == nil {
+			f.actions = make(map[string]map[string]bool)
+		}
+		accepted, seen := f.actions[eventType]
+		if !qualified {
+			// An unqualified entry accepts every action, overriding any
+			// action list given for the same event type.
+			f.actions[eventType] = nil
+			continue
+		}
+		if seen && accepted == nil {
+			continue
+		}
+		if accepted == nil {
+			accepted = make(map[string]bool)
+			f.actions[eventType] = accepted
+		}
+		for _, action := range strings.Split(actionList, ",") {
+			if action = strings.TrimSpace(action); action != "" {
+				accepted[action] = true
+			}
+		}
+		if len(accepted) == 0 {
+			return fmt.Errorf("config.events entry %q lists no actions", entry)
+		}
+	}
+
+	lists := []struct {
+		key  string
+		dest *[]string
+	}{
+		{"repositories", &f.repositories},
+		{"exclude_repositories", &f.excludeRepositories},
+		{"branches", &f.branches},
+		{"exclude_branches", &f.excludeBranches},
+		{"exclude_senders", &f.excludeSenders},
+		{"labels", &f.labels},
+		{"exclude_labels", &f.excludeLabels},
+	}
+	for _, l := range lists {
+		items, ok := raw[l.key].([]any)
+		if !ok {
+			continue
+		}
+		for _, item := range items {
+			pattern, ok := item.(string)
+			if !ok || strings.TrimSpace(pattern) == "" {
+				return fmt.Errorf("config.%s entries must be non-empty strings", l.key)
+			}
+			pattern = strings.TrimSpace(pattern)
+			if _, err := path.Match(escapeGlob(pattern), ""); err != nil {
+				return fmt.Errorf("config.%s entry %q is not a valid pattern", l.key, pattern)
+			}
+			*l.dest = append(*l.dest, pattern)
+		}
+	}
+	return nil
+}
+
+// acceptsEventType reports whether deliveries of eventType pass the events
+// list. It is checked before the payload is normalized.
+func (f webhookFilter) acceptsEventType(eventType string) bool {
+	if len(f.actions) == 0 {
+		return true
+	}
+	_, ok := f.actions[eventType]
+	return ok
+}
+
+// accepts reports whether a normalized event passes every predicate.
+// Branch predicates only apply to events that carry a branch or tag.
+func (f webhookFilter) accepts(event *GitEvent) bool {
+	if !f.acceptsEventType(event.EventType) {
+		return false
+	}
+	if accepted := f.actions[event.EventType]; accepted != nil && !accepted[event.Action] {
+		return false
+	}
+
+	repo := strings.ToLower(event.Repository)
+	if len(f.repositories) > 0 && !matchAnyGlob(f.repositories, repo, true) {
+		return false
+	}
+	if matchAnyGlob(f.excludeRepositories, repo, true) {
+		return false
+	}
+
+	if event.Branch != "" || event.Tag != "" {
+		refs := []string{event.Branch, "refs/heads/" + event.Branch}
+		if event.Tag != "" {
+			refs = []string{event.Tag, "refs/tags/" + event.Tag}
+		}
+		if len(f.branches) > 0 && !matchAnyGlob(f.branches, refs[0], false) && !matchAnyGlob(f.branches, refs[1], false) {
+			return false
+		}
+		if matchAnyGlob(f.excludeBranches, refs[0], false) || matchAnyGlob(f.excludeBranches, refs[1], false) {
+			return false
+		}
+	}
+
+	if event.Sender != "" && matchAnyGlob(f.excludeSenders, strings.ToLower(event.Sender), true) {
+		return false
+	}
+
+	if len(f.labels) > 0 && !anyLabelMatches(f.labels, event.Labels) {
+		return false
+	}
+	if anyLabelMatches(f.excludeLabels, event.Labels) {
+		return false
+	}
+	return true
+}
+
+// anyLabelMatches reports whether any label matches any pattern.
+func anyLabelMatches(patterns, labels []string) bool {
+	for _, label := range labels {
+		if matchAnyGlob(patterns, label, false) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchAnyGlob reports whether s matches any pattern. In patterns, * matches
+// any run of characters other than / and ? matches one character; everything
+// else, including brackets as in "*[bot]", is literal. fold lowercases the
+// patterns; s must already be lowercase.
+func matchAnyGlob(patterns []string, s string, fold bool) bool {
+	for _, pattern := range patterns {
+		if fold {
+			pattern = strings.ToLower(pattern)
+		}
+		if ok, _ := path.Match(escapeGlob(pattern), s); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// escapeGlob quotes the path.Match metacharacters other than * and ?.
+func escapeGlob(pattern string) string {
+	return strings.NewReplacer(`\`, `\\`, `[`, `\[`, `]`, `\]`).Replace(pattern)
+}
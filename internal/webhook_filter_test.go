@@ -0,0 +1,131 @@
+package internal
+
+import "testing"
+
+func newTestWebhookFilter(t *testing.T, raw map[string]any) webhookFilter {
+	t.Helper()
+	cfg, err := parseWebhookConfig(raw)
+	if err != nil {
+		t.Fatalf("parseWebhookConfig: %v", err)
+	}
+	return cfg.Filter
+}
+
+func TestWebhookFilter_EventActions(t *testing.T) {
+	f := newTestWebhookFilter(t, map[string]any{
+		"events": []any{"push", "pull_request:opened, synchronize"},
+	})
+
+	cases := []struct {
+		eventType, action string
+		want              bool
+	}{
+		{"push", "", true},
+		{"pull_request", "opened", true},
+		{"pull_request", "synchronize", true},
+		{"pull_request", "closed", false},
+		{"issues", "opened", false},
+	}
+	for _, tc := range cases {
+		got := f.accepts(&GitEvent{EventType: tc.eventType, Action: tc.action})
+		if got != tc.want {
+			t.Errorf("accepts(%s:%s) = %v, want %v", tc.eventType, tc.action, got, tc.want)
+		}
+	}
+}
+
+func TestWebhookFilter_UnqualifiedEventOverridesActions(t *testing.T) {
+	f := newTestWebhookFilter(t, map[string]any{
+		"events": []any{"pull_request:opened", "pull_request"},
+	})
+	if !f.accepts(&GitEvent{EventType: "pull_request", Action: "closed"}) {
+		t.Error("expected unqualified pull_request entry to accept every action")
+	}
+}
+
+func TestWebhookFilter_Repositories(t *testing.T) {
+	f := newTestWebhookFilter(t, map[string]any{
+		"repositories":         []any{"My-Org/*"},
+		"exclude_repositories": []any{"my-org/legacy-*"},
+	})
+	if !f.accepts(&GitEvent{Repository: "my-org/api"}) {
+		t.Error("expected my-org/api to be accepted")
+	}
+	if f.accepts(&GitEvent{Repository: "my-org/legacy-api"}) {
+		t.Error("expected excluded repository to be ignored")
+	}
+	if f.accepts(&GitEvent{Repository: "other/api"}) {
+		t.Error("expected repository outside the allowlist to be ignored")
+	}
+}
+
+func TestWebhookFilter_Branches(t *testing.T) {
+	f := newTestWebhookFilter(t, map[string]any{
+		"branches":         []any{"main", "refs/heads/release/*", "refs/tags/v*"},
+		"exclude_branches": []any{"release/old"},
+	})
+	cases := []struct {
+		event GitEvent
+		want  bool
+	}{
+		{GitEvent{Branch: "main"}, true},
+		{GitEvent{Branch: "release/1.2"}, true},
+		{GitEvent{Branch: "release/old"}, false},
+		{GitEvent{Branch: "feature/x"}, false},
+		{GitEvent{Branch: "v1.0.0", Tag: "v1.0.0"}, true},
+		{GitEvent{Tag: "v1.0.0"}, true},
+		{GitEvent{Tag: "nightly"}, false},
+		{GitEvent{Branch: "main", Tag: "main"}, true},
+		{GitEvent{EventType: "issues"}, true},
+	}
+	for _, tc := range cases {
+		if got := f.accepts(&tc.event); got != tc.want {
+			t.Errorf("accepts(branch=%q tag=%q) = %v, want %v", tc.event.Branch, tc.event.Tag, got, tc.want)
+		}
+	}
+}
+
+func TestWebhookFilter_ExcludeSenders(t *testing.T) {
+	f := newTestWebhookFilter(t, map[string]any{"exclude_senders": []any{"*[bot]"}})
+	if f.accepts(&GitEvent{Sender: "Dependabot[bot]"}) {
+		t.Error("expected bot sender to be ignored")
+	}
+	if !f.accepts(&GitEvent{Sender: "alice"}) {
+		t.Error("expected human sender to be accepted")
+	}
+}
+
+func TestWebhookFilter_Labels(t *testing.T) {
+	f := newTestWebhookFilter(t, map[string]any{
+		"labels":         []any{"deploy", "release-*"},
+		"exclude_labels": []any{"wip"},
+	})
+	cases := []struct {
+		labels []string
+		want   bool
+	}{
+		{[]string{"deploy"}, true},
+		{[]string{"bug", "release-candidate"}, true},
+		{[]string{"deploy", "wip"}, false},
+		{[]string{"bug"}, false},
+		{nil, false},
+	}
+	for _, tc := range cases {
+		if got := f.accepts(&GitEvent{Labels: tc.labels}); got != tc.want {
+			t.Errorf("accepts(labels=%v) = %v, want %v", tc.labels, got, tc.want)
+		}
+	}
+}
+
+func TestParseWebhookConfig_InvalidFilters(t *testing.T) {
+	for name, raw := range map[string]map[string]any{
+		"empty event type":  {"events": []any{":opened"}},
+		"empty action list": {"events": []any{"pull_request: ,"}},
+		"non-string entry":  {"branches": []any{42}},
+		"empty pattern":     {"exclude_senders": []any{" "}},
+	} {
+		if _, err := parseWebhookConfig(raw); err == nil {
+			t.Errorf("%s: expected error", name)
+		}
+	}
+}
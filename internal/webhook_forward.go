@@ -0,0 +1,65 @@
+package internal
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// webhookForwarder serves the registered git.webhook modules' HTTP handler on
+// a loopback listener. The engine hands plugin steps the parsed JSON body of
+// a request, not the bytes GitHub signed, so the plugin's route forwards the
+// untouched request here with step.http_proxy instead. Paths start with a
+// random token that only step.gh_webhook_forward hands out, so other local
+// processes cannot deliver to the modules directly.
+var webhookForwarder struct {
+	sync.Mutex
+	baseURL string
+}
+
+// webhookForwardURL returns the URL at which the forwarder serves module,
+// starting the listener on first use.
+func webhookForwardURL(module string) (string, error) {
+	webhookForwarder.Lock()
+	defer webhookForwarder.Unlock()
+	if webhookForwarder.baseURL == "" {
+		ln, err := net.Listen("tcp", "127.0.0.1:0")
+		if err != nil {
+			return "", fmt.Errorf("listen for webhook forwarding: %w", err)
+		}
+		token := make([]byte, 16)
+		if _, err := rand.Read(token); err != nil {
+			_ = ln.Close()
+			return "", fmt.Errorf("generate webhook forwarding token: %w", err)
+		}
+		prefix := "/" + hex.EncodeToString(token)
+		mux := http.NewServeMux()
+		mux.Handle(prefix+"/", http.StripPrefix(prefix, http.HandlerFunc(serveForwardedWebhook)))
+		srv := &http.Server{Handler: mux, ReadHeaderTimeout: 10 * time.Second}
+		go func() { _ = srv.Serve(ln) }()
+		webhookForwarder.baseURL = "http://" + ln.Addr().String() + prefix
+	}
+	return webhookForwarder.baseURL + "/" + url.PathEscape(module), nil
+}
+
+// serveForwardedWebhook hands a forwarded request to the git.webhook module
+// its path names.
+func serveForwardedWebhook(w http.ResponseWriter, r *http.Request) {
+	name, err := url.PathUnescape(strings.TrimPrefix(r.URL.EscapedPath(), "/"))
+	if err != nil {
+		http.NotFound(w, r)
+		return
+	}
+	mod, ok := lookupWebhookModule(name)
+	if !ok {
+		http.Error(w, fmt.Sprintf("module %q does not name a running git.webhook module", name), http.StatusNotFound)
+		return
+	}
+	mod.handleWebhook(w, r)
+}
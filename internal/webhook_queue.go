@@ -0,0 +1,358 @@
+package internal
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	mathrand "math/rand/v2"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const webhookQueueVersion = 1
+const webhookQueueFilePrefix = "queued-"
+
+// Defaults for git.webhook asynchronous publishing.
+const (
+	defaultWebhookRetryInitialBackoff = time.Second
+	defaultWebhookRetryMaxBackoff     = 5 * time.Minute
+)
+
+// webhookQueueStopTimeout bounds how long close waits for an in-flight
+// publish, which the message publisher gives no way to cancel.
+const webhookQueueStopTimeout = 10 * time.Second
+
+// webhookQueuedMessage is one accepted event waiting to be published.
+type webhookQueuedMessage struct {
+	Version  int               `json:"version"`
+	Topic    string            `json:"topic"`
+	Payload  json.RawMessage   `json:"payload"`
+	Metadata map[string]string `json:"metadata"`
+}
+
+// webhookPublishFunc publishes a queued message.
+type webhookPublishFunc func(topic string, payload []byte, metadata map[string]string) error
+
+// webhookQueue is a durable on-disk queue of accepted events. Each message is
+// a file in state_dir named so that lexical order is arrival order. A single
+// drain goroutine publishes messages oldest first, retrying failures with
+// jittered exponential backoff, and removes each file once it is published.
+// Files left by a previous process are drained after a restart.
+type webhookQueue struct {
+	stateDir       string
+	initialBackoff time.Duration
+	maxBackoff     time.Duration
+	stopTimeout    time.Duration
+
+	mu      sync.Mutex
+	root    *os.Root
+	lastSeq int64
+
+	wake   chan struct{}
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+func newWebhookQueue(stateDir string, initialBackoff, maxBackoff time.Duration) *webhookQueue {
+	return &webhookQueue{
+		stateDir:       stateDir,
+		initialBackoff: initialBackoff,
+		maxBackoff:     maxBackoff,
+		stopTimeout:    webhookQueueStopTimeout,
+		wake:           make(chan struct{}, 1),
+	}
+}
+
+// open prepares state_dir, removes staging files a crashed process left
+// behind, and resumes numbering after any queued messages.
+func (q *webhookQueue) open() error {
+	root, stateDir, err := openWebhookStateDir(q.stateDir)
+	if err != nil {
+		return err
+	}
+	q.mu.Lock()
+	q.root = root
+	q.stateDir = stateDir
+	q.mu.Unlock()
+
+	if err := q.removeStaging(); err != nil {
+		_ = q.close()
+		return err
+	}
+	names, err := q.pending()
+	if err != nil {
+		_ = q.close()
+		return err
+	}
+	if len(names) > 0 {
+		q.lastSeq = webhookQueueSeq(names[len(names)-1])
+	}
+	return nil
+}
+
+// enqueue durably stores a message and wakes the drain goroutine.
+func (q *webhookQueue) enqueue(topic string, payload []byte, metadata map[string]string) error {
+	data, err := json.Marshal(webhookQueuedMessage{
+		Version:  webhookQueueVersion,
+		Topic:    topic,
+		Payload:  payload,
+		Metadata: metadata,
+	})
+	if err != nil {
+		return fmt.Errorf("encode queued event: %w", err)
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.root == nil {
+		return errors.New("queue is closed")
+	}
+	seq := max(time.Now().UnixNano(), q.lastSeq+1)
+	suffix := make([]byte, 4)
+	if _, err := rand.Read(suffix); err != nil {
+		return fmt.Errorf("generate queue file name: %w", err)
+	}
+	name := fmt.Sprintf("%s%020d-%s.json", webhookQueueFilePrefix, seq, hex.EncodeToString(suffix))
+	if err := writeWebhookStateFile(q.root, q.stateDir, name, data); err != nil {
+		return fmt.Errorf("write queued event: %w", err)
+	}
+	q.lastSeq = seq
+
+	select {
+	case q.wake <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+// start launches the drain goroutine.
+func (q *webhookQueue) start(publish webhookPublishFunc) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.cancel != nil {
+		return
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	q.cancel = cancel
+	q.done = make(chan struct{})
+	go q.run(ctx, publish, q.done)
+}
+
+// close stops the drain goroutine and releases state_dir. Queued messages
+// stay on disk for the next start. A publish still running after
+// stopTimeout is abandoned; its message stays queued and may be published
+// again after a restart.
+func (q *webhookQueue) close() error {
+	if q == nil {
+		return nil
+	}
+	q.mu.Lock()
+	cancel, done := q.cancel, q.done
+	q.cancel, q.done = nil, nil
+	q.mu.Unlock()
+
+	var stopErr error
+	if cancel != nil {
+		cancel()
+		timer := time.NewTimer(q.stopTimeout)
+		select {
+		case <-done:
+		case <-timer.C:
+			stopErr = fmt.Errorf("publish still running after %s; its event stays queued", q.stopTimeout)
+		}
+		timer.Stop()
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.root == nil {
+		return stopErr
+	}
+	err := q.root.Close()
+	q.root = nil
+	return errors.Join(stopErr, err)
+}
+
+// run publishes queued messages until ctx is cancelled.
+func (q *webhookQueue) run(ctx context.Context, publish webhookPublishFunc, done chan<- struct{}) {
+	defer close(done)
+	backoff := q.initialBackoff
+	for {
+		names, err := q.pending()
+		for _, name := range names {
+			if ctx.Err() != nil {
+				return
+			}
+			if err = q.deliver(name, publish); err != nil {
+				break
+			}
+		}
+		if err != nil {
+			// Retry from the oldest message so publish order is kept.
+			if !sleepCtx(ctx, jitterBackoff(backoff)) {
+				return
+			}
+			backoff = min(backoff*2, q.maxBackoff)
+			continue
+		}
+		backoff = q.initialBackoff
+
+		select {
+		case <-q.wake:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// deliver publishes one queued message and removes it. A message that cannot
+// be decoded is renamed aside so it does not block the queue.
+func (q *webhookQueue) deliver(name string, publish webhookPublishFunc) error {
+	q.mu.Lock()
+	root := q.root
+	q.mu.Unlock()
+	if root == nil {
+		return errors.New("queue is closed")
+	}
+
+	data, err := root.ReadFile(name)
+	if err != nil {
+		return fmt.Errorf("read queued event: %w", err)
+	}
+	var msg webhookQueuedMessage
+	if err := json.Unmarshal(data, &msg); err != nil || msg.Version != webhookQueueVersion {
+		return root.Rename(name, "corrupt-"+strings.TrimPrefix(name, webhookQueueFilePrefix))
+	}
+	if err := publish(msg.Topic, msg.Payload, msg.Metadata); err != nil {
+		return err
+	}
+	if err := root.Remove(name); err != nil {
+		return fmt.Errorf("remove published event: %w", err)
+	}
+	return nil
+}
+
+// removeStaging deletes the staging files in state_dir. A process that
+// crashes between creating a staging file and renaming it leaves one behind.
+func (q *webhookQueue) removeStaging() error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	dir, err := q.root.Open(".")
+	if err != nil {
+		return fmt.Errorf("open queue directory: %w", err)
+	}
+	defer dir.Close() //nolint:errcheck
+	entries, err := dir.ReadDir(-1)
+	if err != nil {
+		return fmt.Errorf("list queue directory: %w", err)
+	}
+	for _, entry := range entries {
+		if entry.Type().IsRegular() && strings.HasPrefix(entry.Name(), webhookStagingFilePrefix) {
+			if err := q.root.Remove(entry.Name()); err != nil && !errors.Is(err, os.ErrNotExist) {
+				return fmt.Errorf("remove stale staging file: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+// pending lists queued message files oldest first.
+func (q *webhookQueue) pending() ([]string, error) {
+	q.mu.Lock()
+	root := q.root
+	q.mu.Unlock()
+	if root == nil {
+		return nil, errors.New("queue is closed")
+	}
+	dir, err := root.Open(".")
+	if err != nil {
+		return nil, fmt.Errorf("open queue directory: %w", err)
+	}
+	defer dir.Close() //nolint:errcheck
+	entries, err := dir.ReadDir(-1)
+	if err != nil {
+		return nil, fmt.Errorf("list queue directory: %w", err)
+	}
+	var names []string
+	for _, entry := range entries {
+		if entry.Type().IsRegular() && strings.HasPrefix(entry.Name(), webhookQueueFilePrefix) {
+			names = append(names, entry.Name())
+		}
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// webhookQueueSeq extracts the sequence number from a queue file name.
+func webhookQueueSeq(name string) int64 {
+	seq, _, _ := strings.Cut(strings.TrimPrefix(name, webhookQueueFilePrefix), "-")
+	n, _ := strconv.ParseInt(seq, 10, 64)
+	return n
+}
+
+// jitterBackoff returns a random duration in [d/2, d).
+func jitterBackoff(d time.Duration) time.Duration {
+	if d <= 1 {
+		return d
+	}
+	half := d / 2
+	return half + mathrand.N(d-half)
+}
+
+// sleepCtx waits for d and reports false if ctx ended first.
+func sleepCtx(ctx context.Context, d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// parseWebhookAsyncConfig reads async_publish and the retry backoff bounds.
+// Asynchronous publishing needs state_dir so accepted events survive restarts.
+func parseWebhookAsyncConfig(raw map[string]any, cfg *webhookConfig) error {
+	cfg.AsyncPublish, _ = raw["async_publish"].(bool)
+
+	durations := []struct {
+		key  string
+		dest *time.Duration
+		def  time.Duration
+	}{
+		{"retry_initial_backoff", &cfg.RetryInitialBackoff, defaultWebhookRetryInitialBackoff},
+		{"retry_max_backoff", &cfg.RetryMaxBackoff, defaultWebhookRetryMaxBackoff},
+	}
+	for _, d := range durations {
+		*d.dest = d.def
+		switch v := raw[d.key].(type) {
+		case nil:
+		case string:
+			parsed, err := time.ParseDuration(strings.TrimSpace(v))
+			if err != nil {
+				return fmt.Errorf("config.%s is invalid: %w", d.key, err)
+			}
+			if parsed <= 0 {
+				return fmt.Errorf("config.%s must be positive", d.key)
+			}
+			*d.dest = parsed
+		default:
+			return fmt.Errorf("config.%s must be a duration string", d.key)
+		}
+	}
+	if cfg.RetryMaxBackoff < cfg.RetryInitialBackoff {
+		return fmt.Errorf("config.retry_max_backoff must not be less than retry_initial_backoff")
+	}
+	if cfg.AsyncPublish && cfg.StateDir == "" {
+		return fmt.Errorf("config.async_publish requires config.state_dir")
+	}
+	return nil
+}
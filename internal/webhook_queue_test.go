@@ -0,0 +1,210 @@
+package internal
+
+import (
+	"errors"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+)
+
+// recordingPublisher records published topics and fails the first failures calls.
+type recordingPublisher struct {
+	mu        sync.Mutex
+	failures  int
+	topics    []string
+	published chan struct{}
+}
+
+func newRecordingPublisher(failures int) *recordingPublisher {
+	return &recordingPublisher{failures: failures, published: make(chan struct{}, 16)}
+}
+
+func (p *recordingPublisher) publish(topic string, _ []byte, _ map[string]string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.failures > 0 {
+		p.failures--
+		return errors.New("broker unavailable")
+	}
+	p.topics = append(p.topics, topic)
+	p.published <- struct{}{}
+	return nil
+}
+
+func (p *recordingPublisher) waitFor(t *testing.T, n int) []string {
+	t.Helper()
+	for i := 0; i < n; i++ {
+		select {
+		case <-p.published:
+		case <-time.After(5 * time.Second):
+			t.Fatalf("timed out waiting for publish %d of %d", i+1, n)
+		}
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]string(nil), p.topics...)
+}
+
+func TestWebhookQueue_RetriesInOrder(t *testing.T) {
+	q := newWebhookQueue(t.TempDir(), time.Millisecond, 4*time.Millisecond)
+	if err := q.open(); err != nil {
+		t.Fatalf("open: %v", err)
+	}
+	defer q.close() //nolint:errcheck
+
+	for _, topic := range []string{"first", "second"} {
+		if err := q.enqueue(topic, []byte(`{}`), nil); err != nil {
+			t.Fatalf("enqueue: %v", err)
+		}
+	}
+	pub := newRecordingPublisher(3)
+	q.start(pub.publish)
+
+	topics := pub.waitFor(t, 2)
+	if len(topics) != 2 || topics[0] != "first" || topics[1] != "second" {
+		t.Errorf("expected [first second], got %v", topics)
+	}
+	names, err := q.pending()
+	if err != nil {
+		t.Fatalf("pending: %v", err)
+	}
+	if len(names) != 0 {
+		t.Errorf("expected queue to be empty, got %v", names)
+	}
+}
+
+func TestWebhookQueue_SurvivesRestart(t *testing.T) {
+	dir := t.TempDir()
+	first := newWebhookQueue(dir, time.Millisecond, time.Millisecond)
+	if err := first.open(); err != nil {
+		t.Fatalf("open: %v", err)
+	}
+	if err := first.enqueue("kept", []byte(`{}`), map[string]string{"k": "v"}); err != nil {
+		t.Fatalf("enqueue: %v", err)
+	}
+	if err := first.close(); err != nil {
+		t.Fatalf("close: %v", err)
+	}
+
+	second := newWebhookQueue(dir, time.Millisecond, time.Millisecond)
+	if err := second.open(); err != nil {
+		t.Fatalf("reopen: %v", err)
+	}
+	defer second.close() //nolint:errcheck
+	pub := newRecordingPublisher(0)
+	second.start(pub.publish)
+	if topics := pub.waitFor(t, 1); topics[0] != "kept" {
+		t.Errorf("expected queued event to be published after restart, got %v", topics)
+	}
+}
+
+func TestWebhookQueue_RemovesStaleStagingFiles(t *testing.T) {
+	dir := t.TempDir()
+	stale := filepath.Join(dir, webhookStagingFilePrefix+"0123456789abcdef")
+	if err := os.WriteFile(stale, []byte(`{"version":1}`), 0o600); err != nil {
+		t.Fatalf("write staging file: %v", err)
+	}
+
+	q := newWebhookQueue(dir, time.Millisecond, time.Millisecond)
+	if err := q.open(); err != nil {
+		t.Fatalf("open: %v", err)
+	}
+	defer q.close() //nolint:errcheck
+	if _, err := os.Stat(stale); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("expected the stale staging file to be removed, got %v", err)
+	}
+	if err := q.enqueue("after", []byte(`{}`), nil); err != nil {
+		t.Fatalf("enqueue: %v", err)
+	}
+	if names, err := q.pending(); err != nil || len(names) != 1 {
+		t.Errorf("expected 1 queued event, got %v (%v)", names, err)
+	}
+}
+
+func TestWebhookQueue_CloseDoesNotWaitForHungPublish(t *testing.T) {
+	q := newWebhookQueue(t.TempDir(), time.Millisecond, time.Millisecond)
+	q.stopTimeout = 50 * time.Millisecond
+	if err := q.open(); err != nil {
+		t.Fatalf("open: %v", err)
+	}
+	if err := q.enqueue("stuck", []byte(`{}`), nil); err != nil {
+		t.Fatalf("enqueue: %v", err)
+	}
+	started := make(chan struct{})
+	release := make(chan struct{})
+	defer close(release)
+	q.start(func(string, []byte, map[string]string) error {
+		close(started)
+		<-release
+		return nil
+	})
+	<-started
+
+	start := time.Now()
+	if err := q.close(); err == nil {
+		t.Error("expected close to report the abandoned publish")
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("expected close to give up after the stop timeout, took %s", elapsed)
+	}
+}
+
+func TestParseWebhookAsyncConfig(t *testing.T) {
+	if _, err := parseWebhookConfig(map[string]any{"async_publish": true}); err == nil {
+		t.Error("expected async_publish without state_dir to fail")
+	}
+	if _, err := parseWebhookConfig(map[string]any{"retry_initial_backoff": "1m", "retry_max_backoff": "1s"}); err == nil {
+		t.Error("expected retry_max_backoff below retry_initial_backoff to fail")
+	}
+	cfg, err := parseWebhookConfig(map[string]any{"async_publish": true, "state_dir": t.TempDir()})
+	if err != nil {
+		t.Fatalf("parseWebhookConfig: %v", err)
+	}
+	if cfg.RetryInitialBackoff != defaultWebhookRetryInitialBackoff || cfg.RetryMaxBackoff != defaultWebhookRetryMaxBackoff {
+		t.Errorf("expected default backoff bounds, got %s/%s", cfg.RetryInitialBackoff, cfg.RetryMaxBackoff)
+	}
+}
+
+func TestHandleWebhook_AsyncPublish(t *testing.T) {
+	m := newTestWebhookModule(t, map[string]any{
+		"async_publish":         true,
+		"state_dir":             t.TempDir(),
+		"retry_initial_backoff": "1ms",
+		"retry_max_backoff":     "1ms",
+	})
+	if err := m.Init(); err != nil {
+		t.Fatalf("Init: %v", err)
+	}
+	defer m.Stop(t.Context()) //nolint:errcheck
+	pub := &fakePublisher{}
+	m.SetMessagePublisher(pub)
+
+	rr := doRequest(t, m, http.MethodPost, "push", []byte(`{}`), nil)
+	if rr.Code != http.StatusAccepted {
+		t.Fatalf("expected 202, got %d: %s", rr.Code, rr.Body.String())
+	}
+	if rr.Body.String() != `{"status":"queued"}` {
+		t.Errorf("expected queued status, got %s", rr.Body.String())
+	}
+	if len(pub.messages) != 0 {
+		t.Fatalf("expected nothing published before Start, got %d", len(pub.messages))
+	}
+
+	if err := m.Start(t.Context()); err != nil {
+		t.Fatalf("Start: %v", err)
+	}
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		names, _ := m.queue.pending()
+		if len(names) == 0 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("timed out waiting for the queue to drain")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
@@ -0,0 +1,140 @@
+package internal
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// webhookTopicPlaceholder matches {name} placeholders in topic templates.
+var webhookTopicPlaceholder = regexp.MustCompile(`\{([a-z_]*)\}`)
+
+// webhookTopicFields are the placeholders a topic template may use.
+var webhookTopicFields = map[string]func(*GitEvent) string{
+	"event_type": func(e *GitEvent) string { return e.EventType },
+	"action":     func(e *GitEvent) string { return e.Action },
+	"repository": func(e *GitEvent) string { return e.Repository },
+	"owner": func(e *GitEvent) string {
+		owner, _, _ := strings.Cut(e.Repository, "/")
+		return owner
+	},
+	"repo": func(e *GitEvent) string {
+		_, repo, _ := strings.Cut(e.Repository, "/")
+		return repo
+	},
+}
+
+// webhookRoute sends matching events to its own topic.
+type webhookRoute struct {
+	// eventType is the event type to match; "*" matches every type.
+	eventType string
+	// actions restricts the route to these actions; nil matches every action.
+	actions      map[string]bool
+	repositories []string
+	topic        string
+}
+
+// parseWebhookRoutes reads the routes table. Each route has an event
+// ("pull_request" or "pull_request:opened,synchronize"), optional repository
+// globs, and a topic template.
+func parseWebhookRoutes(raw map[string]any, cfg *webhookConfig) error {
+	if err := validateTopicTemplate(cfg.Topic); err != nil {
+		return fmt.Errorf("config.topic: %w", err)
+	}
+
+	routes, ok := raw["routes"].([]any)
+	if !ok {
+		if raw["routes"] != nil {
+			return fmt.Errorf("config.routes must be a list")
+		}
+		return nil
+	}
+	for i, r := range routes {
+		entry, ok := r.(map[string]any)
+		if !ok {
+			return fmt.Errorf("config.routes[%d] must be a map", i)
+		}
+		var route webhookRoute
+
+		event, _ := entry["event"].(string)
+		eventType, actionList, qualified := strings.Cut(strings.TrimSpace(event), ":")
+		route.eventType = strings.TrimSpace(eventType)
+		if route.eventType == "" {
+			return fmt.Errorf("config.routes[%d].event is required", i)
+		}
+		if qualified {
+			route.actions = make(map[string]bool)
+			for _, action := range strings.Split(actionList, ",") {
+				if action = strings.TrimSpace(action); action != "" {
+					route.actions[action] = true
+				}
+			}
+			if len(route.actions) == 0 {
+				return fmt.Errorf("config.routes[%d].event %q lists no actions", i, event)
+			}
+		}
+
+		if repos, ok := entry["repositories"].([]any); ok {
+			for _, item := range repos {
+				pattern, _ := item.(string)
+				if pattern = strings.TrimSpace(pattern); pattern == "" {
+					return fmt.Errorf("config.routes[%d].repositories entries must be non-empty strings", i)
+				}
+				route.repositories = append(route.repositories, pattern)
+			}
+		}
+
+		route.topic, _ = entry["topic"].(string)
+		if route.topic == "" {
+			return fmt.Errorf("config.routes[%d].topic is required", i)
+		}
+		if err := validateTopicTemplate(route.topic); err != nil {
+			return fmt.Errorf("config.routes[%d].topic: %w", i, err)
+		}
+		cfg.Routes = append(cfg.Routes, route)
+	}
+	return nil
+}
+
+// validateTopicTemplate rejects placeholders that topicFor cannot fill.
+func validateTopicTemplate(topic string) error {
+	for _, m := range webhookTopicPlaceholder.FindAllStringSubmatch(topic, -1) {
+		if _, ok := webhookTopicFields[m[1]]; !ok {
+			return fmt.Errorf("unknown placeholder %s", m[0])
+		}
+	}
+	return nil
+}
+
+// matches reports whether the route applies to event.
+func (r webhookRoute) matches(event *GitEvent) bool {
+	if r.eventType != "*" && r.eventType != event.EventType {
+		return false
+	}
+	if r.actions != nil && !r.actions[event.Action] {
+		return false
+	}
+	if len(r.repositories) > 0 && !matchAnyGlob(r.repositories, strings.ToLower(event.Repository), true) {
+		return false
+	}
+	return true
+}
+
+// topicFor returns the topic for event: the first matching route's topic, or
+// the default topic. Placeholders without a value render as "none".
+func (cfg webhookConfig) topicFor(event *GitEvent) string {
+	topic := cfg.Topic
+	for _, route := range cfg.Routes {
+		if route.matches(event) {
+			topic = route.topic
+			break
+		}
+	}
+	return webhookTopicPlaceholder.ReplaceAllStringFunc(topic, func(placeholder string) string {
+		value := webhookTopicFields[placeholder[1:len(placeholder)-1]](event)
+		if value == "" {
+			return "none"
+		}
+		return value
+	})
+}
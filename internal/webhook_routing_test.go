@@ -0,0 +1,55 @@
+package internal
+
+import "testing"
+
+func TestWebhookTopicFor_DefaultTemplate(t *testing.T) {
+	cfg, err := parseWebhookConfig(map[string]any{"topic": "git.events.{event_type}.{action}"})
+	if err != nil {
+		t.Fatalf("parseWebhookConfig: %v", err)
+	}
+	if got := cfg.topicFor(&GitEvent{EventType: "pull_request", Action: "opened"}); got != "git.events.pull_request.opened" {
+		t.Errorf("expected git.events.pull_request.opened, got %q", got)
+	}
+	if got := cfg.topicFor(&GitEvent{EventType: "push"}); got != "git.events.push.none" {
+		t.Errorf("expected git.events.push.none, got %q", got)
+	}
+}
+
+func TestWebhookTopicFor_Routes(t *testing.T) {
+	cfg, err := parseWebhookConfig(map[string]any{
+		"routes": []any{
+			map[string]any{"event": "pull_request:opened,synchronize", "topic": "prs.updated"},
+			map[string]any{"event": "*", "repositories": []any{"my-org/infra-*"}, "topic": "infra.{owner}.{repo}"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("parseWebhookConfig: %v", err)
+	}
+	cases := []struct {
+		event GitEvent
+		want  string
+	}{
+		{GitEvent{EventType: "pull_request", Action: "synchronize", Repository: "my-org/infra-dns"}, "prs.updated"},
+		{GitEvent{EventType: "pull_request", Action: "closed", Repository: "my-org/infra-dns"}, "infra.my-org.infra-dns"},
+		{GitEvent{EventType: "push", Repository: "my-org/app"}, "git.events"},
+	}
+	for _, tc := range cases {
+		if got := cfg.topicFor(&tc.event); got != tc.want {
+			t.Errorf("topicFor(%s:%s %s) = %q, want %q", tc.event.EventType, tc.event.Action, tc.event.Repository, got, tc.want)
+		}
+	}
+}
+
+func TestParseWebhookRoutes_Invalid(t *testing.T) {
+	for name, raw := range map[string]map[string]any{
+		"unknown placeholder": {"topic": "git.{branch}"},
+		"missing event":       {"routes": []any{map[string]any{"topic": "t"}}},
+		"missing topic":       {"routes": []any{map[string]any{"event": "push"}}},
+		"empty actions":       {"routes": []any{map[string]any{"event": "push:", "topic": "t"}}},
+		"not a list":          {"routes": "push=t"},
+	} {
+		if _, err := parseWebhookConfig(raw); err == nil {
+			t.Errorf("%s: expected error", name)
+		}
+	}
+}
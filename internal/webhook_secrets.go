@@ -0,0 +1,96 @@
+package internal
+
+import (
+	"crypto/hmac"
+	"crypto/sha1" //nolint:gosec // X-Hub-Signature is SHA-1 by definition; opt-in for older GHES.
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// webhookSecret is one accepted webhook secret. Several may be active while a
+// secret is rotated across repositories.
+type webhookSecret struct {
+	Name  string `yaml:"name"`
+	Value string `yaml:"secret"`
+	// ExpiresAt stops the secret from verifying deliveries after this time.
+	// Zero means it never expires.
+	ExpiresAt time.Time `yaml:"expires_at"`
+}
+
+// parseWebhookSecrets reads secret, secrets, and allow_sha1_signature. The
+// single secret, when set, is tried first under the name "secret".
+func parseWebhookSecrets(raw map[string]any, cfg *webhookConfig) error {
+	if cfg.Secret != "" {
+		cfg.Secrets = append(cfg.Secrets, webhookSecret{Name: "secret", Value: cfg.Secret})
+	}
+
+	if raw["secrets"] != nil {
+		entries, ok := raw["secrets"].([]any)
+		if !ok {
+			return fmt.Errorf("config.secrets must be a list")
+		}
+		for i, e := range entries {
+			entry, ok := e.(map[string]any)
+			if !ok {
+				return fmt.Errorf("config.secrets[%d] must be a map", i)
+			}
+			var secret webhookSecret
+			secret.Value, _ = entry["secret"].(string)
+			if secret.Value == "" {
+				return fmt.Errorf("config.secrets[%d].secret is required", i)
+			}
+			secret.Name, _ = entry["name"].(string)
+			if secret.Name == "" {
+				secret.Name = fmt.Sprintf("secrets[%d]", i)
+			}
+			if expires, _ := entry["expires_at"].(string); strings.TrimSpace(expires) != "" {
+				t, err := time.Parse(time.RFC3339, strings.TrimSpace(expires))
+				if err != nil {
+					return fmt.Errorf("config.secrets[%d].expires_at must be an RFC 3339 time: %w", i, err)
+				}
+				secret.ExpiresAt = t
+			}
+			cfg.Secrets = append(cfg.Secrets, secret)
+		}
+	}
+
+	cfg.AllowSHA1Signature, _ = raw["allow_sha1_signature"].(bool)
+	return nil
+}
+
+// verifySignature checks a delivery against every unexpired secret and
+// returns the name of the one that signed it. The legacy SHA-1 header is only
+// consulted when allow_sha1_signature is set and no SHA-256 header was sent.
+func (cfg webhookConfig) verifySignature(body []byte, sha256Sig, sha1Sig string, now time.Time) (string, bool) {
+	for _, secret := range cfg.Secrets {
+		if !secret.ExpiresAt.IsZero() && !now.Before(secret.ExpiresAt) {
+			continue
+		}
+		switch {
+		case sha256Sig != "":
+			if validateSignature(body, secret.Value, sha256Sig) {
+				return secret.Name, true
+			}
+		case cfg.AllowSHA1Signature && sha1Sig != "":
+			if validateSignatureSHA1(body, secret.Value, sha1Sig) {
+				return secret.Name, true
+			}
+		}
+	}
+	return "", false
+}
+
+// validateSignatureSHA1 verifies a legacy X-Hub-Signature HMAC-SHA1
+// signature in the format "sha1=<hex>".
+func validateSignatureSHA1(body []byte, secret, sig string) bool {
+	const prefix = "sha1="
+	if !strings.HasPrefix(sig, prefix) {
+		return false
+	}
+	mac := hmac.New(sha1.New, []byte(secret))
+	mac.Write(body)
+	expected := hex.EncodeToString(mac.Sum(nil))
+	return hmac.Equal([]byte(sig[len(prefix):]), []byte(expected))
+}
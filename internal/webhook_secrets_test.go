@@ -0,0 +1,79 @@
+package internal
+
+import (
+	"crypto/hmac"
+	"crypto/sha1" //nolint:gosec // exercising the legacy X-Hub-Signature header.
+	"encoding/hex"
+	"testing"
+	"time"
+)
+
+func signBodySHA1(secret string, body []byte) string {
+	mac := hmac.New(sha1.New, []byte(secret))
+	mac.Write(body)
+	return "sha1=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func TestVerifySignature_RotatedSecrets(t *testing.T) {
+	cfg, err := parseWebhookConfig(map[string]any{
+		"secret": "current",
+		"secrets": []any{
+			map[string]any{"name": "previous", "secret": "old", "expires_at": "2026-12-01T00:00:00Z"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("parseWebhookConfig: %v", err)
+	}
+	body := []byte(`{}`)
+	before := time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC)
+	after := time.Date(2026, 12, 2, 0, 0, 0, 0, time.UTC)
+
+	if name, ok := cfg.verifySignature(body, signBody("current", body), "", before); !ok || name != "secret" {
+		t.Errorf("expected current secret to match as %q, got %q/%v", "secret", name, ok)
+	}
+	if name, ok := cfg.verifySignature(body, signBody("old", body), "", before); !ok || name != "previous" {
+		t.Errorf("expected previous secret to match before expiry, got %q/%v", name, ok)
+	}
+	if _, ok := cfg.verifySignature(body, signBody("old", body), "", after); ok {
+		t.Error("expected previous secret to be rejected after expiry")
+	}
+	if _, ok := cfg.verifySignature(body, signBody("unknown", body), "", before); ok {
+		t.Error("expected unknown secret to be rejected")
+	}
+}
+
+func TestVerifySignature_SHA1OptIn(t *testing.T) {
+	body := []byte(`{}`)
+	sig := signBodySHA1("s", body)
+
+	cfg, err := parseWebhookConfig(map[string]any{"secret": "s"})
+	if err != nil {
+		t.Fatalf("parseWebhookConfig: %v", err)
+	}
+	if _, ok := cfg.verifySignature(body, "", sig, time.Now()); ok {
+		t.Error("expected SHA-1 signature to be rejected without allow_sha1_signature")
+	}
+
+	cfg, err = parseWebhookConfig(map[string]any{"secret": "s", "allow_sha1_signature": true})
+	if err != nil {
+		t.Fatalf("parseWebhookConfig: %v", err)
+	}
+	if _, ok := cfg.verifySignature(body, "", sig, time.Now()); !ok {
+		t.Error("expected SHA-1 signature to be accepted with allow_sha1_signature")
+	}
+	if _, ok := cfg.verifySignature(body, "sha256=bad", sig, time.Now()); ok {
+		t.Error("expected SHA-1 fallback to be skipped when a SHA-256 signature is sent")
+	}
+}
+
+func TestParseWebhookSecrets_Invalid(t *testing.T) {
+	for name, raw := range map[string]map[string]any{
+		"not a list":     {"secrets": "s"},
+		"missing secret": {"secrets": []any{map[string]any{"name": "x"}}},
+		"bad expiry":     {"secrets": []any{map[string]any{"secret": "s", "expires_at": "tomorrow"}}},
+	} {
+		if _, err := parseWebhookConfig(raw); err == nil {
+			t.Errorf("%s: expected error", name)
+		}
+	}
+}